@@ -86,6 +86,16 @@ const (
 	RuleOperator_RULE_OPERATOR_IN               RuleOperator = 9
 	RuleOperator_RULE_OPERATOR_NOT_IN           RuleOperator = 10
 	RuleOperator_RULE_OPERATOR_NOT              RuleOperator = 11
+	// 下面的运算符只能用于字符串属性
+	RuleOperator_RULE_OPERATOR_STARTS_WITH RuleOperator = 12
+	RuleOperator_RULE_OPERATOR_ENDS_WITH   RuleOperator = 13
+	RuleOperator_RULE_OPERATOR_CONTAINS    RuleOperator = 14
+	// 正则表达式，不要求完整匹配
+	RuleOperator_RULE_OPERATOR_MATCHES            RuleOperator = 15
+	RuleOperator_RULE_OPERATOR_EQUALS_IGNORE_CASE RuleOperator = 16
+	// 下面的运算符只能用于 IP 属性，value 是单个网段或者网段的 JSON 数组
+	RuleOperator_RULE_OPERATOR_IN_CIDR     RuleOperator = 17
+	RuleOperator_RULE_OPERATOR_NOT_IN_CIDR RuleOperator = 18
 )
 
 // Enum value maps for RuleOperator.
@@ -103,20 +113,34 @@ var (
 		9:  "RULE_OPERATOR_IN",
 		10: "RULE_OPERATOR_NOT_IN",
 		11: "RULE_OPERATOR_NOT",
+		12: "RULE_OPERATOR_STARTS_WITH",
+		13: "RULE_OPERATOR_ENDS_WITH",
+		14: "RULE_OPERATOR_CONTAINS",
+		15: "RULE_OPERATOR_MATCHES",
+		16: "RULE_OPERATOR_EQUALS_IGNORE_CASE",
+		17: "RULE_OPERATOR_IN_CIDR",
+		18: "RULE_OPERATOR_NOT_IN_CIDR",
 	}
 	RuleOperator_value = map[string]int32{
-		"RULE_OPERATOR_UNKNOWN":          0,
-		"RULE_OPERATOR_EQUALS":           1,
-		"RULE_OPERATOR_NOT_EQUALS":       2,
-		"RULE_OPERATOR_GREATER":          3,
-		"RULE_OPERATOR_LESS":             4,
-		"RULE_OPERATOR_GREATER_OR_EQUAL": 5,
-		"RULE_OPERATOR_LESS_OR_EQUAL":    6,
-		"RULE_OPERATOR_AND":              7,
-		"RULE_OPERATOR_OR":               8,
-		"RULE_OPERATOR_IN":               9,
-		"RULE_OPERATOR_NOT_IN":           10,
-		"RULE_OPERATOR_NOT":              11,
+		"RULE_OPERATOR_UNKNOWN":            0,
+		"RULE_OPERATOR_EQUALS":             1,
+		"RULE_OPERATOR_NOT_EQUALS":         2,
+		"RULE_OPERATOR_GREATER":            3,
+		"RULE_OPERATOR_LESS":               4,
+		"RULE_OPERATOR_GREATER_OR_EQUAL":   5,
+		"RULE_OPERATOR_LESS_OR_EQUAL":      6,
+		"RULE_OPERATOR_AND":                7,
+		"RULE_OPERATOR_OR":                 8,
+		"RULE_OPERATOR_IN":                 9,
+		"RULE_OPERATOR_NOT_IN":             10,
+		"RULE_OPERATOR_NOT":                11,
+		"RULE_OPERATOR_STARTS_WITH":        12,
+		"RULE_OPERATOR_ENDS_WITH":          13,
+		"RULE_OPERATOR_CONTAINS":           14,
+		"RULE_OPERATOR_MATCHES":            15,
+		"RULE_OPERATOR_EQUALS_IGNORE_CASE": 16,
+		"RULE_OPERATOR_IN_CIDR":            17,
+		"RULE_OPERATOR_NOT_IN_CIDR":        18,
 	}
 )
 
@@ -205,6 +229,8 @@ const (
 	DataType_DATA_TYPE_BOOLEAN  DataType = 3
 	DataType_DATA_TYPE_FLOAT    DataType = 4
 	DataType_DATA_TYPE_DATETIME DataType = 5
+	// IPv4 或者 IPv6 地址，例如作为环境属性传入的客户端 IP
+	DataType_DATA_TYPE_IP DataType = 6
 )
 
 // Enum value maps for DataType.
@@ -216,6 +242,7 @@ var (
 		3: "DATA_TYPE_BOOLEAN",
 		4: "DATA_TYPE_FLOAT",
 		5: "DATA_TYPE_DATETIME",
+		6: "DATA_TYPE_IP",
 	}
 	DataType_value = map[string]int32{
 		"DATA_TYPE_UNKNOWN":  0,
@@ -224,6 +251,7 @@ var (
 		"DATA_TYPE_BOOLEAN":  3,
 		"DATA_TYPE_FLOAT":    4,
 		"DATA_TYPE_DATETIME": 5,
+		"DATA_TYPE_IP":       6,
 	}
 )
 
@@ -308,15 +336,24 @@ func (EntityType) EnumDescriptor() ([]byte, []int) {
 
 // Policy related messages
 type Policy struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Status        PolicyStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=permission.v1.PolicyStatus" json:"status,omitempty"`
-	Effect        Effect                 `protobuf:"varint,6,opt,name=effect,proto3,enum=permission.v1.Effect" json:"effect,omitempty"`
-	Rules         []*PolicyRule          `protobuf:"bytes,7,rep,name=rules,proto3" json:"rules,omitempty"`
-	Ctime         int64                  `protobuf:"varint,8,opt,name=ctime,proto3" json:"ctime,omitempty"`
-	Utime         int64                  `protobuf:"varint,9,opt,name=utime,proto3" json:"utime,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Status      PolicyStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=permission.v1.PolicyStatus" json:"status,omitempty"`
+	Effect      Effect                 `protobuf:"varint,6,opt,name=effect,proto3,enum=permission.v1.Effect" json:"effect,omitempty"`
+	Rules       []*PolicyRule          `protobuf:"bytes,7,rep,name=rules,proto3" json:"rules,omitempty"`
+	Ctime       int64                  `protobuf:"varint,8,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime       int64                  `protobuf:"varint,9,opt,name=utime,proto3" json:"utime,omitempty"`
+	// 执行方式，logic 或者 expression，为空的时候是 logic
+	ExecuteType string `protobuf:"bytes,10,opt,name=execute_type,json=executeType,proto3" json:"execute_type,omitempty"`
+	// execute_type 为 expression 时的策略表达式，例如
+	// subject.dept == resource.owner_dept && env.time in @time(09:00-18:00)
+	Expression string `protobuf:"bytes,11,opt,name=expression,proto3" json:"expression,omitempty"`
+	// 当前生效的版本，0 表示还没有发布过。Save、SaveRule 修改的是草稿，发布之后才会生效
+	PublishedVersion int64 `protobuf:"varint,12,opt,name=published_version,json=publishedVersion,proto3" json:"published_version,omitempty"`
+	// 策略关联的权限以及效果
+	Permissions   []*PolicyPermission `protobuf:"bytes,13,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -407,6 +444,156 @@ func (x *Policy) GetUtime() int64 {
 	return 0
 }
 
+func (x *Policy) GetExecuteType() string {
+	if x != nil {
+		return x.ExecuteType
+	}
+	return ""
+}
+
+func (x *Policy) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *Policy) GetPublishedVersion() int64 {
+	if x != nil {
+		return x.PublishedVersion
+	}
+	return 0
+}
+
+func (x *Policy) GetPermissions() []*PolicyPermission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type PolicyPermission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PermissionId  int64                  `protobuf:"varint,1,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	Effect        Effect                 `protobuf:"varint,2,opt,name=effect,proto3,enum=permission.v1.Effect" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyPermission) Reset() {
+	*x = PolicyPermission{}
+	mi := &file_permission_v1_abac_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyPermission) ProtoMessage() {}
+
+func (x *PolicyPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyPermission.ProtoReflect.Descriptor instead.
+func (*PolicyPermission) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PolicyPermission) GetPermissionId() int64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+func (x *PolicyPermission) GetEffect() Effect {
+	if x != nil {
+		return x.Effect
+	}
+	return Effect_EFFECT_UNKNOWN
+}
+
+// 策略发布之后的版本，不可修改
+type PolicyVersion struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Version  int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// 发布时的策略，包含规则
+	Policy        *Policy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	Ctime         int64   `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyVersion) Reset() {
+	*x = PolicyVersion{}
+	mi := &file_permission_v1_abac_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyVersion) ProtoMessage() {}
+
+func (x *PolicyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyVersion.ProtoReflect.Descriptor instead.
+func (*PolicyVersion) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PolicyVersion) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyVersion) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PolicyVersion) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *PolicyVersion) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
 type PolicyRule struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -417,13 +604,15 @@ type PolicyRule struct {
 	Operator            RuleOperator           `protobuf:"varint,6,opt,name=operator,proto3,enum=permission.v1.RuleOperator" json:"operator,omitempty"`
 	Ctime               int64                  `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
 	Utime               int64                  `protobuf:"varint,8,opt,name=utime,proto3" json:"utime,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// 不为空的时候和这个属性的取值比较，此时不使用 value，两个属性的类型必须一致
+	ValueAttributeDefinition *AttributeDefinition `protobuf:"bytes,9,opt,name=value_attribute_definition,json=valueAttributeDefinition,proto3" json:"value_attribute_definition,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *PolicyRule) Reset() {
 	*x = PolicyRule{}
-	mi := &file_permission_v1_abac_proto_msgTypes[1]
+	mi := &file_permission_v1_abac_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -435,7 +624,7 @@ func (x *PolicyRule) String() string {
 func (*PolicyRule) ProtoMessage() {}
 
 func (x *PolicyRule) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[1]
+	mi := &file_permission_v1_abac_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,7 +637,7 @@ func (x *PolicyRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyRule.ProtoReflect.Descriptor instead.
 func (*PolicyRule) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{1}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PolicyRule) GetId() int64 {
@@ -507,6 +696,13 @@ func (x *PolicyRule) GetUtime() int64 {
 	return 0
 }
 
+func (x *PolicyRule) GetValueAttributeDefinition() *AttributeDefinition {
+	if x != nil {
+		return x.ValueAttributeDefinition
+	}
+	return nil
+}
+
 // Attribute related messages
 type SubjectAttributeValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -521,7 +717,7 @@ type SubjectAttributeValue struct {
 
 func (x *SubjectAttributeValue) Reset() {
 	*x = SubjectAttributeValue{}
-	mi := &file_permission_v1_abac_proto_msgTypes[2]
+	mi := &file_permission_v1_abac_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -533,7 +729,7 @@ func (x *SubjectAttributeValue) String() string {
 func (*SubjectAttributeValue) ProtoMessage() {}
 
 func (x *SubjectAttributeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[2]
+	mi := &file_permission_v1_abac_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +742,7 @@ func (x *SubjectAttributeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubjectAttributeValue.ProtoReflect.Descriptor instead.
 func (*SubjectAttributeValue) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{2}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SubjectAttributeValue) GetId() int64 {
@@ -597,7 +793,7 @@ type ResourceAttributeValue struct {
 
 func (x *ResourceAttributeValue) Reset() {
 	*x = ResourceAttributeValue{}
-	mi := &file_permission_v1_abac_proto_msgTypes[3]
+	mi := &file_permission_v1_abac_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +805,7 @@ func (x *ResourceAttributeValue) String() string {
 func (*ResourceAttributeValue) ProtoMessage() {}
 
 func (x *ResourceAttributeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[3]
+	mi := &file_permission_v1_abac_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +818,7 @@ func (x *ResourceAttributeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceAttributeValue.ProtoReflect.Descriptor instead.
 func (*ResourceAttributeValue) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{3}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ResourceAttributeValue) GetId() int64 {
@@ -673,7 +869,7 @@ type EnvironmentAttributeValue struct {
 
 func (x *EnvironmentAttributeValue) Reset() {
 	*x = EnvironmentAttributeValue{}
-	mi := &file_permission_v1_abac_proto_msgTypes[4]
+	mi := &file_permission_v1_abac_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +881,7 @@ func (x *EnvironmentAttributeValue) String() string {
 func (*EnvironmentAttributeValue) ProtoMessage() {}
 
 func (x *EnvironmentAttributeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[4]
+	mi := &file_permission_v1_abac_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +894,7 @@ func (x *EnvironmentAttributeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentAttributeValue.ProtoReflect.Descriptor instead.
 func (*EnvironmentAttributeValue) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{4}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnvironmentAttributeValue) GetId() int64 {
@@ -746,7 +942,7 @@ type SubjectObject struct {
 
 func (x *SubjectObject) Reset() {
 	*x = SubjectObject{}
-	mi := &file_permission_v1_abac_proto_msgTypes[5]
+	mi := &file_permission_v1_abac_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -758,7 +954,7 @@ func (x *SubjectObject) String() string {
 func (*SubjectObject) ProtoMessage() {}
 
 func (x *SubjectObject) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[5]
+	mi := &file_permission_v1_abac_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +967,7 @@ func (x *SubjectObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubjectObject.ProtoReflect.Descriptor instead.
 func (*SubjectObject) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{5}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SubjectObject) GetId() int64 {
@@ -798,7 +994,7 @@ type ResourceObject struct {
 
 func (x *ResourceObject) Reset() {
 	*x = ResourceObject{}
-	mi := &file_permission_v1_abac_proto_msgTypes[6]
+	mi := &file_permission_v1_abac_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -810,7 +1006,7 @@ func (x *ResourceObject) String() string {
 func (*ResourceObject) ProtoMessage() {}
 
 func (x *ResourceObject) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[6]
+	mi := &file_permission_v1_abac_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1019,7 @@ func (x *ResourceObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceObject.ProtoReflect.Descriptor instead.
 func (*ResourceObject) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{6}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ResourceObject) GetId() int64 {
@@ -849,7 +1045,7 @@ type EnvironmentObject struct {
 
 func (x *EnvironmentObject) Reset() {
 	*x = EnvironmentObject{}
-	mi := &file_permission_v1_abac_proto_msgTypes[7]
+	mi := &file_permission_v1_abac_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -861,7 +1057,7 @@ func (x *EnvironmentObject) String() string {
 func (*EnvironmentObject) ProtoMessage() {}
 
 func (x *EnvironmentObject) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[7]
+	mi := &file_permission_v1_abac_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +1070,7 @@ func (x *EnvironmentObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentObject.ProtoReflect.Descriptor instead.
 func (*EnvironmentObject) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{7}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EnvironmentObject) GetAttributeValues() []*EnvironmentAttributeValue {
@@ -900,7 +1096,7 @@ type AttributeDefinition struct {
 
 func (x *AttributeDefinition) Reset() {
 	*x = AttributeDefinition{}
-	mi := &file_permission_v1_abac_proto_msgTypes[8]
+	mi := &file_permission_v1_abac_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -912,7 +1108,7 @@ func (x *AttributeDefinition) String() string {
 func (*AttributeDefinition) ProtoMessage() {}
 
 func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[8]
+	mi := &file_permission_v1_abac_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,7 +1121,7 @@ func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
 func (*AttributeDefinition) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{8}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AttributeDefinition) GetId() int64 {
@@ -995,7 +1191,7 @@ type BizDefinition struct {
 
 func (x *BizDefinition) Reset() {
 	*x = BizDefinition{}
-	mi := &file_permission_v1_abac_proto_msgTypes[9]
+	mi := &file_permission_v1_abac_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1007,7 +1203,7 @@ func (x *BizDefinition) String() string {
 func (*BizDefinition) ProtoMessage() {}
 
 func (x *BizDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[9]
+	mi := &file_permission_v1_abac_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1216,7 @@ func (x *BizDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BizDefinition.ProtoReflect.Descriptor instead.
 func (*BizDefinition) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{9}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BizDefinition) GetSubjectAttrs() []*AttributeDefinition {
@@ -1053,7 +1249,7 @@ type PolicyServiceSaveRequest struct {
 
 func (x *PolicyServiceSaveRequest) Reset() {
 	*x = PolicyServiceSaveRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[10]
+	mi := &file_permission_v1_abac_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1065,7 +1261,7 @@ func (x *PolicyServiceSaveRequest) String() string {
 func (*PolicyServiceSaveRequest) ProtoMessage() {}
 
 func (x *PolicyServiceSaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[10]
+	mi := &file_permission_v1_abac_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,7 +1274,7 @@ func (x *PolicyServiceSaveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceSaveRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceSaveRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{10}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PolicyServiceSaveRequest) GetPolicy() *Policy {
@@ -1089,15 +1285,17 @@ func (x *PolicyServiceSaveRequest) GetPolicy() *Policy {
 }
 
 type PolicyServiceSaveResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 保存之后分析策略发现的问题，有问题也不影响保存
+	Issues        []*PolicyIssue `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PolicyServiceSaveResponse) Reset() {
 	*x = PolicyServiceSaveResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[11]
+	mi := &file_permission_v1_abac_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1109,7 +1307,7 @@ func (x *PolicyServiceSaveResponse) String() string {
 func (*PolicyServiceSaveResponse) ProtoMessage() {}
 
 func (x *PolicyServiceSaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[11]
+	mi := &file_permission_v1_abac_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,7 +1320,7 @@ func (x *PolicyServiceSaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceSaveResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceSaveResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{11}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PolicyServiceSaveResponse) GetId() int64 {
@@ -1132,6 +1330,13 @@ func (x *PolicyServiceSaveResponse) GetId() int64 {
 	return 0
 }
 
+func (x *PolicyServiceSaveResponse) GetIssues() []*PolicyIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 type PolicyServiceDeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1141,7 +1346,7 @@ type PolicyServiceDeleteRequest struct {
 
 func (x *PolicyServiceDeleteRequest) Reset() {
 	*x = PolicyServiceDeleteRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[12]
+	mi := &file_permission_v1_abac_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1153,7 +1358,7 @@ func (x *PolicyServiceDeleteRequest) String() string {
 func (*PolicyServiceDeleteRequest) ProtoMessage() {}
 
 func (x *PolicyServiceDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[12]
+	mi := &file_permission_v1_abac_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,7 +1371,7 @@ func (x *PolicyServiceDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceDeleteRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{12}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PolicyServiceDeleteRequest) GetId() int64 {
@@ -1184,7 +1389,7 @@ type PolicyServiceDeleteResponse struct {
 
 func (x *PolicyServiceDeleteResponse) Reset() {
 	*x = PolicyServiceDeleteResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[13]
+	mi := &file_permission_v1_abac_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1196,7 +1401,7 @@ func (x *PolicyServiceDeleteResponse) String() string {
 func (*PolicyServiceDeleteResponse) ProtoMessage() {}
 
 func (x *PolicyServiceDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[13]
+	mi := &file_permission_v1_abac_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +1414,7 @@ func (x *PolicyServiceDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceDeleteResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{13}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{15}
 }
 
 type PolicyServiceFirstRequest struct {
@@ -1221,7 +1426,7 @@ type PolicyServiceFirstRequest struct {
 
 func (x *PolicyServiceFirstRequest) Reset() {
 	*x = PolicyServiceFirstRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[14]
+	mi := &file_permission_v1_abac_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1233,7 +1438,7 @@ func (x *PolicyServiceFirstRequest) String() string {
 func (*PolicyServiceFirstRequest) ProtoMessage() {}
 
 func (x *PolicyServiceFirstRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[14]
+	mi := &file_permission_v1_abac_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,7 +1451,7 @@ func (x *PolicyServiceFirstRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceFirstRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceFirstRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{14}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PolicyServiceFirstRequest) GetId() int64 {
@@ -1265,7 +1470,7 @@ type PolicyServiceFirstResponse struct {
 
 func (x *PolicyServiceFirstResponse) Reset() {
 	*x = PolicyServiceFirstResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[15]
+	mi := &file_permission_v1_abac_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1277,7 +1482,7 @@ func (x *PolicyServiceFirstResponse) String() string {
 func (*PolicyServiceFirstResponse) ProtoMessage() {}
 
 func (x *PolicyServiceFirstResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[15]
+	mi := &file_permission_v1_abac_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,7 +1495,7 @@ func (x *PolicyServiceFirstResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceFirstResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceFirstResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{15}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PolicyServiceFirstResponse) GetPolicy() *Policy {
@@ -1310,7 +1515,7 @@ type PolicyServiceSaveRuleRequest struct {
 
 func (x *PolicyServiceSaveRuleRequest) Reset() {
 	*x = PolicyServiceSaveRuleRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[16]
+	mi := &file_permission_v1_abac_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1322,7 +1527,7 @@ func (x *PolicyServiceSaveRuleRequest) String() string {
 func (*PolicyServiceSaveRuleRequest) ProtoMessage() {}
 
 func (x *PolicyServiceSaveRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[16]
+	mi := &file_permission_v1_abac_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1335,7 +1540,7 @@ func (x *PolicyServiceSaveRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceSaveRuleRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceSaveRuleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{16}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PolicyServiceSaveRuleRequest) GetPolicyId() int64 {
@@ -1361,7 +1566,7 @@ type PolicyServiceSaveRuleResponse struct {
 
 func (x *PolicyServiceSaveRuleResponse) Reset() {
 	*x = PolicyServiceSaveRuleResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[17]
+	mi := &file_permission_v1_abac_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1373,7 +1578,7 @@ func (x *PolicyServiceSaveRuleResponse) String() string {
 func (*PolicyServiceSaveRuleResponse) ProtoMessage() {}
 
 func (x *PolicyServiceSaveRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[17]
+	mi := &file_permission_v1_abac_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,7 +1591,7 @@ func (x *PolicyServiceSaveRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceSaveRuleResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceSaveRuleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{17}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PolicyServiceSaveRuleResponse) GetId() int64 {
@@ -1405,7 +1610,7 @@ type PolicyServiceDeleteRuleRequest struct {
 
 func (x *PolicyServiceDeleteRuleRequest) Reset() {
 	*x = PolicyServiceDeleteRuleRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[18]
+	mi := &file_permission_v1_abac_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1417,7 +1622,7 @@ func (x *PolicyServiceDeleteRuleRequest) String() string {
 func (*PolicyServiceDeleteRuleRequest) ProtoMessage() {}
 
 func (x *PolicyServiceDeleteRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[18]
+	mi := &file_permission_v1_abac_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1430,7 +1635,7 @@ func (x *PolicyServiceDeleteRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceDeleteRuleRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceDeleteRuleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{18}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PolicyServiceDeleteRuleRequest) GetRuleId() int64 {
@@ -1448,7 +1653,7 @@ type PolicyServiceDeleteRuleResponse struct {
 
 func (x *PolicyServiceDeleteRuleResponse) Reset() {
 	*x = PolicyServiceDeleteRuleResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[19]
+	mi := &file_permission_v1_abac_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1460,7 +1665,7 @@ func (x *PolicyServiceDeleteRuleResponse) String() string {
 func (*PolicyServiceDeleteRuleResponse) ProtoMessage() {}
 
 func (x *PolicyServiceDeleteRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[19]
+	mi := &file_permission_v1_abac_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1473,7 +1678,7 @@ func (x *PolicyServiceDeleteRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceDeleteRuleResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceDeleteRuleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{19}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{21}
 }
 
 type PolicyServiceFindPoliciesByPermissionIDsRequest struct {
@@ -1485,7 +1690,7 @@ type PolicyServiceFindPoliciesByPermissionIDsRequest struct {
 
 func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) Reset() {
 	*x = PolicyServiceFindPoliciesByPermissionIDsRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[20]
+	mi := &file_permission_v1_abac_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1497,7 +1702,7 @@ func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) String() string {
 func (*PolicyServiceFindPoliciesByPermissionIDsRequest) ProtoMessage() {}
 
 func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[20]
+	mi := &file_permission_v1_abac_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,7 +1715,7 @@ func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) ProtoReflect() protore
 
 // Deprecated: Use PolicyServiceFindPoliciesByPermissionIDsRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceFindPoliciesByPermissionIDsRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{20}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) GetPermissionIds() []int64 {
@@ -1529,7 +1734,7 @@ type PolicyServiceFindPoliciesByPermissionIDsResponse struct {
 
 func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) Reset() {
 	*x = PolicyServiceFindPoliciesByPermissionIDsResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[21]
+	mi := &file_permission_v1_abac_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1541,7 +1746,7 @@ func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) String() string {
 func (*PolicyServiceFindPoliciesByPermissionIDsResponse) ProtoMessage() {}
 
 func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[21]
+	mi := &file_permission_v1_abac_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1554,40 +1759,1320 @@ func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) ProtoReflect() protor
 
 // Deprecated: Use PolicyServiceFindPoliciesByPermissionIDsResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceFindPoliciesByPermissionIDsResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{21}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) GetPolicies() []*Policy {
 	if x != nil {
 		return x.Policies
 	}
-	return nil
+	return nil
+}
+
+type PolicyServiceSavePermissionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	PermissionId  int64                  `protobuf:"varint,2,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	Effect        Effect                 `protobuf:"varint,3,opt,name=effect,proto3,enum=permission.v1.Effect" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) Reset() {
+	*x = PolicyServiceSavePermissionPolicyRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSavePermissionPolicyRequest) ProtoMessage() {}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSavePermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSavePermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) GetPermissionId() int64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) GetEffect() Effect {
+	if x != nil {
+		return x.Effect
+	}
+	return Effect_EFFECT_UNKNOWN
+}
+
+type PolicyServiceSavePermissionPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSavePermissionPolicyResponse) Reset() {
+	*x = PolicyServiceSavePermissionPolicyResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSavePermissionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSavePermissionPolicyResponse) ProtoMessage() {}
+
+func (x *PolicyServiceSavePermissionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSavePermissionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSavePermissionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{25}
+}
+
+type PolicyServiceFindPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindPoliciesRequest) Reset() {
+	*x = PolicyServiceFindPoliciesRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindPoliciesRequest) ProtoMessage() {}
+
+func (x *PolicyServiceFindPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PolicyServiceFindPoliciesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PolicyServiceFindPoliciesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PolicyServiceFindPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Policies      []*Policy              `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindPoliciesResponse) Reset() {
+	*x = PolicyServiceFindPoliciesResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindPoliciesResponse) ProtoMessage() {}
+
+func (x *PolicyServiceFindPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PolicyServiceFindPoliciesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PolicyServiceFindPoliciesResponse) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type PolicyServicePublishRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServicePublishRequest) Reset() {
+	*x = PolicyServicePublishRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServicePublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServicePublishRequest) ProtoMessage() {}
+
+func (x *PolicyServicePublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServicePublishRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServicePublishRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PolicyServicePublishRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+type PolicyServicePublishResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServicePublishResponse) Reset() {
+	*x = PolicyServicePublishResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServicePublishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServicePublishResponse) ProtoMessage() {}
+
+func (x *PolicyServicePublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServicePublishResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServicePublishResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PolicyServicePublishResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PolicyServiceRollbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceRollbackRequest) Reset() {
+	*x = PolicyServiceRollbackRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceRollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceRollbackRequest) ProtoMessage() {}
+
+func (x *PolicyServiceRollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceRollbackRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceRollbackRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PolicyServiceRollbackRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyServiceRollbackRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PolicyServiceRollbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceRollbackResponse) Reset() {
+	*x = PolicyServiceRollbackResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceRollbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceRollbackResponse) ProtoMessage() {}
+
+func (x *PolicyServiceRollbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceRollbackResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceRollbackResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PolicyServiceRollbackResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PolicyServiceFindVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindVersionsRequest) Reset() {
+	*x = PolicyServiceFindVersionsRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindVersionsRequest) ProtoMessage() {}
+
+func (x *PolicyServiceFindVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindVersionsRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PolicyServiceFindVersionsRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+type PolicyServiceFindVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*PolicyVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindVersionsResponse) Reset() {
+	*x = PolicyServiceFindVersionsResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindVersionsResponse) ProtoMessage() {}
+
+func (x *PolicyServiceFindVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindVersionsResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PolicyServiceFindVersionsResponse) GetVersions() []*PolicyVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type PolicyServiceSimulatePolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 候选策略，会替换业务当前生效的全部策略
+	Policies []*Policy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	// 上传的校验请求
+	Requests []*CheckPermissionRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// 再从最近的校验请求里面取多少个一起重放，只能取到处理这次调用的实例上的请求。
+	// 业务需要在 abac.recentRequests 里开启记录，默认不保留实时传入的属性
+	SampleSize    int32 `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSimulatePolicyRequest) Reset() {
+	*x = PolicyServiceSimulatePolicyRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSimulatePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSimulatePolicyRequest) ProtoMessage() {}
+
+func (x *PolicyServiceSimulatePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSimulatePolicyRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSimulatePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PolicyServiceSimulatePolicyRequest) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *PolicyServiceSimulatePolicyRequest) GetRequests() []*CheckPermissionRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *PolicyServiceSimulatePolicyRequest) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+type PolicyServiceSimulatePolicyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 重放的请求数量
+	Total int32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// 判定结果发生变化的请求
+	Flips []*DecisionFlip `protobuf:"bytes,2,rep,name=flips,proto3" json:"flips,omitempty"`
+	// 无法判定的请求，例如资源已经被删除了
+	Failures      []*SimulationFailure `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSimulatePolicyResponse) Reset() {
+	*x = PolicyServiceSimulatePolicyResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSimulatePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSimulatePolicyResponse) ProtoMessage() {}
+
+func (x *PolicyServiceSimulatePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSimulatePolicyResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSimulatePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PolicyServiceSimulatePolicyResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PolicyServiceSimulatePolicyResponse) GetFlips() []*DecisionFlip {
+	if x != nil {
+		return x.Flips
+	}
+	return nil
+}
+
+func (x *PolicyServiceSimulatePolicyResponse) GetFailures() []*SimulationFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type DecisionFlip struct {
+	state   protoimpl.MessageState  `protogen:"open.v1"`
+	Request *CheckPermissionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// 当前生效的策略的判定结果
+	Before bool `protobuf:"varint,2,opt,name=before,proto3" json:"before,omitempty"`
+	// 候选策略的判定结果
+	After bool `protobuf:"varint,3,opt,name=after,proto3" json:"after,omitempty"`
+	// 导致结果变化的策略
+	Changes       []*PolicyChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecisionFlip) Reset() {
+	*x = DecisionFlip{}
+	mi := &file_permission_v1_abac_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecisionFlip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecisionFlip) ProtoMessage() {}
+
+func (x *DecisionFlip) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecisionFlip.ProtoReflect.Descriptor instead.
+func (*DecisionFlip) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DecisionFlip) GetRequest() *CheckPermissionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *DecisionFlip) GetBefore() bool {
+	if x != nil {
+		return x.Before
+	}
+	return false
+}
+
+func (x *DecisionFlip) GetAfter() bool {
+	if x != nil {
+		return x.After
+	}
+	return false
+}
+
+func (x *DecisionFlip) GetChanges() []*PolicyChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// 同一个策略在当前和候选策略中的判定过程，新增或者删除的策略另一边为空
+type PolicyChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Before        *PolicyTrace           `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After         *PolicyTrace           `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyChange) Reset() {
+	*x = PolicyChange{}
+	mi := &file_permission_v1_abac_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyChange) ProtoMessage() {}
+
+func (x *PolicyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyChange.ProtoReflect.Descriptor instead.
+func (*PolicyChange) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PolicyChange) GetBefore() *PolicyTrace {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PolicyChange) GetAfter() *PolicyTrace {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type SimulationFailure struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Request       *CheckPermissionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Error         string                  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationFailure) Reset() {
+	*x = SimulationFailure{}
+	mi := &file_permission_v1_abac_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationFailure) ProtoMessage() {}
+
+func (x *SimulationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationFailure.ProtoReflect.Descriptor instead.
+func (*SimulationFailure) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SimulationFailure) GetRequest() *CheckPermissionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *SimulationFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 策略的测试用例
+type PolicyTestCase struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PolicyId              int64                  `protobuf:"varint,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ResourceType          string                 `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceKey           string                 `protobuf:"bytes,5,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	Action                string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	SubjectAttributes     map[string]string      `protobuf:"bytes,7,rep,name=subject_attributes,json=subjectAttributes,proto3" json:"subject_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResourceAttributes    map[string]string      `protobuf:"bytes,8,rep,name=resource_attributes,json=resourceAttributes,proto3" json:"resource_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EnvironmentAttributes map[string]string      `protobuf:"bytes,9,rep,name=environment_attributes,json=environmentAttributes,proto3" json:"environment_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 预期的结果，allow 或者 deny
+	Expected      Effect `protobuf:"varint,10,opt,name=expected,proto3,enum=permission.v1.Effect" json:"expected,omitempty"`
+	Ctime         int64  `protobuf:"varint,11,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime         int64  `protobuf:"varint,12,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyTestCase) Reset() {
+	*x = PolicyTestCase{}
+	mi := &file_permission_v1_abac_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyTestCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyTestCase) ProtoMessage() {}
+
+func (x *PolicyTestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyTestCase.ProtoReflect.Descriptor instead.
+func (*PolicyTestCase) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PolicyTestCase) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PolicyTestCase) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyTestCase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PolicyTestCase) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *PolicyTestCase) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *PolicyTestCase) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PolicyTestCase) GetSubjectAttributes() map[string]string {
+	if x != nil {
+		return x.SubjectAttributes
+	}
+	return nil
+}
+
+func (x *PolicyTestCase) GetResourceAttributes() map[string]string {
+	if x != nil {
+		return x.ResourceAttributes
+	}
+	return nil
+}
+
+func (x *PolicyTestCase) GetEnvironmentAttributes() map[string]string {
+	if x != nil {
+		return x.EnvironmentAttributes
+	}
+	return nil
+}
+
+func (x *PolicyTestCase) GetExpected() Effect {
+	if x != nil {
+		return x.Expected
+	}
+	return Effect_EFFECT_UNKNOWN
+}
+
+func (x *PolicyTestCase) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *PolicyTestCase) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+type PolicyTestResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TestCase *PolicyTestCase        `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+	Passed   bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// 实际的结果，执行出错的时候为 EFFECT_UNKNOWN
+	Actual        Effect `protobuf:"varint,3,opt,name=actual,proto3,enum=permission.v1.Effect" json:"actual,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyTestResult) Reset() {
+	*x = PolicyTestResult{}
+	mi := &file_permission_v1_abac_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyTestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyTestResult) ProtoMessage() {}
+
+func (x *PolicyTestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyTestResult.ProtoReflect.Descriptor instead.
+func (*PolicyTestResult) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PolicyTestResult) GetTestCase() *PolicyTestCase {
+	if x != nil {
+		return x.TestCase
+	}
+	return nil
+}
+
+func (x *PolicyTestResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *PolicyTestResult) GetActual() Effect {
+	if x != nil {
+		return x.Actual
+	}
+	return Effect_EFFECT_UNKNOWN
+}
+
+func (x *PolicyTestResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PolicyServiceSaveTestCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TestCase      *PolicyTestCase        `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSaveTestCaseRequest) Reset() {
+	*x = PolicyServiceSaveTestCaseRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSaveTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSaveTestCaseRequest) ProtoMessage() {}
+
+func (x *PolicyServiceSaveTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSaveTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSaveTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PolicyServiceSaveTestCaseRequest) GetTestCase() *PolicyTestCase {
+	if x != nil {
+		return x.TestCase
+	}
+	return nil
+}
+
+type PolicyServiceSaveTestCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSaveTestCaseResponse) Reset() {
+	*x = PolicyServiceSaveTestCaseResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSaveTestCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSaveTestCaseResponse) ProtoMessage() {}
+
+func (x *PolicyServiceSaveTestCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSaveTestCaseResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSaveTestCaseResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PolicyServiceSaveTestCaseResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PolicyServiceDeleteTestCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceDeleteTestCaseRequest) Reset() {
+	*x = PolicyServiceDeleteTestCaseRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceDeleteTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceDeleteTestCaseRequest) ProtoMessage() {}
+
+func (x *PolicyServiceDeleteTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceDeleteTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceDeleteTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PolicyServiceDeleteTestCaseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PolicyServiceDeleteTestCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceDeleteTestCaseResponse) Reset() {
+	*x = PolicyServiceDeleteTestCaseResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceDeleteTestCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceDeleteTestCaseResponse) ProtoMessage() {}
+
+func (x *PolicyServiceDeleteTestCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceDeleteTestCaseResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceDeleteTestCaseResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{44}
+}
+
+type PolicyServiceFindTestCasesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 为 0 的时候返回业务下全部的测试用例
+	PolicyId      int64 `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindTestCasesRequest) Reset() {
+	*x = PolicyServiceFindTestCasesRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindTestCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindTestCasesRequest) ProtoMessage() {}
+
+func (x *PolicyServiceFindTestCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindTestCasesRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindTestCasesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PolicyServiceFindTestCasesRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+type PolicyServiceFindTestCasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TestCases     []*PolicyTestCase      `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindTestCasesResponse) Reset() {
+	*x = PolicyServiceFindTestCasesResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindTestCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindTestCasesResponse) ProtoMessage() {}
+
+func (x *PolicyServiceFindTestCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindTestCasesResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindTestCasesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PolicyServiceFindTestCasesResponse) GetTestCases() []*PolicyTestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+type PolicyServiceRunPolicyTestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 为 0 的时候执行业务下全部的测试用例
+	PolicyId      int64 `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceRunPolicyTestsRequest) Reset() {
+	*x = PolicyServiceRunPolicyTestsRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceRunPolicyTestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceRunPolicyTestsRequest) ProtoMessage() {}
+
+func (x *PolicyServiceRunPolicyTestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceRunPolicyTestsRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceRunPolicyTestsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PolicyServiceRunPolicyTestsRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
 }
 
-type PolicyServiceSavePermissionPolicyRequest struct {
+type PolicyServiceRunPolicyTestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
-	PermissionId  int64                  `protobuf:"varint,2,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
-	Effect        Effect                 `protobuf:"varint,3,opt,name=effect,proto3,enum=permission.v1.Effect" json:"effect,omitempty"`
+	Results       []*PolicyTestResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Passed        int32                  `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) Reset() {
-	*x = PolicyServiceSavePermissionPolicyRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[22]
+func (x *PolicyServiceRunPolicyTestsResponse) Reset() {
+	*x = PolicyServiceRunPolicyTestsResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) String() string {
+func (x *PolicyServiceRunPolicyTestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceSavePermissionPolicyRequest) ProtoMessage() {}
+func (*PolicyServiceRunPolicyTestsResponse) ProtoMessage() {}
 
-func (x *PolicyServiceSavePermissionPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[22]
+func (x *PolicyServiceRunPolicyTestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1598,53 +3083,65 @@ func (x *PolicyServiceSavePermissionPolicyRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceSavePermissionPolicyRequest.ProtoReflect.Descriptor instead.
-func (*PolicyServiceSavePermissionPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PolicyServiceRunPolicyTestsResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceRunPolicyTestsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) GetPolicyId() int64 {
+func (x *PolicyServiceRunPolicyTestsResponse) GetResults() []*PolicyTestResult {
 	if x != nil {
-		return x.PolicyId
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) GetPermissionId() int64 {
+func (x *PolicyServiceRunPolicyTestsResponse) GetPassed() int32 {
 	if x != nil {
-		return x.PermissionId
+		return x.Passed
 	}
 	return 0
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) GetEffect() Effect {
+func (x *PolicyServiceRunPolicyTestsResponse) GetFailed() int32 {
 	if x != nil {
-		return x.Effect
+		return x.Failed
 	}
-	return Effect_EFFECT_UNKNOWN
+	return 0
 }
 
-type PolicyServiceSavePermissionPolicyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 静态分析策略发现的问题
+type PolicyIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// error 或者 warning
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// undefined_attribute、type_mismatch、invalid_value、invalid_rule、unsatisfiable、always_true、redundant、conflict
+	Kind     string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	PolicyId int64  `protobuf:"varint,3,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// 有问题的规则，为 0 的时候是整个策略
+	RuleId int64 `protobuf:"varint,4,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// kind 为 conflict 的时候，冲突的另一个策略以及两个策略都关联了的权限
+	RelatedPolicyId int64  `protobuf:"varint,5,opt,name=related_policy_id,json=relatedPolicyId,proto3" json:"related_policy_id,omitempty"`
+	PermissionId    int64  `protobuf:"varint,6,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	Message         string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PolicyServiceSavePermissionPolicyResponse) Reset() {
-	*x = PolicyServiceSavePermissionPolicyResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[23]
+func (x *PolicyIssue) Reset() {
+	*x = PolicyIssue{}
+	mi := &file_permission_v1_abac_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceSavePermissionPolicyResponse) String() string {
+func (x *PolicyIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceSavePermissionPolicyResponse) ProtoMessage() {}
+func (*PolicyIssue) ProtoMessage() {}
 
-func (x *PolicyServiceSavePermissionPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[23]
+func (x *PolicyIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1655,34 +3152,83 @@ func (x *PolicyServiceSavePermissionPolicyResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceSavePermissionPolicyResponse.ProtoReflect.Descriptor instead.
-func (*PolicyServiceSavePermissionPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PolicyIssue.ProtoReflect.Descriptor instead.
+func (*PolicyIssue) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{49}
 }
 
-type PolicyServiceFindPoliciesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+func (x *PolicyIssue) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *PolicyIssue) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PolicyIssue) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyIssue) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *PolicyIssue) GetRelatedPolicyId() int64 {
+	if x != nil {
+		return x.RelatedPolicyId
+	}
+	return 0
+}
+
+func (x *PolicyIssue) GetPermissionId() int64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+func (x *PolicyIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PolicyServiceAnalyzePoliciesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 为 0 的时候分析业务下的全部策略
+	PolicyId      int64 `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceFindPoliciesRequest) Reset() {
-	*x = PolicyServiceFindPoliciesRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[24]
+func (x *PolicyServiceAnalyzePoliciesRequest) Reset() {
+	*x = PolicyServiceAnalyzePoliciesRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceFindPoliciesRequest) String() string {
+func (x *PolicyServiceAnalyzePoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceFindPoliciesRequest) ProtoMessage() {}
+func (*PolicyServiceAnalyzePoliciesRequest) ProtoMessage() {}
 
-func (x *PolicyServiceFindPoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[24]
+func (x *PolicyServiceAnalyzePoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1693,48 +3239,40 @@ func (x *PolicyServiceFindPoliciesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceFindPoliciesRequest.ProtoReflect.Descriptor instead.
-func (*PolicyServiceFindPoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *PolicyServiceFindPoliciesRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use PolicyServiceAnalyzePoliciesRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceAnalyzePoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *PolicyServiceFindPoliciesRequest) GetLimit() int32 {
+func (x *PolicyServiceAnalyzePoliciesRequest) GetPolicyId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.PolicyId
 	}
 	return 0
 }
 
-type PolicyServiceFindPoliciesResponse struct {
+type PolicyServiceAnalyzePoliciesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Policies      []*Policy              `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	Issues        []*PolicyIssue         `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceFindPoliciesResponse) Reset() {
-	*x = PolicyServiceFindPoliciesResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[25]
+func (x *PolicyServiceAnalyzePoliciesResponse) Reset() {
+	*x = PolicyServiceAnalyzePoliciesResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceFindPoliciesResponse) String() string {
+func (x *PolicyServiceAnalyzePoliciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceFindPoliciesResponse) ProtoMessage() {}
+func (*PolicyServiceAnalyzePoliciesResponse) ProtoMessage() {}
 
-func (x *PolicyServiceFindPoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[25]
+func (x *PolicyServiceAnalyzePoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1745,21 +3283,14 @@ func (x *PolicyServiceFindPoliciesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceFindPoliciesResponse.ProtoReflect.Descriptor instead.
-func (*PolicyServiceFindPoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *PolicyServiceFindPoliciesResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use PolicyServiceAnalyzePoliciesResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceAnalyzePoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *PolicyServiceFindPoliciesResponse) GetPolicies() []*Policy {
+func (x *PolicyServiceAnalyzePoliciesResponse) GetIssues() []*PolicyIssue {
 	if x != nil {
-		return x.Policies
+		return x.Issues
 	}
 	return nil
 }
@@ -1774,7 +3305,7 @@ type AttributeValueServiceSaveSubjectValueRequest struct {
 
 func (x *AttributeValueServiceSaveSubjectValueRequest) Reset() {
 	*x = AttributeValueServiceSaveSubjectValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[26]
+	mi := &file_permission_v1_abac_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1786,7 +3317,7 @@ func (x *AttributeValueServiceSaveSubjectValueRequest) String() string {
 func (*AttributeValueServiceSaveSubjectValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveSubjectValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[26]
+	mi := &file_permission_v1_abac_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +3330,7 @@ func (x *AttributeValueServiceSaveSubjectValueRequest) ProtoReflect() protorefle
 
 // Deprecated: Use AttributeValueServiceSaveSubjectValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveSubjectValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{26}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *AttributeValueServiceSaveSubjectValueRequest) GetSubjectId() int64 {
@@ -1825,7 +3356,7 @@ type AttributeValueServiceSaveSubjectValueResponse struct {
 
 func (x *AttributeValueServiceSaveSubjectValueResponse) Reset() {
 	*x = AttributeValueServiceSaveSubjectValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[27]
+	mi := &file_permission_v1_abac_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1837,7 +3368,7 @@ func (x *AttributeValueServiceSaveSubjectValueResponse) String() string {
 func (*AttributeValueServiceSaveSubjectValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveSubjectValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[27]
+	mi := &file_permission_v1_abac_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,7 +3381,7 @@ func (x *AttributeValueServiceSaveSubjectValueResponse) ProtoReflect() protorefl
 
 // Deprecated: Use AttributeValueServiceSaveSubjectValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveSubjectValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{27}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AttributeValueServiceSaveSubjectValueResponse) GetId() int64 {
@@ -1869,7 +3400,7 @@ type AttributeValueServiceDeleteSubjectValueRequest struct {
 
 func (x *AttributeValueServiceDeleteSubjectValueRequest) Reset() {
 	*x = AttributeValueServiceDeleteSubjectValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[28]
+	mi := &file_permission_v1_abac_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1881,7 +3412,7 @@ func (x *AttributeValueServiceDeleteSubjectValueRequest) String() string {
 func (*AttributeValueServiceDeleteSubjectValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteSubjectValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[28]
+	mi := &file_permission_v1_abac_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,7 +3425,7 @@ func (x *AttributeValueServiceDeleteSubjectValueRequest) ProtoReflect() protoref
 
 // Deprecated: Use AttributeValueServiceDeleteSubjectValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteSubjectValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{28}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *AttributeValueServiceDeleteSubjectValueRequest) GetId() int64 {
@@ -1912,7 +3443,7 @@ type AttributeValueServiceDeleteSubjectValueResponse struct {
 
 func (x *AttributeValueServiceDeleteSubjectValueResponse) Reset() {
 	*x = AttributeValueServiceDeleteSubjectValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[29]
+	mi := &file_permission_v1_abac_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1924,7 +3455,7 @@ func (x *AttributeValueServiceDeleteSubjectValueResponse) String() string {
 func (*AttributeValueServiceDeleteSubjectValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteSubjectValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[29]
+	mi := &file_permission_v1_abac_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1937,7 +3468,7 @@ func (x *AttributeValueServiceDeleteSubjectValueResponse) ProtoReflect() protore
 
 // Deprecated: Use AttributeValueServiceDeleteSubjectValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteSubjectValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{29}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{55}
 }
 
 type AttributeValueServiceFindSubjectValueRequest struct {
@@ -1949,7 +3480,7 @@ type AttributeValueServiceFindSubjectValueRequest struct {
 
 func (x *AttributeValueServiceFindSubjectValueRequest) Reset() {
 	*x = AttributeValueServiceFindSubjectValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[30]
+	mi := &file_permission_v1_abac_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1961,7 +3492,7 @@ func (x *AttributeValueServiceFindSubjectValueRequest) String() string {
 func (*AttributeValueServiceFindSubjectValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindSubjectValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[30]
+	mi := &file_permission_v1_abac_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1974,7 +3505,7 @@ func (x *AttributeValueServiceFindSubjectValueRequest) ProtoReflect() protorefle
 
 // Deprecated: Use AttributeValueServiceFindSubjectValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindSubjectValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{30}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *AttributeValueServiceFindSubjectValueRequest) GetSubjectId() int64 {
@@ -1993,7 +3524,7 @@ type AttributeValueServiceFindSubjectValueResponse struct {
 
 func (x *AttributeValueServiceFindSubjectValueResponse) Reset() {
 	*x = AttributeValueServiceFindSubjectValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[31]
+	mi := &file_permission_v1_abac_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2005,7 +3536,7 @@ func (x *AttributeValueServiceFindSubjectValueResponse) String() string {
 func (*AttributeValueServiceFindSubjectValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindSubjectValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[31]
+	mi := &file_permission_v1_abac_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,7 +3549,7 @@ func (x *AttributeValueServiceFindSubjectValueResponse) ProtoReflect() protorefl
 
 // Deprecated: Use AttributeValueServiceFindSubjectValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindSubjectValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{31}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *AttributeValueServiceFindSubjectValueResponse) GetSubject() *SubjectObject {
@@ -2037,7 +3568,7 @@ type AttributeValueServiceFindSubjectValueWithDefinitionRequest struct {
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) Reset() {
 	*x = AttributeValueServiceFindSubjectValueWithDefinitionRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[32]
+	mi := &file_permission_v1_abac_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2049,7 +3580,7 @@ func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) String() st
 func (*AttributeValueServiceFindSubjectValueWithDefinitionRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[32]
+	mi := &file_permission_v1_abac_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2062,7 +3593,7 @@ func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) ProtoReflec
 
 // Deprecated: Use AttributeValueServiceFindSubjectValueWithDefinitionRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindSubjectValueWithDefinitionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{32}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) GetSubjectId() int64 {
@@ -2081,7 +3612,7 @@ type AttributeValueServiceFindSubjectValueWithDefinitionResponse struct {
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) Reset() {
 	*x = AttributeValueServiceFindSubjectValueWithDefinitionResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[33]
+	mi := &file_permission_v1_abac_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2093,7 +3624,7 @@ func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) String() s
 func (*AttributeValueServiceFindSubjectValueWithDefinitionResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[33]
+	mi := &file_permission_v1_abac_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2106,7 +3637,7 @@ func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) ProtoRefle
 
 // Deprecated: Use AttributeValueServiceFindSubjectValueWithDefinitionResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindSubjectValueWithDefinitionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{33}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) GetSubject() *SubjectObject {
@@ -2126,7 +3657,7 @@ type AttributeValueServiceSaveResourceValueRequest struct {
 
 func (x *AttributeValueServiceSaveResourceValueRequest) Reset() {
 	*x = AttributeValueServiceSaveResourceValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[34]
+	mi := &file_permission_v1_abac_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2138,7 +3669,7 @@ func (x *AttributeValueServiceSaveResourceValueRequest) String() string {
 func (*AttributeValueServiceSaveResourceValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveResourceValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[34]
+	mi := &file_permission_v1_abac_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2151,7 +3682,7 @@ func (x *AttributeValueServiceSaveResourceValueRequest) ProtoReflect() protorefl
 
 // Deprecated: Use AttributeValueServiceSaveResourceValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveResourceValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{34}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *AttributeValueServiceSaveResourceValueRequest) GetResourceId() int64 {
@@ -2177,7 +3708,7 @@ type AttributeValueServiceSaveResourceValueResponse struct {
 
 func (x *AttributeValueServiceSaveResourceValueResponse) Reset() {
 	*x = AttributeValueServiceSaveResourceValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[35]
+	mi := &file_permission_v1_abac_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2189,7 +3720,7 @@ func (x *AttributeValueServiceSaveResourceValueResponse) String() string {
 func (*AttributeValueServiceSaveResourceValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveResourceValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[35]
+	mi := &file_permission_v1_abac_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2202,7 +3733,7 @@ func (x *AttributeValueServiceSaveResourceValueResponse) ProtoReflect() protoref
 
 // Deprecated: Use AttributeValueServiceSaveResourceValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveResourceValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{35}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *AttributeValueServiceSaveResourceValueResponse) GetId() int64 {
@@ -2221,7 +3752,7 @@ type AttributeValueServiceDeleteResourceValueRequest struct {
 
 func (x *AttributeValueServiceDeleteResourceValueRequest) Reset() {
 	*x = AttributeValueServiceDeleteResourceValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[36]
+	mi := &file_permission_v1_abac_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2233,7 +3764,7 @@ func (x *AttributeValueServiceDeleteResourceValueRequest) String() string {
 func (*AttributeValueServiceDeleteResourceValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteResourceValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[36]
+	mi := &file_permission_v1_abac_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2246,7 +3777,7 @@ func (x *AttributeValueServiceDeleteResourceValueRequest) ProtoReflect() protore
 
 // Deprecated: Use AttributeValueServiceDeleteResourceValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteResourceValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{36}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *AttributeValueServiceDeleteResourceValueRequest) GetId() int64 {
@@ -2264,7 +3795,7 @@ type AttributeValueServiceDeleteResourceValueResponse struct {
 
 func (x *AttributeValueServiceDeleteResourceValueResponse) Reset() {
 	*x = AttributeValueServiceDeleteResourceValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[37]
+	mi := &file_permission_v1_abac_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2276,7 +3807,7 @@ func (x *AttributeValueServiceDeleteResourceValueResponse) String() string {
 func (*AttributeValueServiceDeleteResourceValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteResourceValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[37]
+	mi := &file_permission_v1_abac_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2289,7 +3820,7 @@ func (x *AttributeValueServiceDeleteResourceValueResponse) ProtoReflect() protor
 
 // Deprecated: Use AttributeValueServiceDeleteResourceValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteResourceValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{37}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{63}
 }
 
 type AttributeValueServiceFindResourceValueRequest struct {
@@ -2301,7 +3832,7 @@ type AttributeValueServiceFindResourceValueRequest struct {
 
 func (x *AttributeValueServiceFindResourceValueRequest) Reset() {
 	*x = AttributeValueServiceFindResourceValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[38]
+	mi := &file_permission_v1_abac_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2313,7 +3844,7 @@ func (x *AttributeValueServiceFindResourceValueRequest) String() string {
 func (*AttributeValueServiceFindResourceValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindResourceValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[38]
+	mi := &file_permission_v1_abac_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2326,7 +3857,7 @@ func (x *AttributeValueServiceFindResourceValueRequest) ProtoReflect() protorefl
 
 // Deprecated: Use AttributeValueServiceFindResourceValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindResourceValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{38}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *AttributeValueServiceFindResourceValueRequest) GetResourceId() int64 {
@@ -2345,7 +3876,7 @@ type AttributeValueServiceFindResourceValueResponse struct {
 
 func (x *AttributeValueServiceFindResourceValueResponse) Reset() {
 	*x = AttributeValueServiceFindResourceValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[39]
+	mi := &file_permission_v1_abac_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2357,7 +3888,7 @@ func (x *AttributeValueServiceFindResourceValueResponse) String() string {
 func (*AttributeValueServiceFindResourceValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindResourceValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[39]
+	mi := &file_permission_v1_abac_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,7 +3901,7 @@ func (x *AttributeValueServiceFindResourceValueResponse) ProtoReflect() protoref
 
 // Deprecated: Use AttributeValueServiceFindResourceValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindResourceValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{39}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *AttributeValueServiceFindResourceValueResponse) GetResource() *ResourceObject {
@@ -2389,7 +3920,7 @@ type AttributeValueServiceFindResourceValueWithDefinitionRequest struct {
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) Reset() {
 	*x = AttributeValueServiceFindResourceValueWithDefinitionRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[40]
+	mi := &file_permission_v1_abac_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2401,7 +3932,7 @@ func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) String() s
 func (*AttributeValueServiceFindResourceValueWithDefinitionRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[40]
+	mi := &file_permission_v1_abac_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,7 +3945,7 @@ func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) ProtoRefle
 
 // Deprecated: Use AttributeValueServiceFindResourceValueWithDefinitionRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindResourceValueWithDefinitionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{40}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) GetResourceId() int64 {
@@ -2433,7 +3964,7 @@ type AttributeValueServiceFindResourceValueWithDefinitionResponse struct {
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) Reset() {
 	*x = AttributeValueServiceFindResourceValueWithDefinitionResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[41]
+	mi := &file_permission_v1_abac_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2445,7 +3976,7 @@ func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) String()
 func (*AttributeValueServiceFindResourceValueWithDefinitionResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[41]
+	mi := &file_permission_v1_abac_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2458,7 +3989,7 @@ func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) ProtoRefl
 
 // Deprecated: Use AttributeValueServiceFindResourceValueWithDefinitionResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindResourceValueWithDefinitionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{41}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) GetResource() *ResourceObject {
@@ -2477,7 +4008,7 @@ type AttributeValueServiceSaveEnvironmentValueRequest struct {
 
 func (x *AttributeValueServiceSaveEnvironmentValueRequest) Reset() {
 	*x = AttributeValueServiceSaveEnvironmentValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[42]
+	mi := &file_permission_v1_abac_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2489,7 +4020,7 @@ func (x *AttributeValueServiceSaveEnvironmentValueRequest) String() string {
 func (*AttributeValueServiceSaveEnvironmentValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveEnvironmentValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[42]
+	mi := &file_permission_v1_abac_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2502,7 +4033,7 @@ func (x *AttributeValueServiceSaveEnvironmentValueRequest) ProtoReflect() protor
 
 // Deprecated: Use AttributeValueServiceSaveEnvironmentValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveEnvironmentValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{42}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *AttributeValueServiceSaveEnvironmentValueRequest) GetValue() *EnvironmentAttributeValue {
@@ -2521,7 +4052,7 @@ type AttributeValueServiceSaveEnvironmentValueResponse struct {
 
 func (x *AttributeValueServiceSaveEnvironmentValueResponse) Reset() {
 	*x = AttributeValueServiceSaveEnvironmentValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[43]
+	mi := &file_permission_v1_abac_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2533,7 +4064,7 @@ func (x *AttributeValueServiceSaveEnvironmentValueResponse) String() string {
 func (*AttributeValueServiceSaveEnvironmentValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveEnvironmentValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[43]
+	mi := &file_permission_v1_abac_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2546,7 +4077,7 @@ func (x *AttributeValueServiceSaveEnvironmentValueResponse) ProtoReflect() proto
 
 // Deprecated: Use AttributeValueServiceSaveEnvironmentValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveEnvironmentValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{43}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *AttributeValueServiceSaveEnvironmentValueResponse) GetId() int64 {
@@ -2565,7 +4096,7 @@ type AttributeValueServiceDeleteEnvironmentValueRequest struct {
 
 func (x *AttributeValueServiceDeleteEnvironmentValueRequest) Reset() {
 	*x = AttributeValueServiceDeleteEnvironmentValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[44]
+	mi := &file_permission_v1_abac_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2577,7 +4108,7 @@ func (x *AttributeValueServiceDeleteEnvironmentValueRequest) String() string {
 func (*AttributeValueServiceDeleteEnvironmentValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteEnvironmentValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[44]
+	mi := &file_permission_v1_abac_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,7 +4121,7 @@ func (x *AttributeValueServiceDeleteEnvironmentValueRequest) ProtoReflect() prot
 
 // Deprecated: Use AttributeValueServiceDeleteEnvironmentValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteEnvironmentValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{44}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *AttributeValueServiceDeleteEnvironmentValueRequest) GetId() int64 {
@@ -2608,7 +4139,7 @@ type AttributeValueServiceDeleteEnvironmentValueResponse struct {
 
 func (x *AttributeValueServiceDeleteEnvironmentValueResponse) Reset() {
 	*x = AttributeValueServiceDeleteEnvironmentValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[45]
+	mi := &file_permission_v1_abac_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2620,7 +4151,7 @@ func (x *AttributeValueServiceDeleteEnvironmentValueResponse) String() string {
 func (*AttributeValueServiceDeleteEnvironmentValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteEnvironmentValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[45]
+	mi := &file_permission_v1_abac_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2633,7 +4164,7 @@ func (x *AttributeValueServiceDeleteEnvironmentValueResponse) ProtoReflect() pro
 
 // Deprecated: Use AttributeValueServiceDeleteEnvironmentValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteEnvironmentValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{45}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{71}
 }
 
 type AttributeValueServiceFindEnvironmentValueRequest struct {
@@ -2644,7 +4175,7 @@ type AttributeValueServiceFindEnvironmentValueRequest struct {
 
 func (x *AttributeValueServiceFindEnvironmentValueRequest) Reset() {
 	*x = AttributeValueServiceFindEnvironmentValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[46]
+	mi := &file_permission_v1_abac_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2656,7 +4187,7 @@ func (x *AttributeValueServiceFindEnvironmentValueRequest) String() string {
 func (*AttributeValueServiceFindEnvironmentValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindEnvironmentValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[46]
+	mi := &file_permission_v1_abac_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2669,7 +4200,7 @@ func (x *AttributeValueServiceFindEnvironmentValueRequest) ProtoReflect() protor
 
 // Deprecated: Use AttributeValueServiceFindEnvironmentValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindEnvironmentValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{46}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{72}
 }
 
 type AttributeValueServiceFindEnvironmentValueResponse struct {
@@ -2681,7 +4212,7 @@ type AttributeValueServiceFindEnvironmentValueResponse struct {
 
 func (x *AttributeValueServiceFindEnvironmentValueResponse) Reset() {
 	*x = AttributeValueServiceFindEnvironmentValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[47]
+	mi := &file_permission_v1_abac_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2693,7 +4224,7 @@ func (x *AttributeValueServiceFindEnvironmentValueResponse) String() string {
 func (*AttributeValueServiceFindEnvironmentValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindEnvironmentValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[47]
+	mi := &file_permission_v1_abac_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2706,7 +4237,7 @@ func (x *AttributeValueServiceFindEnvironmentValueResponse) ProtoReflect() proto
 
 // Deprecated: Use AttributeValueServiceFindEnvironmentValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindEnvironmentValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{47}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *AttributeValueServiceFindEnvironmentValueResponse) GetEnvironment() *EnvironmentObject {
@@ -2724,7 +4255,7 @@ type AttributeValueServiceFindEnvironmentValueWithDefinitionRequest struct {
 
 func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) Reset() {
 	*x = AttributeValueServiceFindEnvironmentValueWithDefinitionRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[48]
+	mi := &file_permission_v1_abac_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2736,7 +4267,7 @@ func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) String(
 func (*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[48]
+	mi := &file_permission_v1_abac_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2749,7 +4280,7 @@ func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ProtoRe
 
 // Deprecated: Use AttributeValueServiceFindEnvironmentValueWithDefinitionRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{48}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{74}
 }
 
 type AttributeValueServiceFindEnvironmentValueWithDefinitionResponse struct {
@@ -2761,7 +4292,7 @@ type AttributeValueServiceFindEnvironmentValueWithDefinitionResponse struct {
 
 func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) Reset() {
 	*x = AttributeValueServiceFindEnvironmentValueWithDefinitionResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[49]
+	mi := &file_permission_v1_abac_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2773,7 +4304,7 @@ func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) String
 func (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[49]
+	mi := &file_permission_v1_abac_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2786,7 +4317,7 @@ func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ProtoR
 
 // Deprecated: Use AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{49}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) GetEnvironment() *EnvironmentObject {
@@ -2805,7 +4336,7 @@ type AttributeDefinitionServiceSaveRequest struct {
 
 func (x *AttributeDefinitionServiceSaveRequest) Reset() {
 	*x = AttributeDefinitionServiceSaveRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[50]
+	mi := &file_permission_v1_abac_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2817,7 +4348,7 @@ func (x *AttributeDefinitionServiceSaveRequest) String() string {
 func (*AttributeDefinitionServiceSaveRequest) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceSaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[50]
+	mi := &file_permission_v1_abac_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2830,7 +4361,7 @@ func (x *AttributeDefinitionServiceSaveRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AttributeDefinitionServiceSaveRequest.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceSaveRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{50}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *AttributeDefinitionServiceSaveRequest) GetDefinition() *AttributeDefinition {
@@ -2849,7 +4380,7 @@ type AttributeDefinitionServiceSaveResponse struct {
 
 func (x *AttributeDefinitionServiceSaveResponse) Reset() {
 	*x = AttributeDefinitionServiceSaveResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[51]
+	mi := &file_permission_v1_abac_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2861,7 +4392,7 @@ func (x *AttributeDefinitionServiceSaveResponse) String() string {
 func (*AttributeDefinitionServiceSaveResponse) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceSaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[51]
+	mi := &file_permission_v1_abac_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2874,7 +4405,7 @@ func (x *AttributeDefinitionServiceSaveResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AttributeDefinitionServiceSaveResponse.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceSaveResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{51}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *AttributeDefinitionServiceSaveResponse) GetId() int64 {
@@ -2893,7 +4424,7 @@ type AttributeDefinitionServiceFirstRequest struct {
 
 func (x *AttributeDefinitionServiceFirstRequest) Reset() {
 	*x = AttributeDefinitionServiceFirstRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[52]
+	mi := &file_permission_v1_abac_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2905,7 +4436,7 @@ func (x *AttributeDefinitionServiceFirstRequest) String() string {
 func (*AttributeDefinitionServiceFirstRequest) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceFirstRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[52]
+	mi := &file_permission_v1_abac_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,7 +4449,7 @@ func (x *AttributeDefinitionServiceFirstRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AttributeDefinitionServiceFirstRequest.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceFirstRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{52}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *AttributeDefinitionServiceFirstRequest) GetId() int64 {
@@ -2937,7 +4468,7 @@ type AttributeDefinitionServiceFirstResponse struct {
 
 func (x *AttributeDefinitionServiceFirstResponse) Reset() {
 	*x = AttributeDefinitionServiceFirstResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[53]
+	mi := &file_permission_v1_abac_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2949,7 +4480,7 @@ func (x *AttributeDefinitionServiceFirstResponse) String() string {
 func (*AttributeDefinitionServiceFirstResponse) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceFirstResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[53]
+	mi := &file_permission_v1_abac_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2962,7 +4493,7 @@ func (x *AttributeDefinitionServiceFirstResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use AttributeDefinitionServiceFirstResponse.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceFirstResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{53}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *AttributeDefinitionServiceFirstResponse) GetDefinition() *AttributeDefinition {
@@ -2981,7 +4512,7 @@ type AttributeDefinitionServiceDeleteRequest struct {
 
 func (x *AttributeDefinitionServiceDeleteRequest) Reset() {
 	*x = AttributeDefinitionServiceDeleteRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[54]
+	mi := &file_permission_v1_abac_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2993,7 +4524,7 @@ func (x *AttributeDefinitionServiceDeleteRequest) String() string {
 func (*AttributeDefinitionServiceDeleteRequest) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[54]
+	mi := &file_permission_v1_abac_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,7 +4537,7 @@ func (x *AttributeDefinitionServiceDeleteRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use AttributeDefinitionServiceDeleteRequest.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{54}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *AttributeDefinitionServiceDeleteRequest) GetId() int64 {
@@ -3024,7 +4555,7 @@ type AttributeDefinitionServiceDeleteResponse struct {
 
 func (x *AttributeDefinitionServiceDeleteResponse) Reset() {
 	*x = AttributeDefinitionServiceDeleteResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[55]
+	mi := &file_permission_v1_abac_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3036,7 +4567,7 @@ func (x *AttributeDefinitionServiceDeleteResponse) String() string {
 func (*AttributeDefinitionServiceDeleteResponse) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[55]
+	mi := &file_permission_v1_abac_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3049,7 +4580,7 @@ func (x *AttributeDefinitionServiceDeleteResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use AttributeDefinitionServiceDeleteResponse.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{55}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{81}
 }
 
 type AttributeDefinitionServiceFindRequest struct {
@@ -3060,7 +4591,7 @@ type AttributeDefinitionServiceFindRequest struct {
 
 func (x *AttributeDefinitionServiceFindRequest) Reset() {
 	*x = AttributeDefinitionServiceFindRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[56]
+	mi := &file_permission_v1_abac_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3072,7 +4603,7 @@ func (x *AttributeDefinitionServiceFindRequest) String() string {
 func (*AttributeDefinitionServiceFindRequest) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceFindRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[56]
+	mi := &file_permission_v1_abac_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3085,7 +4616,7 @@ func (x *AttributeDefinitionServiceFindRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AttributeDefinitionServiceFindRequest.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceFindRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{56}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{82}
 }
 
 type AttributeDefinitionServiceFindResponse struct {
@@ -3097,7 +4628,7 @@ type AttributeDefinitionServiceFindResponse struct {
 
 func (x *AttributeDefinitionServiceFindResponse) Reset() {
 	*x = AttributeDefinitionServiceFindResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[57]
+	mi := &file_permission_v1_abac_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3109,7 +4640,7 @@ func (x *AttributeDefinitionServiceFindResponse) String() string {
 func (*AttributeDefinitionServiceFindResponse) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceFindResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[57]
+	mi := &file_permission_v1_abac_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3122,7 +4653,7 @@ func (x *AttributeDefinitionServiceFindResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AttributeDefinitionServiceFindResponse.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceFindResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{57}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *AttributeDefinitionServiceFindResponse) GetBizDefinition() *BizDefinition {
@@ -3136,7 +4667,7 @@ var File_permission_v1_abac_proto protoreflect.FileDescriptor
 
 const file_permission_v1_abac_proto_rawDesc = "" +
 	"\n" +
-	"\x18permission/v1/abac.proto\x12\rpermission.v1\"\x8f\x02\n" +
+	"\x18permission/v1/abac.proto\x12\rpermission.v1\x1a\x1epermission/v1/permission.proto\"\xc2\x03\n" +
 	"\x06Policy\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
@@ -3145,7 +4676,22 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\x06effect\x18\x06 \x01(\x0e2\x15.permission.v1.EffectR\x06effect\x12/\n" +
 	"\x05rules\x18\a \x03(\v2\x19.permission.v1.PolicyRuleR\x05rules\x12\x14\n" +
 	"\x05ctime\x18\b \x01(\x03R\x05ctime\x12\x14\n" +
-	"\x05utime\x18\t \x01(\x03R\x05utime\"\xe0\x02\n" +
+	"\x05utime\x18\t \x01(\x03R\x05utime\x12!\n" +
+	"\fexecute_type\x18\n" +
+	" \x01(\tR\vexecuteType\x12\x1e\n" +
+	"\n" +
+	"expression\x18\v \x01(\tR\n" +
+	"expression\x12+\n" +
+	"\x11published_version\x18\f \x01(\x03R\x10publishedVersion\x12A\n" +
+	"\vpermissions\x18\r \x03(\v2\x1f.permission.v1.PolicyPermissionR\vpermissions\"f\n" +
+	"\x10PolicyPermission\x12#\n" +
+	"\rpermission_id\x18\x01 \x01(\x03R\fpermissionId\x12-\n" +
+	"\x06effect\x18\x02 \x01(\x0e2\x15.permission.v1.EffectR\x06effect\"\x8b\x01\n" +
+	"\rPolicyVersion\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12-\n" +
+	"\x06policy\x18\x03 \x01(\v2\x15.permission.v1.PolicyR\x06policy\x12\x14\n" +
+	"\x05ctime\x18\x04 \x01(\x03R\x05ctime\"\xc2\x03\n" +
 	"\n" +
 	"PolicyRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12U\n" +
@@ -3156,7 +4702,8 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"right_rule\x18\x05 \x01(\v2\x19.permission.v1.PolicyRuleR\trightRule\x127\n" +
 	"\boperator\x18\x06 \x01(\x0e2\x1b.permission.v1.RuleOperatorR\boperator\x12\x14\n" +
 	"\x05ctime\x18\a \x01(\x03R\x05ctime\x12\x14\n" +
-	"\x05utime\x18\b \x01(\x03R\x05utime\"\xad\x01\n" +
+	"\x05utime\x18\b \x01(\x03R\x05utime\x12`\n" +
+	"\x1avalue_attribute_definition\x18\t \x01(\v2\".permission.v1.AttributeDefinitionR\x18valueAttributeDefinition\"\xad\x01\n" +
 	"\x15SubjectAttributeValue\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12B\n" +
 	"\n" +
@@ -3204,9 +4751,10 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\x0eresource_attrs\x18\x03 \x03(\v2\".permission.v1.AttributeDefinitionR\rresourceAttrs\x12O\n" +
 	"\x11environment_attrs\x18\x04 \x03(\v2\".permission.v1.AttributeDefinitionR\x10environmentAttrs\"I\n" +
 	"\x18PolicyServiceSaveRequest\x12-\n" +
-	"\x06policy\x18\x01 \x01(\v2\x15.permission.v1.PolicyR\x06policy\"+\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.permission.v1.PolicyR\x06policy\"_\n" +
 	"\x19PolicyServiceSaveResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\",\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x122\n" +
+	"\x06issues\x18\x02 \x03(\v2\x1a.permission.v1.PolicyIssueR\x06issues\",\n" +
 	"\x1aPolicyServiceDeleteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1d\n" +
 	"\x1bPolicyServiceDeleteResponse\"+\n" +
@@ -3236,7 +4784,98 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\"l\n" +
 	"!PolicyServiceFindPoliciesResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x03R\x05total\x121\n" +
-	"\bpolicies\x18\x02 \x03(\v2\x15.permission.v1.PolicyR\bpolicies\"\x89\x01\n" +
+	"\bpolicies\x18\x02 \x03(\v2\x15.permission.v1.PolicyR\bpolicies\":\n" +
+	"\x1bPolicyServicePublishRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\"8\n" +
+	"\x1cPolicyServicePublishResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"U\n" +
+	"\x1cPolicyServiceRollbackRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"9\n" +
+	"\x1dPolicyServiceRollbackResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"?\n" +
+	" PolicyServiceFindVersionsRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\"]\n" +
+	"!PolicyServiceFindVersionsResponse\x128\n" +
+	"\bversions\x18\x01 \x03(\v2\x1c.permission.v1.PolicyVersionR\bversions\"\xbb\x01\n" +
+	"\"PolicyServiceSimulatePolicyRequest\x121\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x15.permission.v1.PolicyR\bpolicies\x12A\n" +
+	"\brequests\x18\x02 \x03(\v2%.permission.v1.CheckPermissionRequestR\brequests\x12\x1f\n" +
+	"\vsample_size\x18\x03 \x01(\x05R\n" +
+	"sampleSize\"\xac\x01\n" +
+	"#PolicyServiceSimulatePolicyResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x121\n" +
+	"\x05flips\x18\x02 \x03(\v2\x1b.permission.v1.DecisionFlipR\x05flips\x12<\n" +
+	"\bfailures\x18\x03 \x03(\v2 .permission.v1.SimulationFailureR\bfailures\"\xb4\x01\n" +
+	"\fDecisionFlip\x12?\n" +
+	"\arequest\x18\x01 \x01(\v2%.permission.v1.CheckPermissionRequestR\arequest\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\bR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\bR\x05after\x125\n" +
+	"\achanges\x18\x04 \x03(\v2\x1b.permission.v1.PolicyChangeR\achanges\"t\n" +
+	"\fPolicyChange\x122\n" +
+	"\x06before\x18\x01 \x01(\v2\x1a.permission.v1.PolicyTraceR\x06before\x120\n" +
+	"\x05after\x18\x02 \x01(\v2\x1a.permission.v1.PolicyTraceR\x05after\"j\n" +
+	"\x11SimulationFailure\x12?\n" +
+	"\arequest\x18\x01 \x01(\v2%.permission.v1.CheckPermissionRequestR\arequest\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xa5\x06\n" +
+	"\x0ePolicyTestCase\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tpolicy_id\x18\x02 \x01(\x03R\bpolicyId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rresource_type\x18\x04 \x01(\tR\fresourceType\x12!\n" +
+	"\fresource_key\x18\x05 \x01(\tR\vresourceKey\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12c\n" +
+	"\x12subject_attributes\x18\a \x03(\v24.permission.v1.PolicyTestCase.SubjectAttributesEntryR\x11subjectAttributes\x12f\n" +
+	"\x13resource_attributes\x18\b \x03(\v25.permission.v1.PolicyTestCase.ResourceAttributesEntryR\x12resourceAttributes\x12o\n" +
+	"\x16environment_attributes\x18\t \x03(\v28.permission.v1.PolicyTestCase.EnvironmentAttributesEntryR\x15environmentAttributes\x121\n" +
+	"\bexpected\x18\n" +
+	" \x01(\x0e2\x15.permission.v1.EffectR\bexpected\x12\x14\n" +
+	"\x05ctime\x18\v \x01(\x03R\x05ctime\x12\x14\n" +
+	"\x05utime\x18\f \x01(\x03R\x05utime\x1aD\n" +
+	"\x16SubjectAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aE\n" +
+	"\x17ResourceAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aH\n" +
+	"\x1aEnvironmentAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xab\x01\n" +
+	"\x10PolicyTestResult\x12:\n" +
+	"\ttest_case\x18\x01 \x01(\v2\x1d.permission.v1.PolicyTestCaseR\btestCase\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12-\n" +
+	"\x06actual\x18\x03 \x01(\x0e2\x15.permission.v1.EffectR\x06actual\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"^\n" +
+	" PolicyServiceSaveTestCaseRequest\x12:\n" +
+	"\ttest_case\x18\x01 \x01(\v2\x1d.permission.v1.PolicyTestCaseR\btestCase\"3\n" +
+	"!PolicyServiceSaveTestCaseResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
+	"\"PolicyServiceDeleteTestCaseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"%\n" +
+	"#PolicyServiceDeleteTestCaseResponse\"@\n" +
+	"!PolicyServiceFindTestCasesRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\"b\n" +
+	"\"PolicyServiceFindTestCasesResponse\x12<\n" +
+	"\n" +
+	"test_cases\x18\x01 \x03(\v2\x1d.permission.v1.PolicyTestCaseR\ttestCases\"A\n" +
+	"\"PolicyServiceRunPolicyTestsRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\"\x90\x01\n" +
+	"#PolicyServiceRunPolicyTestsResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.permission.v1.PolicyTestResultR\aresults\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\x05R\x06passed\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\xd8\x01\n" +
+	"\vPolicyIssue\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tpolicy_id\x18\x03 \x01(\x03R\bpolicyId\x12\x17\n" +
+	"\arule_id\x18\x04 \x01(\x03R\x06ruleId\x12*\n" +
+	"\x11related_policy_id\x18\x05 \x01(\x03R\x0frelatedPolicyId\x12#\n" +
+	"\rpermission_id\x18\x06 \x01(\x03R\fpermissionId\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"B\n" +
+	"#PolicyServiceAnalyzePoliciesRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\"Z\n" +
+	"$PolicyServiceAnalyzePoliciesResponse\x122\n" +
+	"\x06issues\x18\x01 \x03(\v2\x1a.permission.v1.PolicyIssueR\x06issues\"\x89\x01\n" +
 	",AttributeValueServiceSaveSubjectValueRequest\x12\x1d\n" +
 	"\n" +
 	"subject_id\x18\x01 \x01(\x03R\tsubjectId\x12:\n" +
@@ -3309,7 +4948,7 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\fPolicyStatus\x12\x19\n" +
 	"\x15POLICY_STATUS_UNKNOWN\x10\x00\x12\x18\n" +
 	"\x14POLICY_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
-	"\x16POLICY_STATUS_INACTIVE\x10\x02*\xcd\x02\n" +
+	"\x16POLICY_STATUS_INACTIVE\x10\x02*\xa0\x04\n" +
 	"\fRuleOperator\x12\x19\n" +
 	"\x15RULE_OPERATOR_UNKNOWN\x10\x00\x12\x18\n" +
 	"\x14RULE_OPERATOR_EQUALS\x10\x01\x12\x1c\n" +
@@ -3323,24 +4962,32 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\x10RULE_OPERATOR_IN\x10\t\x12\x18\n" +
 	"\x14RULE_OPERATOR_NOT_IN\x10\n" +
 	"\x12\x15\n" +
-	"\x11RULE_OPERATOR_NOT\x10\v*?\n" +
+	"\x11RULE_OPERATOR_NOT\x10\v\x12\x1d\n" +
+	"\x19RULE_OPERATOR_STARTS_WITH\x10\f\x12\x1b\n" +
+	"\x17RULE_OPERATOR_ENDS_WITH\x10\r\x12\x1a\n" +
+	"\x16RULE_OPERATOR_CONTAINS\x10\x0e\x12\x19\n" +
+	"\x15RULE_OPERATOR_MATCHES\x10\x0f\x12$\n" +
+	" RULE_OPERATOR_EQUALS_IGNORE_CASE\x10\x10\x12\x19\n" +
+	"\x15RULE_OPERATOR_IN_CIDR\x10\x11\x12\x1d\n" +
+	"\x19RULE_OPERATOR_NOT_IN_CIDR\x10\x12*?\n" +
 	"\x06Effect\x12\x12\n" +
 	"\x0eEFFECT_UNKNOWN\x10\x00\x12\x10\n" +
 	"\fEFFECT_ALLOW\x10\x01\x12\x0f\n" +
-	"\vEFFECT_DENY\x10\x02*\x91\x01\n" +
+	"\vEFFECT_DENY\x10\x02*\xa3\x01\n" +
 	"\bDataType\x12\x15\n" +
 	"\x11DATA_TYPE_UNKNOWN\x10\x00\x12\x14\n" +
 	"\x10DATA_TYPE_STRING\x10\x01\x12\x14\n" +
 	"\x10DATA_TYPE_NUMBER\x10\x02\x12\x15\n" +
 	"\x11DATA_TYPE_BOOLEAN\x10\x03\x12\x13\n" +
 	"\x0fDATA_TYPE_FLOAT\x10\x04\x12\x16\n" +
-	"\x12DATA_TYPE_DATETIME\x10\x05*u\n" +
+	"\x12DATA_TYPE_DATETIME\x10\x05\x12\x10\n" +
+	"\fDATA_TYPE_IP\x10\x06*u\n" +
 	"\n" +
 	"EntityType\x12\x17\n" +
 	"\x13ENTITY_TYPE_UNKNOWN\x10\x00\x12\x17\n" +
 	"\x13ENTITY_TYPE_SUBJECT\x10\x01\x12\x18\n" +
 	"\x14ENTITY_TYPE_RESOURCE\x10\x02\x12\x1b\n" +
-	"\x17ENTITY_TYPE_ENVIRONMENT\x10\x032\x8a\x06\n" +
+	"\x17ENTITY_TYPE_ENVIRONMENT\x10\x032\xaa\x0e\n" +
 	"\rPolicyService\x12[\n" +
 	"\x04Save\x12'.permission.v1.PolicyServiceSaveRequest\x1a(.permission.v1.PolicyServiceSaveResponse\"\x00\x12a\n" +
 	"\x06Delete\x12).permission.v1.PolicyServiceDeleteRequest\x1a*.permission.v1.PolicyServiceDeleteResponse\"\x00\x12^\n" +
@@ -3349,7 +4996,16 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\n" +
 	"DeleteRule\x12-.permission.v1.PolicyServiceDeleteRuleRequest\x1a..permission.v1.PolicyServiceDeleteRuleResponse\"\x00\x12\x8b\x01\n" +
 	"\x14SavePermissionPolicy\x127.permission.v1.PolicyServiceSavePermissionPolicyRequest\x1a8.permission.v1.PolicyServiceSavePermissionPolicyResponse\"\x00\x12s\n" +
-	"\fFindPolicies\x12/.permission.v1.PolicyServiceFindPoliciesRequest\x1a0.permission.v1.PolicyServiceFindPoliciesResponse\"\x002\xf6\v\n" +
+	"\fFindPolicies\x12/.permission.v1.PolicyServiceFindPoliciesRequest\x1a0.permission.v1.PolicyServiceFindPoliciesResponse\"\x00\x12d\n" +
+	"\aPublish\x12*.permission.v1.PolicyServicePublishRequest\x1a+.permission.v1.PolicyServicePublishResponse\"\x00\x12g\n" +
+	"\bRollback\x12+.permission.v1.PolicyServiceRollbackRequest\x1a,.permission.v1.PolicyServiceRollbackResponse\"\x00\x12s\n" +
+	"\fFindVersions\x12/.permission.v1.PolicyServiceFindVersionsRequest\x1a0.permission.v1.PolicyServiceFindVersionsResponse\"\x00\x12y\n" +
+	"\x0eSimulatePolicy\x121.permission.v1.PolicyServiceSimulatePolicyRequest\x1a2.permission.v1.PolicyServiceSimulatePolicyResponse\"\x00\x12s\n" +
+	"\fSaveTestCase\x12/.permission.v1.PolicyServiceSaveTestCaseRequest\x1a0.permission.v1.PolicyServiceSaveTestCaseResponse\"\x00\x12y\n" +
+	"\x0eDeleteTestCase\x121.permission.v1.PolicyServiceDeleteTestCaseRequest\x1a2.permission.v1.PolicyServiceDeleteTestCaseResponse\"\x00\x12v\n" +
+	"\rFindTestCases\x120.permission.v1.PolicyServiceFindTestCasesRequest\x1a1.permission.v1.PolicyServiceFindTestCasesResponse\"\x00\x12y\n" +
+	"\x0eRunPolicyTests\x121.permission.v1.PolicyServiceRunPolicyTestsRequest\x1a2.permission.v1.PolicyServiceRunPolicyTestsResponse\"\x00\x12|\n" +
+	"\x0fAnalyzePolicies\x122.permission.v1.PolicyServiceAnalyzePoliciesRequest\x1a3.permission.v1.PolicyServiceAnalyzePoliciesResponse\"\x002\xf6\v\n" +
 	"\x15AttributeValueService\x12\x8f\x01\n" +
 	"\x10SaveSubjectValue\x12;.permission.v1.AttributeValueServiceSaveSubjectValueRequest\x1a<.permission.v1.AttributeValueServiceSaveSubjectValueResponse\"\x00\x12\x95\x01\n" +
 	"\x12DeleteSubjectValue\x12=.permission.v1.AttributeValueServiceDeleteSubjectValueRequest\x1a>.permission.v1.AttributeValueServiceDeleteSubjectValueResponse\"\x00\x12\xb9\x01\n" +
@@ -3379,158 +5035,231 @@ func file_permission_v1_abac_proto_rawDescGZIP() []byte {
 	return file_permission_v1_abac_proto_rawDescData
 }
 
+var file_permission_v1_abac_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var (
-	file_permission_v1_abac_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-	file_permission_v1_abac_proto_msgTypes  = make([]protoimpl.MessageInfo, 58)
-	file_permission_v1_abac_proto_goTypes   = []any{
+	file_permission_v1_abac_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
+	file_permission_v1_abac_proto_goTypes  = []any{
 		(PolicyStatus)(0),                                                       // 0: permission.v1.PolicyStatus
 		(RuleOperator)(0),                                                       // 1: permission.v1.RuleOperator
 		(Effect)(0),                                                             // 2: permission.v1.Effect
 		(DataType)(0),                                                           // 3: permission.v1.DataType
 		(EntityType)(0),                                                         // 4: permission.v1.EntityType
 		(*Policy)(nil),                                                          // 5: permission.v1.Policy
-		(*PolicyRule)(nil),                                                      // 6: permission.v1.PolicyRule
-		(*SubjectAttributeValue)(nil),                                           // 7: permission.v1.SubjectAttributeValue
-		(*ResourceAttributeValue)(nil),                                          // 8: permission.v1.ResourceAttributeValue
-		(*EnvironmentAttributeValue)(nil),                                       // 9: permission.v1.EnvironmentAttributeValue
-		(*SubjectObject)(nil),                                                   // 10: permission.v1.SubjectObject
-		(*ResourceObject)(nil),                                                  // 11: permission.v1.ResourceObject
-		(*EnvironmentObject)(nil),                                               // 12: permission.v1.EnvironmentObject
-		(*AttributeDefinition)(nil),                                             // 13: permission.v1.AttributeDefinition
-		(*BizDefinition)(nil),                                                   // 14: permission.v1.BizDefinition
-		(*PolicyServiceSaveRequest)(nil),                                        // 15: permission.v1.PolicyServiceSaveRequest
-		(*PolicyServiceSaveResponse)(nil),                                       // 16: permission.v1.PolicyServiceSaveResponse
-		(*PolicyServiceDeleteRequest)(nil),                                      // 17: permission.v1.PolicyServiceDeleteRequest
-		(*PolicyServiceDeleteResponse)(nil),                                     // 18: permission.v1.PolicyServiceDeleteResponse
-		(*PolicyServiceFirstRequest)(nil),                                       // 19: permission.v1.PolicyServiceFirstRequest
-		(*PolicyServiceFirstResponse)(nil),                                      // 20: permission.v1.PolicyServiceFirstResponse
-		(*PolicyServiceSaveRuleRequest)(nil),                                    // 21: permission.v1.PolicyServiceSaveRuleRequest
-		(*PolicyServiceSaveRuleResponse)(nil),                                   // 22: permission.v1.PolicyServiceSaveRuleResponse
-		(*PolicyServiceDeleteRuleRequest)(nil),                                  // 23: permission.v1.PolicyServiceDeleteRuleRequest
-		(*PolicyServiceDeleteRuleResponse)(nil),                                 // 24: permission.v1.PolicyServiceDeleteRuleResponse
-		(*PolicyServiceFindPoliciesByPermissionIDsRequest)(nil),                 // 25: permission.v1.PolicyServiceFindPoliciesByPermissionIDsRequest
-		(*PolicyServiceFindPoliciesByPermissionIDsResponse)(nil),                // 26: permission.v1.PolicyServiceFindPoliciesByPermissionIDsResponse
-		(*PolicyServiceSavePermissionPolicyRequest)(nil),                        // 27: permission.v1.PolicyServiceSavePermissionPolicyRequest
-		(*PolicyServiceSavePermissionPolicyResponse)(nil),                       // 28: permission.v1.PolicyServiceSavePermissionPolicyResponse
-		(*PolicyServiceFindPoliciesRequest)(nil),                                // 29: permission.v1.PolicyServiceFindPoliciesRequest
-		(*PolicyServiceFindPoliciesResponse)(nil),                               // 30: permission.v1.PolicyServiceFindPoliciesResponse
-		(*AttributeValueServiceSaveSubjectValueRequest)(nil),                    // 31: permission.v1.AttributeValueServiceSaveSubjectValueRequest
-		(*AttributeValueServiceSaveSubjectValueResponse)(nil),                   // 32: permission.v1.AttributeValueServiceSaveSubjectValueResponse
-		(*AttributeValueServiceDeleteSubjectValueRequest)(nil),                  // 33: permission.v1.AttributeValueServiceDeleteSubjectValueRequest
-		(*AttributeValueServiceDeleteSubjectValueResponse)(nil),                 // 34: permission.v1.AttributeValueServiceDeleteSubjectValueResponse
-		(*AttributeValueServiceFindSubjectValueRequest)(nil),                    // 35: permission.v1.AttributeValueServiceFindSubjectValueRequest
-		(*AttributeValueServiceFindSubjectValueResponse)(nil),                   // 36: permission.v1.AttributeValueServiceFindSubjectValueResponse
-		(*AttributeValueServiceFindSubjectValueWithDefinitionRequest)(nil),      // 37: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionRequest
-		(*AttributeValueServiceFindSubjectValueWithDefinitionResponse)(nil),     // 38: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse
-		(*AttributeValueServiceSaveResourceValueRequest)(nil),                   // 39: permission.v1.AttributeValueServiceSaveResourceValueRequest
-		(*AttributeValueServiceSaveResourceValueResponse)(nil),                  // 40: permission.v1.AttributeValueServiceSaveResourceValueResponse
-		(*AttributeValueServiceDeleteResourceValueRequest)(nil),                 // 41: permission.v1.AttributeValueServiceDeleteResourceValueRequest
-		(*AttributeValueServiceDeleteResourceValueResponse)(nil),                // 42: permission.v1.AttributeValueServiceDeleteResourceValueResponse
-		(*AttributeValueServiceFindResourceValueRequest)(nil),                   // 43: permission.v1.AttributeValueServiceFindResourceValueRequest
-		(*AttributeValueServiceFindResourceValueResponse)(nil),                  // 44: permission.v1.AttributeValueServiceFindResourceValueResponse
-		(*AttributeValueServiceFindResourceValueWithDefinitionRequest)(nil),     // 45: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionRequest
-		(*AttributeValueServiceFindResourceValueWithDefinitionResponse)(nil),    // 46: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse
-		(*AttributeValueServiceSaveEnvironmentValueRequest)(nil),                // 47: permission.v1.AttributeValueServiceSaveEnvironmentValueRequest
-		(*AttributeValueServiceSaveEnvironmentValueResponse)(nil),               // 48: permission.v1.AttributeValueServiceSaveEnvironmentValueResponse
-		(*AttributeValueServiceDeleteEnvironmentValueRequest)(nil),              // 49: permission.v1.AttributeValueServiceDeleteEnvironmentValueRequest
-		(*AttributeValueServiceDeleteEnvironmentValueResponse)(nil),             // 50: permission.v1.AttributeValueServiceDeleteEnvironmentValueResponse
-		(*AttributeValueServiceFindEnvironmentValueRequest)(nil),                // 51: permission.v1.AttributeValueServiceFindEnvironmentValueRequest
-		(*AttributeValueServiceFindEnvironmentValueResponse)(nil),               // 52: permission.v1.AttributeValueServiceFindEnvironmentValueResponse
-		(*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest)(nil),  // 53: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest
-		(*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse)(nil), // 54: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse
-		(*AttributeDefinitionServiceSaveRequest)(nil),                           // 55: permission.v1.AttributeDefinitionServiceSaveRequest
-		(*AttributeDefinitionServiceSaveResponse)(nil),                          // 56: permission.v1.AttributeDefinitionServiceSaveResponse
-		(*AttributeDefinitionServiceFirstRequest)(nil),                          // 57: permission.v1.AttributeDefinitionServiceFirstRequest
-		(*AttributeDefinitionServiceFirstResponse)(nil),                         // 58: permission.v1.AttributeDefinitionServiceFirstResponse
-		(*AttributeDefinitionServiceDeleteRequest)(nil),                         // 59: permission.v1.AttributeDefinitionServiceDeleteRequest
-		(*AttributeDefinitionServiceDeleteResponse)(nil),                        // 60: permission.v1.AttributeDefinitionServiceDeleteResponse
-		(*AttributeDefinitionServiceFindRequest)(nil),                           // 61: permission.v1.AttributeDefinitionServiceFindRequest
-		(*AttributeDefinitionServiceFindResponse)(nil),                          // 62: permission.v1.AttributeDefinitionServiceFindResponse
+		(*PolicyPermission)(nil),                                                // 6: permission.v1.PolicyPermission
+		(*PolicyVersion)(nil),                                                   // 7: permission.v1.PolicyVersion
+		(*PolicyRule)(nil),                                                      // 8: permission.v1.PolicyRule
+		(*SubjectAttributeValue)(nil),                                           // 9: permission.v1.SubjectAttributeValue
+		(*ResourceAttributeValue)(nil),                                          // 10: permission.v1.ResourceAttributeValue
+		(*EnvironmentAttributeValue)(nil),                                       // 11: permission.v1.EnvironmentAttributeValue
+		(*SubjectObject)(nil),                                                   // 12: permission.v1.SubjectObject
+		(*ResourceObject)(nil),                                                  // 13: permission.v1.ResourceObject
+		(*EnvironmentObject)(nil),                                               // 14: permission.v1.EnvironmentObject
+		(*AttributeDefinition)(nil),                                             // 15: permission.v1.AttributeDefinition
+		(*BizDefinition)(nil),                                                   // 16: permission.v1.BizDefinition
+		(*PolicyServiceSaveRequest)(nil),                                        // 17: permission.v1.PolicyServiceSaveRequest
+		(*PolicyServiceSaveResponse)(nil),                                       // 18: permission.v1.PolicyServiceSaveResponse
+		(*PolicyServiceDeleteRequest)(nil),                                      // 19: permission.v1.PolicyServiceDeleteRequest
+		(*PolicyServiceDeleteResponse)(nil),                                     // 20: permission.v1.PolicyServiceDeleteResponse
+		(*PolicyServiceFirstRequest)(nil),                                       // 21: permission.v1.PolicyServiceFirstRequest
+		(*PolicyServiceFirstResponse)(nil),                                      // 22: permission.v1.PolicyServiceFirstResponse
+		(*PolicyServiceSaveRuleRequest)(nil),                                    // 23: permission.v1.PolicyServiceSaveRuleRequest
+		(*PolicyServiceSaveRuleResponse)(nil),                                   // 24: permission.v1.PolicyServiceSaveRuleResponse
+		(*PolicyServiceDeleteRuleRequest)(nil),                                  // 25: permission.v1.PolicyServiceDeleteRuleRequest
+		(*PolicyServiceDeleteRuleResponse)(nil),                                 // 26: permission.v1.PolicyServiceDeleteRuleResponse
+		(*PolicyServiceFindPoliciesByPermissionIDsRequest)(nil),                 // 27: permission.v1.PolicyServiceFindPoliciesByPermissionIDsRequest
+		(*PolicyServiceFindPoliciesByPermissionIDsResponse)(nil),                // 28: permission.v1.PolicyServiceFindPoliciesByPermissionIDsResponse
+		(*PolicyServiceSavePermissionPolicyRequest)(nil),                        // 29: permission.v1.PolicyServiceSavePermissionPolicyRequest
+		(*PolicyServiceSavePermissionPolicyResponse)(nil),                       // 30: permission.v1.PolicyServiceSavePermissionPolicyResponse
+		(*PolicyServiceFindPoliciesRequest)(nil),                                // 31: permission.v1.PolicyServiceFindPoliciesRequest
+		(*PolicyServiceFindPoliciesResponse)(nil),                               // 32: permission.v1.PolicyServiceFindPoliciesResponse
+		(*PolicyServicePublishRequest)(nil),                                     // 33: permission.v1.PolicyServicePublishRequest
+		(*PolicyServicePublishResponse)(nil),                                    // 34: permission.v1.PolicyServicePublishResponse
+		(*PolicyServiceRollbackRequest)(nil),                                    // 35: permission.v1.PolicyServiceRollbackRequest
+		(*PolicyServiceRollbackResponse)(nil),                                   // 36: permission.v1.PolicyServiceRollbackResponse
+		(*PolicyServiceFindVersionsRequest)(nil),                                // 37: permission.v1.PolicyServiceFindVersionsRequest
+		(*PolicyServiceFindVersionsResponse)(nil),                               // 38: permission.v1.PolicyServiceFindVersionsResponse
+		(*PolicyServiceSimulatePolicyRequest)(nil),                              // 39: permission.v1.PolicyServiceSimulatePolicyRequest
+		(*PolicyServiceSimulatePolicyResponse)(nil),                             // 40: permission.v1.PolicyServiceSimulatePolicyResponse
+		(*DecisionFlip)(nil),                                                    // 41: permission.v1.DecisionFlip
+		(*PolicyChange)(nil),                                                    // 42: permission.v1.PolicyChange
+		(*SimulationFailure)(nil),                                               // 43: permission.v1.SimulationFailure
+		(*PolicyTestCase)(nil),                                                  // 44: permission.v1.PolicyTestCase
+		(*PolicyTestResult)(nil),                                                // 45: permission.v1.PolicyTestResult
+		(*PolicyServiceSaveTestCaseRequest)(nil),                                // 46: permission.v1.PolicyServiceSaveTestCaseRequest
+		(*PolicyServiceSaveTestCaseResponse)(nil),                               // 47: permission.v1.PolicyServiceSaveTestCaseResponse
+		(*PolicyServiceDeleteTestCaseRequest)(nil),                              // 48: permission.v1.PolicyServiceDeleteTestCaseRequest
+		(*PolicyServiceDeleteTestCaseResponse)(nil),                             // 49: permission.v1.PolicyServiceDeleteTestCaseResponse
+		(*PolicyServiceFindTestCasesRequest)(nil),                               // 50: permission.v1.PolicyServiceFindTestCasesRequest
+		(*PolicyServiceFindTestCasesResponse)(nil),                              // 51: permission.v1.PolicyServiceFindTestCasesResponse
+		(*PolicyServiceRunPolicyTestsRequest)(nil),                              // 52: permission.v1.PolicyServiceRunPolicyTestsRequest
+		(*PolicyServiceRunPolicyTestsResponse)(nil),                             // 53: permission.v1.PolicyServiceRunPolicyTestsResponse
+		(*PolicyIssue)(nil),                                                     // 54: permission.v1.PolicyIssue
+		(*PolicyServiceAnalyzePoliciesRequest)(nil),                             // 55: permission.v1.PolicyServiceAnalyzePoliciesRequest
+		(*PolicyServiceAnalyzePoliciesResponse)(nil),                            // 56: permission.v1.PolicyServiceAnalyzePoliciesResponse
+		(*AttributeValueServiceSaveSubjectValueRequest)(nil),                    // 57: permission.v1.AttributeValueServiceSaveSubjectValueRequest
+		(*AttributeValueServiceSaveSubjectValueResponse)(nil),                   // 58: permission.v1.AttributeValueServiceSaveSubjectValueResponse
+		(*AttributeValueServiceDeleteSubjectValueRequest)(nil),                  // 59: permission.v1.AttributeValueServiceDeleteSubjectValueRequest
+		(*AttributeValueServiceDeleteSubjectValueResponse)(nil),                 // 60: permission.v1.AttributeValueServiceDeleteSubjectValueResponse
+		(*AttributeValueServiceFindSubjectValueRequest)(nil),                    // 61: permission.v1.AttributeValueServiceFindSubjectValueRequest
+		(*AttributeValueServiceFindSubjectValueResponse)(nil),                   // 62: permission.v1.AttributeValueServiceFindSubjectValueResponse
+		(*AttributeValueServiceFindSubjectValueWithDefinitionRequest)(nil),      // 63: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionRequest
+		(*AttributeValueServiceFindSubjectValueWithDefinitionResponse)(nil),     // 64: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse
+		(*AttributeValueServiceSaveResourceValueRequest)(nil),                   // 65: permission.v1.AttributeValueServiceSaveResourceValueRequest
+		(*AttributeValueServiceSaveResourceValueResponse)(nil),                  // 66: permission.v1.AttributeValueServiceSaveResourceValueResponse
+		(*AttributeValueServiceDeleteResourceValueRequest)(nil),                 // 67: permission.v1.AttributeValueServiceDeleteResourceValueRequest
+		(*AttributeValueServiceDeleteResourceValueResponse)(nil),                // 68: permission.v1.AttributeValueServiceDeleteResourceValueResponse
+		(*AttributeValueServiceFindResourceValueRequest)(nil),                   // 69: permission.v1.AttributeValueServiceFindResourceValueRequest
+		(*AttributeValueServiceFindResourceValueResponse)(nil),                  // 70: permission.v1.AttributeValueServiceFindResourceValueResponse
+		(*AttributeValueServiceFindResourceValueWithDefinitionRequest)(nil),     // 71: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionRequest
+		(*AttributeValueServiceFindResourceValueWithDefinitionResponse)(nil),    // 72: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse
+		(*AttributeValueServiceSaveEnvironmentValueRequest)(nil),                // 73: permission.v1.AttributeValueServiceSaveEnvironmentValueRequest
+		(*AttributeValueServiceSaveEnvironmentValueResponse)(nil),               // 74: permission.v1.AttributeValueServiceSaveEnvironmentValueResponse
+		(*AttributeValueServiceDeleteEnvironmentValueRequest)(nil),              // 75: permission.v1.AttributeValueServiceDeleteEnvironmentValueRequest
+		(*AttributeValueServiceDeleteEnvironmentValueResponse)(nil),             // 76: permission.v1.AttributeValueServiceDeleteEnvironmentValueResponse
+		(*AttributeValueServiceFindEnvironmentValueRequest)(nil),                // 77: permission.v1.AttributeValueServiceFindEnvironmentValueRequest
+		(*AttributeValueServiceFindEnvironmentValueResponse)(nil),               // 78: permission.v1.AttributeValueServiceFindEnvironmentValueResponse
+		(*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest)(nil),  // 79: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest
+		(*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse)(nil), // 80: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse
+		(*AttributeDefinitionServiceSaveRequest)(nil),                           // 81: permission.v1.AttributeDefinitionServiceSaveRequest
+		(*AttributeDefinitionServiceSaveResponse)(nil),                          // 82: permission.v1.AttributeDefinitionServiceSaveResponse
+		(*AttributeDefinitionServiceFirstRequest)(nil),                          // 83: permission.v1.AttributeDefinitionServiceFirstRequest
+		(*AttributeDefinitionServiceFirstResponse)(nil),                         // 84: permission.v1.AttributeDefinitionServiceFirstResponse
+		(*AttributeDefinitionServiceDeleteRequest)(nil),                         // 85: permission.v1.AttributeDefinitionServiceDeleteRequest
+		(*AttributeDefinitionServiceDeleteResponse)(nil),                        // 86: permission.v1.AttributeDefinitionServiceDeleteResponse
+		(*AttributeDefinitionServiceFindRequest)(nil),                           // 87: permission.v1.AttributeDefinitionServiceFindRequest
+		(*AttributeDefinitionServiceFindResponse)(nil),                          // 88: permission.v1.AttributeDefinitionServiceFindResponse
+		nil,                            // 89: permission.v1.PolicyTestCase.SubjectAttributesEntry
+		nil,                            // 90: permission.v1.PolicyTestCase.ResourceAttributesEntry
+		nil,                            // 91: permission.v1.PolicyTestCase.EnvironmentAttributesEntry
+		(*CheckPermissionRequest)(nil), // 92: permission.v1.CheckPermissionRequest
+		(*PolicyTrace)(nil),            // 93: permission.v1.PolicyTrace
 	}
 )
-
 var file_permission_v1_abac_proto_depIdxs = []int32{
 	0,  // 0: permission.v1.Policy.status:type_name -> permission.v1.PolicyStatus
 	2,  // 1: permission.v1.Policy.effect:type_name -> permission.v1.Effect
-	6,  // 2: permission.v1.Policy.rules:type_name -> permission.v1.PolicyRule
-	13, // 3: permission.v1.PolicyRule.attribute_definition:type_name -> permission.v1.AttributeDefinition
-	6,  // 4: permission.v1.PolicyRule.left_rule:type_name -> permission.v1.PolicyRule
-	6,  // 5: permission.v1.PolicyRule.right_rule:type_name -> permission.v1.PolicyRule
-	1,  // 6: permission.v1.PolicyRule.operator:type_name -> permission.v1.RuleOperator
-	13, // 7: permission.v1.SubjectAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
-	13, // 8: permission.v1.ResourceAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
-	13, // 9: permission.v1.EnvironmentAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
-	7,  // 10: permission.v1.SubjectObject.attribute_values:type_name -> permission.v1.SubjectAttributeValue
-	8,  // 11: permission.v1.ResourceObject.attribute_values:type_name -> permission.v1.ResourceAttributeValue
-	9,  // 12: permission.v1.EnvironmentObject.attribute_values:type_name -> permission.v1.EnvironmentAttributeValue
-	3,  // 13: permission.v1.AttributeDefinition.data_type:type_name -> permission.v1.DataType
-	4,  // 14: permission.v1.AttributeDefinition.entity_type:type_name -> permission.v1.EntityType
-	13, // 15: permission.v1.BizDefinition.subject_attrs:type_name -> permission.v1.AttributeDefinition
-	13, // 16: permission.v1.BizDefinition.resource_attrs:type_name -> permission.v1.AttributeDefinition
-	13, // 17: permission.v1.BizDefinition.environment_attrs:type_name -> permission.v1.AttributeDefinition
-	5,  // 18: permission.v1.PolicyServiceSaveRequest.policy:type_name -> permission.v1.Policy
-	5,  // 19: permission.v1.PolicyServiceFirstResponse.policy:type_name -> permission.v1.Policy
-	6,  // 20: permission.v1.PolicyServiceSaveRuleRequest.rule:type_name -> permission.v1.PolicyRule
-	5,  // 21: permission.v1.PolicyServiceFindPoliciesByPermissionIDsResponse.policies:type_name -> permission.v1.Policy
-	2,  // 22: permission.v1.PolicyServiceSavePermissionPolicyRequest.effect:type_name -> permission.v1.Effect
-	5,  // 23: permission.v1.PolicyServiceFindPoliciesResponse.policies:type_name -> permission.v1.Policy
-	7,  // 24: permission.v1.AttributeValueServiceSaveSubjectValueRequest.value:type_name -> permission.v1.SubjectAttributeValue
-	10, // 25: permission.v1.AttributeValueServiceFindSubjectValueResponse.subject:type_name -> permission.v1.SubjectObject
-	10, // 26: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse.subject:type_name -> permission.v1.SubjectObject
-	8,  // 27: permission.v1.AttributeValueServiceSaveResourceValueRequest.value:type_name -> permission.v1.ResourceAttributeValue
-	11, // 28: permission.v1.AttributeValueServiceFindResourceValueResponse.resource:type_name -> permission.v1.ResourceObject
-	11, // 29: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse.resource:type_name -> permission.v1.ResourceObject
-	9,  // 30: permission.v1.AttributeValueServiceSaveEnvironmentValueRequest.value:type_name -> permission.v1.EnvironmentAttributeValue
-	12, // 31: permission.v1.AttributeValueServiceFindEnvironmentValueResponse.environment:type_name -> permission.v1.EnvironmentObject
-	12, // 32: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.environment:type_name -> permission.v1.EnvironmentObject
-	13, // 33: permission.v1.AttributeDefinitionServiceSaveRequest.definition:type_name -> permission.v1.AttributeDefinition
-	13, // 34: permission.v1.AttributeDefinitionServiceFirstResponse.definition:type_name -> permission.v1.AttributeDefinition
-	14, // 35: permission.v1.AttributeDefinitionServiceFindResponse.biz_definition:type_name -> permission.v1.BizDefinition
-	15, // 36: permission.v1.PolicyService.Save:input_type -> permission.v1.PolicyServiceSaveRequest
-	17, // 37: permission.v1.PolicyService.Delete:input_type -> permission.v1.PolicyServiceDeleteRequest
-	19, // 38: permission.v1.PolicyService.First:input_type -> permission.v1.PolicyServiceFirstRequest
-	21, // 39: permission.v1.PolicyService.SaveRule:input_type -> permission.v1.PolicyServiceSaveRuleRequest
-	23, // 40: permission.v1.PolicyService.DeleteRule:input_type -> permission.v1.PolicyServiceDeleteRuleRequest
-	27, // 41: permission.v1.PolicyService.SavePermissionPolicy:input_type -> permission.v1.PolicyServiceSavePermissionPolicyRequest
-	29, // 42: permission.v1.PolicyService.FindPolicies:input_type -> permission.v1.PolicyServiceFindPoliciesRequest
-	31, // 43: permission.v1.AttributeValueService.SaveSubjectValue:input_type -> permission.v1.AttributeValueServiceSaveSubjectValueRequest
-	33, // 44: permission.v1.AttributeValueService.DeleteSubjectValue:input_type -> permission.v1.AttributeValueServiceDeleteSubjectValueRequest
-	37, // 45: permission.v1.AttributeValueService.FindSubjectValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionRequest
-	39, // 46: permission.v1.AttributeValueService.SaveResourceValue:input_type -> permission.v1.AttributeValueServiceSaveResourceValueRequest
-	41, // 47: permission.v1.AttributeValueService.DeleteResourceValue:input_type -> permission.v1.AttributeValueServiceDeleteResourceValueRequest
-	45, // 48: permission.v1.AttributeValueService.FindResourceValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindResourceValueWithDefinitionRequest
-	47, // 49: permission.v1.AttributeValueService.SaveEnvironmentValue:input_type -> permission.v1.AttributeValueServiceSaveEnvironmentValueRequest
-	49, // 50: permission.v1.AttributeValueService.DeleteEnvironmentValue:input_type -> permission.v1.AttributeValueServiceDeleteEnvironmentValueRequest
-	53, // 51: permission.v1.AttributeValueService.FindEnvironmentValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest
-	55, // 52: permission.v1.AttributeDefinitionService.Save:input_type -> permission.v1.AttributeDefinitionServiceSaveRequest
-	57, // 53: permission.v1.AttributeDefinitionService.First:input_type -> permission.v1.AttributeDefinitionServiceFirstRequest
-	59, // 54: permission.v1.AttributeDefinitionService.Delete:input_type -> permission.v1.AttributeDefinitionServiceDeleteRequest
-	61, // 55: permission.v1.AttributeDefinitionService.Find:input_type -> permission.v1.AttributeDefinitionServiceFindRequest
-	16, // 56: permission.v1.PolicyService.Save:output_type -> permission.v1.PolicyServiceSaveResponse
-	18, // 57: permission.v1.PolicyService.Delete:output_type -> permission.v1.PolicyServiceDeleteResponse
-	20, // 58: permission.v1.PolicyService.First:output_type -> permission.v1.PolicyServiceFirstResponse
-	22, // 59: permission.v1.PolicyService.SaveRule:output_type -> permission.v1.PolicyServiceSaveRuleResponse
-	24, // 60: permission.v1.PolicyService.DeleteRule:output_type -> permission.v1.PolicyServiceDeleteRuleResponse
-	28, // 61: permission.v1.PolicyService.SavePermissionPolicy:output_type -> permission.v1.PolicyServiceSavePermissionPolicyResponse
-	30, // 62: permission.v1.PolicyService.FindPolicies:output_type -> permission.v1.PolicyServiceFindPoliciesResponse
-	32, // 63: permission.v1.AttributeValueService.SaveSubjectValue:output_type -> permission.v1.AttributeValueServiceSaveSubjectValueResponse
-	34, // 64: permission.v1.AttributeValueService.DeleteSubjectValue:output_type -> permission.v1.AttributeValueServiceDeleteSubjectValueResponse
-	38, // 65: permission.v1.AttributeValueService.FindSubjectValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse
-	40, // 66: permission.v1.AttributeValueService.SaveResourceValue:output_type -> permission.v1.AttributeValueServiceSaveResourceValueResponse
-	42, // 67: permission.v1.AttributeValueService.DeleteResourceValue:output_type -> permission.v1.AttributeValueServiceDeleteResourceValueResponse
-	46, // 68: permission.v1.AttributeValueService.FindResourceValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse
-	48, // 69: permission.v1.AttributeValueService.SaveEnvironmentValue:output_type -> permission.v1.AttributeValueServiceSaveEnvironmentValueResponse
-	50, // 70: permission.v1.AttributeValueService.DeleteEnvironmentValue:output_type -> permission.v1.AttributeValueServiceDeleteEnvironmentValueResponse
-	54, // 71: permission.v1.AttributeValueService.FindEnvironmentValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse
-	56, // 72: permission.v1.AttributeDefinitionService.Save:output_type -> permission.v1.AttributeDefinitionServiceSaveResponse
-	58, // 73: permission.v1.AttributeDefinitionService.First:output_type -> permission.v1.AttributeDefinitionServiceFirstResponse
-	60, // 74: permission.v1.AttributeDefinitionService.Delete:output_type -> permission.v1.AttributeDefinitionServiceDeleteResponse
-	62, // 75: permission.v1.AttributeDefinitionService.Find:output_type -> permission.v1.AttributeDefinitionServiceFindResponse
-	56, // [56:76] is the sub-list for method output_type
-	36, // [36:56] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	8,  // 2: permission.v1.Policy.rules:type_name -> permission.v1.PolicyRule
+	6,  // 3: permission.v1.Policy.permissions:type_name -> permission.v1.PolicyPermission
+	2,  // 4: permission.v1.PolicyPermission.effect:type_name -> permission.v1.Effect
+	5,  // 5: permission.v1.PolicyVersion.policy:type_name -> permission.v1.Policy
+	15, // 6: permission.v1.PolicyRule.attribute_definition:type_name -> permission.v1.AttributeDefinition
+	8,  // 7: permission.v1.PolicyRule.left_rule:type_name -> permission.v1.PolicyRule
+	8,  // 8: permission.v1.PolicyRule.right_rule:type_name -> permission.v1.PolicyRule
+	1,  // 9: permission.v1.PolicyRule.operator:type_name -> permission.v1.RuleOperator
+	15, // 10: permission.v1.PolicyRule.value_attribute_definition:type_name -> permission.v1.AttributeDefinition
+	15, // 11: permission.v1.SubjectAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
+	15, // 12: permission.v1.ResourceAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
+	15, // 13: permission.v1.EnvironmentAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
+	9,  // 14: permission.v1.SubjectObject.attribute_values:type_name -> permission.v1.SubjectAttributeValue
+	10, // 15: permission.v1.ResourceObject.attribute_values:type_name -> permission.v1.ResourceAttributeValue
+	11, // 16: permission.v1.EnvironmentObject.attribute_values:type_name -> permission.v1.EnvironmentAttributeValue
+	3,  // 17: permission.v1.AttributeDefinition.data_type:type_name -> permission.v1.DataType
+	4,  // 18: permission.v1.AttributeDefinition.entity_type:type_name -> permission.v1.EntityType
+	15, // 19: permission.v1.BizDefinition.subject_attrs:type_name -> permission.v1.AttributeDefinition
+	15, // 20: permission.v1.BizDefinition.resource_attrs:type_name -> permission.v1.AttributeDefinition
+	15, // 21: permission.v1.BizDefinition.environment_attrs:type_name -> permission.v1.AttributeDefinition
+	5,  // 22: permission.v1.PolicyServiceSaveRequest.policy:type_name -> permission.v1.Policy
+	54, // 23: permission.v1.PolicyServiceSaveResponse.issues:type_name -> permission.v1.PolicyIssue
+	5,  // 24: permission.v1.PolicyServiceFirstResponse.policy:type_name -> permission.v1.Policy
+	8,  // 25: permission.v1.PolicyServiceSaveRuleRequest.rule:type_name -> permission.v1.PolicyRule
+	5,  // 26: permission.v1.PolicyServiceFindPoliciesByPermissionIDsResponse.policies:type_name -> permission.v1.Policy
+	2,  // 27: permission.v1.PolicyServiceSavePermissionPolicyRequest.effect:type_name -> permission.v1.Effect
+	5,  // 28: permission.v1.PolicyServiceFindPoliciesResponse.policies:type_name -> permission.v1.Policy
+	7,  // 29: permission.v1.PolicyServiceFindVersionsResponse.versions:type_name -> permission.v1.PolicyVersion
+	5,  // 30: permission.v1.PolicyServiceSimulatePolicyRequest.policies:type_name -> permission.v1.Policy
+	92, // 31: permission.v1.PolicyServiceSimulatePolicyRequest.requests:type_name -> permission.v1.CheckPermissionRequest
+	41, // 32: permission.v1.PolicyServiceSimulatePolicyResponse.flips:type_name -> permission.v1.DecisionFlip
+	43, // 33: permission.v1.PolicyServiceSimulatePolicyResponse.failures:type_name -> permission.v1.SimulationFailure
+	92, // 34: permission.v1.DecisionFlip.request:type_name -> permission.v1.CheckPermissionRequest
+	42, // 35: permission.v1.DecisionFlip.changes:type_name -> permission.v1.PolicyChange
+	93, // 36: permission.v1.PolicyChange.before:type_name -> permission.v1.PolicyTrace
+	93, // 37: permission.v1.PolicyChange.after:type_name -> permission.v1.PolicyTrace
+	92, // 38: permission.v1.SimulationFailure.request:type_name -> permission.v1.CheckPermissionRequest
+	89, // 39: permission.v1.PolicyTestCase.subject_attributes:type_name -> permission.v1.PolicyTestCase.SubjectAttributesEntry
+	90, // 40: permission.v1.PolicyTestCase.resource_attributes:type_name -> permission.v1.PolicyTestCase.ResourceAttributesEntry
+	91, // 41: permission.v1.PolicyTestCase.environment_attributes:type_name -> permission.v1.PolicyTestCase.EnvironmentAttributesEntry
+	2,  // 42: permission.v1.PolicyTestCase.expected:type_name -> permission.v1.Effect
+	44, // 43: permission.v1.PolicyTestResult.test_case:type_name -> permission.v1.PolicyTestCase
+	2,  // 44: permission.v1.PolicyTestResult.actual:type_name -> permission.v1.Effect
+	44, // 45: permission.v1.PolicyServiceSaveTestCaseRequest.test_case:type_name -> permission.v1.PolicyTestCase
+	44, // 46: permission.v1.PolicyServiceFindTestCasesResponse.test_cases:type_name -> permission.v1.PolicyTestCase
+	45, // 47: permission.v1.PolicyServiceRunPolicyTestsResponse.results:type_name -> permission.v1.PolicyTestResult
+	54, // 48: permission.v1.PolicyServiceAnalyzePoliciesResponse.issues:type_name -> permission.v1.PolicyIssue
+	9,  // 49: permission.v1.AttributeValueServiceSaveSubjectValueRequest.value:type_name -> permission.v1.SubjectAttributeValue
+	12, // 50: permission.v1.AttributeValueServiceFindSubjectValueResponse.subject:type_name -> permission.v1.SubjectObject
+	12, // 51: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse.subject:type_name -> permission.v1.SubjectObject
+	10, // 52: permission.v1.AttributeValueServiceSaveResourceValueRequest.value:type_name -> permission.v1.ResourceAttributeValue
+	13, // 53: permission.v1.AttributeValueServiceFindResourceValueResponse.resource:type_name -> permission.v1.ResourceObject
+	13, // 54: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse.resource:type_name -> permission.v1.ResourceObject
+	11, // 55: permission.v1.AttributeValueServiceSaveEnvironmentValueRequest.value:type_name -> permission.v1.EnvironmentAttributeValue
+	14, // 56: permission.v1.AttributeValueServiceFindEnvironmentValueResponse.environment:type_name -> permission.v1.EnvironmentObject
+	14, // 57: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.environment:type_name -> permission.v1.EnvironmentObject
+	15, // 58: permission.v1.AttributeDefinitionServiceSaveRequest.definition:type_name -> permission.v1.AttributeDefinition
+	15, // 59: permission.v1.AttributeDefinitionServiceFirstResponse.definition:type_name -> permission.v1.AttributeDefinition
+	16, // 60: permission.v1.AttributeDefinitionServiceFindResponse.biz_definition:type_name -> permission.v1.BizDefinition
+	17, // 61: permission.v1.PolicyService.Save:input_type -> permission.v1.PolicyServiceSaveRequest
+	19, // 62: permission.v1.PolicyService.Delete:input_type -> permission.v1.PolicyServiceDeleteRequest
+	21, // 63: permission.v1.PolicyService.First:input_type -> permission.v1.PolicyServiceFirstRequest
+	23, // 64: permission.v1.PolicyService.SaveRule:input_type -> permission.v1.PolicyServiceSaveRuleRequest
+	25, // 65: permission.v1.PolicyService.DeleteRule:input_type -> permission.v1.PolicyServiceDeleteRuleRequest
+	29, // 66: permission.v1.PolicyService.SavePermissionPolicy:input_type -> permission.v1.PolicyServiceSavePermissionPolicyRequest
+	31, // 67: permission.v1.PolicyService.FindPolicies:input_type -> permission.v1.PolicyServiceFindPoliciesRequest
+	33, // 68: permission.v1.PolicyService.Publish:input_type -> permission.v1.PolicyServicePublishRequest
+	35, // 69: permission.v1.PolicyService.Rollback:input_type -> permission.v1.PolicyServiceRollbackRequest
+	37, // 70: permission.v1.PolicyService.FindVersions:input_type -> permission.v1.PolicyServiceFindVersionsRequest
+	39, // 71: permission.v1.PolicyService.SimulatePolicy:input_type -> permission.v1.PolicyServiceSimulatePolicyRequest
+	46, // 72: permission.v1.PolicyService.SaveTestCase:input_type -> permission.v1.PolicyServiceSaveTestCaseRequest
+	48, // 73: permission.v1.PolicyService.DeleteTestCase:input_type -> permission.v1.PolicyServiceDeleteTestCaseRequest
+	50, // 74: permission.v1.PolicyService.FindTestCases:input_type -> permission.v1.PolicyServiceFindTestCasesRequest
+	52, // 75: permission.v1.PolicyService.RunPolicyTests:input_type -> permission.v1.PolicyServiceRunPolicyTestsRequest
+	55, // 76: permission.v1.PolicyService.AnalyzePolicies:input_type -> permission.v1.PolicyServiceAnalyzePoliciesRequest
+	57, // 77: permission.v1.AttributeValueService.SaveSubjectValue:input_type -> permission.v1.AttributeValueServiceSaveSubjectValueRequest
+	59, // 78: permission.v1.AttributeValueService.DeleteSubjectValue:input_type -> permission.v1.AttributeValueServiceDeleteSubjectValueRequest
+	63, // 79: permission.v1.AttributeValueService.FindSubjectValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionRequest
+	65, // 80: permission.v1.AttributeValueService.SaveResourceValue:input_type -> permission.v1.AttributeValueServiceSaveResourceValueRequest
+	67, // 81: permission.v1.AttributeValueService.DeleteResourceValue:input_type -> permission.v1.AttributeValueServiceDeleteResourceValueRequest
+	71, // 82: permission.v1.AttributeValueService.FindResourceValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindResourceValueWithDefinitionRequest
+	73, // 83: permission.v1.AttributeValueService.SaveEnvironmentValue:input_type -> permission.v1.AttributeValueServiceSaveEnvironmentValueRequest
+	75, // 84: permission.v1.AttributeValueService.DeleteEnvironmentValue:input_type -> permission.v1.AttributeValueServiceDeleteEnvironmentValueRequest
+	79, // 85: permission.v1.AttributeValueService.FindEnvironmentValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest
+	81, // 86: permission.v1.AttributeDefinitionService.Save:input_type -> permission.v1.AttributeDefinitionServiceSaveRequest
+	83, // 87: permission.v1.AttributeDefinitionService.First:input_type -> permission.v1.AttributeDefinitionServiceFirstRequest
+	85, // 88: permission.v1.AttributeDefinitionService.Delete:input_type -> permission.v1.AttributeDefinitionServiceDeleteRequest
+	87, // 89: permission.v1.AttributeDefinitionService.Find:input_type -> permission.v1.AttributeDefinitionServiceFindRequest
+	18, // 90: permission.v1.PolicyService.Save:output_type -> permission.v1.PolicyServiceSaveResponse
+	20, // 91: permission.v1.PolicyService.Delete:output_type -> permission.v1.PolicyServiceDeleteResponse
+	22, // 92: permission.v1.PolicyService.First:output_type -> permission.v1.PolicyServiceFirstResponse
+	24, // 93: permission.v1.PolicyService.SaveRule:output_type -> permission.v1.PolicyServiceSaveRuleResponse
+	26, // 94: permission.v1.PolicyService.DeleteRule:output_type -> permission.v1.PolicyServiceDeleteRuleResponse
+	30, // 95: permission.v1.PolicyService.SavePermissionPolicy:output_type -> permission.v1.PolicyServiceSavePermissionPolicyResponse
+	32, // 96: permission.v1.PolicyService.FindPolicies:output_type -> permission.v1.PolicyServiceFindPoliciesResponse
+	34, // 97: permission.v1.PolicyService.Publish:output_type -> permission.v1.PolicyServicePublishResponse
+	36, // 98: permission.v1.PolicyService.Rollback:output_type -> permission.v1.PolicyServiceRollbackResponse
+	38, // 99: permission.v1.PolicyService.FindVersions:output_type -> permission.v1.PolicyServiceFindVersionsResponse
+	40, // 100: permission.v1.PolicyService.SimulatePolicy:output_type -> permission.v1.PolicyServiceSimulatePolicyResponse
+	47, // 101: permission.v1.PolicyService.SaveTestCase:output_type -> permission.v1.PolicyServiceSaveTestCaseResponse
+	49, // 102: permission.v1.PolicyService.DeleteTestCase:output_type -> permission.v1.PolicyServiceDeleteTestCaseResponse
+	51, // 103: permission.v1.PolicyService.FindTestCases:output_type -> permission.v1.PolicyServiceFindTestCasesResponse
+	53, // 104: permission.v1.PolicyService.RunPolicyTests:output_type -> permission.v1.PolicyServiceRunPolicyTestsResponse
+	56, // 105: permission.v1.PolicyService.AnalyzePolicies:output_type -> permission.v1.PolicyServiceAnalyzePoliciesResponse
+	58, // 106: permission.v1.AttributeValueService.SaveSubjectValue:output_type -> permission.v1.AttributeValueServiceSaveSubjectValueResponse
+	60, // 107: permission.v1.AttributeValueService.DeleteSubjectValue:output_type -> permission.v1.AttributeValueServiceDeleteSubjectValueResponse
+	64, // 108: permission.v1.AttributeValueService.FindSubjectValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse
+	66, // 109: permission.v1.AttributeValueService.SaveResourceValue:output_type -> permission.v1.AttributeValueServiceSaveResourceValueResponse
+	68, // 110: permission.v1.AttributeValueService.DeleteResourceValue:output_type -> permission.v1.AttributeValueServiceDeleteResourceValueResponse
+	72, // 111: permission.v1.AttributeValueService.FindResourceValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse
+	74, // 112: permission.v1.AttributeValueService.SaveEnvironmentValue:output_type -> permission.v1.AttributeValueServiceSaveEnvironmentValueResponse
+	76, // 113: permission.v1.AttributeValueService.DeleteEnvironmentValue:output_type -> permission.v1.AttributeValueServiceDeleteEnvironmentValueResponse
+	80, // 114: permission.v1.AttributeValueService.FindEnvironmentValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse
+	82, // 115: permission.v1.AttributeDefinitionService.Save:output_type -> permission.v1.AttributeDefinitionServiceSaveResponse
+	84, // 116: permission.v1.AttributeDefinitionService.First:output_type -> permission.v1.AttributeDefinitionServiceFirstResponse
+	86, // 117: permission.v1.AttributeDefinitionService.Delete:output_type -> permission.v1.AttributeDefinitionServiceDeleteResponse
+	88, // 118: permission.v1.AttributeDefinitionService.Find:output_type -> permission.v1.AttributeDefinitionServiceFindResponse
+	90, // [90:119] is the sub-list for method output_type
+	61, // [61:90] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_permission_v1_abac_proto_init() }
@@ -3538,13 +5267,14 @@ func file_permission_v1_abac_proto_init() {
 	if File_permission_v1_abac_proto != nil {
 		return
 	}
+	file_permission_v1_permission_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permission_v1_abac_proto_rawDesc), len(file_permission_v1_abac_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   58,
+			NumMessages:   87,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -104,6 +104,46 @@ func (m *Policy) validate(all bool) error {
 
 	// no validation rules for Utime
 
+	// no validation rules for ExecuteType
+
+	// no validation rules for Expression
+
+	// no validation rules for PublishedVersion
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PolicyMultiError(errors)
 	}
@@ -181,6 +221,245 @@ var _ interface {
 	ErrorName() string
 } = PolicyValidationError{}
 
+// Validate checks the field values on PolicyPermission with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PolicyPermission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyPermission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyPermissionMultiError, or nil if none found.
+func (m *PolicyPermission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyPermission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PermissionId
+
+	// no validation rules for Effect
+
+	if len(errors) > 0 {
+		return PolicyPermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyPermissionMultiError is an error wrapping multiple validation errors
+// returned by PolicyPermission.ValidateAll() if the designated constraints
+// aren't met.
+type PolicyPermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyPermissionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyPermissionMultiError) AllErrors() []error { return m }
+
+// PolicyPermissionValidationError is the validation error returned by
+// PolicyPermission.Validate if the designated constraints aren't met.
+type PolicyPermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyPermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyPermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyPermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyPermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyPermissionValidationError) ErrorName() string { return "PolicyPermissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyPermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyPermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyPermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyPermissionValidationError{}
+
+// Validate checks the field values on PolicyVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyVersionMultiError, or
+// nil if none found.
+func (m *PolicyVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyVersionValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyVersionValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyVersionValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Ctime
+
+	if len(errors) > 0 {
+		return PolicyVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyVersionMultiError is an error wrapping multiple validation errors
+// returned by PolicyVersion.ValidateAll() if the designated constraints
+// aren't met.
+type PolicyVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyVersionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyVersionMultiError) AllErrors() []error { return m }
+
+// PolicyVersionValidationError is the validation error returned by
+// PolicyVersion.Validate if the designated constraints aren't met.
+type PolicyVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyVersionValidationError) ErrorName() string { return "PolicyVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyVersionValidationError{}
+
 // Validate checks the field values on PolicyRule with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -300,6 +579,35 @@ func (m *PolicyRule) validate(all bool) error {
 
 	// no validation rules for Utime
 
+	if all {
+		switch v := interface{}(m.GetValueAttributeDefinition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyRuleValidationError{
+					field:  "ValueAttributeDefinition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyRuleValidationError{
+					field:  "ValueAttributeDefinition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValueAttributeDefinition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyRuleValidationError{
+				field:  "ValueAttributeDefinition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PolicyRuleMultiError(errors)
 	}
@@ -1677,17 +1985,51 @@ func (m *PolicyServiceSaveResponse) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if len(errors) > 0 {
-		return PolicyServiceSaveResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// PolicyServiceSaveResponseMultiError is an error wrapping multiple validation
-// errors returned by PolicyServiceSaveResponse.ValidateAll() if the
-// designated constraints aren't met.
-type PolicyServiceSaveResponseMultiError []error
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceSaveResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceSaveResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceSaveResponseValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceSaveResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceSaveResponseMultiError is an error wrapping multiple validation
+// errors returned by PolicyServiceSaveResponse.ValidateAll() if the
+// designated constraints aren't met.
+type PolicyServiceSaveResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m PolicyServiceSaveResponseMultiError) Error() string {
@@ -3372,6 +3714,3047 @@ var _ interface {
 	ErrorName() string
 } = PolicyServiceFindPoliciesResponseValidationError{}
 
+// Validate checks the field values on PolicyServicePublishRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServicePublishRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServicePublishRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyServicePublishRequestMultiError, or nil if none found.
+func (m *PolicyServicePublishRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServicePublishRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	if len(errors) > 0 {
+		return PolicyServicePublishRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServicePublishRequestMultiError is an error wrapping multiple
+// validation errors returned by PolicyServicePublishRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PolicyServicePublishRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServicePublishRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServicePublishRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServicePublishRequestValidationError is the validation error returned
+// by PolicyServicePublishRequest.Validate if the designated constraints
+// aren't met.
+type PolicyServicePublishRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServicePublishRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServicePublishRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServicePublishRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServicePublishRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServicePublishRequestValidationError) ErrorName() string {
+	return "PolicyServicePublishRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServicePublishRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServicePublishRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServicePublishRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServicePublishRequestValidationError{}
+
+// Validate checks the field values on PolicyServicePublishResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServicePublishResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServicePublishResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyServicePublishResponseMultiError, or nil if none found.
+func (m *PolicyServicePublishResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServicePublishResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return PolicyServicePublishResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServicePublishResponseMultiError is an error wrapping multiple
+// validation errors returned by PolicyServicePublishResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PolicyServicePublishResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServicePublishResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServicePublishResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServicePublishResponseValidationError is the validation error returned
+// by PolicyServicePublishResponse.Validate if the designated constraints
+// aren't met.
+type PolicyServicePublishResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServicePublishResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServicePublishResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServicePublishResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServicePublishResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServicePublishResponseValidationError) ErrorName() string {
+	return "PolicyServicePublishResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServicePublishResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServicePublishResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServicePublishResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServicePublishResponseValidationError{}
+
+// Validate checks the field values on PolicyServiceRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceRollbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyServiceRollbackRequestMultiError, or nil if none found.
+func (m *PolicyServiceRollbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceRollbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return PolicyServiceRollbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceRollbackRequestMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceRollbackRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PolicyServiceRollbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceRollbackRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceRollbackRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceRollbackRequestValidationError is the validation error returned
+// by PolicyServiceRollbackRequest.Validate if the designated constraints
+// aren't met.
+type PolicyServiceRollbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceRollbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceRollbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceRollbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceRollbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceRollbackRequestValidationError) ErrorName() string {
+	return "PolicyServiceRollbackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceRollbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceRollbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceRollbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceRollbackRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceRollbackResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceRollbackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceRollbackResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceRollbackResponseMultiError, or nil if none found.
+func (m *PolicyServiceRollbackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceRollbackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return PolicyServiceRollbackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceRollbackResponseMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceRollbackResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PolicyServiceRollbackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceRollbackResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceRollbackResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceRollbackResponseValidationError is the validation error
+// returned by PolicyServiceRollbackResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceRollbackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceRollbackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceRollbackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceRollbackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceRollbackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceRollbackResponseValidationError) ErrorName() string {
+	return "PolicyServiceRollbackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceRollbackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceRollbackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceRollbackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceRollbackResponseValidationError{}
+
+// Validate checks the field values on PolicyServiceFindVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceFindVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceFindVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceFindVersionsRequestMultiError, or nil if none found.
+func (m *PolicyServiceFindVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceFindVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	if len(errors) > 0 {
+		return PolicyServiceFindVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceFindVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceFindVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceFindVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceFindVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceFindVersionsRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceFindVersionsRequestValidationError is the validation error
+// returned by PolicyServiceFindVersionsRequest.Validate if the designated
+// constraints aren't met.
+type PolicyServiceFindVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceFindVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceFindVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceFindVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceFindVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceFindVersionsRequestValidationError) ErrorName() string {
+	return "PolicyServiceFindVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceFindVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceFindVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceFindVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceFindVersionsRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceFindVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceFindVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceFindVersionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceFindVersionsResponseMultiError, or nil if none found.
+func (m *PolicyServiceFindVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceFindVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceFindVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceFindVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceFindVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceFindVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceFindVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceFindVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceFindVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceFindVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceFindVersionsResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceFindVersionsResponseValidationError is the validation error
+// returned by PolicyServiceFindVersionsResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceFindVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceFindVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceFindVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceFindVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceFindVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceFindVersionsResponseValidationError) ErrorName() string {
+	return "PolicyServiceFindVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceFindVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceFindVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceFindVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceFindVersionsResponseValidationError{}
+
+// Validate checks the field values on PolicyServiceSimulatePolicyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceSimulatePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceSimulatePolicyRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceSimulatePolicyRequestMultiError, or nil if none found.
+func (m *PolicyServiceSimulatePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceSimulatePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceSimulatePolicyRequestValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceSimulatePolicyRequestValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceSimulatePolicyRequestValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceSimulatePolicyRequestValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceSimulatePolicyRequestValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceSimulatePolicyRequestValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SampleSize
+
+	if len(errors) > 0 {
+		return PolicyServiceSimulatePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceSimulatePolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceSimulatePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceSimulatePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceSimulatePolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceSimulatePolicyRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceSimulatePolicyRequestValidationError is the validation error
+// returned by PolicyServiceSimulatePolicyRequest.Validate if the designated
+// constraints aren't met.
+type PolicyServiceSimulatePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceSimulatePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceSimulatePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceSimulatePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceSimulatePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceSimulatePolicyRequestValidationError) ErrorName() string {
+	return "PolicyServiceSimulatePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceSimulatePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceSimulatePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceSimulatePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceSimulatePolicyRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceSimulatePolicyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceSimulatePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceSimulatePolicyResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceSimulatePolicyResponseMultiError, or nil if none found.
+func (m *PolicyServiceSimulatePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceSimulatePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetFlips() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceSimulatePolicyResponseValidationError{
+						field:  fmt.Sprintf("Flips[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceSimulatePolicyResponseValidationError{
+						field:  fmt.Sprintf("Flips[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceSimulatePolicyResponseValidationError{
+					field:  fmt.Sprintf("Flips[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceSimulatePolicyResponseValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceSimulatePolicyResponseValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceSimulatePolicyResponseValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceSimulatePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceSimulatePolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceSimulatePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceSimulatePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceSimulatePolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceSimulatePolicyResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceSimulatePolicyResponseValidationError is the validation error
+// returned by PolicyServiceSimulatePolicyResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceSimulatePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceSimulatePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceSimulatePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceSimulatePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceSimulatePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceSimulatePolicyResponseValidationError) ErrorName() string {
+	return "PolicyServiceSimulatePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceSimulatePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceSimulatePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceSimulatePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceSimulatePolicyResponseValidationError{}
+
+// Validate checks the field values on DecisionFlip with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DecisionFlip) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DecisionFlip with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DecisionFlipMultiError, or
+// nil if none found.
+func (m *DecisionFlip) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DecisionFlip) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DecisionFlipValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DecisionFlipValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DecisionFlipValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DecisionFlipValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DecisionFlipValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DecisionFlipValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DecisionFlipMultiError(errors)
+	}
+
+	return nil
+}
+
+// DecisionFlipMultiError is an error wrapping multiple validation errors
+// returned by DecisionFlip.ValidateAll() if the designated constraints aren't met.
+type DecisionFlipMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DecisionFlipMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DecisionFlipMultiError) AllErrors() []error { return m }
+
+// DecisionFlipValidationError is the validation error returned by
+// DecisionFlip.Validate if the designated constraints aren't met.
+type DecisionFlipValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecisionFlipValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecisionFlipValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecisionFlipValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecisionFlipValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecisionFlipValidationError) ErrorName() string { return "DecisionFlipValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DecisionFlipValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecisionFlip.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecisionFlipValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecisionFlipValidationError{}
+
+// Validate checks the field values on PolicyChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyChangeMultiError, or
+// nil if none found.
+func (m *PolicyChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyChangeValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyChangeValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyChangeValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyChangeValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyChangeValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyChangeValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PolicyChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyChangeMultiError is an error wrapping multiple validation errors
+// returned by PolicyChange.ValidateAll() if the designated constraints aren't met.
+type PolicyChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyChangeMultiError) AllErrors() []error { return m }
+
+// PolicyChangeValidationError is the validation error returned by
+// PolicyChange.Validate if the designated constraints aren't met.
+type PolicyChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyChangeValidationError) ErrorName() string { return "PolicyChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyChangeValidationError{}
+
+// Validate checks the field values on SimulationFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SimulationFailure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SimulationFailure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SimulationFailureMultiError, or nil if none found.
+func (m *SimulationFailure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SimulationFailure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SimulationFailureValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SimulationFailureValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SimulationFailureValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return SimulationFailureMultiError(errors)
+	}
+
+	return nil
+}
+
+// SimulationFailureMultiError is an error wrapping multiple validation errors
+// returned by SimulationFailure.ValidateAll() if the designated constraints
+// aren't met.
+type SimulationFailureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SimulationFailureMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SimulationFailureMultiError) AllErrors() []error { return m }
+
+// SimulationFailureValidationError is the validation error returned by
+// SimulationFailure.Validate if the designated constraints aren't met.
+type SimulationFailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulationFailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulationFailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulationFailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulationFailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulationFailureValidationError) ErrorName() string {
+	return "SimulationFailureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SimulationFailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulationFailure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulationFailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulationFailureValidationError{}
+
+// Validate checks the field values on PolicyTestCase with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyTestCase) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyTestCase with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyTestCaseMultiError,
+// or nil if none found.
+func (m *PolicyTestCase) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyTestCase) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PolicyId
+
+	// no validation rules for Name
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceKey
+
+	// no validation rules for Action
+
+	// no validation rules for SubjectAttributes
+
+	// no validation rules for ResourceAttributes
+
+	// no validation rules for EnvironmentAttributes
+
+	// no validation rules for Expected
+
+	// no validation rules for Ctime
+
+	// no validation rules for Utime
+
+	if len(errors) > 0 {
+		return PolicyTestCaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyTestCaseMultiError is an error wrapping multiple validation errors
+// returned by PolicyTestCase.ValidateAll() if the designated constraints
+// aren't met.
+type PolicyTestCaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyTestCaseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyTestCaseMultiError) AllErrors() []error { return m }
+
+// PolicyTestCaseValidationError is the validation error returned by
+// PolicyTestCase.Validate if the designated constraints aren't met.
+type PolicyTestCaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyTestCaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyTestCaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyTestCaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyTestCaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyTestCaseValidationError) ErrorName() string { return "PolicyTestCaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyTestCaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyTestCase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyTestCaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyTestCaseValidationError{}
+
+// Validate checks the field values on PolicyTestResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PolicyTestResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyTestResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyTestResultMultiError, or nil if none found.
+func (m *PolicyTestResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyTestResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTestCase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyTestResultValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyTestResultValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTestCase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyTestResultValidationError{
+				field:  "TestCase",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Passed
+
+	// no validation rules for Actual
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return PolicyTestResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyTestResultMultiError is an error wrapping multiple validation errors
+// returned by PolicyTestResult.ValidateAll() if the designated constraints
+// aren't met.
+type PolicyTestResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyTestResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyTestResultMultiError) AllErrors() []error { return m }
+
+// PolicyTestResultValidationError is the validation error returned by
+// PolicyTestResult.Validate if the designated constraints aren't met.
+type PolicyTestResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyTestResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyTestResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyTestResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyTestResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyTestResultValidationError) ErrorName() string { return "PolicyTestResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyTestResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyTestResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyTestResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyTestResultValidationError{}
+
+// Validate checks the field values on PolicyServiceSaveTestCaseRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceSaveTestCaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceSaveTestCaseRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceSaveTestCaseRequestMultiError, or nil if none found.
+func (m *PolicyServiceSaveTestCaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceSaveTestCaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTestCase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyServiceSaveTestCaseRequestValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyServiceSaveTestCaseRequestValidationError{
+					field:  "TestCase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTestCase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyServiceSaveTestCaseRequestValidationError{
+				field:  "TestCase",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceSaveTestCaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceSaveTestCaseRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceSaveTestCaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceSaveTestCaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceSaveTestCaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceSaveTestCaseRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceSaveTestCaseRequestValidationError is the validation error
+// returned by PolicyServiceSaveTestCaseRequest.Validate if the designated
+// constraints aren't met.
+type PolicyServiceSaveTestCaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceSaveTestCaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceSaveTestCaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceSaveTestCaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceSaveTestCaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceSaveTestCaseRequestValidationError) ErrorName() string {
+	return "PolicyServiceSaveTestCaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceSaveTestCaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceSaveTestCaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceSaveTestCaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceSaveTestCaseRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceSaveTestCaseResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceSaveTestCaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceSaveTestCaseResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceSaveTestCaseResponseMultiError, or nil if none found.
+func (m *PolicyServiceSaveTestCaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceSaveTestCaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return PolicyServiceSaveTestCaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceSaveTestCaseResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceSaveTestCaseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceSaveTestCaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceSaveTestCaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceSaveTestCaseResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceSaveTestCaseResponseValidationError is the validation error
+// returned by PolicyServiceSaveTestCaseResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceSaveTestCaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceSaveTestCaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceSaveTestCaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceSaveTestCaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceSaveTestCaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceSaveTestCaseResponseValidationError) ErrorName() string {
+	return "PolicyServiceSaveTestCaseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceSaveTestCaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceSaveTestCaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceSaveTestCaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceSaveTestCaseResponseValidationError{}
+
+// Validate checks the field values on PolicyServiceDeleteTestCaseRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceDeleteTestCaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceDeleteTestCaseRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceDeleteTestCaseRequestMultiError, or nil if none found.
+func (m *PolicyServiceDeleteTestCaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceDeleteTestCaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return PolicyServiceDeleteTestCaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceDeleteTestCaseRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceDeleteTestCaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceDeleteTestCaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceDeleteTestCaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceDeleteTestCaseRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceDeleteTestCaseRequestValidationError is the validation error
+// returned by PolicyServiceDeleteTestCaseRequest.Validate if the designated
+// constraints aren't met.
+type PolicyServiceDeleteTestCaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceDeleteTestCaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceDeleteTestCaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceDeleteTestCaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceDeleteTestCaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceDeleteTestCaseRequestValidationError) ErrorName() string {
+	return "PolicyServiceDeleteTestCaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceDeleteTestCaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceDeleteTestCaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceDeleteTestCaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceDeleteTestCaseRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceDeleteTestCaseResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceDeleteTestCaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceDeleteTestCaseResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceDeleteTestCaseResponseMultiError, or nil if none found.
+func (m *PolicyServiceDeleteTestCaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceDeleteTestCaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PolicyServiceDeleteTestCaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceDeleteTestCaseResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceDeleteTestCaseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceDeleteTestCaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceDeleteTestCaseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceDeleteTestCaseResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceDeleteTestCaseResponseValidationError is the validation error
+// returned by PolicyServiceDeleteTestCaseResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceDeleteTestCaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceDeleteTestCaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceDeleteTestCaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceDeleteTestCaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceDeleteTestCaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceDeleteTestCaseResponseValidationError) ErrorName() string {
+	return "PolicyServiceDeleteTestCaseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceDeleteTestCaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceDeleteTestCaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceDeleteTestCaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceDeleteTestCaseResponseValidationError{}
+
+// Validate checks the field values on PolicyServiceFindTestCasesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceFindTestCasesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceFindTestCasesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceFindTestCasesRequestMultiError, or nil if none found.
+func (m *PolicyServiceFindTestCasesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceFindTestCasesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	if len(errors) > 0 {
+		return PolicyServiceFindTestCasesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceFindTestCasesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceFindTestCasesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceFindTestCasesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceFindTestCasesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceFindTestCasesRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceFindTestCasesRequestValidationError is the validation error
+// returned by PolicyServiceFindTestCasesRequest.Validate if the designated
+// constraints aren't met.
+type PolicyServiceFindTestCasesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceFindTestCasesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceFindTestCasesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceFindTestCasesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceFindTestCasesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceFindTestCasesRequestValidationError) ErrorName() string {
+	return "PolicyServiceFindTestCasesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceFindTestCasesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceFindTestCasesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceFindTestCasesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceFindTestCasesRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceFindTestCasesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceFindTestCasesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceFindTestCasesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceFindTestCasesResponseMultiError, or nil if none found.
+func (m *PolicyServiceFindTestCasesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceFindTestCasesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTestCases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceFindTestCasesResponseValidationError{
+						field:  fmt.Sprintf("TestCases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceFindTestCasesResponseValidationError{
+						field:  fmt.Sprintf("TestCases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceFindTestCasesResponseValidationError{
+					field:  fmt.Sprintf("TestCases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceFindTestCasesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceFindTestCasesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceFindTestCasesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceFindTestCasesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceFindTestCasesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceFindTestCasesResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceFindTestCasesResponseValidationError is the validation error
+// returned by PolicyServiceFindTestCasesResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceFindTestCasesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceFindTestCasesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceFindTestCasesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceFindTestCasesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceFindTestCasesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceFindTestCasesResponseValidationError) ErrorName() string {
+	return "PolicyServiceFindTestCasesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceFindTestCasesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceFindTestCasesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceFindTestCasesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceFindTestCasesResponseValidationError{}
+
+// Validate checks the field values on PolicyServiceRunPolicyTestsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceRunPolicyTestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceRunPolicyTestsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceRunPolicyTestsRequestMultiError, or nil if none found.
+func (m *PolicyServiceRunPolicyTestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceRunPolicyTestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	if len(errors) > 0 {
+		return PolicyServiceRunPolicyTestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceRunPolicyTestsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceRunPolicyTestsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceRunPolicyTestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceRunPolicyTestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceRunPolicyTestsRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceRunPolicyTestsRequestValidationError is the validation error
+// returned by PolicyServiceRunPolicyTestsRequest.Validate if the designated
+// constraints aren't met.
+type PolicyServiceRunPolicyTestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceRunPolicyTestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceRunPolicyTestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceRunPolicyTestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceRunPolicyTestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceRunPolicyTestsRequestValidationError) ErrorName() string {
+	return "PolicyServiceRunPolicyTestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceRunPolicyTestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceRunPolicyTestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceRunPolicyTestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceRunPolicyTestsRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceRunPolicyTestsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceRunPolicyTestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceRunPolicyTestsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceRunPolicyTestsResponseMultiError, or nil if none found.
+func (m *PolicyServiceRunPolicyTestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceRunPolicyTestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceRunPolicyTestsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceRunPolicyTestsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceRunPolicyTestsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Passed
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return PolicyServiceRunPolicyTestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceRunPolicyTestsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceRunPolicyTestsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceRunPolicyTestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceRunPolicyTestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceRunPolicyTestsResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceRunPolicyTestsResponseValidationError is the validation error
+// returned by PolicyServiceRunPolicyTestsResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceRunPolicyTestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceRunPolicyTestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceRunPolicyTestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceRunPolicyTestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceRunPolicyTestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceRunPolicyTestsResponseValidationError) ErrorName() string {
+	return "PolicyServiceRunPolicyTestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceRunPolicyTestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceRunPolicyTestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceRunPolicyTestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceRunPolicyTestsResponseValidationError{}
+
+// Validate checks the field values on PolicyIssue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyIssue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyIssue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyIssueMultiError, or
+// nil if none found.
+func (m *PolicyIssue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyIssue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Level
+
+	// no validation rules for Kind
+
+	// no validation rules for PolicyId
+
+	// no validation rules for RuleId
+
+	// no validation rules for RelatedPolicyId
+
+	// no validation rules for PermissionId
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return PolicyIssueMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyIssueMultiError is an error wrapping multiple validation errors
+// returned by PolicyIssue.ValidateAll() if the designated constraints aren't met.
+type PolicyIssueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyIssueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyIssueMultiError) AllErrors() []error { return m }
+
+// PolicyIssueValidationError is the validation error returned by
+// PolicyIssue.Validate if the designated constraints aren't met.
+type PolicyIssueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyIssueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyIssueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyIssueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyIssueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyIssueValidationError) ErrorName() string { return "PolicyIssueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyIssueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyIssue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyIssueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyIssueValidationError{}
+
+// Validate checks the field values on PolicyServiceAnalyzePoliciesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceAnalyzePoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceAnalyzePoliciesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceAnalyzePoliciesRequestMultiError, or nil if none found.
+func (m *PolicyServiceAnalyzePoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceAnalyzePoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	if len(errors) > 0 {
+		return PolicyServiceAnalyzePoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceAnalyzePoliciesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceAnalyzePoliciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceAnalyzePoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceAnalyzePoliciesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceAnalyzePoliciesRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceAnalyzePoliciesRequestValidationError is the validation error
+// returned by PolicyServiceAnalyzePoliciesRequest.Validate if the designated
+// constraints aren't met.
+type PolicyServiceAnalyzePoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceAnalyzePoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceAnalyzePoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceAnalyzePoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceAnalyzePoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceAnalyzePoliciesRequestValidationError) ErrorName() string {
+	return "PolicyServiceAnalyzePoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceAnalyzePoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceAnalyzePoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceAnalyzePoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceAnalyzePoliciesRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceAnalyzePoliciesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PolicyServiceAnalyzePoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceAnalyzePoliciesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceAnalyzePoliciesResponseMultiError, or nil if none found.
+func (m *PolicyServiceAnalyzePoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceAnalyzePoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceAnalyzePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceAnalyzePoliciesResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceAnalyzePoliciesResponseValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceAnalyzePoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceAnalyzePoliciesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceAnalyzePoliciesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceAnalyzePoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceAnalyzePoliciesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceAnalyzePoliciesResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceAnalyzePoliciesResponseValidationError is the validation error
+// returned by PolicyServiceAnalyzePoliciesResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceAnalyzePoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceAnalyzePoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceAnalyzePoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceAnalyzePoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceAnalyzePoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceAnalyzePoliciesResponseValidationError) ErrorName() string {
+	return "PolicyServiceAnalyzePoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceAnalyzePoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceAnalyzePoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceAnalyzePoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceAnalyzePoliciesResponseValidationError{}
+
 // Validate checks the field values on
 // AttributeValueServiceSaveSubjectValueRequest with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -27,6 +27,15 @@ const (
 	PolicyService_DeleteRule_FullMethodName           = "/permission.v1.PolicyService/DeleteRule"
 	PolicyService_SavePermissionPolicy_FullMethodName = "/permission.v1.PolicyService/SavePermissionPolicy"
 	PolicyService_FindPolicies_FullMethodName         = "/permission.v1.PolicyService/FindPolicies"
+	PolicyService_Publish_FullMethodName              = "/permission.v1.PolicyService/Publish"
+	PolicyService_Rollback_FullMethodName             = "/permission.v1.PolicyService/Rollback"
+	PolicyService_FindVersions_FullMethodName         = "/permission.v1.PolicyService/FindVersions"
+	PolicyService_SimulatePolicy_FullMethodName       = "/permission.v1.PolicyService/SimulatePolicy"
+	PolicyService_SaveTestCase_FullMethodName         = "/permission.v1.PolicyService/SaveTestCase"
+	PolicyService_DeleteTestCase_FullMethodName       = "/permission.v1.PolicyService/DeleteTestCase"
+	PolicyService_FindTestCases_FullMethodName        = "/permission.v1.PolicyService/FindTestCases"
+	PolicyService_RunPolicyTests_FullMethodName       = "/permission.v1.PolicyService/RunPolicyTests"
+	PolicyService_AnalyzePolicies_FullMethodName      = "/permission.v1.PolicyService/AnalyzePolicies"
 )
 
 // PolicyServiceClient is the client API for PolicyService service.
@@ -42,6 +51,21 @@ type PolicyServiceClient interface {
 	DeleteRule(ctx context.Context, in *PolicyServiceDeleteRuleRequest, opts ...grpc.CallOption) (*PolicyServiceDeleteRuleResponse, error)
 	SavePermissionPolicy(ctx context.Context, in *PolicyServiceSavePermissionPolicyRequest, opts ...grpc.CallOption) (*PolicyServiceSavePermissionPolicyResponse, error)
 	FindPolicies(ctx context.Context, in *PolicyServiceFindPoliciesRequest, opts ...grpc.CallOption) (*PolicyServiceFindPoliciesResponse, error)
+	// 发布策略的草稿，包括策略、规则以及关联的权限
+	Publish(ctx context.Context, in *PolicyServicePublishRequest, opts ...grpc.CallOption) (*PolicyServicePublishResponse, error)
+	// 回滚到指定版本，会用这个版本的内容发布一个新版本，草稿不受影响。和发布一样要先通过测试用例
+	Rollback(ctx context.Context, in *PolicyServiceRollbackRequest, opts ...grpc.CallOption) (*PolicyServiceRollbackResponse, error)
+	FindVersions(ctx context.Context, in *PolicyServiceFindVersionsRequest, opts ...grpc.CallOption) (*PolicyServiceFindVersionsResponse, error)
+	// 用候选策略重放校验请求，找出判定结果发生变化的请求，不会修改任何数据
+	SimulatePolicy(ctx context.Context, in *PolicyServiceSimulatePolicyRequest, opts ...grpc.CallOption) (*PolicyServiceSimulatePolicyResponse, error)
+	// 保存策略的测试用例，发布策略之前会执行这个策略的全部测试用例，有失败的就不允许发布
+	SaveTestCase(ctx context.Context, in *PolicyServiceSaveTestCaseRequest, opts ...grpc.CallOption) (*PolicyServiceSaveTestCaseResponse, error)
+	DeleteTestCase(ctx context.Context, in *PolicyServiceDeleteTestCaseRequest, opts ...grpc.CallOption) (*PolicyServiceDeleteTestCaseResponse, error)
+	FindTestCases(ctx context.Context, in *PolicyServiceFindTestCasesRequest, opts ...grpc.CallOption) (*PolicyServiceFindTestCasesResponse, error)
+	// 用当前生效的策略执行测试用例，只使用用例里面的属性，不读取预存的属性值
+	RunPolicyTests(ctx context.Context, in *PolicyServiceRunPolicyTestsRequest, opts ...grpc.CallOption) (*PolicyServiceRunPolicyTestsResponse, error)
+	// 静态分析策略的草稿，找出矛盾、多余或者总是满足的规则，类型错误，引用了已经删除的属性，以及允许和拒绝的冲突
+	AnalyzePolicies(ctx context.Context, in *PolicyServiceAnalyzePoliciesRequest, opts ...grpc.CallOption) (*PolicyServiceAnalyzePoliciesResponse, error)
 }
 
 type policyServiceClient struct {
@@ -122,6 +146,96 @@ func (c *policyServiceClient) FindPolicies(ctx context.Context, in *PolicyServic
 	return out, nil
 }
 
+func (c *policyServiceClient) Publish(ctx context.Context, in *PolicyServicePublishRequest, opts ...grpc.CallOption) (*PolicyServicePublishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServicePublishResponse)
+	err := c.cc.Invoke(ctx, PolicyService_Publish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) Rollback(ctx context.Context, in *PolicyServiceRollbackRequest, opts ...grpc.CallOption) (*PolicyServiceRollbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceRollbackResponse)
+	err := c.cc.Invoke(ctx, PolicyService_Rollback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) FindVersions(ctx context.Context, in *PolicyServiceFindVersionsRequest, opts ...grpc.CallOption) (*PolicyServiceFindVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceFindVersionsResponse)
+	err := c.cc.Invoke(ctx, PolicyService_FindVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) SimulatePolicy(ctx context.Context, in *PolicyServiceSimulatePolicyRequest, opts ...grpc.CallOption) (*PolicyServiceSimulatePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceSimulatePolicyResponse)
+	err := c.cc.Invoke(ctx, PolicyService_SimulatePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) SaveTestCase(ctx context.Context, in *PolicyServiceSaveTestCaseRequest, opts ...grpc.CallOption) (*PolicyServiceSaveTestCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceSaveTestCaseResponse)
+	err := c.cc.Invoke(ctx, PolicyService_SaveTestCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) DeleteTestCase(ctx context.Context, in *PolicyServiceDeleteTestCaseRequest, opts ...grpc.CallOption) (*PolicyServiceDeleteTestCaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceDeleteTestCaseResponse)
+	err := c.cc.Invoke(ctx, PolicyService_DeleteTestCase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) FindTestCases(ctx context.Context, in *PolicyServiceFindTestCasesRequest, opts ...grpc.CallOption) (*PolicyServiceFindTestCasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceFindTestCasesResponse)
+	err := c.cc.Invoke(ctx, PolicyService_FindTestCases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) RunPolicyTests(ctx context.Context, in *PolicyServiceRunPolicyTestsRequest, opts ...grpc.CallOption) (*PolicyServiceRunPolicyTestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceRunPolicyTestsResponse)
+	err := c.cc.Invoke(ctx, PolicyService_RunPolicyTests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) AnalyzePolicies(ctx context.Context, in *PolicyServiceAnalyzePoliciesRequest, opts ...grpc.CallOption) (*PolicyServiceAnalyzePoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceAnalyzePoliciesResponse)
+	err := c.cc.Invoke(ctx, PolicyService_AnalyzePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PolicyServiceServer is the server API for PolicyService service.
 // All implementations should embed UnimplementedPolicyServiceServer
 // for forward compatibility.
@@ -135,6 +249,21 @@ type PolicyServiceServer interface {
 	DeleteRule(context.Context, *PolicyServiceDeleteRuleRequest) (*PolicyServiceDeleteRuleResponse, error)
 	SavePermissionPolicy(context.Context, *PolicyServiceSavePermissionPolicyRequest) (*PolicyServiceSavePermissionPolicyResponse, error)
 	FindPolicies(context.Context, *PolicyServiceFindPoliciesRequest) (*PolicyServiceFindPoliciesResponse, error)
+	// 发布策略的草稿，包括策略、规则以及关联的权限
+	Publish(context.Context, *PolicyServicePublishRequest) (*PolicyServicePublishResponse, error)
+	// 回滚到指定版本，会用这个版本的内容发布一个新版本，草稿不受影响。和发布一样要先通过测试用例
+	Rollback(context.Context, *PolicyServiceRollbackRequest) (*PolicyServiceRollbackResponse, error)
+	FindVersions(context.Context, *PolicyServiceFindVersionsRequest) (*PolicyServiceFindVersionsResponse, error)
+	// 用候选策略重放校验请求，找出判定结果发生变化的请求，不会修改任何数据
+	SimulatePolicy(context.Context, *PolicyServiceSimulatePolicyRequest) (*PolicyServiceSimulatePolicyResponse, error)
+	// 保存策略的测试用例，发布策略之前会执行这个策略的全部测试用例，有失败的就不允许发布
+	SaveTestCase(context.Context, *PolicyServiceSaveTestCaseRequest) (*PolicyServiceSaveTestCaseResponse, error)
+	DeleteTestCase(context.Context, *PolicyServiceDeleteTestCaseRequest) (*PolicyServiceDeleteTestCaseResponse, error)
+	FindTestCases(context.Context, *PolicyServiceFindTestCasesRequest) (*PolicyServiceFindTestCasesResponse, error)
+	// 用当前生效的策略执行测试用例，只使用用例里面的属性，不读取预存的属性值
+	RunPolicyTests(context.Context, *PolicyServiceRunPolicyTestsRequest) (*PolicyServiceRunPolicyTestsResponse, error)
+	// 静态分析策略的草稿，找出矛盾、多余或者总是满足的规则，类型错误，引用了已经删除的属性，以及允许和拒绝的冲突
+	AnalyzePolicies(context.Context, *PolicyServiceAnalyzePoliciesRequest) (*PolicyServiceAnalyzePoliciesResponse, error)
 }
 
 // UnimplementedPolicyServiceServer should be embedded to have
@@ -147,30 +276,51 @@ type UnimplementedPolicyServiceServer struct{}
 func (UnimplementedPolicyServiceServer) Save(context.Context, *PolicyServiceSaveRequest) (*PolicyServiceSaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
 }
-
 func (UnimplementedPolicyServiceServer) Delete(context.Context, *PolicyServiceDeleteRequest) (*PolicyServiceDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-
 func (UnimplementedPolicyServiceServer) First(context.Context, *PolicyServiceFirstRequest) (*PolicyServiceFirstResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method First not implemented")
 }
-
 func (UnimplementedPolicyServiceServer) SaveRule(context.Context, *PolicyServiceSaveRuleRequest) (*PolicyServiceSaveRuleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveRule not implemented")
 }
-
 func (UnimplementedPolicyServiceServer) DeleteRule(context.Context, *PolicyServiceDeleteRuleRequest) (*PolicyServiceDeleteRuleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
 }
-
 func (UnimplementedPolicyServiceServer) SavePermissionPolicy(context.Context, *PolicyServiceSavePermissionPolicyRequest) (*PolicyServiceSavePermissionPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SavePermissionPolicy not implemented")
 }
-
 func (UnimplementedPolicyServiceServer) FindPolicies(context.Context, *PolicyServiceFindPoliciesRequest) (*PolicyServiceFindPoliciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindPolicies not implemented")
 }
+func (UnimplementedPolicyServiceServer) Publish(context.Context, *PolicyServicePublishRequest) (*PolicyServicePublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedPolicyServiceServer) Rollback(context.Context, *PolicyServiceRollbackRequest) (*PolicyServiceRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedPolicyServiceServer) FindVersions(context.Context, *PolicyServiceFindVersionsRequest) (*PolicyServiceFindVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindVersions not implemented")
+}
+func (UnimplementedPolicyServiceServer) SimulatePolicy(context.Context, *PolicyServiceSimulatePolicyRequest) (*PolicyServiceSimulatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePolicy not implemented")
+}
+func (UnimplementedPolicyServiceServer) SaveTestCase(context.Context, *PolicyServiceSaveTestCaseRequest) (*PolicyServiceSaveTestCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveTestCase not implemented")
+}
+func (UnimplementedPolicyServiceServer) DeleteTestCase(context.Context, *PolicyServiceDeleteTestCaseRequest) (*PolicyServiceDeleteTestCaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTestCase not implemented")
+}
+func (UnimplementedPolicyServiceServer) FindTestCases(context.Context, *PolicyServiceFindTestCasesRequest) (*PolicyServiceFindTestCasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTestCases not implemented")
+}
+func (UnimplementedPolicyServiceServer) RunPolicyTests(context.Context, *PolicyServiceRunPolicyTestsRequest) (*PolicyServiceRunPolicyTestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunPolicyTests not implemented")
+}
+func (UnimplementedPolicyServiceServer) AnalyzePolicies(context.Context, *PolicyServiceAnalyzePoliciesRequest) (*PolicyServiceAnalyzePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzePolicies not implemented")
+}
 func (UnimplementedPolicyServiceServer) testEmbeddedByValue() {}
 
 // UnsafePolicyServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -317,6 +467,168 @@ func _PolicyService_FindPolicies_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PolicyService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServicePublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).Publish(ctx, req.(*PolicyServicePublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_Rollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).Rollback(ctx, req.(*PolicyServiceRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_FindVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceFindVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).FindVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_FindVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).FindVersions(ctx, req.(*PolicyServiceFindVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_SimulatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceSimulatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).SimulatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_SimulatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).SimulatePolicy(ctx, req.(*PolicyServiceSimulatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_SaveTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceSaveTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).SaveTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_SaveTestCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).SaveTestCase(ctx, req.(*PolicyServiceSaveTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_DeleteTestCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceDeleteTestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).DeleteTestCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_DeleteTestCase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).DeleteTestCase(ctx, req.(*PolicyServiceDeleteTestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_FindTestCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceFindTestCasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).FindTestCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_FindTestCases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).FindTestCases(ctx, req.(*PolicyServiceFindTestCasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_RunPolicyTests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceRunPolicyTestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).RunPolicyTests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_RunPolicyTests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).RunPolicyTests(ctx, req.(*PolicyServiceRunPolicyTestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_AnalyzePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceAnalyzePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).AnalyzePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_AnalyzePolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).AnalyzePolicies(ctx, req.(*PolicyServiceAnalyzePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PolicyService_ServiceDesc is the grpc.ServiceDesc for PolicyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -352,6 +664,42 @@ var PolicyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindPolicies",
 			Handler:    _PolicyService_FindPolicies_Handler,
 		},
+		{
+			MethodName: "Publish",
+			Handler:    _PolicyService_Publish_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _PolicyService_Rollback_Handler,
+		},
+		{
+			MethodName: "FindVersions",
+			Handler:    _PolicyService_FindVersions_Handler,
+		},
+		{
+			MethodName: "SimulatePolicy",
+			Handler:    _PolicyService_SimulatePolicy_Handler,
+		},
+		{
+			MethodName: "SaveTestCase",
+			Handler:    _PolicyService_SaveTestCase_Handler,
+		},
+		{
+			MethodName: "DeleteTestCase",
+			Handler:    _PolicyService_DeleteTestCase_Handler,
+		},
+		{
+			MethodName: "FindTestCases",
+			Handler:    _PolicyService_FindTestCases_Handler,
+		},
+		{
+			MethodName: "RunPolicyTests",
+			Handler:    _PolicyService_RunPolicyTests_Handler,
+		},
+		{
+			MethodName: "AnalyzePolicies",
+			Handler:    _PolicyService_AnalyzePolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "permission/v1/abac.proto",
@@ -511,35 +859,27 @@ type UnimplementedAttributeValueServiceServer struct{}
 func (UnimplementedAttributeValueServiceServer) SaveSubjectValue(context.Context, *AttributeValueServiceSaveSubjectValueRequest) (*AttributeValueServiceSaveSubjectValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveSubjectValue not implemented")
 }
-
 func (UnimplementedAttributeValueServiceServer) DeleteSubjectValue(context.Context, *AttributeValueServiceDeleteSubjectValueRequest) (*AttributeValueServiceDeleteSubjectValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubjectValue not implemented")
 }
-
 func (UnimplementedAttributeValueServiceServer) FindSubjectValueWithDefinition(context.Context, *AttributeValueServiceFindSubjectValueWithDefinitionRequest) (*AttributeValueServiceFindSubjectValueWithDefinitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindSubjectValueWithDefinition not implemented")
 }
-
 func (UnimplementedAttributeValueServiceServer) SaveResourceValue(context.Context, *AttributeValueServiceSaveResourceValueRequest) (*AttributeValueServiceSaveResourceValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveResourceValue not implemented")
 }
-
 func (UnimplementedAttributeValueServiceServer) DeleteResourceValue(context.Context, *AttributeValueServiceDeleteResourceValueRequest) (*AttributeValueServiceDeleteResourceValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteResourceValue not implemented")
 }
-
 func (UnimplementedAttributeValueServiceServer) FindResourceValueWithDefinition(context.Context, *AttributeValueServiceFindResourceValueWithDefinitionRequest) (*AttributeValueServiceFindResourceValueWithDefinitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindResourceValueWithDefinition not implemented")
 }
-
 func (UnimplementedAttributeValueServiceServer) SaveEnvironmentValue(context.Context, *AttributeValueServiceSaveEnvironmentValueRequest) (*AttributeValueServiceSaveEnvironmentValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveEnvironmentValue not implemented")
 }
-
 func (UnimplementedAttributeValueServiceServer) DeleteEnvironmentValue(context.Context, *AttributeValueServiceDeleteEnvironmentValueRequest) (*AttributeValueServiceDeleteEnvironmentValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironmentValue not implemented")
 }
-
 func (UnimplementedAttributeValueServiceServer) FindEnvironmentValueWithDefinition(context.Context, *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindEnvironmentValueWithDefinition not implemented")
 }
@@ -862,15 +1202,12 @@ type UnimplementedAttributeDefinitionServiceServer struct{}
 func (UnimplementedAttributeDefinitionServiceServer) Save(context.Context, *AttributeDefinitionServiceSaveRequest) (*AttributeDefinitionServiceSaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
 }
-
 func (UnimplementedAttributeDefinitionServiceServer) First(context.Context, *AttributeDefinitionServiceFirstRequest) (*AttributeDefinitionServiceFirstResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method First not implemented")
 }
-
 func (UnimplementedAttributeDefinitionServiceServer) Delete(context.Context, *AttributeDefinitionServiceDeleteRequest) (*AttributeDefinitionServiceDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-
 func (UnimplementedAttributeDefinitionServiceServer) Find(context.Context, *AttributeDefinitionServiceFindRequest) (*AttributeDefinitionServiceFindResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Find not implemented")
 }
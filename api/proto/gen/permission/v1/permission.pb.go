@@ -24,18 +24,19 @@ const (
 
 // 权限定义（资源 + 操作）
 type Permission struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	ResourceId    int64                  `protobuf:"varint,5,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	ResourceType  string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // 资源类型
-	ResourceKey   string                 `protobuf:"bytes,7,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`    // 资源标识符，类似于 /xxx/xxx/xxx 的格式
-	Actions       []string               `protobuf:"bytes,8,rep,name=actions,proto3" json:"actions,omitempty"`                               // 允许的操作列表
-	Metadata      string                 `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId              int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ResourceId         int64                  `protobuf:"varint,5,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	ResourceType       string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // 资源类型
+	ResourceKey        string                 `protobuf:"bytes,7,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`    // 资源标识符，类似于 /xxx/xxx/xxx 的格式
+	Actions            []string               `protobuf:"bytes,8,rep,name=actions,proto3" json:"actions,omitempty"`                               // 允许的操作列表
+	Metadata           string                 `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CombiningAlgorithm string                 `protobuf:"bytes,10,opt,name=combining_algorithm,json=combiningAlgorithm,proto3" json:"combining_algorithm,omitempty"` // ABAC 策略合并算法，为空表示使用业务配置
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Permission) Reset() {
@@ -131,6 +132,13 @@ func (x *Permission) GetMetadata() string {
 	return ""
 }
 
+func (x *Permission) GetCombiningAlgorithm() string {
+	if x != nil {
+		return x.CombiningAlgorithm
+	}
+	return ""
+}
+
 // 权限检查请求
 type CheckPermissionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -343,35 +351,32 @@ func (x *CheckPermissionResponse) GetAllowed() bool {
 	return false
 }
 
-type Resource struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BizId       int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Type        string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Key         string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	Name        string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Description string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	// 和 Resource 有关的内容，是业务方在创建 Resource 的时候传入的内容
-	// 原封不动的返回
-	Metadata      string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 权限解释请求，和 CheckPermissionRequest 的含义一致
+type ExplainPermissionRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Uid                   int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Permission            *Permission            `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	SubjectAttributes     map[string]string      `protobuf:"bytes,3,rep,name=subject_attributes,json=subjectAttributes,proto3" json:"subject_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResourceAttributes    map[string]string      `protobuf:"bytes,4,rep,name=resource_attributes,json=resourceAttributes,proto3" json:"resource_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EnvironmentAttributes map[string]string      `protobuf:"bytes,5,rep,name=environment_attributes,json=environmentAttributes,proto3" json:"environment_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *Resource) Reset() {
-	*x = Resource{}
+func (x *ExplainPermissionRequest) Reset() {
+	*x = ExplainPermissionRequest{}
 	mi := &file_permission_v1_permission_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Resource) String() string {
+func (x *ExplainPermissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Resource) ProtoMessage() {}
+func (*ExplainPermissionRequest) ProtoMessage() {}
 
-func (x *Resource) ProtoReflect() protoreflect.Message {
+func (x *ExplainPermissionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_permission_v1_permission_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,156 +388,1515 @@ func (x *Resource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
-func (*Resource) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExplainPermissionRequest.ProtoReflect.Descriptor instead.
+func (*ExplainPermissionRequest) Descriptor() ([]byte, []int) {
 	return file_permission_v1_permission_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Resource) GetId() int64 {
+func (x *ExplainPermissionRequest) GetUid() int64 {
 	if x != nil {
-		return x.Id
+		return x.Uid
 	}
 	return 0
 }
 
-func (x *Resource) GetBizId() int64 {
+func (x *ExplainPermissionRequest) GetPermission() *Permission {
 	if x != nil {
-		return x.BizId
+		return x.Permission
+	}
+	return nil
+}
+
+func (x *ExplainPermissionRequest) GetSubjectAttributes() map[string]string {
+	if x != nil {
+		return x.SubjectAttributes
+	}
+	return nil
+}
+
+func (x *ExplainPermissionRequest) GetResourceAttributes() map[string]string {
+	if x != nil {
+		return x.ResourceAttributes
+	}
+	return nil
+}
+
+func (x *ExplainPermissionRequest) GetEnvironmentAttributes() map[string]string {
+	if x != nil {
+		return x.EnvironmentAttributes
+	}
+	return nil
+}
+
+// 权限解释响应，没有执行的部分为空
+type ExplainPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Rbac          *RBACTrace             `protobuf:"bytes,2,opt,name=rbac,proto3" json:"rbac,omitempty"`
+	Abac          *ABACTrace             `protobuf:"bytes,3,opt,name=abac,proto3" json:"abac,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainPermissionResponse) Reset() {
+	*x = ExplainPermissionResponse{}
+	mi := &file_permission_v1_permission_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainPermissionResponse) ProtoMessage() {}
+
+func (x *ExplainPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainPermissionResponse.ProtoReflect.Descriptor instead.
+func (*ExplainPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExplainPermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ExplainPermissionResponse) GetRbac() *RBACTrace {
+	if x != nil {
+		return x.Rbac
+	}
+	return nil
+}
+
+func (x *ExplainPermissionResponse) GetAbac() *ABACTrace {
+	if x != nil {
+		return x.Abac
+	}
+	return nil
+}
+
+// RBAC 的判定过程
+type RBACTrace struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Allowed bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// 资源和操作都匹配上的权限，包括不在生效期内的
+	Matched []*PermissionMatch `protobuf:"bytes,2,rep,name=matched,proto3" json:"matched,omitempty"`
+	// 决定了最终结果的权限，拒绝优先
+	Decision      *PermissionMatch `protobuf:"bytes,3,opt,name=decision,proto3" json:"decision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RBACTrace) Reset() {
+	*x = RBACTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RBACTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RBACTrace) ProtoMessage() {}
+
+func (x *RBACTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RBACTrace.ProtoReflect.Descriptor instead.
+func (*RBACTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RBACTrace) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *RBACTrace) GetMatched() []*PermissionMatch {
+	if x != nil {
+		return x.Matched
+	}
+	return nil
+}
+
+func (x *RBACTrace) GetDecision() *PermissionMatch {
+	if x != nil {
+		return x.Decision
+	}
+	return nil
+}
+
+type PermissionMatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 直接授予用户的权限才有 user_permission_id
+	UserPermissionId int64  `protobuf:"varint,1,opt,name=user_permission_id,json=userPermissionId,proto3" json:"user_permission_id,omitempty"`
+	PermissionId     int64  `protobuf:"varint,2,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	ResourceType     string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceKey      string `protobuf:"bytes,4,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	Action           string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Effect           string `protobuf:"bytes,6,opt,name=effect,proto3" json:"effect,omitempty"`
+	StartTime        int64  `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          int64  `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 判定时是否处于生效期内
+	Valid bool `protobuf:"varint,9,opt,name=valid,proto3" json:"valid,omitempty"`
+	// 为空表示直接授予用户的权限，否则是从用户直接拥有的角色开始，沿着角色包含关系一路走到授予该权限的角色
+	RolePath []int64 `protobuf:"varint,10,rep,packed,name=role_path,json=rolePath,proto3" json:"role_path,omitempty"`
+	// 不为空表示这条权限是按照操作层级，从授权时的操作 implied_by 展开出来的，action 是被蕴含的操作
+	ImpliedBy     string `protobuf:"bytes,11,opt,name=implied_by,json=impliedBy,proto3" json:"implied_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionMatch) Reset() {
+	*x = PermissionMatch{}
+	mi := &file_permission_v1_permission_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionMatch) ProtoMessage() {}
+
+func (x *PermissionMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionMatch.ProtoReflect.Descriptor instead.
+func (*PermissionMatch) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PermissionMatch) GetUserPermissionId() int64 {
+	if x != nil {
+		return x.UserPermissionId
 	}
 	return 0
 }
 
-func (x *Resource) GetType() string {
+func (x *PermissionMatch) GetPermissionId() int64 {
 	if x != nil {
-		return x.Type
+		return x.PermissionId
 	}
-	return ""
+	return 0
 }
 
-func (x *Resource) GetKey() string {
+func (x *PermissionMatch) GetResourceType() string {
 	if x != nil {
-		return x.Key
+		return x.ResourceType
 	}
 	return ""
 }
 
-func (x *Resource) GetName() string {
+func (x *PermissionMatch) GetResourceKey() string {
 	if x != nil {
-		return x.Name
+		return x.ResourceKey
 	}
 	return ""
 }
 
-func (x *Resource) GetDescription() string {
+func (x *PermissionMatch) GetAction() string {
 	if x != nil {
-		return x.Description
+		return x.Action
 	}
 	return ""
 }
 
-func (x *Resource) GetMetadata() string {
+func (x *PermissionMatch) GetEffect() string {
 	if x != nil {
-		return x.Metadata
+		return x.Effect
 	}
 	return ""
 }
 
-var File_permission_v1_permission_proto protoreflect.FileDescriptor
+func (x *PermissionMatch) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
 
-const file_permission_v1_permission_proto_rawDesc = "" +
-	"\n" +
-	"\x1epermission/v1/permission.proto\x12\rpermission.v1\"\x88\x02\n" +
-	"\n" +
-	"Permission\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
-	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
-	"\vresource_id\x18\x05 \x01(\x03R\n" +
-	"resourceId\x12#\n" +
-	"\rresource_type\x18\x06 \x01(\tR\fresourceType\x12!\n" +
-	"\fresource_key\x18\a \x01(\tR\vresourceKey\x12\x18\n" +
-	"\aactions\x18\b \x03(\tR\aactions\x12\x1a\n" +
-	"\bmetadata\x18\t \x01(\tR\bmetadata\"\x92\x05\n" +
-	"\x16CheckPermissionRequest\x12\x10\n" +
-	"\x03uid\x18\x01 \x01(\x03R\x03uid\x129\n" +
-	"\n" +
-	"permission\x18\x02 \x01(\v2\x19.permission.v1.PermissionR\n" +
-	"permission\x12k\n" +
-	"\x12subject_attributes\x18\x03 \x03(\v2<.permission.v1.CheckPermissionRequest.SubjectAttributesEntryR\x11subjectAttributes\x12n\n" +
-	"\x13resource_attributes\x18\x04 \x03(\v2=.permission.v1.CheckPermissionRequest.ResourceAttributesEntryR\x12resourceAttributes\x12w\n" +
-	"\x16environment_attributes\x18\x05 \x03(\v2@.permission.v1.CheckPermissionRequest.EnvironmentAttributesEntryR\x15environmentAttributes\x1aD\n" +
-	"\x16SubjectAttributesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aE\n" +
-	"\x17ResourceAttributesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aH\n" +
-	"\x1aEnvironmentAttributesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"`\n" +
-	"\x1bBatchCheckPermissionRequest\x12A\n" +
-	"\brequests\x18\x01 \x03(\v2%.permission.v1.CheckPermissionRequestR\brequests\"8\n" +
-	"\x1cBatchCheckPermissionResponse\x12\x18\n" +
-	"\aallowed\x18\x01 \x03(\bR\aallowed\"3\n" +
-	"\x17CheckPermissionResponse\x12\x18\n" +
-	"\aallowed\x18\x01 \x01(\bR\aallowed\"\xa9\x01\n" +
-	"\bResource\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
-	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x10\n" +
-	"\x03key\x18\x04 \x01(\tR\x03key\x12\x12\n" +
-	"\x04name\x18\x05 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bmetadata\x18\a \x01(\tR\bmetadata2u\n" +
-	"\x11PermissionService\x12`\n" +
-	"\x0fCheckPermission\x12%.permission.v1.CheckPermissionRequest\x1a&.permission.v1.CheckPermissionResponse2\x89\x01\n" +
-	"\x16BatchPermissionService\x12o\n" +
-	"\x14BatchCheckPermission\x12*.permission.v1.BatchCheckPermissionRequest\x1a+.permission.v1.BatchCheckPermissionResponseB\xc9\x01\n" +
-	"\x11com.permission.v1B\x0fPermissionProtoP\x01ZNgitee.com/flycash/permission-platform/api/proto/gen/permission/v1;permissionv1\xa2\x02\x03PXX\xaa\x02\rPermission.V1\xca\x02\rPermission\\V1\xe2\x02\x19Permission\\V1\\GPBMetadata\xea\x02\x0ePermission::V1b\x06proto3"
+func (x *PermissionMatch) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
 
-var (
-	file_permission_v1_permission_proto_rawDescOnce sync.Once
-	file_permission_v1_permission_proto_rawDescData []byte
-)
+func (x *PermissionMatch) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
 
-func file_permission_v1_permission_proto_rawDescGZIP() []byte {
-	file_permission_v1_permission_proto_rawDescOnce.Do(func() {
-		file_permission_v1_permission_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_permission_v1_permission_proto_rawDesc), len(file_permission_v1_permission_proto_rawDesc)))
-	})
-	return file_permission_v1_permission_proto_rawDescData
+func (x *PermissionMatch) GetRolePath() []int64 {
+	if x != nil {
+		return x.RolePath
+	}
+	return nil
 }
 
-var (
-	file_permission_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-	file_permission_v1_permission_proto_goTypes  = []any{
-		(*Permission)(nil),                   // 0: permission.v1.Permission
-		(*CheckPermissionRequest)(nil),       // 1: permission.v1.CheckPermissionRequest
-		(*BatchCheckPermissionRequest)(nil),  // 2: permission.v1.BatchCheckPermissionRequest
-		(*BatchCheckPermissionResponse)(nil), // 3: permission.v1.BatchCheckPermissionResponse
-		(*CheckPermissionResponse)(nil),      // 4: permission.v1.CheckPermissionResponse
-		(*Resource)(nil),                     // 5: permission.v1.Resource
-		nil,                                  // 6: permission.v1.CheckPermissionRequest.SubjectAttributesEntry
-		nil,                                  // 7: permission.v1.CheckPermissionRequest.ResourceAttributesEntry
-		nil,                                  // 8: permission.v1.CheckPermissionRequest.EnvironmentAttributesEntry
+func (x *PermissionMatch) GetImpliedBy() string {
+	if x != nil {
+		return x.ImpliedBy
 	}
-)
+	return ""
+}
 
+// ABAC 的判定过程
+type ABACTrace struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Allowed bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// 合并了预存属性和实时属性之后，参与判定的属性值
+	Attributes    []*AttributeValueTrace `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Policies      []*PolicyTrace         `protobuf:"bytes,3,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ABACTrace) Reset() {
+	*x = ABACTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ABACTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ABACTrace) ProtoMessage() {}
+
+func (x *ABACTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ABACTrace.ProtoReflect.Descriptor instead.
+func (*ABACTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ABACTrace) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ABACTrace) GetAttributes() []*AttributeValueTrace {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ABACTrace) GetPolicies() []*PolicyTrace {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type AttributeValueTrace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttrDefId     int64                  `protobuf:"varint,1,opt,name=attr_def_id,json=attrDefId,proto3" json:"attr_def_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EntityType    string                 `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	DataType      string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Value         string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeValueTrace) Reset() {
+	*x = AttributeValueTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValueTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValueTrace) ProtoMessage() {}
+
+func (x *AttributeValueTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValueTrace.ProtoReflect.Descriptor instead.
+func (*AttributeValueTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AttributeValueTrace) GetAttrDefId() int64 {
+	if x != nil {
+		return x.AttrDefId
+	}
+	return 0
+}
+
+func (x *AttributeValueTrace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeValueTrace) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AttributeValueTrace) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *AttributeValueTrace) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PolicyTrace struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Matched  bool                   `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	// 策略关联的权限以及效果，策略命中的时候生效
+	Permissions   []*PolicyPermissionTrace `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Rules         []*RuleTrace             `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyTrace) Reset() {
+	*x = PolicyTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyTrace) ProtoMessage() {}
+
+func (x *PolicyTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyTrace.ProtoReflect.Descriptor instead.
+func (*PolicyTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PolicyTrace) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyTrace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PolicyTrace) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *PolicyTrace) GetPermissions() []*PolicyPermissionTrace {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *PolicyTrace) GetRules() []*RuleTrace {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type PolicyPermissionTrace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PermissionId  int64                  `protobuf:"varint,1,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	Effect        string                 `protobuf:"bytes,2,opt,name=effect,proto3" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyPermissionTrace) Reset() {
+	*x = PolicyPermissionTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyPermissionTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyPermissionTrace) ProtoMessage() {}
+
+func (x *PolicyPermissionTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyPermissionTrace.ProtoReflect.Descriptor instead.
+func (*PolicyPermissionTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PolicyPermissionTrace) GetPermissionId() int64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+func (x *PolicyPermissionTrace) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+// 规则树上单个节点的判定过程
+type RuleTrace struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RuleId    int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Operator  string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	AttrDefId int64                  `protobuf:"varint,3,opt,name=attr_def_id,json=attrDefId,proto3" json:"attr_def_id,omitempty"`
+	AttrName  string                 `protobuf:"bytes,4,opt,name=attr_name,json=attrName,proto3" json:"attr_name,omitempty"`
+	// 叶子节点上规则期望的值和实际使用的属性值
+	Value       string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	ActualValue string `protobuf:"bytes,6,opt,name=actual_value,json=actualValue,proto3" json:"actual_value,omitempty"`
+	Result      bool   `protobuf:"varint,7,opt,name=result,proto3" json:"result,omitempty"`
+	// 叶子节点求值失败的原因，失败的节点视为不满足
+	Error string     `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Left  *RuleTrace `protobuf:"bytes,9,opt,name=left,proto3" json:"left,omitempty"`
+	Right *RuleTrace `protobuf:"bytes,10,opt,name=right,proto3" json:"right,omitempty"`
+	// 和另一个属性比较的时候，value 是这个属性实际使用的值
+	ValueAttrDefId int64  `protobuf:"varint,11,opt,name=value_attr_def_id,json=valueAttrDefId,proto3" json:"value_attr_def_id,omitempty"`
+	ValueAttrName  string `protobuf:"bytes,12,opt,name=value_attr_name,json=valueAttrName,proto3" json:"value_attr_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RuleTrace) Reset() {
+	*x = RuleTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleTrace) ProtoMessage() {}
+
+func (x *RuleTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleTrace.ProtoReflect.Descriptor instead.
+func (*RuleTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RuleTrace) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *RuleTrace) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetAttrDefId() int64 {
+	if x != nil {
+		return x.AttrDefId
+	}
+	return 0
+}
+
+func (x *RuleTrace) GetAttrName() string {
+	if x != nil {
+		return x.AttrName
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetActualValue() string {
+	if x != nil {
+		return x.ActualValue
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *RuleTrace) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetLeft() *RuleTrace {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *RuleTrace) GetRight() *RuleTrace {
+	if x != nil {
+		return x.Right
+	}
+	return nil
+}
+
+func (x *RuleTrace) GetValueAttrDefId() int64 {
+	if x != nil {
+		return x.ValueAttrDefId
+	}
+	return 0
+}
+
+func (x *RuleTrace) GetValueAttrName() string {
+	if x != nil {
+		return x.ValueAttrName
+	}
+	return ""
+}
+
+// 可访问资源查询请求，attributes 的含义和 CheckPermissionRequest 一致，资源属性由调用方自己代入
+type ListAccessibleResourcesRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Uid                   int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ResourceType          string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Action                string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	SubjectAttributes     map[string]string      `protobuf:"bytes,4,rep,name=subject_attributes,json=subjectAttributes,proto3" json:"subject_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EnvironmentAttributes map[string]string      `protobuf:"bytes,5,rep,name=environment_attributes,json=environmentAttributes,proto3" json:"environment_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListAccessibleResourcesRequest) Reset() {
+	*x = ListAccessibleResourcesRequest{}
+	mi := &file_permission_v1_permission_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessibleResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessibleResourcesRequest) ProtoMessage() {}
+
+func (x *ListAccessibleResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessibleResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessibleResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAccessibleResourcesRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ListAccessibleResourcesRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListAccessibleResourcesRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAccessibleResourcesRequest) GetSubjectAttributes() map[string]string {
+	if x != nil {
+		return x.SubjectAttributes
+	}
+	return nil
+}
+
+func (x *ListAccessibleResourcesRequest) GetEnvironmentAttributes() map[string]string {
+	if x != nil {
+		return x.EnvironmentAttributes
+	}
+	return nil
+}
+
+// 可访问资源查询响应，没有使用的判定引擎对应的部分为空，两部分都不为空的时候要同时满足
+type ListAccessibleResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rbac          *RBACAccess            `protobuf:"bytes,1,opt,name=rbac,proto3" json:"rbac,omitempty"`
+	Abac          *ABACAccess            `protobuf:"bytes,2,opt,name=abac,proto3" json:"abac,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessibleResourcesResponse) Reset() {
+	*x = ListAccessibleResourcesResponse{}
+	mi := &file_permission_v1_permission_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessibleResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessibleResourcesResponse) ProtoMessage() {}
+
+func (x *ListAccessibleResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessibleResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessibleResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAccessibleResourcesResponse) GetRbac() *RBACAccess {
+	if x != nil {
+		return x.Rbac
+	}
+	return nil
+}
+
+func (x *ListAccessibleResourcesResponse) GetAbac() *ABACAccess {
+	if x != nil {
+		return x.Abac
+	}
+	return nil
+}
+
+// RBAC 允许访问的资源标识，资源标识匹配任意一个 keys 就可以访问
+type RBACAccess struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*AccessibleKey       `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RBACAccess) Reset() {
+	*x = RBACAccess{}
+	mi := &file_permission_v1_permission_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RBACAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RBACAccess) ProtoMessage() {}
+
+func (x *RBACAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RBACAccess.ProtoReflect.Descriptor instead.
+func (*RBACAccess) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RBACAccess) GetKeys() []*AccessibleKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// 资源标识匹配 key、并且不匹配 except 里的任何一个的时候才可以访问，匹配规则和校验时一致
+type AccessibleKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Except        []string               `protobuf:"bytes,2,rep,name=except,proto3" json:"except,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessibleKey) Reset() {
+	*x = AccessibleKey{}
+	mi := &file_permission_v1_permission_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessibleKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessibleKey) ProtoMessage() {}
+
+func (x *AccessibleKey) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessibleKey.ProtoReflect.Descriptor instead.
+func (*AccessibleKey) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AccessibleKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AccessibleKey) GetExcept() []string {
+	if x != nil {
+		return x.Except
+	}
+	return nil
+}
+
+// ABAC 下每个资源还要满足的条件
+type ABACAccess struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 绑定了策略的资源上剩下的、只和资源属性有关的条件
+	Residuals []*ResourceResidual `protobuf:"bytes,1,rep,name=residuals,proto3" json:"residuals,omitempty"`
+	// 策略太多、没有办法化简的资源，需要逐个调用 CheckPermission
+	Unresolved []string `protobuf:"bytes,2,rep,name=unresolved,proto3" json:"unresolved,omitempty"`
+	// 不在上面两个列表里的资源是否可以访问
+	DefaultAllowed bool `protobuf:"varint,3,opt,name=default_allowed,json=defaultAllowed,proto3" json:"default_allowed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ABACAccess) Reset() {
+	*x = ABACAccess{}
+	mi := &file_permission_v1_permission_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ABACAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ABACAccess) ProtoMessage() {}
+
+func (x *ABACAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ABACAccess.ProtoReflect.Descriptor instead.
+func (*ABACAccess) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ABACAccess) GetResiduals() []*ResourceResidual {
+	if x != nil {
+		return x.Residuals
+	}
+	return nil
+}
+
+func (x *ABACAccess) GetUnresolved() []string {
+	if x != nil {
+		return x.Unresolved
+	}
+	return nil
+}
+
+func (x *ABACAccess) GetDefaultAllowed() bool {
+	if x != nil {
+		return x.DefaultAllowed
+	}
+	return false
+}
+
+type ResourceResidual struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ResourceKey string                 `protobuf:"bytes,1,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	// condition 为空的时候结果已经确定，就是 allowed
+	Allowed       bool               `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Condition     *ResidualCondition `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceResidual) Reset() {
+	*x = ResourceResidual{}
+	mi := &file_permission_v1_permission_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceResidual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceResidual) ProtoMessage() {}
+
+func (x *ResourceResidual) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceResidual.ProtoReflect.Descriptor instead.
+func (*ResourceResidual) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ResourceResidual) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *ResourceResidual) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ResourceResidual) GetCondition() *ResidualCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+// 剩下的条件树，逻辑节点的 operator 是 AND、OR、NOT，NOT 只有 right。
+// 叶子节点是 attr_name operator value，attr_name 是资源属性名；value_attr_name 不为空的时候和另一个资源属性比较；
+// reversed 表示属性在运算符的右边，即 value operator attr_name。资源没有对应属性取值的时候叶子节点的结果是 false
+type ResidualCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operator      string                 `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	AttrName      string                 `protobuf:"bytes,2,opt,name=attr_name,json=attrName,proto3" json:"attr_name,omitempty"`
+	DataType      string                 `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	ValueAttrName string                 `protobuf:"bytes,5,opt,name=value_attr_name,json=valueAttrName,proto3" json:"value_attr_name,omitempty"`
+	Reversed      bool                   `protobuf:"varint,6,opt,name=reversed,proto3" json:"reversed,omitempty"`
+	Left          *ResidualCondition     `protobuf:"bytes,7,opt,name=left,proto3" json:"left,omitempty"`
+	Right         *ResidualCondition     `protobuf:"bytes,8,opt,name=right,proto3" json:"right,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResidualCondition) Reset() {
+	*x = ResidualCondition{}
+	mi := &file_permission_v1_permission_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResidualCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResidualCondition) ProtoMessage() {}
+
+func (x *ResidualCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResidualCondition.ProtoReflect.Descriptor instead.
+func (*ResidualCondition) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResidualCondition) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ResidualCondition) GetAttrName() string {
+	if x != nil {
+		return x.AttrName
+	}
+	return ""
+}
+
+func (x *ResidualCondition) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *ResidualCondition) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ResidualCondition) GetValueAttrName() string {
+	if x != nil {
+		return x.ValueAttrName
+	}
+	return ""
+}
+
+func (x *ResidualCondition) GetReversed() bool {
+	if x != nil {
+		return x.Reversed
+	}
+	return false
+}
+
+func (x *ResidualCondition) GetLeft() *ResidualCondition {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *ResidualCondition) GetRight() *ResidualCondition {
+	if x != nil {
+		return x.Right
+	}
+	return nil
+}
+
+type Resource struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId       int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Type        string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Key         string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Name        string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// 和 Resource 有关的内容，是业务方在创建 Resource 的时候传入的内容
+	// 原封不动的返回
+	Metadata      string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_permission_v1_permission_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Resource) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Resource) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *Resource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Resource) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Resource) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+var File_permission_v1_permission_proto protoreflect.FileDescriptor
+
+const file_permission_v1_permission_proto_rawDesc = "" +
+	"\n" +
+	"\x1epermission/v1/permission.proto\x12\rpermission.v1\"\xb9\x02\n" +
+	"\n" +
+	"Permission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vresource_id\x18\x05 \x01(\x03R\n" +
+	"resourceId\x12#\n" +
+	"\rresource_type\x18\x06 \x01(\tR\fresourceType\x12!\n" +
+	"\fresource_key\x18\a \x01(\tR\vresourceKey\x12\x18\n" +
+	"\aactions\x18\b \x03(\tR\aactions\x12\x1a\n" +
+	"\bmetadata\x18\t \x01(\tR\bmetadata\x12/\n" +
+	"\x13combining_algorithm\x18\n" +
+	" \x01(\tR\x12combiningAlgorithm\"\x92\x05\n" +
+	"\x16CheckPermissionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x129\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\v2\x19.permission.v1.PermissionR\n" +
+	"permission\x12k\n" +
+	"\x12subject_attributes\x18\x03 \x03(\v2<.permission.v1.CheckPermissionRequest.SubjectAttributesEntryR\x11subjectAttributes\x12n\n" +
+	"\x13resource_attributes\x18\x04 \x03(\v2=.permission.v1.CheckPermissionRequest.ResourceAttributesEntryR\x12resourceAttributes\x12w\n" +
+	"\x16environment_attributes\x18\x05 \x03(\v2@.permission.v1.CheckPermissionRequest.EnvironmentAttributesEntryR\x15environmentAttributes\x1aD\n" +
+	"\x16SubjectAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aE\n" +
+	"\x17ResourceAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aH\n" +
+	"\x1aEnvironmentAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"`\n" +
+	"\x1bBatchCheckPermissionRequest\x12A\n" +
+	"\brequests\x18\x01 \x03(\v2%.permission.v1.CheckPermissionRequestR\brequests\"8\n" +
+	"\x1cBatchCheckPermissionResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x03(\bR\aallowed\"3\n" +
+	"\x17CheckPermissionResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\"\x9a\x05\n" +
+	"\x18ExplainPermissionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x129\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\v2\x19.permission.v1.PermissionR\n" +
+	"permission\x12m\n" +
+	"\x12subject_attributes\x18\x03 \x03(\v2>.permission.v1.ExplainPermissionRequest.SubjectAttributesEntryR\x11subjectAttributes\x12p\n" +
+	"\x13resource_attributes\x18\x04 \x03(\v2?.permission.v1.ExplainPermissionRequest.ResourceAttributesEntryR\x12resourceAttributes\x12y\n" +
+	"\x16environment_attributes\x18\x05 \x03(\v2B.permission.v1.ExplainPermissionRequest.EnvironmentAttributesEntryR\x15environmentAttributes\x1aD\n" +
+	"\x16SubjectAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aE\n" +
+	"\x17ResourceAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aH\n" +
+	"\x1aEnvironmentAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x91\x01\n" +
+	"\x19ExplainPermissionResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12,\n" +
+	"\x04rbac\x18\x02 \x01(\v2\x18.permission.v1.RBACTraceR\x04rbac\x12,\n" +
+	"\x04abac\x18\x03 \x01(\v2\x18.permission.v1.ABACTraceR\x04abac\"\x9b\x01\n" +
+	"\tRBACTrace\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x128\n" +
+	"\amatched\x18\x02 \x03(\v2\x1e.permission.v1.PermissionMatchR\amatched\x12:\n" +
+	"\bdecision\x18\x03 \x01(\v2\x1e.permission.v1.PermissionMatchR\bdecision\"\xe8\x02\n" +
+	"\x0fPermissionMatch\x12,\n" +
+	"\x12user_permission_id\x18\x01 \x01(\x03R\x10userPermissionId\x12#\n" +
+	"\rpermission_id\x18\x02 \x01(\x03R\fpermissionId\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12!\n" +
+	"\fresource_key\x18\x04 \x01(\tR\vresourceKey\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x16\n" +
+	"\x06effect\x18\x06 \x01(\tR\x06effect\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\a \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\b \x01(\x03R\aendTime\x12\x14\n" +
+	"\x05valid\x18\t \x01(\bR\x05valid\x12\x1b\n" +
+	"\trole_path\x18\n" +
+	" \x03(\x03R\brolePath\x12\x1d\n" +
+	"\n" +
+	"implied_by\x18\v \x01(\tR\timpliedBy\"\xa1\x01\n" +
+	"\tABACTrace\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12B\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2\".permission.v1.AttributeValueTraceR\n" +
+	"attributes\x126\n" +
+	"\bpolicies\x18\x03 \x03(\v2\x1a.permission.v1.PolicyTraceR\bpolicies\"\x9d\x01\n" +
+	"\x13AttributeValueTrace\x12\x1e\n" +
+	"\vattr_def_id\x18\x01 \x01(\x03R\tattrDefId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\ventity_type\x18\x03 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tdata_type\x18\x04 \x01(\tR\bdataType\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\"\xd0\x01\n" +
+	"\vPolicyTrace\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\amatched\x18\x03 \x01(\bR\amatched\x12F\n" +
+	"\vpermissions\x18\x04 \x03(\v2$.permission.v1.PolicyPermissionTraceR\vpermissions\x12.\n" +
+	"\x05rules\x18\x05 \x03(\v2\x18.permission.v1.RuleTraceR\x05rules\"T\n" +
+	"\x15PolicyPermissionTrace\x12#\n" +
+	"\rpermission_id\x18\x01 \x01(\x03R\fpermissionId\x12\x16\n" +
+	"\x06effect\x18\x02 \x01(\tR\x06effect\"\x95\x03\n" +
+	"\tRuleTrace\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x1e\n" +
+	"\vattr_def_id\x18\x03 \x01(\x03R\tattrDefId\x12\x1b\n" +
+	"\tattr_name\x18\x04 \x01(\tR\battrName\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12!\n" +
+	"\factual_value\x18\x06 \x01(\tR\vactualValue\x12\x16\n" +
+	"\x06result\x18\a \x01(\bR\x06result\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12,\n" +
+	"\x04left\x18\t \x01(\v2\x18.permission.v1.RuleTraceR\x04left\x12.\n" +
+	"\x05right\x18\n" +
+	" \x01(\v2\x18.permission.v1.RuleTraceR\x05right\x12)\n" +
+	"\x11value_attr_def_id\x18\v \x01(\x03R\x0evalueAttrDefId\x12&\n" +
+	"\x0fvalue_attr_name\x18\f \x01(\tR\rvalueAttrName\"\xf5\x03\n" +
+	"\x1eListAccessibleResourcesRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12s\n" +
+	"\x12subject_attributes\x18\x04 \x03(\v2D.permission.v1.ListAccessibleResourcesRequest.SubjectAttributesEntryR\x11subjectAttributes\x12\x7f\n" +
+	"\x16environment_attributes\x18\x05 \x03(\v2H.permission.v1.ListAccessibleResourcesRequest.EnvironmentAttributesEntryR\x15environmentAttributes\x1aD\n" +
+	"\x16SubjectAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aH\n" +
+	"\x1aEnvironmentAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x7f\n" +
+	"\x1fListAccessibleResourcesResponse\x12-\n" +
+	"\x04rbac\x18\x01 \x01(\v2\x19.permission.v1.RBACAccessR\x04rbac\x12-\n" +
+	"\x04abac\x18\x02 \x01(\v2\x19.permission.v1.ABACAccessR\x04abac\">\n" +
+	"\n" +
+	"RBACAccess\x120\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1c.permission.v1.AccessibleKeyR\x04keys\"9\n" +
+	"\rAccessibleKey\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06except\x18\x02 \x03(\tR\x06except\"\x94\x01\n" +
+	"\n" +
+	"ABACAccess\x12=\n" +
+	"\tresiduals\x18\x01 \x03(\v2\x1f.permission.v1.ResourceResidualR\tresiduals\x12\x1e\n" +
+	"\n" +
+	"unresolved\x18\x02 \x03(\tR\n" +
+	"unresolved\x12'\n" +
+	"\x0fdefault_allowed\x18\x03 \x01(\bR\x0edefaultAllowed\"\x8f\x01\n" +
+	"\x10ResourceResidual\x12!\n" +
+	"\fresource_key\x18\x01 \x01(\tR\vresourceKey\x12\x18\n" +
+	"\aallowed\x18\x02 \x01(\bR\aallowed\x12>\n" +
+	"\tcondition\x18\x03 \x01(\v2 .permission.v1.ResidualConditionR\tcondition\"\xb1\x02\n" +
+	"\x11ResidualCondition\x12\x1a\n" +
+	"\boperator\x18\x01 \x01(\tR\boperator\x12\x1b\n" +
+	"\tattr_name\x18\x02 \x01(\tR\battrName\x12\x1b\n" +
+	"\tdata_type\x18\x03 \x01(\tR\bdataType\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12&\n" +
+	"\x0fvalue_attr_name\x18\x05 \x01(\tR\rvalueAttrName\x12\x1a\n" +
+	"\breversed\x18\x06 \x01(\bR\breversed\x124\n" +
+	"\x04left\x18\a \x01(\v2 .permission.v1.ResidualConditionR\x04left\x126\n" +
+	"\x05right\x18\b \x01(\v2 .permission.v1.ResidualConditionR\x05right\"\xa9\x01\n" +
+	"\bResource\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bmetadata\x18\a \x01(\tR\bmetadata2\xd7\x02\n" +
+	"\x11PermissionService\x12`\n" +
+	"\x0fCheckPermission\x12%.permission.v1.CheckPermissionRequest\x1a&.permission.v1.CheckPermissionResponse\x12f\n" +
+	"\x11ExplainPermission\x12'.permission.v1.ExplainPermissionRequest\x1a(.permission.v1.ExplainPermissionResponse\x12x\n" +
+	"\x17ListAccessibleResources\x12-.permission.v1.ListAccessibleResourcesRequest\x1a..permission.v1.ListAccessibleResourcesResponse2\x89\x01\n" +
+	"\x16BatchPermissionService\x12o\n" +
+	"\x14BatchCheckPermission\x12*.permission.v1.BatchCheckPermissionRequest\x1a+.permission.v1.BatchCheckPermissionResponseB\xc9\x01\n" +
+	"\x11com.permission.v1B\x0fPermissionProtoP\x01ZNgitee.com/flycash/permission-platform/api/proto/gen/permission/v1;permissionv1\xa2\x02\x03PXX\xaa\x02\rPermission.V1\xca\x02\rPermission\\V1\xe2\x02\x19Permission\\V1\\GPBMetadata\xea\x02\x0ePermission::V1b\x06proto3"
+
+var (
+	file_permission_v1_permission_proto_rawDescOnce sync.Once
+	file_permission_v1_permission_proto_rawDescData []byte
+)
+
+func file_permission_v1_permission_proto_rawDescGZIP() []byte {
+	file_permission_v1_permission_proto_rawDescOnce.Do(func() {
+		file_permission_v1_permission_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_permission_v1_permission_proto_rawDesc), len(file_permission_v1_permission_proto_rawDesc)))
+	})
+	return file_permission_v1_permission_proto_rawDescData
+}
+
+var (
+	file_permission_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+	file_permission_v1_permission_proto_goTypes  = []any{
+		(*Permission)(nil),                      // 0: permission.v1.Permission
+		(*CheckPermissionRequest)(nil),          // 1: permission.v1.CheckPermissionRequest
+		(*BatchCheckPermissionRequest)(nil),     // 2: permission.v1.BatchCheckPermissionRequest
+		(*BatchCheckPermissionResponse)(nil),    // 3: permission.v1.BatchCheckPermissionResponse
+		(*CheckPermissionResponse)(nil),         // 4: permission.v1.CheckPermissionResponse
+		(*ExplainPermissionRequest)(nil),        // 5: permission.v1.ExplainPermissionRequest
+		(*ExplainPermissionResponse)(nil),       // 6: permission.v1.ExplainPermissionResponse
+		(*RBACTrace)(nil),                       // 7: permission.v1.RBACTrace
+		(*PermissionMatch)(nil),                 // 8: permission.v1.PermissionMatch
+		(*ABACTrace)(nil),                       // 9: permission.v1.ABACTrace
+		(*AttributeValueTrace)(nil),             // 10: permission.v1.AttributeValueTrace
+		(*PolicyTrace)(nil),                     // 11: permission.v1.PolicyTrace
+		(*PolicyPermissionTrace)(nil),           // 12: permission.v1.PolicyPermissionTrace
+		(*RuleTrace)(nil),                       // 13: permission.v1.RuleTrace
+		(*ListAccessibleResourcesRequest)(nil),  // 14: permission.v1.ListAccessibleResourcesRequest
+		(*ListAccessibleResourcesResponse)(nil), // 15: permission.v1.ListAccessibleResourcesResponse
+		(*RBACAccess)(nil),                      // 16: permission.v1.RBACAccess
+		(*AccessibleKey)(nil),                   // 17: permission.v1.AccessibleKey
+		(*ABACAccess)(nil),                      // 18: permission.v1.ABACAccess
+		(*ResourceResidual)(nil),                // 19: permission.v1.ResourceResidual
+		(*ResidualCondition)(nil),               // 20: permission.v1.ResidualCondition
+		(*Resource)(nil),                        // 21: permission.v1.Resource
+		nil,                                     // 22: permission.v1.CheckPermissionRequest.SubjectAttributesEntry
+		nil,                                     // 23: permission.v1.CheckPermissionRequest.ResourceAttributesEntry
+		nil,                                     // 24: permission.v1.CheckPermissionRequest.EnvironmentAttributesEntry
+		nil,                                     // 25: permission.v1.ExplainPermissionRequest.SubjectAttributesEntry
+		nil,                                     // 26: permission.v1.ExplainPermissionRequest.ResourceAttributesEntry
+		nil,                                     // 27: permission.v1.ExplainPermissionRequest.EnvironmentAttributesEntry
+		nil,                                     // 28: permission.v1.ListAccessibleResourcesRequest.SubjectAttributesEntry
+		nil,                                     // 29: permission.v1.ListAccessibleResourcesRequest.EnvironmentAttributesEntry
+	}
+)
 var file_permission_v1_permission_proto_depIdxs = []int32{
-	0, // 0: permission.v1.CheckPermissionRequest.permission:type_name -> permission.v1.Permission
-	6, // 1: permission.v1.CheckPermissionRequest.subject_attributes:type_name -> permission.v1.CheckPermissionRequest.SubjectAttributesEntry
-	7, // 2: permission.v1.CheckPermissionRequest.resource_attributes:type_name -> permission.v1.CheckPermissionRequest.ResourceAttributesEntry
-	8, // 3: permission.v1.CheckPermissionRequest.environment_attributes:type_name -> permission.v1.CheckPermissionRequest.EnvironmentAttributesEntry
-	1, // 4: permission.v1.BatchCheckPermissionRequest.requests:type_name -> permission.v1.CheckPermissionRequest
-	1, // 5: permission.v1.PermissionService.CheckPermission:input_type -> permission.v1.CheckPermissionRequest
-	2, // 6: permission.v1.BatchPermissionService.BatchCheckPermission:input_type -> permission.v1.BatchCheckPermissionRequest
-	4, // 7: permission.v1.PermissionService.CheckPermission:output_type -> permission.v1.CheckPermissionResponse
-	3, // 8: permission.v1.BatchPermissionService.BatchCheckPermission:output_type -> permission.v1.BatchCheckPermissionResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: permission.v1.CheckPermissionRequest.permission:type_name -> permission.v1.Permission
+	22, // 1: permission.v1.CheckPermissionRequest.subject_attributes:type_name -> permission.v1.CheckPermissionRequest.SubjectAttributesEntry
+	23, // 2: permission.v1.CheckPermissionRequest.resource_attributes:type_name -> permission.v1.CheckPermissionRequest.ResourceAttributesEntry
+	24, // 3: permission.v1.CheckPermissionRequest.environment_attributes:type_name -> permission.v1.CheckPermissionRequest.EnvironmentAttributesEntry
+	1,  // 4: permission.v1.BatchCheckPermissionRequest.requests:type_name -> permission.v1.CheckPermissionRequest
+	0,  // 5: permission.v1.ExplainPermissionRequest.permission:type_name -> permission.v1.Permission
+	25, // 6: permission.v1.ExplainPermissionRequest.subject_attributes:type_name -> permission.v1.ExplainPermissionRequest.SubjectAttributesEntry
+	26, // 7: permission.v1.ExplainPermissionRequest.resource_attributes:type_name -> permission.v1.ExplainPermissionRequest.ResourceAttributesEntry
+	27, // 8: permission.v1.ExplainPermissionRequest.environment_attributes:type_name -> permission.v1.ExplainPermissionRequest.EnvironmentAttributesEntry
+	7,  // 9: permission.v1.ExplainPermissionResponse.rbac:type_name -> permission.v1.RBACTrace
+	9,  // 10: permission.v1.ExplainPermissionResponse.abac:type_name -> permission.v1.ABACTrace
+	8,  // 11: permission.v1.RBACTrace.matched:type_name -> permission.v1.PermissionMatch
+	8,  // 12: permission.v1.RBACTrace.decision:type_name -> permission.v1.PermissionMatch
+	10, // 13: permission.v1.ABACTrace.attributes:type_name -> permission.v1.AttributeValueTrace
+	11, // 14: permission.v1.ABACTrace.policies:type_name -> permission.v1.PolicyTrace
+	12, // 15: permission.v1.PolicyTrace.permissions:type_name -> permission.v1.PolicyPermissionTrace
+	13, // 16: permission.v1.PolicyTrace.rules:type_name -> permission.v1.RuleTrace
+	13, // 17: permission.v1.RuleTrace.left:type_name -> permission.v1.RuleTrace
+	13, // 18: permission.v1.RuleTrace.right:type_name -> permission.v1.RuleTrace
+	28, // 19: permission.v1.ListAccessibleResourcesRequest.subject_attributes:type_name -> permission.v1.ListAccessibleResourcesRequest.SubjectAttributesEntry
+	29, // 20: permission.v1.ListAccessibleResourcesRequest.environment_attributes:type_name -> permission.v1.ListAccessibleResourcesRequest.EnvironmentAttributesEntry
+	16, // 21: permission.v1.ListAccessibleResourcesResponse.rbac:type_name -> permission.v1.RBACAccess
+	18, // 22: permission.v1.ListAccessibleResourcesResponse.abac:type_name -> permission.v1.ABACAccess
+	17, // 23: permission.v1.RBACAccess.keys:type_name -> permission.v1.AccessibleKey
+	19, // 24: permission.v1.ABACAccess.residuals:type_name -> permission.v1.ResourceResidual
+	20, // 25: permission.v1.ResourceResidual.condition:type_name -> permission.v1.ResidualCondition
+	20, // 26: permission.v1.ResidualCondition.left:type_name -> permission.v1.ResidualCondition
+	20, // 27: permission.v1.ResidualCondition.right:type_name -> permission.v1.ResidualCondition
+	1,  // 28: permission.v1.PermissionService.CheckPermission:input_type -> permission.v1.CheckPermissionRequest
+	5,  // 29: permission.v1.PermissionService.ExplainPermission:input_type -> permission.v1.ExplainPermissionRequest
+	14, // 30: permission.v1.PermissionService.ListAccessibleResources:input_type -> permission.v1.ListAccessibleResourcesRequest
+	2,  // 31: permission.v1.BatchPermissionService.BatchCheckPermission:input_type -> permission.v1.BatchCheckPermissionRequest
+	4,  // 32: permission.v1.PermissionService.CheckPermission:output_type -> permission.v1.CheckPermissionResponse
+	6,  // 33: permission.v1.PermissionService.ExplainPermission:output_type -> permission.v1.ExplainPermissionResponse
+	15, // 34: permission.v1.PermissionService.ListAccessibleResources:output_type -> permission.v1.ListAccessibleResourcesResponse
+	3,  // 35: permission.v1.BatchPermissionService.BatchCheckPermission:output_type -> permission.v1.BatchCheckPermissionResponse
+	32, // [32:36] is the sub-list for method output_type
+	28, // [28:32] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_permission_v1_permission_proto_init() }
@@ -546,7 +1910,7 @@ func file_permission_v1_permission_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permission_v1_permission_proto_rawDesc), len(file_permission_v1_permission_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
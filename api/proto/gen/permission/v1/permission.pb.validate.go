@@ -73,6 +73,8 @@ func (m *Permission) validate(all bool) error {
 
 	// no validation rules for Metadata
 
+	// no validation rules for CombiningAlgorithm
+
 	if len(errors) > 0 {
 		return PermissionMultiError(errors)
 	}
@@ -633,6 +635,2277 @@ var _ interface {
 	ErrorName() string
 } = CheckPermissionResponseValidationError{}
 
+// Validate checks the field values on ExplainPermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExplainPermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainPermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainPermissionRequestMultiError, or nil if none found.
+func (m *ExplainPermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainPermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	if all {
+		switch v := interface{}(m.GetPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainPermissionRequestValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainPermissionRequestValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainPermissionRequestValidationError{
+				field:  "Permission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SubjectAttributes
+
+	// no validation rules for ResourceAttributes
+
+	// no validation rules for EnvironmentAttributes
+
+	if len(errors) > 0 {
+		return ExplainPermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainPermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by ExplainPermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExplainPermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainPermissionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainPermissionRequestMultiError) AllErrors() []error { return m }
+
+// ExplainPermissionRequestValidationError is the validation error returned by
+// ExplainPermissionRequest.Validate if the designated constraints aren't met.
+type ExplainPermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainPermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainPermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainPermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainPermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainPermissionRequestValidationError) ErrorName() string {
+	return "ExplainPermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainPermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainPermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainPermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainPermissionRequestValidationError{}
+
+// Validate checks the field values on ExplainPermissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExplainPermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainPermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainPermissionResponseMultiError, or nil if none found.
+func (m *ExplainPermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainPermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	if all {
+		switch v := interface{}(m.GetRbac()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainPermissionResponseValidationError{
+					field:  "Rbac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainPermissionResponseValidationError{
+					field:  "Rbac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRbac()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainPermissionResponseValidationError{
+				field:  "Rbac",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAbac()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainPermissionResponseValidationError{
+					field:  "Abac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainPermissionResponseValidationError{
+					field:  "Abac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAbac()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainPermissionResponseValidationError{
+				field:  "Abac",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExplainPermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainPermissionResponseMultiError is an error wrapping multiple validation
+// errors returned by ExplainPermissionResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ExplainPermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainPermissionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainPermissionResponseMultiError) AllErrors() []error { return m }
+
+// ExplainPermissionResponseValidationError is the validation error returned by
+// ExplainPermissionResponse.Validate if the designated constraints aren't met.
+type ExplainPermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainPermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainPermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainPermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainPermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainPermissionResponseValidationError) ErrorName() string {
+	return "ExplainPermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainPermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainPermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainPermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainPermissionResponseValidationError{}
+
+// Validate checks the field values on RBACTrace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RBACTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RBACTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RBACTraceMultiError, or nil
+// if none found.
+func (m *RBACTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RBACTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	for idx, item := range m.GetMatched() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RBACTraceValidationError{
+						field:  fmt.Sprintf("Matched[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RBACTraceValidationError{
+						field:  fmt.Sprintf("Matched[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RBACTraceValidationError{
+					field:  fmt.Sprintf("Matched[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDecision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RBACTraceValidationError{
+					field:  "Decision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RBACTraceValidationError{
+					field:  "Decision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RBACTraceValidationError{
+				field:  "Decision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RBACTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// RBACTraceMultiError is an error wrapping multiple validation errors returned
+// by RBACTrace.ValidateAll() if the designated constraints aren't met.
+type RBACTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RBACTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RBACTraceMultiError) AllErrors() []error { return m }
+
+// RBACTraceValidationError is the validation error returned by
+// RBACTrace.Validate if the designated constraints aren't met.
+type RBACTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RBACTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RBACTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RBACTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RBACTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RBACTraceValidationError) ErrorName() string { return "RBACTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RBACTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRBACTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RBACTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RBACTraceValidationError{}
+
+// Validate checks the field values on PermissionMatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PermissionMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionMatchMultiError, or nil if none found.
+func (m *PermissionMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserPermissionId
+
+	// no validation rules for PermissionId
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceKey
+
+	// no validation rules for Action
+
+	// no validation rules for Effect
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Valid
+
+	// no validation rules for ImpliedBy
+
+	if len(errors) > 0 {
+		return PermissionMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionMatchMultiError is an error wrapping multiple validation errors
+// returned by PermissionMatch.ValidateAll() if the designated constraints
+// aren't met.
+type PermissionMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionMatchMultiError) AllErrors() []error { return m }
+
+// PermissionMatchValidationError is the validation error returned by
+// PermissionMatch.Validate if the designated constraints aren't met.
+type PermissionMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionMatchValidationError) ErrorName() string { return "PermissionMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PermissionMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionMatchValidationError{}
+
+// Validate checks the field values on ABACTrace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ABACTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ABACTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ABACTraceMultiError, or nil
+// if none found.
+func (m *ABACTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ABACTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ABACTraceValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ABACTraceValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ABACTraceValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ABACTraceValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ABACTraceValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ABACTraceValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ABACTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ABACTraceMultiError is an error wrapping multiple validation errors returned
+// by ABACTrace.ValidateAll() if the designated constraints aren't met.
+type ABACTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ABACTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ABACTraceMultiError) AllErrors() []error { return m }
+
+// ABACTraceValidationError is the validation error returned by
+// ABACTrace.Validate if the designated constraints aren't met.
+type ABACTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ABACTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ABACTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ABACTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ABACTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ABACTraceValidationError) ErrorName() string { return "ABACTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ABACTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sABACTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ABACTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ABACTraceValidationError{}
+
+// Validate checks the field values on AttributeValueTrace with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeValueTrace with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeValueTraceMultiError, or nil if none found.
+func (m *AttributeValueTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AttrDefId
+
+	// no validation rules for Name
+
+	// no validation rules for EntityType
+
+	// no validation rules for DataType
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return AttributeValueTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueTraceMultiError is an error wrapping multiple validation
+// errors returned by AttributeValueTrace.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeValueTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueTraceMultiError) AllErrors() []error { return m }
+
+// AttributeValueTraceValidationError is the validation error returned by
+// AttributeValueTrace.Validate if the designated constraints aren't met.
+type AttributeValueTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeValueTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueTraceValidationError) ErrorName() string {
+	return "AttributeValueTraceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueTraceValidationError{}
+
+// Validate checks the field values on PolicyTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyTraceMultiError, or
+// nil if none found.
+func (m *PolicyTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	// no validation rules for Name
+
+	// no validation rules for Matched
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyTraceValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyTraceValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyTraceValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyTraceValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyTraceValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyTraceValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyTraceMultiError is an error wrapping multiple validation errors
+// returned by PolicyTrace.ValidateAll() if the designated constraints aren't met.
+type PolicyTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyTraceMultiError) AllErrors() []error { return m }
+
+// PolicyTraceValidationError is the validation error returned by
+// PolicyTrace.Validate if the designated constraints aren't met.
+type PolicyTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyTraceValidationError) ErrorName() string { return "PolicyTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyTraceValidationError{}
+
+// Validate checks the field values on PolicyPermissionTrace with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyPermissionTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyPermissionTrace with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyPermissionTraceMultiError, or nil if none found.
+func (m *PolicyPermissionTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyPermissionTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PermissionId
+
+	// no validation rules for Effect
+
+	if len(errors) > 0 {
+		return PolicyPermissionTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyPermissionTraceMultiError is an error wrapping multiple validation
+// errors returned by PolicyPermissionTrace.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyPermissionTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyPermissionTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyPermissionTraceMultiError) AllErrors() []error { return m }
+
+// PolicyPermissionTraceValidationError is the validation error returned by
+// PolicyPermissionTrace.Validate if the designated constraints aren't met.
+type PolicyPermissionTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyPermissionTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyPermissionTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyPermissionTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyPermissionTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyPermissionTraceValidationError) ErrorName() string {
+	return "PolicyPermissionTraceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyPermissionTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyPermissionTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyPermissionTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyPermissionTraceValidationError{}
+
+// Validate checks the field values on RuleTrace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RuleTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RuleTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RuleTraceMultiError, or nil
+// if none found.
+func (m *RuleTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RuleTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RuleId
+
+	// no validation rules for Operator
+
+	// no validation rules for AttrDefId
+
+	// no validation rules for AttrName
+
+	// no validation rules for Value
+
+	// no validation rules for ActualValue
+
+	// no validation rules for Result
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetLeft()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuleTraceValidationError{
+					field:  "Left",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuleTraceValidationError{
+					field:  "Left",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeft()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleTraceValidationError{
+				field:  "Left",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuleTraceValidationError{
+					field:  "Right",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuleTraceValidationError{
+					field:  "Right",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleTraceValidationError{
+				field:  "Right",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ValueAttrDefId
+
+	// no validation rules for ValueAttrName
+
+	if len(errors) > 0 {
+		return RuleTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// RuleTraceMultiError is an error wrapping multiple validation errors returned
+// by RuleTrace.ValidateAll() if the designated constraints aren't met.
+type RuleTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RuleTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RuleTraceMultiError) AllErrors() []error { return m }
+
+// RuleTraceValidationError is the validation error returned by
+// RuleTrace.Validate if the designated constraints aren't met.
+type RuleTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleTraceValidationError) ErrorName() string { return "RuleTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleTraceValidationError{}
+
+// Validate checks the field values on ListAccessibleResourcesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessibleResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessibleResourcesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAccessibleResourcesRequestMultiError, or nil if none found.
+func (m *ListAccessibleResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessibleResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	// no validation rules for ResourceType
+
+	// no validation rules for Action
+
+	// no validation rules for SubjectAttributes
+
+	// no validation rules for EnvironmentAttributes
+
+	if len(errors) > 0 {
+		return ListAccessibleResourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessibleResourcesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAccessibleResourcesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListAccessibleResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessibleResourcesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessibleResourcesRequestMultiError) AllErrors() []error { return m }
+
+// ListAccessibleResourcesRequestValidationError is the validation error
+// returned by ListAccessibleResourcesRequest.Validate if the designated
+// constraints aren't met.
+type ListAccessibleResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessibleResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessibleResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessibleResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessibleResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessibleResourcesRequestValidationError) ErrorName() string {
+	return "ListAccessibleResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessibleResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessibleResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessibleResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessibleResourcesRequestValidationError{}
+
+// Validate checks the field values on ListAccessibleResourcesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessibleResourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessibleResourcesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAccessibleResourcesResponseMultiError, or nil if none found.
+func (m *ListAccessibleResourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessibleResourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRbac()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAccessibleResourcesResponseValidationError{
+					field:  "Rbac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAccessibleResourcesResponseValidationError{
+					field:  "Rbac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRbac()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAccessibleResourcesResponseValidationError{
+				field:  "Rbac",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAbac()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAccessibleResourcesResponseValidationError{
+					field:  "Abac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAccessibleResourcesResponseValidationError{
+					field:  "Abac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAbac()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAccessibleResourcesResponseValidationError{
+				field:  "Abac",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListAccessibleResourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessibleResourcesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAccessibleResourcesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListAccessibleResourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessibleResourcesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessibleResourcesResponseMultiError) AllErrors() []error { return m }
+
+// ListAccessibleResourcesResponseValidationError is the validation error
+// returned by ListAccessibleResourcesResponse.Validate if the designated
+// constraints aren't met.
+type ListAccessibleResourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessibleResourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessibleResourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessibleResourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessibleResourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessibleResourcesResponseValidationError) ErrorName() string {
+	return "ListAccessibleResourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessibleResourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessibleResourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessibleResourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessibleResourcesResponseValidationError{}
+
+// Validate checks the field values on RBACAccess with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RBACAccess) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RBACAccess with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RBACAccessMultiError, or
+// nil if none found.
+func (m *RBACAccess) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RBACAccess) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RBACAccessValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RBACAccessValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RBACAccessValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RBACAccessMultiError(errors)
+	}
+
+	return nil
+}
+
+// RBACAccessMultiError is an error wrapping multiple validation errors
+// returned by RBACAccess.ValidateAll() if the designated constraints aren't met.
+type RBACAccessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RBACAccessMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RBACAccessMultiError) AllErrors() []error { return m }
+
+// RBACAccessValidationError is the validation error returned by
+// RBACAccess.Validate if the designated constraints aren't met.
+type RBACAccessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RBACAccessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RBACAccessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RBACAccessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RBACAccessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RBACAccessValidationError) ErrorName() string { return "RBACAccessValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RBACAccessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRBACAccess.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RBACAccessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RBACAccessValidationError{}
+
+// Validate checks the field values on AccessibleKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessibleKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessibleKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessibleKeyMultiError, or
+// nil if none found.
+func (m *AccessibleKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessibleKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return AccessibleKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessibleKeyMultiError is an error wrapping multiple validation errors
+// returned by AccessibleKey.ValidateAll() if the designated constraints
+// aren't met.
+type AccessibleKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessibleKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessibleKeyMultiError) AllErrors() []error { return m }
+
+// AccessibleKeyValidationError is the validation error returned by
+// AccessibleKey.Validate if the designated constraints aren't met.
+type AccessibleKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessibleKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessibleKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessibleKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessibleKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessibleKeyValidationError) ErrorName() string { return "AccessibleKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessibleKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessibleKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessibleKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessibleKeyValidationError{}
+
+// Validate checks the field values on ABACAccess with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ABACAccess) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ABACAccess with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ABACAccessMultiError, or
+// nil if none found.
+func (m *ABACAccess) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ABACAccess) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResiduals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ABACAccessValidationError{
+						field:  fmt.Sprintf("Residuals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ABACAccessValidationError{
+						field:  fmt.Sprintf("Residuals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ABACAccessValidationError{
+					field:  fmt.Sprintf("Residuals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DefaultAllowed
+
+	if len(errors) > 0 {
+		return ABACAccessMultiError(errors)
+	}
+
+	return nil
+}
+
+// ABACAccessMultiError is an error wrapping multiple validation errors
+// returned by ABACAccess.ValidateAll() if the designated constraints aren't met.
+type ABACAccessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ABACAccessMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ABACAccessMultiError) AllErrors() []error { return m }
+
+// ABACAccessValidationError is the validation error returned by
+// ABACAccess.Validate if the designated constraints aren't met.
+type ABACAccessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ABACAccessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ABACAccessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ABACAccessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ABACAccessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ABACAccessValidationError) ErrorName() string { return "ABACAccessValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ABACAccessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sABACAccess.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ABACAccessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ABACAccessValidationError{}
+
+// Validate checks the field values on ResourceResidual with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResourceResidual) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceResidual with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceResidualMultiError, or nil if none found.
+func (m *ResourceResidual) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceResidual) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceKey
+
+	// no validation rules for Allowed
+
+	if all {
+		switch v := interface{}(m.GetCondition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceResidualValidationError{
+					field:  "Condition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceResidualValidationError{
+					field:  "Condition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCondition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceResidualValidationError{
+				field:  "Condition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResourceResidualMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceResidualMultiError is an error wrapping multiple validation errors
+// returned by ResourceResidual.ValidateAll() if the designated constraints
+// aren't met.
+type ResourceResidualMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceResidualMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceResidualMultiError) AllErrors() []error { return m }
+
+// ResourceResidualValidationError is the validation error returned by
+// ResourceResidual.Validate if the designated constraints aren't met.
+type ResourceResidualValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceResidualValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceResidualValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceResidualValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceResidualValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceResidualValidationError) ErrorName() string { return "ResourceResidualValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceResidualValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceResidual.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceResidualValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceResidualValidationError{}
+
+// Validate checks the field values on ResidualCondition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResidualCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResidualCondition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResidualConditionMultiError, or nil if none found.
+func (m *ResidualCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResidualCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operator
+
+	// no validation rules for AttrName
+
+	// no validation rules for DataType
+
+	// no validation rules for Value
+
+	// no validation rules for ValueAttrName
+
+	// no validation rules for Reversed
+
+	if all {
+		switch v := interface{}(m.GetLeft()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResidualConditionValidationError{
+					field:  "Left",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResidualConditionValidationError{
+					field:  "Left",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeft()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResidualConditionValidationError{
+				field:  "Left",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResidualConditionValidationError{
+					field:  "Right",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResidualConditionValidationError{
+					field:  "Right",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResidualConditionValidationError{
+				field:  "Right",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResidualConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResidualConditionMultiError is an error wrapping multiple validation errors
+// returned by ResidualCondition.ValidateAll() if the designated constraints
+// aren't met.
+type ResidualConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResidualConditionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResidualConditionMultiError) AllErrors() []error { return m }
+
+// ResidualConditionValidationError is the validation error returned by
+// ResidualCondition.Validate if the designated constraints aren't met.
+type ResidualConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResidualConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResidualConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResidualConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResidualConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResidualConditionValidationError) ErrorName() string {
+	return "ResidualConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResidualConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResidualCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResidualConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResidualConditionValidationError{}
+
 // Validate checks the field values on Resource with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -20,7 +20,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PermissionService_CheckPermission_FullMethodName = "/permission.v1.PermissionService/CheckPermission"
+	PermissionService_CheckPermission_FullMethodName         = "/permission.v1.PermissionService/CheckPermission"
+	PermissionService_ExplainPermission_FullMethodName       = "/permission.v1.PermissionService/ExplainPermission"
+	PermissionService_ListAccessibleResources_FullMethodName = "/permission.v1.PermissionService/ListAccessibleResources"
 )
 
 // PermissionServiceClient is the client API for PermissionService service.
@@ -31,6 +33,10 @@ const (
 type PermissionServiceClient interface {
 	// 权限校验
 	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
+	// 解释权限校验的判定过程，用于排查问题，不走缓存
+	ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...grpc.CallOption) (*ExplainPermissionResponse, error)
+	// 用户在某一类资源的某个操作上可以访问的资源，用于列表过滤，不需要逐个校验
+	ListAccessibleResources(ctx context.Context, in *ListAccessibleResourcesRequest, opts ...grpc.CallOption) (*ListAccessibleResourcesResponse, error)
 }
 
 type permissionServiceClient struct {
@@ -51,6 +57,26 @@ func (c *permissionServiceClient) CheckPermission(ctx context.Context, in *Check
 	return out, nil
 }
 
+func (c *permissionServiceClient) ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...grpc.CallOption) (*ExplainPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainPermissionResponse)
+	err := c.cc.Invoke(ctx, PermissionService_ExplainPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionServiceClient) ListAccessibleResources(ctx context.Context, in *ListAccessibleResourcesRequest, opts ...grpc.CallOption) (*ListAccessibleResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessibleResourcesResponse)
+	err := c.cc.Invoke(ctx, PermissionService_ListAccessibleResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PermissionServiceServer is the server API for PermissionService service.
 // All implementations should embed UnimplementedPermissionServiceServer
 // for forward compatibility.
@@ -59,6 +85,10 @@ func (c *permissionServiceClient) CheckPermission(ctx context.Context, in *Check
 type PermissionServiceServer interface {
 	// 权限校验
 	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	// 解释权限校验的判定过程，用于排查问题，不走缓存
+	ExplainPermission(context.Context, *ExplainPermissionRequest) (*ExplainPermissionResponse, error)
+	// 用户在某一类资源的某个操作上可以访问的资源，用于列表过滤，不需要逐个校验
+	ListAccessibleResources(context.Context, *ListAccessibleResourcesRequest) (*ListAccessibleResourcesResponse, error)
 }
 
 // UnimplementedPermissionServiceServer should be embedded to have
@@ -71,6 +101,12 @@ type UnimplementedPermissionServiceServer struct{}
 func (UnimplementedPermissionServiceServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
 }
+func (UnimplementedPermissionServiceServer) ExplainPermission(context.Context, *ExplainPermissionRequest) (*ExplainPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPermission not implemented")
+}
+func (UnimplementedPermissionServiceServer) ListAccessibleResources(context.Context, *ListAccessibleResourcesRequest) (*ListAccessibleResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessibleResources not implemented")
+}
 func (UnimplementedPermissionServiceServer) testEmbeddedByValue() {}
 
 // UnsafePermissionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -109,6 +145,42 @@ func _PermissionService_CheckPermission_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PermissionService_ExplainPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).ExplainPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_ExplainPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).ExplainPermission(ctx, req.(*ExplainPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionService_ListAccessibleResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessibleResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).ListAccessibleResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionService_ListAccessibleResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).ListAccessibleResources(ctx, req.(*ListAccessibleResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PermissionService_ServiceDesc is the grpc.ServiceDesc for PermissionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -120,6 +192,14 @@ var PermissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckPermission",
 			Handler:    _PermissionService_CheckPermission_Handler,
 		},
+		{
+			MethodName: "ExplainPermission",
+			Handler:    _PermissionService_ExplainPermission_Handler,
+		},
+		{
+			MethodName: "ListAccessibleResources",
+			Handler:    _PermissionService_ListAccessibleResources_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "permission/v1/permission.proto",
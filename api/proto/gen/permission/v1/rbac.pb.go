@@ -118,22 +118,215 @@ func (x *GetAllPermissionsResponse) GetUserPermissions() []*UserPermission {
 	return nil
 }
 
-// ==== 业务配置相关消息定义 ====
-type BusinessConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OwnerId       int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	OwnerType     string                 `protobuf:"bytes,3,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"` // person, organization
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	RateLimit     int32                  `protobuf:"varint,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
-	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+type ListSubjectsWithPermissionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// 资源标识，匹配规则和 CheckPermission 一致，例如 /payments/* 查的是哪些用户对任意一个支付都有权限
+	ResourceKey string `protobuf:"bytes,2,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	Action      string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// 上一页返回的 next_cursor，第一页传 0
+	Cursor        int64 `protobuf:"varint,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubjectsWithPermissionRequest) Reset() {
+	*x = ListSubjectsWithPermissionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubjectsWithPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubjectsWithPermissionRequest) ProtoMessage() {}
+
+func (x *ListSubjectsWithPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubjectsWithPermissionRequest.ProtoReflect.Descriptor instead.
+func (*ListSubjectsWithPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSubjectsWithPermissionRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListSubjectsWithPermissionRequest) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *ListSubjectsWithPermissionRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListSubjectsWithPermissionRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListSubjectsWithPermissionRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 拥有权限的用户
+type PermissionSubject struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 当前生效的允许授权以及各自的来源，valid 总是 true
+	Sources       []*PermissionMatch `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionSubject) Reset() {
+	*x = PermissionSubject{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionSubject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionSubject) ProtoMessage() {}
+
+func (x *PermissionSubject) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionSubject.ProtoReflect.Descriptor instead.
+func (*PermissionSubject) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PermissionSubject) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PermissionSubject) GetSources() []*PermissionMatch {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type ListSubjectsWithPermissionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 被拒绝的用户会被剔除，所以一页的数量可能少于 limit
+	Subjects []*PermissionSubject `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	// 为 0 表示没有更多
+	NextCursor    int64 `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ListSubjectsWithPermissionResponse) Reset() {
+	*x = ListSubjectsWithPermissionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubjectsWithPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubjectsWithPermissionResponse) ProtoMessage() {}
+
+func (x *ListSubjectsWithPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubjectsWithPermissionResponse.ProtoReflect.Descriptor instead.
+func (*ListSubjectsWithPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSubjectsWithPermissionResponse) GetSubjects() []*PermissionSubject {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *ListSubjectsWithPermissionResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// ==== 业务配置相关消息定义 ====
+type BusinessConfig struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId   int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OwnerType string                 `protobuf:"bytes,3,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"` // person, organization
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	RateLimit int32                  `protobuf:"varint,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	Token     string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	Engine    string                 `protobuf:"bytes,7,opt,name=engine,proto3" json:"engine,omitempty"` // 权限判定引擎：rbac, abac, rbac_abac, role_as_attribute，为空表示 rbac
+	// ABAC 默认的策略合并算法：deny_overrides, permit_overrides, first_applicable, only_one_applicable,
+	// deny_unless_permit, permit_unless_deny，为空表示 deny_overrides
+	CombiningAlgorithm string `protobuf:"bytes,8,opt,name=combining_algorithm,json=combiningAlgorithm,proto3" json:"combining_algorithm,omitempty"`
+	NoPolicyEffect     string `protobuf:"bytes,9,opt,name=no_policy_effect,json=noPolicyEffect,proto3" json:"no_policy_effect,omitempty"` // ABAC 校验的权限上没有绑定任何策略时的结果：allow, deny，为空表示 allow
+	// ABAC 校验时实时传入的属性值不合法的处理方式：log 记录日志并且使用原始值，reject 直接返回错误，ignore 忽略这个属性，为空表示 log
+	InvalidAttributeAction string `protobuf:"bytes,10,opt,name=invalid_attribute_action,json=invalidAttributeAction,proto3" json:"invalid_attribute_action,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
 func (x *BusinessConfig) Reset() {
 	*x = BusinessConfig{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[2]
+	mi := &file_permission_v1_rbac_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -145,7 +338,7 @@ func (x *BusinessConfig) String() string {
 func (*BusinessConfig) ProtoMessage() {}
 
 func (x *BusinessConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[2]
+	mi := &file_permission_v1_rbac_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,7 +351,7 @@ func (x *BusinessConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BusinessConfig.ProtoReflect.Descriptor instead.
 func (*BusinessConfig) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{2}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BusinessConfig) GetId() int64 {
@@ -203,6 +396,34 @@ func (x *BusinessConfig) GetToken() string {
 	return ""
 }
 
+func (x *BusinessConfig) GetEngine() string {
+	if x != nil {
+		return x.Engine
+	}
+	return ""
+}
+
+func (x *BusinessConfig) GetCombiningAlgorithm() string {
+	if x != nil {
+		return x.CombiningAlgorithm
+	}
+	return ""
+}
+
+func (x *BusinessConfig) GetNoPolicyEffect() string {
+	if x != nil {
+		return x.NoPolicyEffect
+	}
+	return ""
+}
+
+func (x *BusinessConfig) GetInvalidAttributeAction() string {
+	if x != nil {
+		return x.InvalidAttributeAction
+	}
+	return ""
+}
+
 type CreateBusinessConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Config        *BusinessConfig        `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
@@ -212,7 +433,7 @@ type CreateBusinessConfigRequest struct {
 
 func (x *CreateBusinessConfigRequest) Reset() {
 	*x = CreateBusinessConfigRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[3]
+	mi := &file_permission_v1_rbac_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -224,7 +445,7 @@ func (x *CreateBusinessConfigRequest) String() string {
 func (*CreateBusinessConfigRequest) ProtoMessage() {}
 
 func (x *CreateBusinessConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[3]
+	mi := &file_permission_v1_rbac_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +458,7 @@ func (x *CreateBusinessConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBusinessConfigRequest.ProtoReflect.Descriptor instead.
 func (*CreateBusinessConfigRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{3}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateBusinessConfigRequest) GetConfig() *BusinessConfig {
@@ -256,7 +477,7 @@ type CreateBusinessConfigResponse struct {
 
 func (x *CreateBusinessConfigResponse) Reset() {
 	*x = CreateBusinessConfigResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[4]
+	mi := &file_permission_v1_rbac_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -268,7 +489,7 @@ func (x *CreateBusinessConfigResponse) String() string {
 func (*CreateBusinessConfigResponse) ProtoMessage() {}
 
 func (x *CreateBusinessConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[4]
+	mi := &file_permission_v1_rbac_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +502,7 @@ func (x *CreateBusinessConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBusinessConfigResponse.ProtoReflect.Descriptor instead.
 func (*CreateBusinessConfigResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{4}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateBusinessConfigResponse) GetConfig() *BusinessConfig {
@@ -301,7 +522,7 @@ type GetBusinessConfigRequest struct {
 
 func (x *GetBusinessConfigRequest) Reset() {
 	*x = GetBusinessConfigRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[5]
+	mi := &file_permission_v1_rbac_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +534,7 @@ func (x *GetBusinessConfigRequest) String() string {
 func (*GetBusinessConfigRequest) ProtoMessage() {}
 
 func (x *GetBusinessConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[5]
+	mi := &file_permission_v1_rbac_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +547,7 @@ func (x *GetBusinessConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBusinessConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetBusinessConfigRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{5}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetBusinessConfigRequest) GetBizId() int64 {
@@ -352,7 +573,7 @@ type GetBusinessConfigResponse struct {
 
 func (x *GetBusinessConfigResponse) Reset() {
 	*x = GetBusinessConfigResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[6]
+	mi := &file_permission_v1_rbac_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +585,7 @@ func (x *GetBusinessConfigResponse) String() string {
 func (*GetBusinessConfigResponse) ProtoMessage() {}
 
 func (x *GetBusinessConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[6]
+	mi := &file_permission_v1_rbac_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +598,7 @@ func (x *GetBusinessConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBusinessConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetBusinessConfigResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{6}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetBusinessConfigResponse) GetConfig() *BusinessConfig {
@@ -396,7 +617,7 @@ type UpdateBusinessConfigRequest struct {
 
 func (x *UpdateBusinessConfigRequest) Reset() {
 	*x = UpdateBusinessConfigRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[7]
+	mi := &file_permission_v1_rbac_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -408,7 +629,7 @@ func (x *UpdateBusinessConfigRequest) String() string {
 func (*UpdateBusinessConfigRequest) ProtoMessage() {}
 
 func (x *UpdateBusinessConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[7]
+	mi := &file_permission_v1_rbac_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +642,7 @@ func (x *UpdateBusinessConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBusinessConfigRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBusinessConfigRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{7}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateBusinessConfigRequest) GetConfig() *BusinessConfig {
@@ -440,7 +661,7 @@ type UpdateBusinessConfigResponse struct {
 
 func (x *UpdateBusinessConfigResponse) Reset() {
 	*x = UpdateBusinessConfigResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[8]
+	mi := &file_permission_v1_rbac_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +673,7 @@ func (x *UpdateBusinessConfigResponse) String() string {
 func (*UpdateBusinessConfigResponse) ProtoMessage() {}
 
 func (x *UpdateBusinessConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[8]
+	mi := &file_permission_v1_rbac_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +686,7 @@ func (x *UpdateBusinessConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBusinessConfigResponse.ProtoReflect.Descriptor instead.
 func (*UpdateBusinessConfigResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{8}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateBusinessConfigResponse) GetSuccess() bool {
@@ -485,7 +706,7 @@ type DeleteBusinessConfigRequest struct {
 
 func (x *DeleteBusinessConfigRequest) Reset() {
 	*x = DeleteBusinessConfigRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[9]
+	mi := &file_permission_v1_rbac_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -497,7 +718,7 @@ func (x *DeleteBusinessConfigRequest) String() string {
 func (*DeleteBusinessConfigRequest) ProtoMessage() {}
 
 func (x *DeleteBusinessConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[9]
+	mi := &file_permission_v1_rbac_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +731,7 @@ func (x *DeleteBusinessConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBusinessConfigRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBusinessConfigRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{9}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteBusinessConfigRequest) GetBizId() int64 {
@@ -536,7 +757,7 @@ type DeleteBusinessConfigResponse struct {
 
 func (x *DeleteBusinessConfigResponse) Reset() {
 	*x = DeleteBusinessConfigResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[10]
+	mi := &file_permission_v1_rbac_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -548,7 +769,7 @@ func (x *DeleteBusinessConfigResponse) String() string {
 func (*DeleteBusinessConfigResponse) ProtoMessage() {}
 
 func (x *DeleteBusinessConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[10]
+	mi := &file_permission_v1_rbac_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,7 +782,7 @@ func (x *DeleteBusinessConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBusinessConfigResponse.ProtoReflect.Descriptor instead.
 func (*DeleteBusinessConfigResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{10}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteBusinessConfigResponse) GetSuccess() bool {
@@ -581,7 +802,7 @@ type ListBusinessConfigsRequest struct {
 
 func (x *ListBusinessConfigsRequest) Reset() {
 	*x = ListBusinessConfigsRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[11]
+	mi := &file_permission_v1_rbac_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +814,7 @@ func (x *ListBusinessConfigsRequest) String() string {
 func (*ListBusinessConfigsRequest) ProtoMessage() {}
 
 func (x *ListBusinessConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[11]
+	mi := &file_permission_v1_rbac_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +827,7 @@ func (x *ListBusinessConfigsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBusinessConfigsRequest.ProtoReflect.Descriptor instead.
 func (*ListBusinessConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{11}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListBusinessConfigsRequest) GetOffset() int32 {
@@ -632,7 +853,7 @@ type ListBusinessConfigsResponse struct {
 
 func (x *ListBusinessConfigsResponse) Reset() {
 	*x = ListBusinessConfigsResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[12]
+	mi := &file_permission_v1_rbac_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -644,7 +865,7 @@ func (x *ListBusinessConfigsResponse) String() string {
 func (*ListBusinessConfigsResponse) ProtoMessage() {}
 
 func (x *ListBusinessConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[12]
+	mi := &file_permission_v1_rbac_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,7 +878,7 @@ func (x *ListBusinessConfigsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBusinessConfigsResponse.ProtoReflect.Descriptor instead.
 func (*ListBusinessConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{12}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListBusinessConfigsResponse) GetConfigs() []*BusinessConfig {
@@ -677,7 +898,7 @@ type CreateResourceRequest struct {
 
 func (x *CreateResourceRequest) Reset() {
 	*x = CreateResourceRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[13]
+	mi := &file_permission_v1_rbac_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -689,7 +910,7 @@ func (x *CreateResourceRequest) String() string {
 func (*CreateResourceRequest) ProtoMessage() {}
 
 func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[13]
+	mi := &file_permission_v1_rbac_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +923,7 @@ func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResourceRequest.ProtoReflect.Descriptor instead.
 func (*CreateResourceRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{13}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateResourceRequest) GetResource() *Resource {
@@ -721,7 +942,7 @@ type CreateResourceResponse struct {
 
 func (x *CreateResourceResponse) Reset() {
 	*x = CreateResourceResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[14]
+	mi := &file_permission_v1_rbac_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -733,7 +954,7 @@ func (x *CreateResourceResponse) String() string {
 func (*CreateResourceResponse) ProtoMessage() {}
 
 func (x *CreateResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[14]
+	mi := &file_permission_v1_rbac_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,7 +967,7 @@ func (x *CreateResourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResourceResponse.ProtoReflect.Descriptor instead.
 func (*CreateResourceResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{14}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateResourceResponse) GetResource() *Resource {
@@ -766,7 +987,7 @@ type GetResourceRequest struct {
 
 func (x *GetResourceRequest) Reset() {
 	*x = GetResourceRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[15]
+	mi := &file_permission_v1_rbac_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -778,7 +999,7 @@ func (x *GetResourceRequest) String() string {
 func (*GetResourceRequest) ProtoMessage() {}
 
 func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[15]
+	mi := &file_permission_v1_rbac_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +1012,7 @@ func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
 func (*GetResourceRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{15}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetResourceRequest) GetBizId() int64 {
@@ -817,7 +1038,7 @@ type GetResourceResponse struct {
 
 func (x *GetResourceResponse) Reset() {
 	*x = GetResourceResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[16]
+	mi := &file_permission_v1_rbac_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -829,7 +1050,7 @@ func (x *GetResourceResponse) String() string {
 func (*GetResourceResponse) ProtoMessage() {}
 
 func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[16]
+	mi := &file_permission_v1_rbac_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,7 +1063,7 @@ func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResourceResponse.ProtoReflect.Descriptor instead.
 func (*GetResourceResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{16}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetResourceResponse) GetResource() *Resource {
@@ -861,7 +1082,7 @@ type UpdateResourceRequest struct {
 
 func (x *UpdateResourceRequest) Reset() {
 	*x = UpdateResourceRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[17]
+	mi := &file_permission_v1_rbac_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -873,7 +1094,7 @@ func (x *UpdateResourceRequest) String() string {
 func (*UpdateResourceRequest) ProtoMessage() {}
 
 func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[17]
+	mi := &file_permission_v1_rbac_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +1107,7 @@ func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateResourceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateResourceRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{17}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateResourceRequest) GetResource() *Resource {
@@ -905,7 +1126,7 @@ type UpdateResourceResponse struct {
 
 func (x *UpdateResourceResponse) Reset() {
 	*x = UpdateResourceResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[18]
+	mi := &file_permission_v1_rbac_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -917,7 +1138,7 @@ func (x *UpdateResourceResponse) String() string {
 func (*UpdateResourceResponse) ProtoMessage() {}
 
 func (x *UpdateResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[18]
+	mi := &file_permission_v1_rbac_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +1151,7 @@ func (x *UpdateResourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateResourceResponse.ProtoReflect.Descriptor instead.
 func (*UpdateResourceResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{18}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UpdateResourceResponse) GetSuccess() bool {
@@ -950,7 +1171,7 @@ type DeleteResourceRequest struct {
 
 func (x *DeleteResourceRequest) Reset() {
 	*x = DeleteResourceRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[19]
+	mi := &file_permission_v1_rbac_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -962,7 +1183,7 @@ func (x *DeleteResourceRequest) String() string {
 func (*DeleteResourceRequest) ProtoMessage() {}
 
 func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[19]
+	mi := &file_permission_v1_rbac_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1196,7 @@ func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{19}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteResourceRequest) GetBizId() int64 {
@@ -1001,7 +1222,7 @@ type DeleteResourceResponse struct {
 
 func (x *DeleteResourceResponse) Reset() {
 	*x = DeleteResourceResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[20]
+	mi := &file_permission_v1_rbac_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1013,7 +1234,7 @@ func (x *DeleteResourceResponse) String() string {
 func (*DeleteResourceResponse) ProtoMessage() {}
 
 func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[20]
+	mi := &file_permission_v1_rbac_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,7 +1247,7 @@ func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
 func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{20}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DeleteResourceResponse) GetSuccess() bool {
@@ -1047,7 +1268,7 @@ type ListResourcesRequest struct {
 
 func (x *ListResourcesRequest) Reset() {
 	*x = ListResourcesRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[21]
+	mi := &file_permission_v1_rbac_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1059,7 +1280,7 @@ func (x *ListResourcesRequest) String() string {
 func (*ListResourcesRequest) ProtoMessage() {}
 
 func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[21]
+	mi := &file_permission_v1_rbac_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1293,7 @@ func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{21}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListResourcesRequest) GetBizId() int64 {
@@ -1105,7 +1326,7 @@ type ListResourcesResponse struct {
 
 func (x *ListResourcesResponse) Reset() {
 	*x = ListResourcesResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[22]
+	mi := &file_permission_v1_rbac_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1117,7 +1338,7 @@ func (x *ListResourcesResponse) String() string {
 func (*ListResourcesResponse) ProtoMessage() {}
 
 func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[22]
+	mi := &file_permission_v1_rbac_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +1351,7 @@ func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{22}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListResourcesResponse) GetResources() []*Resource {
@@ -1150,7 +1371,7 @@ type CreatePermissionRequest struct {
 
 func (x *CreatePermissionRequest) Reset() {
 	*x = CreatePermissionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[23]
+	mi := &file_permission_v1_rbac_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1162,7 +1383,7 @@ func (x *CreatePermissionRequest) String() string {
 func (*CreatePermissionRequest) ProtoMessage() {}
 
 func (x *CreatePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[23]
+	mi := &file_permission_v1_rbac_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,7 +1396,7 @@ func (x *CreatePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePermissionRequest.ProtoReflect.Descriptor instead.
 func (*CreatePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{23}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CreatePermissionRequest) GetPermission() *Permission {
@@ -1194,7 +1415,7 @@ type CreatePermissionResponse struct {
 
 func (x *CreatePermissionResponse) Reset() {
 	*x = CreatePermissionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[24]
+	mi := &file_permission_v1_rbac_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1206,7 +1427,7 @@ func (x *CreatePermissionResponse) String() string {
 func (*CreatePermissionResponse) ProtoMessage() {}
 
 func (x *CreatePermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[24]
+	mi := &file_permission_v1_rbac_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +1440,7 @@ func (x *CreatePermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePermissionResponse.ProtoReflect.Descriptor instead.
 func (*CreatePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{24}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CreatePermissionResponse) GetPermission() *Permission {
@@ -1239,7 +1460,7 @@ type GetPermissionRequest struct {
 
 func (x *GetPermissionRequest) Reset() {
 	*x = GetPermissionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[25]
+	mi := &file_permission_v1_rbac_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1251,7 +1472,7 @@ func (x *GetPermissionRequest) String() string {
 func (*GetPermissionRequest) ProtoMessage() {}
 
 func (x *GetPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[25]
+	mi := &file_permission_v1_rbac_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1264,7 +1485,7 @@ func (x *GetPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionRequest.ProtoReflect.Descriptor instead.
 func (*GetPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{25}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetPermissionRequest) GetBizId() int64 {
@@ -1290,7 +1511,7 @@ type GetPermissionResponse struct {
 
 func (x *GetPermissionResponse) Reset() {
 	*x = GetPermissionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[26]
+	mi := &file_permission_v1_rbac_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1302,7 +1523,7 @@ func (x *GetPermissionResponse) String() string {
 func (*GetPermissionResponse) ProtoMessage() {}
 
 func (x *GetPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[26]
+	mi := &file_permission_v1_rbac_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1315,7 +1536,7 @@ func (x *GetPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionResponse.ProtoReflect.Descriptor instead.
 func (*GetPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{26}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetPermissionResponse) GetPermission() *Permission {
@@ -1334,7 +1555,7 @@ type UpdatePermissionRequest struct {
 
 func (x *UpdatePermissionRequest) Reset() {
 	*x = UpdatePermissionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[27]
+	mi := &file_permission_v1_rbac_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1346,7 +1567,7 @@ func (x *UpdatePermissionRequest) String() string {
 func (*UpdatePermissionRequest) ProtoMessage() {}
 
 func (x *UpdatePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[27]
+	mi := &file_permission_v1_rbac_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1359,7 +1580,7 @@ func (x *UpdatePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePermissionRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{27}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdatePermissionRequest) GetPermission() *Permission {
@@ -1378,7 +1599,7 @@ type UpdatePermissionResponse struct {
 
 func (x *UpdatePermissionResponse) Reset() {
 	*x = UpdatePermissionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[28]
+	mi := &file_permission_v1_rbac_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1390,7 +1611,7 @@ func (x *UpdatePermissionResponse) String() string {
 func (*UpdatePermissionResponse) ProtoMessage() {}
 
 func (x *UpdatePermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[28]
+	mi := &file_permission_v1_rbac_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,7 +1624,7 @@ func (x *UpdatePermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePermissionResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{28}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdatePermissionResponse) GetSuccess() bool {
@@ -1423,7 +1644,7 @@ type DeletePermissionRequest struct {
 
 func (x *DeletePermissionRequest) Reset() {
 	*x = DeletePermissionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[29]
+	mi := &file_permission_v1_rbac_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1435,7 +1656,7 @@ func (x *DeletePermissionRequest) String() string {
 func (*DeletePermissionRequest) ProtoMessage() {}
 
 func (x *DeletePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[29]
+	mi := &file_permission_v1_rbac_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,7 +1669,7 @@ func (x *DeletePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePermissionRequest.ProtoReflect.Descriptor instead.
 func (*DeletePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{29}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DeletePermissionRequest) GetBizId() int64 {
@@ -1474,7 +1695,7 @@ type DeletePermissionResponse struct {
 
 func (x *DeletePermissionResponse) Reset() {
 	*x = DeletePermissionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[30]
+	mi := &file_permission_v1_rbac_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1486,7 +1707,7 @@ func (x *DeletePermissionResponse) String() string {
 func (*DeletePermissionResponse) ProtoMessage() {}
 
 func (x *DeletePermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[30]
+	mi := &file_permission_v1_rbac_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1499,7 +1720,7 @@ func (x *DeletePermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePermissionResponse.ProtoReflect.Descriptor instead.
 func (*DeletePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{30}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DeletePermissionResponse) GetSuccess() bool {
@@ -1520,7 +1741,7 @@ type ListPermissionsRequest struct {
 
 func (x *ListPermissionsRequest) Reset() {
 	*x = ListPermissionsRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[31]
+	mi := &file_permission_v1_rbac_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1532,7 +1753,7 @@ func (x *ListPermissionsRequest) String() string {
 func (*ListPermissionsRequest) ProtoMessage() {}
 
 func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[31]
+	mi := &file_permission_v1_rbac_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,7 +1766,7 @@ func (x *ListPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{31}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListPermissionsRequest) GetBizId() int64 {
@@ -1578,7 +1799,7 @@ type ListPermissionsResponse struct {
 
 func (x *ListPermissionsResponse) Reset() {
 	*x = ListPermissionsResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[32]
+	mi := &file_permission_v1_rbac_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1590,7 +1811,7 @@ func (x *ListPermissionsResponse) String() string {
 func (*ListPermissionsResponse) ProtoMessage() {}
 
 func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[32]
+	mi := &file_permission_v1_rbac_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1603,7 +1824,7 @@ func (x *ListPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{32}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListPermissionsResponse) GetPermissions() []*Permission {
@@ -1613,34 +1834,34 @@ func (x *ListPermissionsResponse) GetPermissions() []*Permission {
 	return nil
 }
 
-// ==== 角色相关消息定义 ====
-type Role struct {
+// ==== 操作定义相关消息定义 ====
+// ActionDefinition 业务方定义的操作，授予了 name 就同时授予了它蕴含的所有操作
+type ActionDefinition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Metadata      string                 `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON格式的元数据
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // 创建后不可修改，不能是 *
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Implies       []string               `protobuf:"bytes,5,rep,name=implies,proto3" json:"implies,omitempty"` // 直接蕴含的操作，例如 write 蕴含 read
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Role) Reset() {
-	*x = Role{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[33]
+func (x *ActionDefinition) Reset() {
+	*x = ActionDefinition{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Role) String() string {
+func (x *ActionDefinition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Role) ProtoMessage() {}
+func (*ActionDefinition) ProtoMessage() {}
 
-func (x *Role) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[33]
+func (x *ActionDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,75 +1872,68 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Role.ProtoReflect.Descriptor instead.
-func (*Role) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ActionDefinition.ProtoReflect.Descriptor instead.
+func (*ActionDefinition) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Role) GetId() int64 {
+func (x *ActionDefinition) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Role) GetBizId() int64 {
+func (x *ActionDefinition) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *Role) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *Role) GetName() string {
+func (x *ActionDefinition) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Role) GetDescription() string {
+func (x *ActionDefinition) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *Role) GetMetadata() string {
+func (x *ActionDefinition) GetImplies() []string {
 	if x != nil {
-		return x.Metadata
+		return x.Implies
 	}
-	return ""
+	return nil
 }
 
-type CreateRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateActionDefinitionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ActionDefinition *ActionDefinition      `protobuf:"bytes,1,opt,name=action_definition,json=actionDefinition,proto3" json:"action_definition,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateRoleRequest) Reset() {
-	*x = CreateRoleRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[34]
+func (x *CreateActionDefinitionRequest) Reset() {
+	*x = CreateActionDefinitionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoleRequest) String() string {
+func (x *CreateActionDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoleRequest) ProtoMessage() {}
+func (*CreateActionDefinitionRequest) ProtoMessage() {}
 
-func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[34]
+func (x *CreateActionDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,40 +1944,40 @@ func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use CreateActionDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*CreateActionDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CreateRoleRequest) GetRole() *Role {
+func (x *CreateActionDefinitionRequest) GetActionDefinition() *ActionDefinition {
 	if x != nil {
-		return x.Role
+		return x.ActionDefinition
 	}
 	return nil
 }
 
-type CreateRoleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateActionDefinitionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ActionDefinition *ActionDefinition      `protobuf:"bytes,1,opt,name=action_definition,json=actionDefinition,proto3" json:"action_definition,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateRoleResponse) Reset() {
-	*x = CreateRoleResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[35]
+func (x *CreateActionDefinitionResponse) Reset() {
+	*x = CreateActionDefinitionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoleResponse) String() string {
+func (x *CreateActionDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoleResponse) ProtoMessage() {}
+func (*CreateActionDefinitionResponse) ProtoMessage() {}
 
-func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[35]
+func (x *CreateActionDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1774,19 +1988,19 @@ func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoleResponse.ProtoReflect.Descriptor instead.
-func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateActionDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*CreateActionDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreateRoleResponse) GetRole() *Role {
+func (x *CreateActionDefinitionResponse) GetActionDefinition() *ActionDefinition {
 	if x != nil {
-		return x.Role
+		return x.ActionDefinition
 	}
 	return nil
 }
 
-type GetRoleRequest struct {
+type GetActionDefinitionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
 	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -1794,21 +2008,21 @@ type GetRoleRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoleRequest) Reset() {
-	*x = GetRoleRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[36]
+func (x *GetActionDefinitionRequest) Reset() {
+	*x = GetActionDefinitionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoleRequest) String() string {
+func (x *GetActionDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoleRequest) ProtoMessage() {}
+func (*GetActionDefinitionRequest) ProtoMessage() {}
 
-func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[36]
+func (x *GetActionDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1819,47 +2033,47 @@ func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoleRequest.ProtoReflect.Descriptor instead.
-func (*GetRoleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetActionDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*GetActionDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetRoleRequest) GetBizId() int64 {
+func (x *GetActionDefinitionRequest) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *GetRoleRequest) GetId() int64 {
+func (x *GetActionDefinitionRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetRoleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetActionDefinitionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ActionDefinition *ActionDefinition      `protobuf:"bytes,1,opt,name=action_definition,json=actionDefinition,proto3" json:"action_definition,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetRoleResponse) Reset() {
-	*x = GetRoleResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[37]
+func (x *GetActionDefinitionResponse) Reset() {
+	*x = GetActionDefinitionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoleResponse) String() string {
+func (x *GetActionDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoleResponse) ProtoMessage() {}
+func (*GetActionDefinitionResponse) ProtoMessage() {}
 
-func (x *GetRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[37]
+func (x *GetActionDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,40 +2084,40 @@ func (x *GetRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoleResponse.ProtoReflect.Descriptor instead.
-func (*GetRoleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetActionDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*GetActionDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetRoleResponse) GetRole() *Role {
+func (x *GetActionDefinitionResponse) GetActionDefinition() *ActionDefinition {
 	if x != nil {
-		return x.Role
+		return x.ActionDefinition
 	}
 	return nil
 }
 
-type UpdateRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateActionDefinitionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ActionDefinition *ActionDefinition      `protobuf:"bytes,1,opt,name=action_definition,json=actionDefinition,proto3" json:"action_definition,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpdateRoleRequest) Reset() {
-	*x = UpdateRoleRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[38]
+func (x *UpdateActionDefinitionRequest) Reset() {
+	*x = UpdateActionDefinitionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoleRequest) String() string {
+func (x *UpdateActionDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleRequest) ProtoMessage() {}
+func (*UpdateActionDefinitionRequest) ProtoMessage() {}
 
-func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[38]
+func (x *UpdateActionDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1914,40 +2128,40 @@ func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use UpdateActionDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateActionDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *UpdateRoleRequest) GetRole() *Role {
+func (x *UpdateActionDefinitionRequest) GetActionDefinition() *ActionDefinition {
 	if x != nil {
-		return x.Role
+		return x.ActionDefinition
 	}
 	return nil
 }
 
-type UpdateRoleResponse struct {
+type UpdateActionDefinitionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoleResponse) Reset() {
-	*x = UpdateRoleResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[39]
+func (x *UpdateActionDefinitionResponse) Reset() {
+	*x = UpdateActionDefinitionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoleResponse) String() string {
+func (x *UpdateActionDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleResponse) ProtoMessage() {}
+func (*UpdateActionDefinitionResponse) ProtoMessage() {}
 
-func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[39]
+func (x *UpdateActionDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1958,19 +2172,19 @@ func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UpdateActionDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateActionDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdateRoleResponse) GetSuccess() bool {
+func (x *UpdateActionDefinitionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type DeleteRoleRequest struct {
+type DeleteActionDefinitionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
 	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -1978,21 +2192,21 @@ type DeleteRoleRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRoleRequest) Reset() {
-	*x = DeleteRoleRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[40]
+func (x *DeleteActionDefinitionRequest) Reset() {
+	*x = DeleteActionDefinitionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoleRequest) String() string {
+func (x *DeleteActionDefinitionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoleRequest) ProtoMessage() {}
+func (*DeleteActionDefinitionRequest) ProtoMessage() {}
 
-func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[40]
+func (x *DeleteActionDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,47 +2217,47 @@ func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use DeleteActionDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteActionDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DeleteRoleRequest) GetBizId() int64 {
+func (x *DeleteActionDefinitionRequest) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *DeleteRoleRequest) GetId() int64 {
+func (x *DeleteActionDefinitionRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteRoleResponse struct {
+type DeleteActionDefinitionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRoleResponse) Reset() {
-	*x = DeleteRoleResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[41]
+func (x *DeleteActionDefinitionResponse) Reset() {
+	*x = DeleteActionDefinitionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoleResponse) String() string {
+func (x *DeleteActionDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoleResponse) ProtoMessage() {}
+func (*DeleteActionDefinitionResponse) ProtoMessage() {}
 
-func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[41]
+func (x *DeleteActionDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2054,43 +2268,42 @@ func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DeleteActionDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteActionDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DeleteRoleResponse) GetSuccess() bool {
+func (x *DeleteActionDefinitionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type ListRolesRequest struct {
+type ListActionDefinitionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRolesRequest) Reset() {
-	*x = ListRolesRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[42]
+func (x *ListActionDefinitionsRequest) Reset() {
+	*x = ListActionDefinitionsRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolesRequest) String() string {
+func (x *ListActionDefinitionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesRequest) ProtoMessage() {}
+func (*ListActionDefinitionsRequest) ProtoMessage() {}
 
-func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[42]
+func (x *ListActionDefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,61 +2314,54 @@ func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
-func (*ListRolesRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListActionDefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListActionDefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListRolesRequest) GetBizId() int64 {
+func (x *ListActionDefinitionsRequest) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *ListRolesRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *ListRolesRequest) GetOffset() int32 {
+func (x *ListActionDefinitionsRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-func (x *ListRolesRequest) GetLimit() int32 {
+func (x *ListActionDefinitionsRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-type ListRolesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListActionDefinitionsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ActionDefinitions []*ActionDefinition    `protobuf:"bytes,1,rep,name=action_definitions,json=actionDefinitions,proto3" json:"action_definitions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListRolesResponse) Reset() {
-	*x = ListRolesResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[43]
+func (x *ListActionDefinitionsResponse) Reset() {
+	*x = ListActionDefinitionsResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolesResponse) String() string {
+func (x *ListActionDefinitionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesResponse) ProtoMessage() {}
+func (*ListActionDefinitionsResponse) ProtoMessage() {}
 
-func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[43]
+func (x *ListActionDefinitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2166,48 +2372,48 @@ func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
-func (*ListRolesResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListActionDefinitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListActionDefinitionsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListRolesResponse) GetRoles() []*Role {
+func (x *ListActionDefinitionsResponse) GetActionDefinitions() []*ActionDefinition {
 	if x != nil {
-		return x.Roles
+		return x.ActionDefinitions
 	}
 	return nil
 }
 
-// ==== 角色包含关系相关消息定义 ====
-type RoleInclusion struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BizId             int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	IncludingRoleId   int64                  `protobuf:"varint,3,opt,name=including_role_id,json=includingRoleId,proto3" json:"including_role_id,omitempty"`
-	IncludingRoleType string                 `protobuf:"bytes,4,opt,name=including_role_type,json=includingRoleType,proto3" json:"including_role_type,omitempty"`
-	IncludingRoleName string                 `protobuf:"bytes,5,opt,name=including_role_name,json=includingRoleName,proto3" json:"including_role_name,omitempty"`
-	IncludedRoleId    int64                  `protobuf:"varint,6,opt,name=included_role_id,json=includedRoleId,proto3" json:"included_role_id,omitempty"`
-	IncludedRoleType  string                 `protobuf:"bytes,7,opt,name=included_role_type,json=includedRoleType,proto3" json:"included_role_type,omitempty"`
-	IncludedRoleName  string                 `protobuf:"bytes,8,opt,name=included_role_name,json=includedRoleName,proto3" json:"included_role_name,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// ==== 角色相关消息定义 ====
+type Role struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId           int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata        string                 `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`                                           // JSON格式的元数据
+	MaxMembers      int32                  `protobuf:"varint,7,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`                    // 最多授予多少个用户，0 表示不限制
+	MaxRolesPerUser int32                  `protobuf:"varint,8,opt,name=max_roles_per_user,json=maxRolesPerUser,proto3" json:"max_roles_per_user,omitempty"` // 拥有该角色的用户最多拥有多少个角色（包含该角色），0 表示不限制
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RoleInclusion) Reset() {
-	*x = RoleInclusion{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[44]
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleInclusion) String() string {
+func (x *Role) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleInclusion) ProtoMessage() {}
+func (*Role) ProtoMessage() {}
 
-func (x *RoleInclusion) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[44]
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,89 +2424,89 @@ func (x *RoleInclusion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleInclusion.ProtoReflect.Descriptor instead.
-func (*RoleInclusion) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RoleInclusion) GetId() int64 {
+func (x *Role) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *RoleInclusion) GetBizId() int64 {
+func (x *Role) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *RoleInclusion) GetIncludingRoleId() int64 {
+func (x *Role) GetType() string {
 	if x != nil {
-		return x.IncludingRoleId
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *RoleInclusion) GetIncludingRoleType() string {
+func (x *Role) GetName() string {
 	if x != nil {
-		return x.IncludingRoleType
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RoleInclusion) GetIncludingRoleName() string {
+func (x *Role) GetDescription() string {
 	if x != nil {
-		return x.IncludingRoleName
+		return x.Description
 	}
 	return ""
 }
 
-func (x *RoleInclusion) GetIncludedRoleId() int64 {
+func (x *Role) GetMetadata() string {
 	if x != nil {
-		return x.IncludedRoleId
+		return x.Metadata
 	}
-	return 0
+	return ""
 }
 
-func (x *RoleInclusion) GetIncludedRoleType() string {
+func (x *Role) GetMaxMembers() int32 {
 	if x != nil {
-		return x.IncludedRoleType
+		return x.MaxMembers
 	}
-	return ""
+	return 0
 }
 
-func (x *RoleInclusion) GetIncludedRoleName() string {
+func (x *Role) GetMaxRolesPerUser() int32 {
 	if x != nil {
-		return x.IncludedRoleName
+		return x.MaxRolesPerUser
 	}
-	return ""
+	return 0
 }
 
-type CreateRoleInclusionRequest struct {
+type CreateRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoleInclusion *RoleInclusion         `protobuf:"bytes,1,opt,name=role_inclusion,json=roleInclusion,proto3" json:"role_inclusion,omitempty"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoleInclusionRequest) Reset() {
-	*x = CreateRoleInclusionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[45]
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoleInclusionRequest) String() string {
+func (x *CreateRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoleInclusionRequest) ProtoMessage() {}
+func (*CreateRoleRequest) ProtoMessage() {}
 
-func (x *CreateRoleInclusionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[45]
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2311,40 +2517,40 @@ func (x *CreateRoleInclusionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoleInclusionRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoleInclusionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CreateRoleInclusionRequest) GetRoleInclusion() *RoleInclusion {
+func (x *CreateRoleRequest) GetRole() *Role {
 	if x != nil {
-		return x.RoleInclusion
+		return x.Role
 	}
 	return nil
 }
 
-type CreateRoleInclusionResponse struct {
+type CreateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoleInclusion *RoleInclusion         `protobuf:"bytes,1,opt,name=role_inclusion,json=roleInclusion,proto3" json:"role_inclusion,omitempty"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoleInclusionResponse) Reset() {
-	*x = CreateRoleInclusionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[46]
+func (x *CreateRoleResponse) Reset() {
+	*x = CreateRoleResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoleInclusionResponse) String() string {
+func (x *CreateRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoleInclusionResponse) ProtoMessage() {}
+func (*CreateRoleResponse) ProtoMessage() {}
 
-func (x *CreateRoleInclusionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[46]
+func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2355,19 +2561,19 @@ func (x *CreateRoleInclusionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoleInclusionResponse.ProtoReflect.Descriptor instead.
-func (*CreateRoleInclusionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use CreateRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateRoleInclusionResponse) GetRoleInclusion() *RoleInclusion {
+func (x *CreateRoleResponse) GetRole() *Role {
 	if x != nil {
-		return x.RoleInclusion
+		return x.Role
 	}
 	return nil
 }
 
-type GetRoleInclusionRequest struct {
+type GetRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
 	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -2375,21 +2581,21 @@ type GetRoleInclusionRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoleInclusionRequest) Reset() {
-	*x = GetRoleInclusionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[47]
+func (x *GetRoleRequest) Reset() {
+	*x = GetRoleRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoleInclusionRequest) String() string {
+func (x *GetRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoleInclusionRequest) ProtoMessage() {}
+func (*GetRoleRequest) ProtoMessage() {}
 
-func (x *GetRoleInclusionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[47]
+func (x *GetRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,47 +2606,47 @@ func (x *GetRoleInclusionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoleInclusionRequest.ProtoReflect.Descriptor instead.
-func (*GetRoleInclusionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GetRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetRoleRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetRoleInclusionRequest) GetBizId() int64 {
+func (x *GetRoleRequest) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *GetRoleInclusionRequest) GetId() int64 {
+func (x *GetRoleRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetRoleInclusionResponse struct {
+type GetRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoleInclusion *RoleInclusion         `protobuf:"bytes,1,opt,name=role_inclusion,json=roleInclusion,proto3" json:"role_inclusion,omitempty"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoleInclusionResponse) Reset() {
-	*x = GetRoleInclusionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[48]
+func (x *GetRoleResponse) Reset() {
+	*x = GetRoleResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoleInclusionResponse) String() string {
+func (x *GetRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoleInclusionResponse) ProtoMessage() {}
+func (*GetRoleResponse) ProtoMessage() {}
 
-func (x *GetRoleInclusionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[48]
+func (x *GetRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2451,41 +2657,40 @@ func (x *GetRoleInclusionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoleInclusionResponse.ProtoReflect.Descriptor instead.
-func (*GetRoleInclusionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use GetRoleResponse.ProtoReflect.Descriptor instead.
+func (*GetRoleResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetRoleInclusionResponse) GetRoleInclusion() *RoleInclusion {
+func (x *GetRoleResponse) GetRole() *Role {
 	if x != nil {
-		return x.RoleInclusion
+		return x.Role
 	}
 	return nil
 }
 
-type DeleteRoleInclusionRequest struct {
+type UpdateRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRoleInclusionRequest) Reset() {
-	*x = DeleteRoleInclusionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[49]
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoleInclusionRequest) String() string {
+func (x *UpdateRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoleInclusionRequest) ProtoMessage() {}
+func (*UpdateRoleRequest) ProtoMessage() {}
 
-func (x *DeleteRoleInclusionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[49]
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,47 +2701,40 @@ func (x *DeleteRoleInclusionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoleInclusionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRoleInclusionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *DeleteRoleInclusionRequest) GetBizId() int64 {
-	if x != nil {
-		return x.BizId
-	}
-	return 0
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DeleteRoleInclusionRequest) GetId() int64 {
+func (x *UpdateRoleRequest) GetRole() *Role {
 	if x != nil {
-		return x.Id
+		return x.Role
 	}
-	return 0
+	return nil
 }
 
-type DeleteRoleInclusionResponse struct {
+type UpdateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRoleInclusionResponse) Reset() {
-	*x = DeleteRoleInclusionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[50]
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoleInclusionResponse) String() string {
+func (x *UpdateRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoleInclusionResponse) ProtoMessage() {}
+func (*UpdateRoleResponse) ProtoMessage() {}
 
-func (x *DeleteRoleInclusionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[50]
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2547,42 +2745,41 @@ func (x *DeleteRoleInclusionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoleInclusionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRoleInclusionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *DeleteRoleInclusionResponse) GetSuccess() bool {
+func (x *UpdateRoleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type ListRoleInclusionsRequest struct {
+type DeleteRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoleInclusionsRequest) Reset() {
-	*x = ListRoleInclusionsRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[51]
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoleInclusionsRequest) String() string {
+func (x *DeleteRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoleInclusionsRequest) ProtoMessage() {}
+func (*DeleteRoleRequest) ProtoMessage() {}
 
-func (x *ListRoleInclusionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[51]
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2593,54 +2790,47 @@ func (x *ListRoleInclusionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoleInclusionsRequest.ProtoReflect.Descriptor instead.
-func (*ListRoleInclusionsRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ListRoleInclusionsRequest) GetBizId() int64 {
+func (x *DeleteRoleRequest) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *ListRoleInclusionsRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *ListRoleInclusionsRequest) GetLimit() int32 {
+func (x *DeleteRoleRequest) GetId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.Id
 	}
 	return 0
 }
 
-type ListRoleInclusionsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RoleInclusions []*RoleInclusion       `protobuf:"bytes,1,rep,name=role_inclusions,json=roleInclusions,proto3" json:"role_inclusions,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoleInclusionsResponse) Reset() {
-	*x = ListRoleInclusionsResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[52]
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoleInclusionsResponse) String() string {
+func (x *DeleteRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoleInclusionsResponse) ProtoMessage() {}
+func (*DeleteRoleResponse) ProtoMessage() {}
 
-func (x *ListRoleInclusionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[52]
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2651,49 +2841,41 @@ func (x *ListRoleInclusionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoleInclusionsResponse.ProtoReflect.Descriptor instead.
-func (*ListRoleInclusionsResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ListRoleInclusionsResponse) GetRoleInclusions() []*RoleInclusion {
+func (x *DeleteRoleResponse) GetSuccess() bool {
 	if x != nil {
-		return x.RoleInclusions
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// ==== 角色权限相关消息定义 ====
-type RolePermission struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BizId            int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	RoleId           int64                  `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	PermissionId     int64                  `protobuf:"varint,4,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
-	RoleName         string                 `protobuf:"bytes,5,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
-	RoleType         string                 `protobuf:"bytes,6,opt,name=role_type,json=roleType,proto3" json:"role_type,omitempty"`
-	ResourceType     string                 `protobuf:"bytes,7,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
-	ResourceKey      string                 `protobuf:"bytes,8,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
-	PermissionAction string                 `protobuf:"bytes,9,opt,name=permission_action,json=permissionAction,proto3" json:"permission_action,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type RoleUtilization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Members       int64                  `protobuf:"varint,2,opt,name=members,proto3" json:"members,omitempty"` // 尚未失效的授予人数，包含还未到生效时间的
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RolePermission) Reset() {
-	*x = RolePermission{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[53]
+func (x *RoleUtilization) Reset() {
+	*x = RoleUtilization{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RolePermission) String() string {
+func (x *RoleUtilization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RolePermission) ProtoMessage() {}
+func (*RoleUtilization) ProtoMessage() {}
 
-func (x *RolePermission) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[53]
+func (x *RoleUtilization) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2704,96 +2886,99 @@ func (x *RolePermission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RolePermission.ProtoReflect.Descriptor instead.
-func (*RolePermission) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use RoleUtilization.ProtoReflect.Descriptor instead.
+func (*RoleUtilization) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *RolePermission) GetId() int64 {
+func (x *RoleUtilization) GetRole() *Role {
 	if x != nil {
-		return x.Id
+		return x.Role
 	}
-	return 0
+	return nil
 }
 
-func (x *RolePermission) GetBizId() int64 {
+func (x *RoleUtilization) GetMembers() int64 {
 	if x != nil {
-		return x.BizId
+		return x.Members
 	}
 	return 0
 }
 
-func (x *RolePermission) GetRoleId() int64 {
-	if x != nil {
-		return x.RoleId
-	}
-	return 0
+type ListRoleUtilizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RolePermission) GetPermissionId() int64 {
-	if x != nil {
-		return x.PermissionId
-	}
-	return 0
+func (x *ListRoleUtilizationsRequest) Reset() {
+	*x = ListRoleUtilizationsRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RolePermission) GetRoleName() string {
-	if x != nil {
-		return x.RoleName
-	}
-	return ""
+func (x *ListRoleUtilizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RolePermission) GetRoleType() string {
+func (*ListRoleUtilizationsRequest) ProtoMessage() {}
+
+func (x *ListRoleUtilizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[57]
 	if x != nil {
-		return x.RoleType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RolePermission) GetResourceType() string {
-	if x != nil {
-		return x.ResourceType
-	}
-	return ""
+// Deprecated: Use ListRoleUtilizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleUtilizationsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *RolePermission) GetResourceKey() string {
+func (x *ListRoleUtilizationsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.ResourceKey
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *RolePermission) GetPermissionAction() string {
+func (x *ListRoleUtilizationsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.PermissionAction
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type GrantRolePermissionRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RolePermission *RolePermission        `protobuf:"bytes,1,opt,name=role_permission,json=rolePermission,proto3" json:"role_permission,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListRoleUtilizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Utilizations  []*RoleUtilization     `protobuf:"bytes,1,rep,name=utilizations,proto3" json:"utilizations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GrantRolePermissionRequest) Reset() {
-	*x = GrantRolePermissionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[54]
+func (x *ListRoleUtilizationsResponse) Reset() {
+	*x = ListRoleUtilizationsResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GrantRolePermissionRequest) String() string {
+func (x *ListRoleUtilizationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrantRolePermissionRequest) ProtoMessage() {}
+func (*ListRoleUtilizationsResponse) ProtoMessage() {}
 
-func (x *GrantRolePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[54]
+func (x *ListRoleUtilizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2804,40 +2989,43 @@ func (x *GrantRolePermissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrantRolePermissionRequest.ProtoReflect.Descriptor instead.
-func (*GrantRolePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ListRoleUtilizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoleUtilizationsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GrantRolePermissionRequest) GetRolePermission() *RolePermission {
+func (x *ListRoleUtilizationsResponse) GetUtilizations() []*RoleUtilization {
 	if x != nil {
-		return x.RolePermission
+		return x.Utilizations
 	}
 	return nil
 }
 
-type GrantRolePermissionResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RolePermission *RolePermission        `protobuf:"bytes,1,opt,name=role_permission,json=rolePermission,proto3" json:"role_permission,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GrantRolePermissionResponse) Reset() {
-	*x = GrantRolePermissionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[55]
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GrantRolePermissionResponse) String() string {
+func (x *ListRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrantRolePermissionResponse) ProtoMessage() {}
+func (*ListRolesRequest) ProtoMessage() {}
 
-func (x *GrantRolePermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[55]
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2848,41 +3036,61 @@ func (x *GrantRolePermissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrantRolePermissionResponse.ProtoReflect.Descriptor instead.
-func (*GrantRolePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GrantRolePermissionResponse) GetRolePermission() *RolePermission {
+func (x *ListRolesRequest) GetBizId() int64 {
 	if x != nil {
-		return x.RolePermission
+		return x.BizId
 	}
-	return nil
+	return 0
 }
 
-type RevokeRolePermissionRequest struct {
+func (x *ListRolesRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListRolesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeRolePermissionRequest) Reset() {
-	*x = RevokeRolePermissionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[56]
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeRolePermissionRequest) String() string {
+func (x *ListRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeRolePermissionRequest) ProtoMessage() {}
+func (*ListRolesResponse) ProtoMessage() {}
 
-func (x *RevokeRolePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[56]
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2893,47 +3101,141 @@ func (x *RevokeRolePermissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeRolePermissionRequest.ProtoReflect.Descriptor instead.
-func (*RevokeRolePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *RevokeRolePermissionRequest) GetBizId() int64 {
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// ==== 角色包含关系相关消息定义 ====
+type RoleInclusion struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId             int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	IncludingRoleId   int64                  `protobuf:"varint,3,opt,name=including_role_id,json=includingRoleId,proto3" json:"including_role_id,omitempty"`
+	IncludingRoleType string                 `protobuf:"bytes,4,opt,name=including_role_type,json=includingRoleType,proto3" json:"including_role_type,omitempty"`
+	IncludingRoleName string                 `protobuf:"bytes,5,opt,name=including_role_name,json=includingRoleName,proto3" json:"including_role_name,omitempty"`
+	IncludedRoleId    int64                  `protobuf:"varint,6,opt,name=included_role_id,json=includedRoleId,proto3" json:"included_role_id,omitempty"`
+	IncludedRoleType  string                 `protobuf:"bytes,7,opt,name=included_role_type,json=includedRoleType,proto3" json:"included_role_type,omitempty"`
+	IncludedRoleName  string                 `protobuf:"bytes,8,opt,name=included_role_name,json=includedRoleName,proto3" json:"included_role_name,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RoleInclusion) Reset() {
+	*x = RoleInclusion{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleInclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleInclusion) ProtoMessage() {}
+
+func (x *RoleInclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleInclusion.ProtoReflect.Descriptor instead.
+func (*RoleInclusion) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RoleInclusion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoleInclusion) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *RevokeRolePermissionRequest) GetId() int64 {
+func (x *RoleInclusion) GetIncludingRoleId() int64 {
 	if x != nil {
-		return x.Id
+		return x.IncludingRoleId
 	}
 	return 0
 }
 
-type RevokeRolePermissionResponse struct {
+func (x *RoleInclusion) GetIncludingRoleType() string {
+	if x != nil {
+		return x.IncludingRoleType
+	}
+	return ""
+}
+
+func (x *RoleInclusion) GetIncludingRoleName() string {
+	if x != nil {
+		return x.IncludingRoleName
+	}
+	return ""
+}
+
+func (x *RoleInclusion) GetIncludedRoleId() int64 {
+	if x != nil {
+		return x.IncludedRoleId
+	}
+	return 0
+}
+
+func (x *RoleInclusion) GetIncludedRoleType() string {
+	if x != nil {
+		return x.IncludedRoleType
+	}
+	return ""
+}
+
+func (x *RoleInclusion) GetIncludedRoleName() string {
+	if x != nil {
+		return x.IncludedRoleName
+	}
+	return ""
+}
+
+type CreateRoleInclusionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RoleInclusion *RoleInclusion         `protobuf:"bytes,1,opt,name=role_inclusion,json=roleInclusion,proto3" json:"role_inclusion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeRolePermissionResponse) Reset() {
-	*x = RevokeRolePermissionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[57]
+func (x *CreateRoleInclusionRequest) Reset() {
+	*x = CreateRoleInclusionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeRolePermissionResponse) String() string {
+func (x *CreateRoleInclusionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeRolePermissionResponse) ProtoMessage() {}
+func (*CreateRoleInclusionRequest) ProtoMessage() {}
 
-func (x *RevokeRolePermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[57]
+func (x *CreateRoleInclusionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,40 +3246,40 @@ func (x *RevokeRolePermissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeRolePermissionResponse.ProtoReflect.Descriptor instead.
-func (*RevokeRolePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CreateRoleInclusionRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleInclusionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *RevokeRolePermissionResponse) GetSuccess() bool {
+func (x *CreateRoleInclusionRequest) GetRoleInclusion() *RoleInclusion {
 	if x != nil {
-		return x.Success
+		return x.RoleInclusion
 	}
-	return false
+	return nil
 }
 
-type ListRolePermissionsRequest struct {
+type CreateRoleInclusionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	RoleInclusion *RoleInclusion         `protobuf:"bytes,1,opt,name=role_inclusion,json=roleInclusion,proto3" json:"role_inclusion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRolePermissionsRequest) Reset() {
-	*x = ListRolePermissionsRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[58]
+func (x *CreateRoleInclusionResponse) Reset() {
+	*x = CreateRoleInclusionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolePermissionsRequest) String() string {
+func (x *CreateRoleInclusionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolePermissionsRequest) ProtoMessage() {}
+func (*CreateRoleInclusionResponse) ProtoMessage() {}
 
-func (x *ListRolePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[58]
+func (x *CreateRoleInclusionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2988,40 +3290,41 @@ func (x *ListRolePermissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolePermissionsRequest.ProtoReflect.Descriptor instead.
-func (*ListRolePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use CreateRoleInclusionResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoleInclusionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ListRolePermissionsRequest) GetBizId() int64 {
+func (x *CreateRoleInclusionResponse) GetRoleInclusion() *RoleInclusion {
 	if x != nil {
-		return x.BizId
+		return x.RoleInclusion
 	}
-	return 0
+	return nil
 }
 
-type ListRolePermissionsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RolePermissions []*RolePermission      `protobuf:"bytes,1,rep,name=role_permissions,json=rolePermissions,proto3" json:"role_permissions,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetRoleInclusionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRolePermissionsResponse) Reset() {
-	*x = ListRolePermissionsResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[59]
+func (x *GetRoleInclusionRequest) Reset() {
+	*x = GetRoleInclusionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolePermissionsResponse) String() string {
+func (x *GetRoleInclusionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolePermissionsResponse) ProtoMessage() {}
+func (*GetRoleInclusionRequest) ProtoMessage() {}
 
-func (x *ListRolePermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[59]
+func (x *GetRoleInclusionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3032,48 +3335,1283 @@ func (x *ListRolePermissionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolePermissionsResponse.ProtoReflect.Descriptor instead.
-func (*ListRolePermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use GetRoleInclusionRequest.ProtoReflect.Descriptor instead.
+func (*GetRoleInclusionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetRoleInclusionRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *GetRoleInclusionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetRoleInclusionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleInclusion *RoleInclusion         `protobuf:"bytes,1,opt,name=role_inclusion,json=roleInclusion,proto3" json:"role_inclusion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleInclusionResponse) Reset() {
+	*x = GetRoleInclusionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleInclusionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleInclusionResponse) ProtoMessage() {}
+
+func (x *GetRoleInclusionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleInclusionResponse.ProtoReflect.Descriptor instead.
+func (*GetRoleInclusionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetRoleInclusionResponse) GetRoleInclusion() *RoleInclusion {
+	if x != nil {
+		return x.RoleInclusion
+	}
+	return nil
+}
+
+type DeleteRoleInclusionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleInclusionRequest) Reset() {
+	*x = DeleteRoleInclusionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleInclusionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleInclusionRequest) ProtoMessage() {}
+
+func (x *DeleteRoleInclusionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleInclusionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleInclusionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteRoleInclusionRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *DeleteRoleInclusionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteRoleInclusionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleInclusionResponse) Reset() {
+	*x = DeleteRoleInclusionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleInclusionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleInclusionResponse) ProtoMessage() {}
+
+func (x *DeleteRoleInclusionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleInclusionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleInclusionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteRoleInclusionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListRoleInclusionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleInclusionsRequest) Reset() {
+	*x = ListRoleInclusionsRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleInclusionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleInclusionsRequest) ProtoMessage() {}
+
+func (x *ListRoleInclusionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleInclusionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleInclusionsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListRoleInclusionsRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ListRoleInclusionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListRoleInclusionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRoleInclusionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RoleInclusions []*RoleInclusion       `protobuf:"bytes,1,rep,name=role_inclusions,json=roleInclusions,proto3" json:"role_inclusions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRoleInclusionsResponse) Reset() {
+	*x = ListRoleInclusionsResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleInclusionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleInclusionsResponse) ProtoMessage() {}
+
+func (x *ListRoleInclusionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleInclusionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoleInclusionsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListRoleInclusionsResponse) GetRoleInclusions() []*RoleInclusion {
+	if x != nil {
+		return x.RoleInclusions
+	}
+	return nil
+}
+
+// ==== 角色权限相关消息定义 ====
+type RolePermission struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId            int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	RoleId           int64                  `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	PermissionId     int64                  `protobuf:"varint,4,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	RoleName         string                 `protobuf:"bytes,5,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	RoleType         string                 `protobuf:"bytes,6,opt,name=role_type,json=roleType,proto3" json:"role_type,omitempty"`
+	ResourceType     string                 `protobuf:"bytes,7,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceKey      string                 `protobuf:"bytes,8,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	PermissionAction string                 `protobuf:"bytes,9,opt,name=permission_action,json=permissionAction,proto3" json:"permission_action,omitempty"`
+	StartTime        int64                  `protobuf:"varint,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 生效时间，不传表示立即生效
+	EndTime          int64                  `protobuf:"varint,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // 失效时间，不传表示长期有效
+	Effect           string                 `protobuf:"bytes,12,opt,name=effect,proto3" json:"effect,omitempty"`                         // allow, deny，不传默认 allow
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RolePermission) Reset() {
+	*x = RolePermission{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolePermission) ProtoMessage() {}
+
+func (x *RolePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolePermission.ProtoReflect.Descriptor instead.
+func (*RolePermission) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RolePermission) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RolePermission) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *RolePermission) GetRoleId() int64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *RolePermission) GetPermissionId() int64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+func (x *RolePermission) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *RolePermission) GetRoleType() string {
+	if x != nil {
+		return x.RoleType
+	}
+	return ""
+}
+
+func (x *RolePermission) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *RolePermission) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *RolePermission) GetPermissionAction() string {
+	if x != nil {
+		return x.PermissionAction
+	}
+	return ""
+}
+
+func (x *RolePermission) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *RolePermission) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *RolePermission) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+type GrantRolePermissionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RolePermission *RolePermission        `protobuf:"bytes,1,opt,name=role_permission,json=rolePermission,proto3" json:"role_permission,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GrantRolePermissionRequest) Reset() {
+	*x = GrantRolePermissionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantRolePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRolePermissionRequest) ProtoMessage() {}
+
+func (x *GrantRolePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRolePermissionRequest.ProtoReflect.Descriptor instead.
+func (*GrantRolePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GrantRolePermissionRequest) GetRolePermission() *RolePermission {
+	if x != nil {
+		return x.RolePermission
+	}
+	return nil
+}
+
+type GrantRolePermissionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RolePermission *RolePermission        `protobuf:"bytes,1,opt,name=role_permission,json=rolePermission,proto3" json:"role_permission,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GrantRolePermissionResponse) Reset() {
+	*x = GrantRolePermissionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantRolePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRolePermissionResponse) ProtoMessage() {}
+
+func (x *GrantRolePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRolePermissionResponse.ProtoReflect.Descriptor instead.
+func (*GrantRolePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GrantRolePermissionResponse) GetRolePermission() *RolePermission {
+	if x != nil {
+		return x.RolePermission
+	}
+	return nil
+}
+
+type RevokeRolePermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRolePermissionRequest) Reset() {
+	*x = RevokeRolePermissionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRolePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRolePermissionRequest) ProtoMessage() {}
+
+func (x *RevokeRolePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRolePermissionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRolePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RevokeRolePermissionRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *RevokeRolePermissionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeRolePermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRolePermissionResponse) Reset() {
+	*x = RevokeRolePermissionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRolePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRolePermissionResponse) ProtoMessage() {}
+
+func (x *RevokeRolePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRolePermissionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRolePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RevokeRolePermissionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListRolePermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolePermissionsRequest) Reset() {
+	*x = ListRolePermissionsRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolePermissionsRequest) ProtoMessage() {}
+
+func (x *ListRolePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRolePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListRolePermissionsRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+type ListRolePermissionsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RolePermissions []*RolePermission      `protobuf:"bytes,1,rep,name=role_permissions,json=rolePermissions,proto3" json:"role_permissions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListRolePermissionsResponse) Reset() {
+	*x = ListRolePermissionsResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolePermissionsResponse) ProtoMessage() {}
+
+func (x *ListRolePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRolePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListRolePermissionsResponse) GetRolePermissions() []*RolePermission {
+	if x != nil {
+		return x.RolePermissions
+	}
+	return nil
+}
+
+// ==== 用户角色相关消息定义 ====
+type UserRole struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        int64                  `protobuf:"varint,4,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	RoleName      string                 `protobuf:"bytes,5,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	RoleType      string                 `protobuf:"bytes,6,opt,name=role_type,json=roleType,proto3" json:"role_type,omitempty"`
+	StartTime     int64                  `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 授予角色生效时间
+	EndTime       int64                  `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // 授予角色失效时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRole) Reset() {
+	*x = UserRole{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRole) ProtoMessage() {}
+
+func (x *UserRole) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRole.ProtoReflect.Descriptor instead.
+func (*UserRole) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UserRole) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserRole) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *UserRole) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserRole) GetRoleId() int64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *UserRole) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *UserRole) GetRoleType() string {
+	if x != nil {
+		return x.RoleType
+	}
+	return ""
+}
+
+func (x *UserRole) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *UserRole) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type GrantUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserRole      *UserRole              `protobuf:"bytes,1,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantUserRoleRequest) Reset() {
+	*x = GrantUserRoleRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantUserRoleRequest) ProtoMessage() {}
+
+func (x *GrantUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*GrantUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GrantUserRoleRequest) GetUserRole() *UserRole {
+	if x != nil {
+		return x.UserRole
+	}
+	return nil
+}
+
+type GrantUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserRole      *UserRole              `protobuf:"bytes,1,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantUserRoleResponse) Reset() {
+	*x = GrantUserRoleResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantUserRoleResponse) ProtoMessage() {}
+
+func (x *GrantUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*GrantUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GrantUserRoleResponse) GetUserRole() *UserRole {
+	if x != nil {
+		return x.UserRole
+	}
+	return nil
+}
+
+type RevokeUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserRoleRequest) Reset() {
+	*x = RevokeUserRoleRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserRoleRequest) ProtoMessage() {}
+
+func (x *RevokeUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RevokeUserRoleRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *RevokeUserRoleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserRoleResponse) Reset() {
+	*x = RevokeUserRoleResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserRoleResponse) ProtoMessage() {}
+
+func (x *RevokeUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*RevokeUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RevokeUserRoleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserRolesRequest) Reset() {
+	*x = ListUserRolesRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRolesRequest) ProtoMessage() {}
+
+func (x *ListUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListUserRolesRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+type ListUserRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserRoles     []*UserRole            `protobuf:"bytes,1,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserRolesResponse) Reset() {
+	*x = ListUserRolesResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRolesResponse) ProtoMessage() {}
+
+func (x *ListUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListUserRolesResponse) GetUserRoles() []*UserRole {
+	if x != nil {
+		return x.UserRoles
+	}
+	return nil
+}
+
+// ==== 用户权限相关消息定义 ====
+type UserPermission struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId            int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PermissionId     int64                  `protobuf:"varint,4,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	PermissionName   string                 `protobuf:"bytes,5,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
+	ResourceType     string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceKey      string                 `protobuf:"bytes,7,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	PermissionAction string                 `protobuf:"bytes,8,opt,name=permission_action,json=permissionAction,proto3" json:"permission_action,omitempty"`
+	StartTime        int64                  `protobuf:"varint,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          int64                  `protobuf:"varint,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Effect           string                 `protobuf:"bytes,11,opt,name=effect,proto3" json:"effect,omitempty"` // allow, deny
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserPermission) Reset() {
+	*x = UserPermission{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPermission) ProtoMessage() {}
+
+func (x *UserPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPermission.ProtoReflect.Descriptor instead.
+func (*UserPermission) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UserPermission) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserPermission) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *UserPermission) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserPermission) GetPermissionId() int64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+func (x *UserPermission) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *UserPermission) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *UserPermission) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *UserPermission) GetPermissionAction() string {
+	if x != nil {
+		return x.PermissionAction
+	}
+	return ""
+}
+
+func (x *UserPermission) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *UserPermission) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *UserPermission) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+type GrantUserPermissionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserPermission *UserPermission        `protobuf:"bytes,1,opt,name=user_permission,json=userPermission,proto3" json:"user_permission,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GrantUserPermissionRequest) Reset() {
+	*x = GrantUserPermissionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantUserPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantUserPermissionRequest) ProtoMessage() {}
+
+func (x *GrantUserPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantUserPermissionRequest.ProtoReflect.Descriptor instead.
+func (*GrantUserPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GrantUserPermissionRequest) GetUserPermission() *UserPermission {
+	if x != nil {
+		return x.UserPermission
+	}
+	return nil
+}
+
+type GrantUserPermissionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserPermission *UserPermission        `protobuf:"bytes,1,opt,name=user_permission,json=userPermission,proto3" json:"user_permission,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GrantUserPermissionResponse) Reset() {
+	*x = GrantUserPermissionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantUserPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantUserPermissionResponse) ProtoMessage() {}
+
+func (x *GrantUserPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantUserPermissionResponse.ProtoReflect.Descriptor instead.
+func (*GrantUserPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *ListRolePermissionsResponse) GetRolePermissions() []*RolePermission {
+func (x *GrantUserPermissionResponse) GetUserPermission() *UserPermission {
 	if x != nil {
-		return x.RolePermissions
+		return x.UserPermission
 	}
 	return nil
 }
 
-// ==== 用户角色相关消息定义 ====
-type UserRole struct {
+type RevokeUserPermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RoleId        int64                  `protobuf:"varint,4,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	RoleName      string                 `protobuf:"bytes,5,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
-	RoleType      string                 `protobuf:"bytes,6,opt,name=role_type,json=roleType,proto3" json:"role_type,omitempty"`
-	StartTime     int64                  `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 授予角色生效时间
-	EndTime       int64                  `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // 授予角色失效时间
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserRole) Reset() {
-	*x = UserRole{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[60]
+func (x *RevokeUserPermissionRequest) Reset() {
+	*x = RevokeUserPermissionRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserRole) String() string {
+func (x *RevokeUserPermissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRole) ProtoMessage() {}
+func (*RevokeUserPermissionRequest) ProtoMessage() {}
 
-func (x *UserRole) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[60]
+func (x *RevokeUserPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3084,89 +4622,93 @@ func (x *UserRole) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRole.ProtoReflect.Descriptor instead.
-func (*UserRole) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use RevokeUserPermissionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *UserRole) GetId() int64 {
+func (x *RevokeUserPermissionRequest) GetBizId() int64 {
 	if x != nil {
-		return x.Id
+		return x.BizId
 	}
 	return 0
 }
 
-func (x *UserRole) GetBizId() int64 {
+func (x *RevokeUserPermissionRequest) GetId() int64 {
 	if x != nil {
-		return x.BizId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UserRole) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+type RevokeUserPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserRole) GetRoleId() int64 {
-	if x != nil {
-		return x.RoleId
-	}
-	return 0
+func (x *RevokeUserPermissionResponse) Reset() {
+	*x = RevokeUserPermissionResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserRole) GetRoleName() string {
-	if x != nil {
-		return x.RoleName
-	}
-	return ""
+func (x *RevokeUserPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserRole) GetRoleType() string {
+func (*RevokeUserPermissionResponse) ProtoMessage() {}
+
+func (x *RevokeUserPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[88]
 	if x != nil {
-		return x.RoleType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserRole) GetStartTime() int64 {
-	if x != nil {
-		return x.StartTime
-	}
-	return 0
+// Deprecated: Use RevokeUserPermissionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeUserPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *UserRole) GetEndTime() int64 {
+func (x *RevokeUserPermissionResponse) GetSuccess() bool {
 	if x != nil {
-		return x.EndTime
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type GrantUserRoleRequest struct {
+type ListUserPermissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserRole      *UserRole              `protobuf:"bytes,1,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GrantUserRoleRequest) Reset() {
-	*x = GrantUserRoleRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[61]
+func (x *ListUserPermissionsRequest) Reset() {
+	*x = ListUserPermissionsRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GrantUserRoleRequest) String() string {
+func (x *ListUserPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrantUserRoleRequest) ProtoMessage() {}
+func (*ListUserPermissionsRequest) ProtoMessage() {}
 
-func (x *GrantUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[61]
+func (x *ListUserPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3177,40 +4719,54 @@ func (x *GrantUserRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrantUserRoleRequest.ProtoReflect.Descriptor instead.
-func (*GrantUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ListUserPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GrantUserRoleRequest) GetUserRole() *UserRole {
+func (x *ListUserPermissionsRequest) GetBizId() int64 {
 	if x != nil {
-		return x.UserRole
+		return x.BizId
 	}
-	return nil
+	return 0
 }
 
-type GrantUserRoleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserRole      *UserRole              `protobuf:"bytes,1,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListUserPermissionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
 }
 
-func (x *GrantUserRoleResponse) Reset() {
-	*x = GrantUserRoleResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[62]
+func (x *ListUserPermissionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUserPermissionsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserPermissions []*UserPermission      `protobuf:"bytes,1,rep,name=user_permissions,json=userPermissions,proto3" json:"user_permissions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListUserPermissionsResponse) Reset() {
+	*x = ListUserPermissionsResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GrantUserRoleResponse) String() string {
+func (x *ListUserPermissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrantUserRoleResponse) ProtoMessage() {}
+func (*ListUserPermissionsResponse) ProtoMessage() {}
 
-func (x *GrantUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[62]
+func (x *ListUserPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3221,41 +4777,47 @@ func (x *GrantUserRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrantUserRoleResponse.ProtoReflect.Descriptor instead.
-func (*GrantUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ListUserPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GrantUserRoleResponse) GetUserRole() *UserRole {
+func (x *ListUserPermissionsResponse) GetUserPermissions() []*UserPermission {
 	if x != nil {
-		return x.UserRole
+		return x.UserPermissions
 	}
 	return nil
 }
 
-type RevokeUserRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+// ==== 职责分离约束相关消息定义 ====
+type SoDConstraint struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId       int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Type        string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"` // static 不能同时拥有，dynamic 不能同时激活
+	// 两两互斥的角色，直接拥有和通过角色包含关系间接拥有都算
+	RoleIds       []int64 `protobuf:"varint,6,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeUserRoleRequest) Reset() {
-	*x = RevokeUserRoleRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[63]
+func (x *SoDConstraint) Reset() {
+	*x = SoDConstraint{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeUserRoleRequest) String() string {
+func (x *SoDConstraint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeUserRoleRequest) ProtoMessage() {}
+func (*SoDConstraint) ProtoMessage() {}
 
-func (x *RevokeUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[63]
+func (x *SoDConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3266,91 +4828,75 @@ func (x *RevokeUserRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeUserRoleRequest.ProtoReflect.Descriptor instead.
-func (*RevokeUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use SoDConstraint.ProtoReflect.Descriptor instead.
+func (*SoDConstraint) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *RevokeUserRoleRequest) GetBizId() int64 {
+func (x *SoDConstraint) GetId() int64 {
 	if x != nil {
-		return x.BizId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *RevokeUserRoleRequest) GetId() int64 {
+func (x *SoDConstraint) GetBizId() int64 {
 	if x != nil {
-		return x.Id
+		return x.BizId
 	}
 	return 0
 }
 
-type RevokeUserRoleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RevokeUserRoleResponse) Reset() {
-	*x = RevokeUserRoleResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[64]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RevokeUserRoleResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SoDConstraint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*RevokeUserRoleResponse) ProtoMessage() {}
-
-func (x *RevokeUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[64]
+func (x *SoDConstraint) GetDescription() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Description
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RevokeUserRoleResponse.ProtoReflect.Descriptor instead.
-func (*RevokeUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{64}
+func (x *SoDConstraint) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *RevokeUserRoleResponse) GetSuccess() bool {
+func (x *SoDConstraint) GetRoleIds() []int64 {
 	if x != nil {
-		return x.Success
+		return x.RoleIds
 	}
-	return false
+	return nil
 }
 
-type ListUserRolesRequest struct {
+type CreateSoDConstraintRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Constraint    *SoDConstraint         `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserRolesRequest) Reset() {
-	*x = ListUserRolesRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[65]
+func (x *CreateSoDConstraintRequest) Reset() {
+	*x = CreateSoDConstraintRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserRolesRequest) String() string {
+func (x *CreateSoDConstraintRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserRolesRequest) ProtoMessage() {}
+func (*CreateSoDConstraintRequest) ProtoMessage() {}
 
-func (x *ListUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[65]
+func (x *CreateSoDConstraintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3361,40 +4907,40 @@ func (x *ListUserRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserRolesRequest.ProtoReflect.Descriptor instead.
-func (*ListUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use CreateSoDConstraintRequest.ProtoReflect.Descriptor instead.
+func (*CreateSoDConstraintRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ListUserRolesRequest) GetBizId() int64 {
+func (x *CreateSoDConstraintRequest) GetConstraint() *SoDConstraint {
 	if x != nil {
-		return x.BizId
+		return x.Constraint
 	}
-	return 0
+	return nil
 }
 
-type ListUserRolesResponse struct {
+type CreateSoDConstraintResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserRoles     []*UserRole            `protobuf:"bytes,1,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
+	Constraint    *SoDConstraint         `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserRolesResponse) Reset() {
-	*x = ListUserRolesResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[66]
+func (x *CreateSoDConstraintResponse) Reset() {
+	*x = CreateSoDConstraintResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserRolesResponse) String() string {
+func (x *CreateSoDConstraintResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserRolesResponse) ProtoMessage() {}
+func (*CreateSoDConstraintResponse) ProtoMessage() {}
 
-func (x *ListUserRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[66]
+func (x *CreateSoDConstraintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3405,51 +4951,41 @@ func (x *ListUserRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserRolesResponse.ProtoReflect.Descriptor instead.
-func (*ListUserRolesResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use CreateSoDConstraintResponse.ProtoReflect.Descriptor instead.
+func (*CreateSoDConstraintResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *ListUserRolesResponse) GetUserRoles() []*UserRole {
+func (x *CreateSoDConstraintResponse) GetConstraint() *SoDConstraint {
 	if x != nil {
-		return x.UserRoles
+		return x.Constraint
 	}
 	return nil
 }
 
-// ==== 用户权限相关消息定义 ====
-type UserPermission struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BizId            int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	UserId           int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PermissionId     int64                  `protobuf:"varint,4,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
-	PermissionName   string                 `protobuf:"bytes,5,opt,name=permission_name,json=permissionName,proto3" json:"permission_name,omitempty"`
-	ResourceType     string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
-	ResourceKey      string                 `protobuf:"bytes,7,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
-	PermissionAction string                 `protobuf:"bytes,8,opt,name=permission_action,json=permissionAction,proto3" json:"permission_action,omitempty"`
-	StartTime        int64                  `protobuf:"varint,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime          int64                  `protobuf:"varint,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Effect           string                 `protobuf:"bytes,11,opt,name=effect,proto3" json:"effect,omitempty"` // allow, deny
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetSoDConstraintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserPermission) Reset() {
-	*x = UserPermission{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[67]
+func (x *GetSoDConstraintRequest) Reset() {
+	*x = GetSoDConstraintRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserPermission) String() string {
+func (x *GetSoDConstraintRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPermission) ProtoMessage() {}
+func (*GetSoDConstraintRequest) ProtoMessage() {}
 
-func (x *UserPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[67]
+func (x *GetSoDConstraintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3460,110 +4996,92 @@ func (x *UserPermission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserPermission.ProtoReflect.Descriptor instead.
-func (*UserPermission) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *UserPermission) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use GetSoDConstraintRequest.ProtoReflect.Descriptor instead.
+func (*GetSoDConstraintRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UserPermission) GetBizId() int64 {
+func (x *GetSoDConstraintRequest) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *UserPermission) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *UserPermission) GetPermissionId() int64 {
+func (x *GetSoDConstraintRequest) GetId() int64 {
 	if x != nil {
-		return x.PermissionId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UserPermission) GetPermissionName() string {
-	if x != nil {
-		return x.PermissionName
-	}
-	return ""
+type GetSoDConstraintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Constraint    *SoDConstraint         `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserPermission) GetResourceType() string {
-	if x != nil {
-		return x.ResourceType
-	}
-	return ""
+func (x *GetSoDConstraintResponse) Reset() {
+	*x = GetSoDConstraintResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserPermission) GetResourceKey() string {
-	if x != nil {
-		return x.ResourceKey
-	}
-	return ""
+func (x *GetSoDConstraintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserPermission) GetPermissionAction() string {
-	if x != nil {
-		return x.PermissionAction
-	}
-	return ""
-}
+func (*GetSoDConstraintResponse) ProtoMessage() {}
 
-func (x *UserPermission) GetStartTime() int64 {
+func (x *GetSoDConstraintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[95]
 	if x != nil {
-		return x.StartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserPermission) GetEndTime() int64 {
-	if x != nil {
-		return x.EndTime
-	}
-	return 0
+// Deprecated: Use GetSoDConstraintResponse.ProtoReflect.Descriptor instead.
+func (*GetSoDConstraintResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *UserPermission) GetEffect() string {
+func (x *GetSoDConstraintResponse) GetConstraint() *SoDConstraint {
 	if x != nil {
-		return x.Effect
+		return x.Constraint
 	}
-	return ""
+	return nil
 }
 
-type GrantUserPermissionRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserPermission *UserPermission        `protobuf:"bytes,1,opt,name=user_permission,json=userPermission,proto3" json:"user_permission,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteSoDConstraintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GrantUserPermissionRequest) Reset() {
-	*x = GrantUserPermissionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[68]
+func (x *DeleteSoDConstraintRequest) Reset() {
+	*x = DeleteSoDConstraintRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GrantUserPermissionRequest) String() string {
+func (x *DeleteSoDConstraintRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrantUserPermissionRequest) ProtoMessage() {}
+func (*DeleteSoDConstraintRequest) ProtoMessage() {}
 
-func (x *GrantUserPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[68]
+func (x *DeleteSoDConstraintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3574,40 +5092,47 @@ func (x *GrantUserPermissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrantUserPermissionRequest.ProtoReflect.Descriptor instead.
-func (*GrantUserPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use DeleteSoDConstraintRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSoDConstraintRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GrantUserPermissionRequest) GetUserPermission() *UserPermission {
+func (x *DeleteSoDConstraintRequest) GetBizId() int64 {
 	if x != nil {
-		return x.UserPermission
+		return x.BizId
 	}
-	return nil
+	return 0
 }
 
-type GrantUserPermissionResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserPermission *UserPermission        `protobuf:"bytes,1,opt,name=user_permission,json=userPermission,proto3" json:"user_permission,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *DeleteSoDConstraintRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
-func (x *GrantUserPermissionResponse) Reset() {
-	*x = GrantUserPermissionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[69]
+type DeleteSoDConstraintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSoDConstraintResponse) Reset() {
+	*x = DeleteSoDConstraintResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GrantUserPermissionResponse) String() string {
+func (x *DeleteSoDConstraintResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrantUserPermissionResponse) ProtoMessage() {}
+func (*DeleteSoDConstraintResponse) ProtoMessage() {}
 
-func (x *GrantUserPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[69]
+func (x *DeleteSoDConstraintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3618,41 +5143,40 @@ func (x *GrantUserPermissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrantUserPermissionResponse.ProtoReflect.Descriptor instead.
-func (*GrantUserPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use DeleteSoDConstraintResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSoDConstraintResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GrantUserPermissionResponse) GetUserPermission() *UserPermission {
+func (x *DeleteSoDConstraintResponse) GetSuccess() bool {
 	if x != nil {
-		return x.UserPermission
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type RevokeUserPermissionRequest struct {
+type ListSoDConstraintsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeUserPermissionRequest) Reset() {
-	*x = RevokeUserPermissionRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[70]
+func (x *ListSoDConstraintsRequest) Reset() {
+	*x = ListSoDConstraintsRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeUserPermissionRequest) String() string {
+func (x *ListSoDConstraintsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeUserPermissionRequest) ProtoMessage() {}
+func (*ListSoDConstraintsRequest) ProtoMessage() {}
 
-func (x *RevokeUserPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[70]
+func (x *ListSoDConstraintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3663,47 +5187,40 @@ func (x *RevokeUserPermissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeUserPermissionRequest.ProtoReflect.Descriptor instead.
-func (*RevokeUserPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use ListSoDConstraintsRequest.ProtoReflect.Descriptor instead.
+func (*ListSoDConstraintsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *RevokeUserPermissionRequest) GetBizId() int64 {
+func (x *ListSoDConstraintsRequest) GetBizId() int64 {
 	if x != nil {
 		return x.BizId
 	}
 	return 0
 }
 
-func (x *RevokeUserPermissionRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type RevokeUserPermissionResponse struct {
+type ListSoDConstraintsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Constraints   []*SoDConstraint       `protobuf:"bytes,1,rep,name=constraints,proto3" json:"constraints,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeUserPermissionResponse) Reset() {
-	*x = RevokeUserPermissionResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[71]
+func (x *ListSoDConstraintsResponse) Reset() {
+	*x = ListSoDConstraintsResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeUserPermissionResponse) String() string {
+func (x *ListSoDConstraintsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeUserPermissionResponse) ProtoMessage() {}
+func (*ListSoDConstraintsResponse) ProtoMessage() {}
 
-func (x *RevokeUserPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[71]
+func (x *ListSoDConstraintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3714,42 +5231,42 @@ func (x *RevokeUserPermissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeUserPermissionResponse.ProtoReflect.Descriptor instead.
-func (*RevokeUserPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use ListSoDConstraintsResponse.ProtoReflect.Descriptor instead.
+func (*ListSoDConstraintsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *RevokeUserPermissionResponse) GetSuccess() bool {
+func (x *ListSoDConstraintsResponse) GetConstraints() []*SoDConstraint {
 	if x != nil {
-		return x.Success
+		return x.Constraints
 	}
-	return false
+	return nil
 }
 
-type ListUserPermissionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BizId         int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+type ActivateRolesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 用户当前必须直接或者间接拥有这些角色
+	RoleIds       []int64 `protobuf:"varint,2,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserPermissionsRequest) Reset() {
-	*x = ListUserPermissionsRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[72]
+func (x *ActivateRolesRequest) Reset() {
+	*x = ActivateRolesRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserPermissionsRequest) String() string {
+func (x *ActivateRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserPermissionsRequest) ProtoMessage() {}
+func (*ActivateRolesRequest) ProtoMessage() {}
 
-func (x *ListUserPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[72]
+func (x *ActivateRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3760,54 +5277,48 @@ func (x *ListUserPermissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserPermissionsRequest.ProtoReflect.Descriptor instead.
-func (*ListUserPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *ListUserPermissionsRequest) GetBizId() int64 {
-	if x != nil {
-		return x.BizId
-	}
-	return 0
+// Deprecated: Use ActivateRolesRequest.ProtoReflect.Descriptor instead.
+func (*ActivateRolesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *ListUserPermissionsRequest) GetOffset() int32 {
+func (x *ActivateRolesRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Offset
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ListUserPermissionsRequest) GetLimit() int32 {
+func (x *ActivateRolesRequest) GetRoleIds() []int64 {
 	if x != nil {
-		return x.Limit
+		return x.RoleIds
 	}
-	return 0
+	return nil
 }
 
-type ListUserPermissionsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserPermissions []*UserPermission      `protobuf:"bytes,1,rep,name=user_permissions,json=userPermissions,proto3" json:"user_permissions,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ActivateRolesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 激活之后直接或者间接生效的角色
+	EffectiveRoleIds []int64 `protobuf:"varint,1,rep,packed,name=effective_role_ids,json=effectiveRoleIds,proto3" json:"effective_role_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListUserPermissionsResponse) Reset() {
-	*x = ListUserPermissionsResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[73]
+func (x *ActivateRolesResponse) Reset() {
+	*x = ActivateRolesResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserPermissionsResponse) String() string {
+func (x *ActivateRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserPermissionsResponse) ProtoMessage() {}
+func (*ActivateRolesResponse) ProtoMessage() {}
 
-func (x *ListUserPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[73]
+func (x *ActivateRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3818,14 +5329,14 @@ func (x *ListUserPermissionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserPermissionsResponse.ProtoReflect.Descriptor instead.
-func (*ListUserPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use ActivateRolesResponse.ProtoReflect.Descriptor instead.
+func (*ActivateRolesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *ListUserPermissionsResponse) GetUserPermissions() []*UserPermission {
+func (x *ActivateRolesResponse) GetEffectiveRoleIds() []int64 {
 	if x != nil {
-		return x.UserPermissions
+		return x.EffectiveRoleIds
 	}
 	return nil
 }
@@ -3839,7 +5350,20 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\"e\n" +
 	"\x19GetAllPermissionsResponse\x12H\n" +
-	"\x10user_permissions\x18\x01 \x03(\v2\x1d.permission.v1.UserPermissionR\x0fuserPermissions\"\xa3\x01\n" +
+	"\x10user_permissions\x18\x01 \x03(\v2\x1d.permission.v1.UserPermissionR\x0fuserPermissions\"\xb1\x01\n" +
+	"!ListSubjectsWithPermissionRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12!\n" +
+	"\fresource_key\x18\x02 \x01(\tR\vresourceKey\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"f\n" +
+	"\x11PermissionSubject\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x128\n" +
+	"\asources\x18\x02 \x03(\v2\x1e.permission.v1.PermissionMatchR\asources\"\x83\x01\n" +
+	"\"ListSubjectsWithPermissionResponse\x12<\n" +
+	"\bsubjects\x18\x01 \x03(\v2 .permission.v1.PermissionSubjectR\bsubjects\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\"\xd0\x02\n" +
 	"\x0eBusinessConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12\x1d\n" +
@@ -3848,7 +5372,12 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
 	"rate_limit\x18\x05 \x01(\x05R\trateLimit\x12\x14\n" +
-	"\x05token\x18\x06 \x01(\tR\x05token\"T\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12\x16\n" +
+	"\x06engine\x18\a \x01(\tR\x06engine\x12/\n" +
+	"\x13combining_algorithm\x18\b \x01(\tR\x12combiningAlgorithm\x12(\n" +
+	"\x10no_policy_effect\x18\t \x01(\tR\x0enoPolicyEffect\x128\n" +
+	"\x18invalid_attribute_action\x18\n" +
+	" \x01(\tR\x16invalidAttributeAction\"T\n" +
 	"\x1bCreateBusinessConfigRequest\x125\n" +
 	"\x06config\x18\x01 \x01(\v2\x1d.permission.v1.BusinessConfigR\x06config\"U\n" +
 	"\x1cCreateBusinessConfigResponse\x125\n" +
@@ -3927,14 +5456,47 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\"V\n" +
 	"\x17ListPermissionsResponse\x12;\n" +
-	"\vpermissions\x18\x01 \x03(\v2\x19.permission.v1.PermissionR\vpermissions\"\x93\x01\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x19.permission.v1.PermissionR\vpermissions\"\x89\x01\n" +
+	"\x10ActionDefinition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aimplies\x18\x05 \x03(\tR\aimplies\"m\n" +
+	"\x1dCreateActionDefinitionRequest\x12L\n" +
+	"\x11action_definition\x18\x01 \x01(\v2\x1f.permission.v1.ActionDefinitionR\x10actionDefinition\"n\n" +
+	"\x1eCreateActionDefinitionResponse\x12L\n" +
+	"\x11action_definition\x18\x01 \x01(\v2\x1f.permission.v1.ActionDefinitionR\x10actionDefinition\"C\n" +
+	"\x1aGetActionDefinitionRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"k\n" +
+	"\x1bGetActionDefinitionResponse\x12L\n" +
+	"\x11action_definition\x18\x01 \x01(\v2\x1f.permission.v1.ActionDefinitionR\x10actionDefinition\"m\n" +
+	"\x1dUpdateActionDefinitionRequest\x12L\n" +
+	"\x11action_definition\x18\x01 \x01(\v2\x1f.permission.v1.ActionDefinitionR\x10actionDefinition\":\n" +
+	"\x1eUpdateActionDefinitionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\x1dDeleteActionDefinitionRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\":\n" +
+	"\x1eDeleteActionDefinitionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"c\n" +
+	"\x1cListActionDefinitionsRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"o\n" +
+	"\x1dListActionDefinitionsResponse\x12N\n" +
+	"\x12action_definitions\x18\x01 \x03(\v2\x1f.permission.v1.ActionDefinitionR\x11actionDefinitions\"\xe1\x01\n" +
 	"\x04Role\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
 	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bmetadata\x18\x06 \x01(\tR\bmetadata\"<\n" +
+	"\bmetadata\x18\x06 \x01(\tR\bmetadata\x12\x1f\n" +
+	"\vmax_members\x18\a \x01(\x05R\n" +
+	"maxMembers\x12+\n" +
+	"\x12max_roles_per_user\x18\b \x01(\x05R\x0fmaxRolesPerUser\"<\n" +
 	"\x11CreateRoleRequest\x12'\n" +
 	"\x04role\x18\x01 \x01(\v2\x13.permission.v1.RoleR\x04role\"=\n" +
 	"\x12CreateRoleResponse\x12'\n" +
@@ -3952,7 +5514,15 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x03R\x02id\".\n" +
 	"\x12DeleteRoleResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"k\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"T\n" +
+	"\x0fRoleUtilization\x12'\n" +
+	"\x04role\x18\x01 \x01(\v2\x13.permission.v1.RoleR\x04role\x12\x18\n" +
+	"\amembers\x18\x02 \x01(\x03R\amembers\"K\n" +
+	"\x1bListRoleUtilizationsRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"b\n" +
+	"\x1cListRoleUtilizationsResponse\x12B\n" +
+	"\futilizations\x18\x01 \x03(\v2\x1e.permission.v1.RoleUtilizationR\futilizations\"k\n" +
 	"\x10ListRolesRequest\x12\x15\n" +
 	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
@@ -3988,7 +5558,7 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\"c\n" +
 	"\x1aListRoleInclusionsResponse\x12E\n" +
-	"\x0frole_inclusions\x18\x01 \x03(\v2\x1c.permission.v1.RoleInclusionR\x0eroleInclusions\"\xa4\x02\n" +
+	"\x0frole_inclusions\x18\x01 \x03(\v2\x1c.permission.v1.RoleInclusionR\x0eroleInclusions\"\xf6\x02\n" +
 	"\x0eRolePermission\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
 	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x17\n" +
@@ -3998,7 +5568,12 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\trole_type\x18\x06 \x01(\tR\broleType\x12#\n" +
 	"\rresource_type\x18\a \x01(\tR\fresourceType\x12!\n" +
 	"\fresource_key\x18\b \x01(\tR\vresourceKey\x12+\n" +
-	"\x11permission_action\x18\t \x01(\tR\x10permissionAction\"d\n" +
+	"\x11permission_action\x18\t \x01(\tR\x10permissionAction\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\n" +
+	" \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\v \x01(\x03R\aendTime\x12\x16\n" +
+	"\x06effect\x18\f \x01(\tR\x06effect\"d\n" +
 	"\x1aGrantRolePermissionRequest\x12F\n" +
 	"\x0frole_permission\x18\x01 \x01(\v2\x1d.permission.v1.RolePermissionR\x0erolePermission\"e\n" +
 	"\x1bGrantRolePermissionResponse\x12F\n" +
@@ -4064,7 +5639,43 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\"g\n" +
 	"\x1bListUserPermissionsResponse\x12H\n" +
-	"\x10user_permissions\x18\x01 \x03(\v2\x1d.permission.v1.UserPermissionR\x0fuserPermissions2\xca\x1a\n" +
+	"\x10user_permissions\x18\x01 \x03(\v2\x1d.permission.v1.UserPermissionR\x0fuserPermissions\"\x9b\x01\n" +
+	"\rSoDConstraint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x19\n" +
+	"\brole_ids\x18\x06 \x03(\x03R\aroleIds\"Z\n" +
+	"\x1aCreateSoDConstraintRequest\x12<\n" +
+	"\n" +
+	"constraint\x18\x01 \x01(\v2\x1c.permission.v1.SoDConstraintR\n" +
+	"constraint\"[\n" +
+	"\x1bCreateSoDConstraintResponse\x12<\n" +
+	"\n" +
+	"constraint\x18\x01 \x01(\v2\x1c.permission.v1.SoDConstraintR\n" +
+	"constraint\"@\n" +
+	"\x17GetSoDConstraintRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"X\n" +
+	"\x18GetSoDConstraintResponse\x12<\n" +
+	"\n" +
+	"constraint\x18\x01 \x01(\v2\x1c.permission.v1.SoDConstraintR\n" +
+	"constraint\"C\n" +
+	"\x1aDeleteSoDConstraintRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"7\n" +
+	"\x1bDeleteSoDConstraintResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x19ListSoDConstraintsRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\"\\\n" +
+	"\x1aListSoDConstraintsResponse\x12>\n" +
+	"\vconstraints\x18\x01 \x03(\v2\x1c.permission.v1.SoDConstraintR\vconstraints\"J\n" +
+	"\x14ActivateRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\brole_ids\x18\x02 \x03(\x03R\aroleIds\"E\n" +
+	"\x15ActivateRolesResponse\x12,\n" +
+	"\x12effective_role_ids\x18\x01 \x03(\x03R\x10effectiveRoleIds2\x8e%\n" +
 	"\vRBACService\x12o\n" +
 	"\x14CreateBusinessConfig\x12*.permission.v1.CreateBusinessConfigRequest\x1a+.permission.v1.CreateBusinessConfigResponse\x12f\n" +
 	"\x11GetBusinessConfig\x12'.permission.v1.GetBusinessConfigRequest\x1a(.permission.v1.GetBusinessConfigResponse\x12o\n" +
@@ -4080,7 +5691,12 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\rGetPermission\x12#.permission.v1.GetPermissionRequest\x1a$.permission.v1.GetPermissionResponse\x12c\n" +
 	"\x10UpdatePermission\x12&.permission.v1.UpdatePermissionRequest\x1a'.permission.v1.UpdatePermissionResponse\x12c\n" +
 	"\x10DeletePermission\x12&.permission.v1.DeletePermissionRequest\x1a'.permission.v1.DeletePermissionResponse\x12`\n" +
-	"\x0fListPermissions\x12%.permission.v1.ListPermissionsRequest\x1a&.permission.v1.ListPermissionsResponse\x12Q\n" +
+	"\x0fListPermissions\x12%.permission.v1.ListPermissionsRequest\x1a&.permission.v1.ListPermissionsResponse\x12u\n" +
+	"\x16CreateActionDefinition\x12,.permission.v1.CreateActionDefinitionRequest\x1a-.permission.v1.CreateActionDefinitionResponse\x12l\n" +
+	"\x13GetActionDefinition\x12).permission.v1.GetActionDefinitionRequest\x1a*.permission.v1.GetActionDefinitionResponse\x12u\n" +
+	"\x16UpdateActionDefinition\x12,.permission.v1.UpdateActionDefinitionRequest\x1a-.permission.v1.UpdateActionDefinitionResponse\x12u\n" +
+	"\x16DeleteActionDefinition\x12,.permission.v1.DeleteActionDefinitionRequest\x1a-.permission.v1.DeleteActionDefinitionResponse\x12r\n" +
+	"\x15ListActionDefinitions\x12+.permission.v1.ListActionDefinitionsRequest\x1a,.permission.v1.ListActionDefinitionsResponse\x12Q\n" +
 	"\n" +
 	"CreateRole\x12 .permission.v1.CreateRoleRequest\x1a!.permission.v1.CreateRoleResponse\x12H\n" +
 	"\aGetRole\x12\x1d.permission.v1.GetRoleRequest\x1a\x1e.permission.v1.GetRoleResponse\x12Q\n" +
@@ -4088,7 +5704,8 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"UpdateRole\x12 .permission.v1.UpdateRoleRequest\x1a!.permission.v1.UpdateRoleResponse\x12Q\n" +
 	"\n" +
 	"DeleteRole\x12 .permission.v1.DeleteRoleRequest\x1a!.permission.v1.DeleteRoleResponse\x12N\n" +
-	"\tListRoles\x12\x1f.permission.v1.ListRolesRequest\x1a .permission.v1.ListRolesResponse\x12l\n" +
+	"\tListRoles\x12\x1f.permission.v1.ListRolesRequest\x1a .permission.v1.ListRolesResponse\x12o\n" +
+	"\x14ListRoleUtilizations\x12*.permission.v1.ListRoleUtilizationsRequest\x1a+.permission.v1.ListRoleUtilizationsResponse\x12l\n" +
 	"\x13CreateRoleInclusion\x12).permission.v1.CreateRoleInclusionRequest\x1a*.permission.v1.CreateRoleInclusionResponse\x12c\n" +
 	"\x10GetRoleInclusion\x12&.permission.v1.GetRoleInclusionRequest\x1a'.permission.v1.GetRoleInclusionResponse\x12l\n" +
 	"\x13DeleteRoleInclusion\x12).permission.v1.DeleteRoleInclusionRequest\x1a*.permission.v1.DeleteRoleInclusionResponse\x12i\n" +
@@ -4102,7 +5719,13 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x13GrantUserPermission\x12).permission.v1.GrantUserPermissionRequest\x1a*.permission.v1.GrantUserPermissionResponse\x12o\n" +
 	"\x14RevokeUserPermission\x12*.permission.v1.RevokeUserPermissionRequest\x1a+.permission.v1.RevokeUserPermissionResponse\x12l\n" +
 	"\x13ListUserPermissions\x12).permission.v1.ListUserPermissionsRequest\x1a*.permission.v1.ListUserPermissionsResponse\x12f\n" +
-	"\x11GetAllPermissions\x12'.permission.v1.GetAllPermissionsRequest\x1a(.permission.v1.GetAllPermissionsResponseB\xc3\x01\n" +
+	"\x11GetAllPermissions\x12'.permission.v1.GetAllPermissionsRequest\x1a(.permission.v1.GetAllPermissionsResponse\x12\x81\x01\n" +
+	"\x1aListSubjectsWithPermission\x120.permission.v1.ListSubjectsWithPermissionRequest\x1a1.permission.v1.ListSubjectsWithPermissionResponse\x12l\n" +
+	"\x13CreateSoDConstraint\x12).permission.v1.CreateSoDConstraintRequest\x1a*.permission.v1.CreateSoDConstraintResponse\x12c\n" +
+	"\x10GetSoDConstraint\x12&.permission.v1.GetSoDConstraintRequest\x1a'.permission.v1.GetSoDConstraintResponse\x12l\n" +
+	"\x13DeleteSoDConstraint\x12).permission.v1.DeleteSoDConstraintRequest\x1a*.permission.v1.DeleteSoDConstraintResponse\x12i\n" +
+	"\x12ListSoDConstraints\x12(.permission.v1.ListSoDConstraintsRequest\x1a).permission.v1.ListSoDConstraintsResponse\x12Z\n" +
+	"\rActivateRoles\x12#.permission.v1.ActivateRolesRequest\x1a$.permission.v1.ActivateRolesResponseB\xc3\x01\n" +
 	"\x11com.permission.v1B\tRbacProtoP\x01ZNgitee.com/flycash/permission-platform/api/proto/gen/permission/v1;permissionv1\xa2\x02\x03PXX\xaa\x02\rPermission.V1\xca\x02\rPermission\\V1\xe2\x02\x19Permission\\V1\\GPBMetadata\xea\x02\x0ePermission::V1b\x06proto3"
 
 var (
@@ -4118,195 +5741,260 @@ func file_permission_v1_rbac_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_permission_v1_rbac_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+	file_permission_v1_rbac_proto_msgTypes = make([]protoimpl.MessageInfo, 102)
 	file_permission_v1_rbac_proto_goTypes  = []any{
-		(*GetAllPermissionsRequest)(nil),     // 0: permission.v1.GetAllPermissionsRequest
-		(*GetAllPermissionsResponse)(nil),    // 1: permission.v1.GetAllPermissionsResponse
-		(*BusinessConfig)(nil),               // 2: permission.v1.BusinessConfig
-		(*CreateBusinessConfigRequest)(nil),  // 3: permission.v1.CreateBusinessConfigRequest
-		(*CreateBusinessConfigResponse)(nil), // 4: permission.v1.CreateBusinessConfigResponse
-		(*GetBusinessConfigRequest)(nil),     // 5: permission.v1.GetBusinessConfigRequest
-		(*GetBusinessConfigResponse)(nil),    // 6: permission.v1.GetBusinessConfigResponse
-		(*UpdateBusinessConfigRequest)(nil),  // 7: permission.v1.UpdateBusinessConfigRequest
-		(*UpdateBusinessConfigResponse)(nil), // 8: permission.v1.UpdateBusinessConfigResponse
-		(*DeleteBusinessConfigRequest)(nil),  // 9: permission.v1.DeleteBusinessConfigRequest
-		(*DeleteBusinessConfigResponse)(nil), // 10: permission.v1.DeleteBusinessConfigResponse
-		(*ListBusinessConfigsRequest)(nil),   // 11: permission.v1.ListBusinessConfigsRequest
-		(*ListBusinessConfigsResponse)(nil),  // 12: permission.v1.ListBusinessConfigsResponse
-		(*CreateResourceRequest)(nil),        // 13: permission.v1.CreateResourceRequest
-		(*CreateResourceResponse)(nil),       // 14: permission.v1.CreateResourceResponse
-		(*GetResourceRequest)(nil),           // 15: permission.v1.GetResourceRequest
-		(*GetResourceResponse)(nil),          // 16: permission.v1.GetResourceResponse
-		(*UpdateResourceRequest)(nil),        // 17: permission.v1.UpdateResourceRequest
-		(*UpdateResourceResponse)(nil),       // 18: permission.v1.UpdateResourceResponse
-		(*DeleteResourceRequest)(nil),        // 19: permission.v1.DeleteResourceRequest
-		(*DeleteResourceResponse)(nil),       // 20: permission.v1.DeleteResourceResponse
-		(*ListResourcesRequest)(nil),         // 21: permission.v1.ListResourcesRequest
-		(*ListResourcesResponse)(nil),        // 22: permission.v1.ListResourcesResponse
-		(*CreatePermissionRequest)(nil),      // 23: permission.v1.CreatePermissionRequest
-		(*CreatePermissionResponse)(nil),     // 24: permission.v1.CreatePermissionResponse
-		(*GetPermissionRequest)(nil),         // 25: permission.v1.GetPermissionRequest
-		(*GetPermissionResponse)(nil),        // 26: permission.v1.GetPermissionResponse
-		(*UpdatePermissionRequest)(nil),      // 27: permission.v1.UpdatePermissionRequest
-		(*UpdatePermissionResponse)(nil),     // 28: permission.v1.UpdatePermissionResponse
-		(*DeletePermissionRequest)(nil),      // 29: permission.v1.DeletePermissionRequest
-		(*DeletePermissionResponse)(nil),     // 30: permission.v1.DeletePermissionResponse
-		(*ListPermissionsRequest)(nil),       // 31: permission.v1.ListPermissionsRequest
-		(*ListPermissionsResponse)(nil),      // 32: permission.v1.ListPermissionsResponse
-		(*Role)(nil),                         // 33: permission.v1.Role
-		(*CreateRoleRequest)(nil),            // 34: permission.v1.CreateRoleRequest
-		(*CreateRoleResponse)(nil),           // 35: permission.v1.CreateRoleResponse
-		(*GetRoleRequest)(nil),               // 36: permission.v1.GetRoleRequest
-		(*GetRoleResponse)(nil),              // 37: permission.v1.GetRoleResponse
-		(*UpdateRoleRequest)(nil),            // 38: permission.v1.UpdateRoleRequest
-		(*UpdateRoleResponse)(nil),           // 39: permission.v1.UpdateRoleResponse
-		(*DeleteRoleRequest)(nil),            // 40: permission.v1.DeleteRoleRequest
-		(*DeleteRoleResponse)(nil),           // 41: permission.v1.DeleteRoleResponse
-		(*ListRolesRequest)(nil),             // 42: permission.v1.ListRolesRequest
-		(*ListRolesResponse)(nil),            // 43: permission.v1.ListRolesResponse
-		(*RoleInclusion)(nil),                // 44: permission.v1.RoleInclusion
-		(*CreateRoleInclusionRequest)(nil),   // 45: permission.v1.CreateRoleInclusionRequest
-		(*CreateRoleInclusionResponse)(nil),  // 46: permission.v1.CreateRoleInclusionResponse
-		(*GetRoleInclusionRequest)(nil),      // 47: permission.v1.GetRoleInclusionRequest
-		(*GetRoleInclusionResponse)(nil),     // 48: permission.v1.GetRoleInclusionResponse
-		(*DeleteRoleInclusionRequest)(nil),   // 49: permission.v1.DeleteRoleInclusionRequest
-		(*DeleteRoleInclusionResponse)(nil),  // 50: permission.v1.DeleteRoleInclusionResponse
-		(*ListRoleInclusionsRequest)(nil),    // 51: permission.v1.ListRoleInclusionsRequest
-		(*ListRoleInclusionsResponse)(nil),   // 52: permission.v1.ListRoleInclusionsResponse
-		(*RolePermission)(nil),               // 53: permission.v1.RolePermission
-		(*GrantRolePermissionRequest)(nil),   // 54: permission.v1.GrantRolePermissionRequest
-		(*GrantRolePermissionResponse)(nil),  // 55: permission.v1.GrantRolePermissionResponse
-		(*RevokeRolePermissionRequest)(nil),  // 56: permission.v1.RevokeRolePermissionRequest
-		(*RevokeRolePermissionResponse)(nil), // 57: permission.v1.RevokeRolePermissionResponse
-		(*ListRolePermissionsRequest)(nil),   // 58: permission.v1.ListRolePermissionsRequest
-		(*ListRolePermissionsResponse)(nil),  // 59: permission.v1.ListRolePermissionsResponse
-		(*UserRole)(nil),                     // 60: permission.v1.UserRole
-		(*GrantUserRoleRequest)(nil),         // 61: permission.v1.GrantUserRoleRequest
-		(*GrantUserRoleResponse)(nil),        // 62: permission.v1.GrantUserRoleResponse
-		(*RevokeUserRoleRequest)(nil),        // 63: permission.v1.RevokeUserRoleRequest
-		(*RevokeUserRoleResponse)(nil),       // 64: permission.v1.RevokeUserRoleResponse
-		(*ListUserRolesRequest)(nil),         // 65: permission.v1.ListUserRolesRequest
-		(*ListUserRolesResponse)(nil),        // 66: permission.v1.ListUserRolesResponse
-		(*UserPermission)(nil),               // 67: permission.v1.UserPermission
-		(*GrantUserPermissionRequest)(nil),   // 68: permission.v1.GrantUserPermissionRequest
-		(*GrantUserPermissionResponse)(nil),  // 69: permission.v1.GrantUserPermissionResponse
-		(*RevokeUserPermissionRequest)(nil),  // 70: permission.v1.RevokeUserPermissionRequest
-		(*RevokeUserPermissionResponse)(nil), // 71: permission.v1.RevokeUserPermissionResponse
-		(*ListUserPermissionsRequest)(nil),   // 72: permission.v1.ListUserPermissionsRequest
-		(*ListUserPermissionsResponse)(nil),  // 73: permission.v1.ListUserPermissionsResponse
-		(*Resource)(nil),                     // 74: permission.v1.Resource
-		(*Permission)(nil),                   // 75: permission.v1.Permission
+		(*GetAllPermissionsRequest)(nil),           // 0: permission.v1.GetAllPermissionsRequest
+		(*GetAllPermissionsResponse)(nil),          // 1: permission.v1.GetAllPermissionsResponse
+		(*ListSubjectsWithPermissionRequest)(nil),  // 2: permission.v1.ListSubjectsWithPermissionRequest
+		(*PermissionSubject)(nil),                  // 3: permission.v1.PermissionSubject
+		(*ListSubjectsWithPermissionResponse)(nil), // 4: permission.v1.ListSubjectsWithPermissionResponse
+		(*BusinessConfig)(nil),                     // 5: permission.v1.BusinessConfig
+		(*CreateBusinessConfigRequest)(nil),        // 6: permission.v1.CreateBusinessConfigRequest
+		(*CreateBusinessConfigResponse)(nil),       // 7: permission.v1.CreateBusinessConfigResponse
+		(*GetBusinessConfigRequest)(nil),           // 8: permission.v1.GetBusinessConfigRequest
+		(*GetBusinessConfigResponse)(nil),          // 9: permission.v1.GetBusinessConfigResponse
+		(*UpdateBusinessConfigRequest)(nil),        // 10: permission.v1.UpdateBusinessConfigRequest
+		(*UpdateBusinessConfigResponse)(nil),       // 11: permission.v1.UpdateBusinessConfigResponse
+		(*DeleteBusinessConfigRequest)(nil),        // 12: permission.v1.DeleteBusinessConfigRequest
+		(*DeleteBusinessConfigResponse)(nil),       // 13: permission.v1.DeleteBusinessConfigResponse
+		(*ListBusinessConfigsRequest)(nil),         // 14: permission.v1.ListBusinessConfigsRequest
+		(*ListBusinessConfigsResponse)(nil),        // 15: permission.v1.ListBusinessConfigsResponse
+		(*CreateResourceRequest)(nil),              // 16: permission.v1.CreateResourceRequest
+		(*CreateResourceResponse)(nil),             // 17: permission.v1.CreateResourceResponse
+		(*GetResourceRequest)(nil),                 // 18: permission.v1.GetResourceRequest
+		(*GetResourceResponse)(nil),                // 19: permission.v1.GetResourceResponse
+		(*UpdateResourceRequest)(nil),              // 20: permission.v1.UpdateResourceRequest
+		(*UpdateResourceResponse)(nil),             // 21: permission.v1.UpdateResourceResponse
+		(*DeleteResourceRequest)(nil),              // 22: permission.v1.DeleteResourceRequest
+		(*DeleteResourceResponse)(nil),             // 23: permission.v1.DeleteResourceResponse
+		(*ListResourcesRequest)(nil),               // 24: permission.v1.ListResourcesRequest
+		(*ListResourcesResponse)(nil),              // 25: permission.v1.ListResourcesResponse
+		(*CreatePermissionRequest)(nil),            // 26: permission.v1.CreatePermissionRequest
+		(*CreatePermissionResponse)(nil),           // 27: permission.v1.CreatePermissionResponse
+		(*GetPermissionRequest)(nil),               // 28: permission.v1.GetPermissionRequest
+		(*GetPermissionResponse)(nil),              // 29: permission.v1.GetPermissionResponse
+		(*UpdatePermissionRequest)(nil),            // 30: permission.v1.UpdatePermissionRequest
+		(*UpdatePermissionResponse)(nil),           // 31: permission.v1.UpdatePermissionResponse
+		(*DeletePermissionRequest)(nil),            // 32: permission.v1.DeletePermissionRequest
+		(*DeletePermissionResponse)(nil),           // 33: permission.v1.DeletePermissionResponse
+		(*ListPermissionsRequest)(nil),             // 34: permission.v1.ListPermissionsRequest
+		(*ListPermissionsResponse)(nil),            // 35: permission.v1.ListPermissionsResponse
+		(*ActionDefinition)(nil),                   // 36: permission.v1.ActionDefinition
+		(*CreateActionDefinitionRequest)(nil),      // 37: permission.v1.CreateActionDefinitionRequest
+		(*CreateActionDefinitionResponse)(nil),     // 38: permission.v1.CreateActionDefinitionResponse
+		(*GetActionDefinitionRequest)(nil),         // 39: permission.v1.GetActionDefinitionRequest
+		(*GetActionDefinitionResponse)(nil),        // 40: permission.v1.GetActionDefinitionResponse
+		(*UpdateActionDefinitionRequest)(nil),      // 41: permission.v1.UpdateActionDefinitionRequest
+		(*UpdateActionDefinitionResponse)(nil),     // 42: permission.v1.UpdateActionDefinitionResponse
+		(*DeleteActionDefinitionRequest)(nil),      // 43: permission.v1.DeleteActionDefinitionRequest
+		(*DeleteActionDefinitionResponse)(nil),     // 44: permission.v1.DeleteActionDefinitionResponse
+		(*ListActionDefinitionsRequest)(nil),       // 45: permission.v1.ListActionDefinitionsRequest
+		(*ListActionDefinitionsResponse)(nil),      // 46: permission.v1.ListActionDefinitionsResponse
+		(*Role)(nil),                               // 47: permission.v1.Role
+		(*CreateRoleRequest)(nil),                  // 48: permission.v1.CreateRoleRequest
+		(*CreateRoleResponse)(nil),                 // 49: permission.v1.CreateRoleResponse
+		(*GetRoleRequest)(nil),                     // 50: permission.v1.GetRoleRequest
+		(*GetRoleResponse)(nil),                    // 51: permission.v1.GetRoleResponse
+		(*UpdateRoleRequest)(nil),                  // 52: permission.v1.UpdateRoleRequest
+		(*UpdateRoleResponse)(nil),                 // 53: permission.v1.UpdateRoleResponse
+		(*DeleteRoleRequest)(nil),                  // 54: permission.v1.DeleteRoleRequest
+		(*DeleteRoleResponse)(nil),                 // 55: permission.v1.DeleteRoleResponse
+		(*RoleUtilization)(nil),                    // 56: permission.v1.RoleUtilization
+		(*ListRoleUtilizationsRequest)(nil),        // 57: permission.v1.ListRoleUtilizationsRequest
+		(*ListRoleUtilizationsResponse)(nil),       // 58: permission.v1.ListRoleUtilizationsResponse
+		(*ListRolesRequest)(nil),                   // 59: permission.v1.ListRolesRequest
+		(*ListRolesResponse)(nil),                  // 60: permission.v1.ListRolesResponse
+		(*RoleInclusion)(nil),                      // 61: permission.v1.RoleInclusion
+		(*CreateRoleInclusionRequest)(nil),         // 62: permission.v1.CreateRoleInclusionRequest
+		(*CreateRoleInclusionResponse)(nil),        // 63: permission.v1.CreateRoleInclusionResponse
+		(*GetRoleInclusionRequest)(nil),            // 64: permission.v1.GetRoleInclusionRequest
+		(*GetRoleInclusionResponse)(nil),           // 65: permission.v1.GetRoleInclusionResponse
+		(*DeleteRoleInclusionRequest)(nil),         // 66: permission.v1.DeleteRoleInclusionRequest
+		(*DeleteRoleInclusionResponse)(nil),        // 67: permission.v1.DeleteRoleInclusionResponse
+		(*ListRoleInclusionsRequest)(nil),          // 68: permission.v1.ListRoleInclusionsRequest
+		(*ListRoleInclusionsResponse)(nil),         // 69: permission.v1.ListRoleInclusionsResponse
+		(*RolePermission)(nil),                     // 70: permission.v1.RolePermission
+		(*GrantRolePermissionRequest)(nil),         // 71: permission.v1.GrantRolePermissionRequest
+		(*GrantRolePermissionResponse)(nil),        // 72: permission.v1.GrantRolePermissionResponse
+		(*RevokeRolePermissionRequest)(nil),        // 73: permission.v1.RevokeRolePermissionRequest
+		(*RevokeRolePermissionResponse)(nil),       // 74: permission.v1.RevokeRolePermissionResponse
+		(*ListRolePermissionsRequest)(nil),         // 75: permission.v1.ListRolePermissionsRequest
+		(*ListRolePermissionsResponse)(nil),        // 76: permission.v1.ListRolePermissionsResponse
+		(*UserRole)(nil),                           // 77: permission.v1.UserRole
+		(*GrantUserRoleRequest)(nil),               // 78: permission.v1.GrantUserRoleRequest
+		(*GrantUserRoleResponse)(nil),              // 79: permission.v1.GrantUserRoleResponse
+		(*RevokeUserRoleRequest)(nil),              // 80: permission.v1.RevokeUserRoleRequest
+		(*RevokeUserRoleResponse)(nil),             // 81: permission.v1.RevokeUserRoleResponse
+		(*ListUserRolesRequest)(nil),               // 82: permission.v1.ListUserRolesRequest
+		(*ListUserRolesResponse)(nil),              // 83: permission.v1.ListUserRolesResponse
+		(*UserPermission)(nil),                     // 84: permission.v1.UserPermission
+		(*GrantUserPermissionRequest)(nil),         // 85: permission.v1.GrantUserPermissionRequest
+		(*GrantUserPermissionResponse)(nil),        // 86: permission.v1.GrantUserPermissionResponse
+		(*RevokeUserPermissionRequest)(nil),        // 87: permission.v1.RevokeUserPermissionRequest
+		(*RevokeUserPermissionResponse)(nil),       // 88: permission.v1.RevokeUserPermissionResponse
+		(*ListUserPermissionsRequest)(nil),         // 89: permission.v1.ListUserPermissionsRequest
+		(*ListUserPermissionsResponse)(nil),        // 90: permission.v1.ListUserPermissionsResponse
+		(*SoDConstraint)(nil),                      // 91: permission.v1.SoDConstraint
+		(*CreateSoDConstraintRequest)(nil),         // 92: permission.v1.CreateSoDConstraintRequest
+		(*CreateSoDConstraintResponse)(nil),        // 93: permission.v1.CreateSoDConstraintResponse
+		(*GetSoDConstraintRequest)(nil),            // 94: permission.v1.GetSoDConstraintRequest
+		(*GetSoDConstraintResponse)(nil),           // 95: permission.v1.GetSoDConstraintResponse
+		(*DeleteSoDConstraintRequest)(nil),         // 96: permission.v1.DeleteSoDConstraintRequest
+		(*DeleteSoDConstraintResponse)(nil),        // 97: permission.v1.DeleteSoDConstraintResponse
+		(*ListSoDConstraintsRequest)(nil),          // 98: permission.v1.ListSoDConstraintsRequest
+		(*ListSoDConstraintsResponse)(nil),         // 99: permission.v1.ListSoDConstraintsResponse
+		(*ActivateRolesRequest)(nil),               // 100: permission.v1.ActivateRolesRequest
+		(*ActivateRolesResponse)(nil),              // 101: permission.v1.ActivateRolesResponse
+		(*PermissionMatch)(nil),                    // 102: permission.v1.PermissionMatch
+		(*Resource)(nil),                           // 103: permission.v1.Resource
+		(*Permission)(nil),                         // 104: permission.v1.Permission
 	}
 )
-
 var file_permission_v1_rbac_proto_depIdxs = []int32{
-	67, // 0: permission.v1.GetAllPermissionsResponse.user_permissions:type_name -> permission.v1.UserPermission
-	2,  // 1: permission.v1.CreateBusinessConfigRequest.config:type_name -> permission.v1.BusinessConfig
-	2,  // 2: permission.v1.CreateBusinessConfigResponse.config:type_name -> permission.v1.BusinessConfig
-	2,  // 3: permission.v1.GetBusinessConfigResponse.config:type_name -> permission.v1.BusinessConfig
-	2,  // 4: permission.v1.UpdateBusinessConfigRequest.config:type_name -> permission.v1.BusinessConfig
-	2,  // 5: permission.v1.ListBusinessConfigsResponse.configs:type_name -> permission.v1.BusinessConfig
-	74, // 6: permission.v1.CreateResourceRequest.resource:type_name -> permission.v1.Resource
-	74, // 7: permission.v1.CreateResourceResponse.resource:type_name -> permission.v1.Resource
-	74, // 8: permission.v1.GetResourceResponse.resource:type_name -> permission.v1.Resource
-	74, // 9: permission.v1.UpdateResourceRequest.resource:type_name -> permission.v1.Resource
-	74, // 10: permission.v1.ListResourcesResponse.resources:type_name -> permission.v1.Resource
-	75, // 11: permission.v1.CreatePermissionRequest.permission:type_name -> permission.v1.Permission
-	75, // 12: permission.v1.CreatePermissionResponse.permission:type_name -> permission.v1.Permission
-	75, // 13: permission.v1.GetPermissionResponse.permission:type_name -> permission.v1.Permission
-	75, // 14: permission.v1.UpdatePermissionRequest.permission:type_name -> permission.v1.Permission
-	75, // 15: permission.v1.ListPermissionsResponse.permissions:type_name -> permission.v1.Permission
-	33, // 16: permission.v1.CreateRoleRequest.role:type_name -> permission.v1.Role
-	33, // 17: permission.v1.CreateRoleResponse.role:type_name -> permission.v1.Role
-	33, // 18: permission.v1.GetRoleResponse.role:type_name -> permission.v1.Role
-	33, // 19: permission.v1.UpdateRoleRequest.role:type_name -> permission.v1.Role
-	33, // 20: permission.v1.ListRolesResponse.roles:type_name -> permission.v1.Role
-	44, // 21: permission.v1.CreateRoleInclusionRequest.role_inclusion:type_name -> permission.v1.RoleInclusion
-	44, // 22: permission.v1.CreateRoleInclusionResponse.role_inclusion:type_name -> permission.v1.RoleInclusion
-	44, // 23: permission.v1.GetRoleInclusionResponse.role_inclusion:type_name -> permission.v1.RoleInclusion
-	44, // 24: permission.v1.ListRoleInclusionsResponse.role_inclusions:type_name -> permission.v1.RoleInclusion
-	53, // 25: permission.v1.GrantRolePermissionRequest.role_permission:type_name -> permission.v1.RolePermission
-	53, // 26: permission.v1.GrantRolePermissionResponse.role_permission:type_name -> permission.v1.RolePermission
-	53, // 27: permission.v1.ListRolePermissionsResponse.role_permissions:type_name -> permission.v1.RolePermission
-	60, // 28: permission.v1.GrantUserRoleRequest.user_role:type_name -> permission.v1.UserRole
-	60, // 29: permission.v1.GrantUserRoleResponse.user_role:type_name -> permission.v1.UserRole
-	60, // 30: permission.v1.ListUserRolesResponse.user_roles:type_name -> permission.v1.UserRole
-	67, // 31: permission.v1.GrantUserPermissionRequest.user_permission:type_name -> permission.v1.UserPermission
-	67, // 32: permission.v1.GrantUserPermissionResponse.user_permission:type_name -> permission.v1.UserPermission
-	67, // 33: permission.v1.ListUserPermissionsResponse.user_permissions:type_name -> permission.v1.UserPermission
-	3,  // 34: permission.v1.RBACService.CreateBusinessConfig:input_type -> permission.v1.CreateBusinessConfigRequest
-	5,  // 35: permission.v1.RBACService.GetBusinessConfig:input_type -> permission.v1.GetBusinessConfigRequest
-	7,  // 36: permission.v1.RBACService.UpdateBusinessConfig:input_type -> permission.v1.UpdateBusinessConfigRequest
-	9,  // 37: permission.v1.RBACService.DeleteBusinessConfig:input_type -> permission.v1.DeleteBusinessConfigRequest
-	11, // 38: permission.v1.RBACService.ListBusinessConfigs:input_type -> permission.v1.ListBusinessConfigsRequest
-	13, // 39: permission.v1.RBACService.CreateResource:input_type -> permission.v1.CreateResourceRequest
-	15, // 40: permission.v1.RBACService.GetResource:input_type -> permission.v1.GetResourceRequest
-	17, // 41: permission.v1.RBACService.UpdateResource:input_type -> permission.v1.UpdateResourceRequest
-	19, // 42: permission.v1.RBACService.DeleteResource:input_type -> permission.v1.DeleteResourceRequest
-	21, // 43: permission.v1.RBACService.ListResources:input_type -> permission.v1.ListResourcesRequest
-	23, // 44: permission.v1.RBACService.CreatePermission:input_type -> permission.v1.CreatePermissionRequest
-	25, // 45: permission.v1.RBACService.GetPermission:input_type -> permission.v1.GetPermissionRequest
-	27, // 46: permission.v1.RBACService.UpdatePermission:input_type -> permission.v1.UpdatePermissionRequest
-	29, // 47: permission.v1.RBACService.DeletePermission:input_type -> permission.v1.DeletePermissionRequest
-	31, // 48: permission.v1.RBACService.ListPermissions:input_type -> permission.v1.ListPermissionsRequest
-	34, // 49: permission.v1.RBACService.CreateRole:input_type -> permission.v1.CreateRoleRequest
-	36, // 50: permission.v1.RBACService.GetRole:input_type -> permission.v1.GetRoleRequest
-	38, // 51: permission.v1.RBACService.UpdateRole:input_type -> permission.v1.UpdateRoleRequest
-	40, // 52: permission.v1.RBACService.DeleteRole:input_type -> permission.v1.DeleteRoleRequest
-	42, // 53: permission.v1.RBACService.ListRoles:input_type -> permission.v1.ListRolesRequest
-	45, // 54: permission.v1.RBACService.CreateRoleInclusion:input_type -> permission.v1.CreateRoleInclusionRequest
-	47, // 55: permission.v1.RBACService.GetRoleInclusion:input_type -> permission.v1.GetRoleInclusionRequest
-	49, // 56: permission.v1.RBACService.DeleteRoleInclusion:input_type -> permission.v1.DeleteRoleInclusionRequest
-	51, // 57: permission.v1.RBACService.ListRoleInclusions:input_type -> permission.v1.ListRoleInclusionsRequest
-	54, // 58: permission.v1.RBACService.GrantRolePermission:input_type -> permission.v1.GrantRolePermissionRequest
-	56, // 59: permission.v1.RBACService.RevokeRolePermission:input_type -> permission.v1.RevokeRolePermissionRequest
-	58, // 60: permission.v1.RBACService.ListRolePermissions:input_type -> permission.v1.ListRolePermissionsRequest
-	61, // 61: permission.v1.RBACService.GrantUserRole:input_type -> permission.v1.GrantUserRoleRequest
-	63, // 62: permission.v1.RBACService.RevokeUserRole:input_type -> permission.v1.RevokeUserRoleRequest
-	65, // 63: permission.v1.RBACService.ListUserRoles:input_type -> permission.v1.ListUserRolesRequest
-	68, // 64: permission.v1.RBACService.GrantUserPermission:input_type -> permission.v1.GrantUserPermissionRequest
-	70, // 65: permission.v1.RBACService.RevokeUserPermission:input_type -> permission.v1.RevokeUserPermissionRequest
-	72, // 66: permission.v1.RBACService.ListUserPermissions:input_type -> permission.v1.ListUserPermissionsRequest
-	0,  // 67: permission.v1.RBACService.GetAllPermissions:input_type -> permission.v1.GetAllPermissionsRequest
-	4,  // 68: permission.v1.RBACService.CreateBusinessConfig:output_type -> permission.v1.CreateBusinessConfigResponse
-	6,  // 69: permission.v1.RBACService.GetBusinessConfig:output_type -> permission.v1.GetBusinessConfigResponse
-	8,  // 70: permission.v1.RBACService.UpdateBusinessConfig:output_type -> permission.v1.UpdateBusinessConfigResponse
-	10, // 71: permission.v1.RBACService.DeleteBusinessConfig:output_type -> permission.v1.DeleteBusinessConfigResponse
-	12, // 72: permission.v1.RBACService.ListBusinessConfigs:output_type -> permission.v1.ListBusinessConfigsResponse
-	14, // 73: permission.v1.RBACService.CreateResource:output_type -> permission.v1.CreateResourceResponse
-	16, // 74: permission.v1.RBACService.GetResource:output_type -> permission.v1.GetResourceResponse
-	18, // 75: permission.v1.RBACService.UpdateResource:output_type -> permission.v1.UpdateResourceResponse
-	20, // 76: permission.v1.RBACService.DeleteResource:output_type -> permission.v1.DeleteResourceResponse
-	22, // 77: permission.v1.RBACService.ListResources:output_type -> permission.v1.ListResourcesResponse
-	24, // 78: permission.v1.RBACService.CreatePermission:output_type -> permission.v1.CreatePermissionResponse
-	26, // 79: permission.v1.RBACService.GetPermission:output_type -> permission.v1.GetPermissionResponse
-	28, // 80: permission.v1.RBACService.UpdatePermission:output_type -> permission.v1.UpdatePermissionResponse
-	30, // 81: permission.v1.RBACService.DeletePermission:output_type -> permission.v1.DeletePermissionResponse
-	32, // 82: permission.v1.RBACService.ListPermissions:output_type -> permission.v1.ListPermissionsResponse
-	35, // 83: permission.v1.RBACService.CreateRole:output_type -> permission.v1.CreateRoleResponse
-	37, // 84: permission.v1.RBACService.GetRole:output_type -> permission.v1.GetRoleResponse
-	39, // 85: permission.v1.RBACService.UpdateRole:output_type -> permission.v1.UpdateRoleResponse
-	41, // 86: permission.v1.RBACService.DeleteRole:output_type -> permission.v1.DeleteRoleResponse
-	43, // 87: permission.v1.RBACService.ListRoles:output_type -> permission.v1.ListRolesResponse
-	46, // 88: permission.v1.RBACService.CreateRoleInclusion:output_type -> permission.v1.CreateRoleInclusionResponse
-	48, // 89: permission.v1.RBACService.GetRoleInclusion:output_type -> permission.v1.GetRoleInclusionResponse
-	50, // 90: permission.v1.RBACService.DeleteRoleInclusion:output_type -> permission.v1.DeleteRoleInclusionResponse
-	52, // 91: permission.v1.RBACService.ListRoleInclusions:output_type -> permission.v1.ListRoleInclusionsResponse
-	55, // 92: permission.v1.RBACService.GrantRolePermission:output_type -> permission.v1.GrantRolePermissionResponse
-	57, // 93: permission.v1.RBACService.RevokeRolePermission:output_type -> permission.v1.RevokeRolePermissionResponse
-	59, // 94: permission.v1.RBACService.ListRolePermissions:output_type -> permission.v1.ListRolePermissionsResponse
-	62, // 95: permission.v1.RBACService.GrantUserRole:output_type -> permission.v1.GrantUserRoleResponse
-	64, // 96: permission.v1.RBACService.RevokeUserRole:output_type -> permission.v1.RevokeUserRoleResponse
-	66, // 97: permission.v1.RBACService.ListUserRoles:output_type -> permission.v1.ListUserRolesResponse
-	69, // 98: permission.v1.RBACService.GrantUserPermission:output_type -> permission.v1.GrantUserPermissionResponse
-	71, // 99: permission.v1.RBACService.RevokeUserPermission:output_type -> permission.v1.RevokeUserPermissionResponse
-	73, // 100: permission.v1.RBACService.ListUserPermissions:output_type -> permission.v1.ListUserPermissionsResponse
-	1,  // 101: permission.v1.RBACService.GetAllPermissions:output_type -> permission.v1.GetAllPermissionsResponse
-	68, // [68:102] is the sub-list for method output_type
-	34, // [34:68] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	84,  // 0: permission.v1.GetAllPermissionsResponse.user_permissions:type_name -> permission.v1.UserPermission
+	102, // 1: permission.v1.PermissionSubject.sources:type_name -> permission.v1.PermissionMatch
+	3,   // 2: permission.v1.ListSubjectsWithPermissionResponse.subjects:type_name -> permission.v1.PermissionSubject
+	5,   // 3: permission.v1.CreateBusinessConfigRequest.config:type_name -> permission.v1.BusinessConfig
+	5,   // 4: permission.v1.CreateBusinessConfigResponse.config:type_name -> permission.v1.BusinessConfig
+	5,   // 5: permission.v1.GetBusinessConfigResponse.config:type_name -> permission.v1.BusinessConfig
+	5,   // 6: permission.v1.UpdateBusinessConfigRequest.config:type_name -> permission.v1.BusinessConfig
+	5,   // 7: permission.v1.ListBusinessConfigsResponse.configs:type_name -> permission.v1.BusinessConfig
+	103, // 8: permission.v1.CreateResourceRequest.resource:type_name -> permission.v1.Resource
+	103, // 9: permission.v1.CreateResourceResponse.resource:type_name -> permission.v1.Resource
+	103, // 10: permission.v1.GetResourceResponse.resource:type_name -> permission.v1.Resource
+	103, // 11: permission.v1.UpdateResourceRequest.resource:type_name -> permission.v1.Resource
+	103, // 12: permission.v1.ListResourcesResponse.resources:type_name -> permission.v1.Resource
+	104, // 13: permission.v1.CreatePermissionRequest.permission:type_name -> permission.v1.Permission
+	104, // 14: permission.v1.CreatePermissionResponse.permission:type_name -> permission.v1.Permission
+	104, // 15: permission.v1.GetPermissionResponse.permission:type_name -> permission.v1.Permission
+	104, // 16: permission.v1.UpdatePermissionRequest.permission:type_name -> permission.v1.Permission
+	104, // 17: permission.v1.ListPermissionsResponse.permissions:type_name -> permission.v1.Permission
+	36,  // 18: permission.v1.CreateActionDefinitionRequest.action_definition:type_name -> permission.v1.ActionDefinition
+	36,  // 19: permission.v1.CreateActionDefinitionResponse.action_definition:type_name -> permission.v1.ActionDefinition
+	36,  // 20: permission.v1.GetActionDefinitionResponse.action_definition:type_name -> permission.v1.ActionDefinition
+	36,  // 21: permission.v1.UpdateActionDefinitionRequest.action_definition:type_name -> permission.v1.ActionDefinition
+	36,  // 22: permission.v1.ListActionDefinitionsResponse.action_definitions:type_name -> permission.v1.ActionDefinition
+	47,  // 23: permission.v1.CreateRoleRequest.role:type_name -> permission.v1.Role
+	47,  // 24: permission.v1.CreateRoleResponse.role:type_name -> permission.v1.Role
+	47,  // 25: permission.v1.GetRoleResponse.role:type_name -> permission.v1.Role
+	47,  // 26: permission.v1.UpdateRoleRequest.role:type_name -> permission.v1.Role
+	47,  // 27: permission.v1.RoleUtilization.role:type_name -> permission.v1.Role
+	56,  // 28: permission.v1.ListRoleUtilizationsResponse.utilizations:type_name -> permission.v1.RoleUtilization
+	47,  // 29: permission.v1.ListRolesResponse.roles:type_name -> permission.v1.Role
+	61,  // 30: permission.v1.CreateRoleInclusionRequest.role_inclusion:type_name -> permission.v1.RoleInclusion
+	61,  // 31: permission.v1.CreateRoleInclusionResponse.role_inclusion:type_name -> permission.v1.RoleInclusion
+	61,  // 32: permission.v1.GetRoleInclusionResponse.role_inclusion:type_name -> permission.v1.RoleInclusion
+	61,  // 33: permission.v1.ListRoleInclusionsResponse.role_inclusions:type_name -> permission.v1.RoleInclusion
+	70,  // 34: permission.v1.GrantRolePermissionRequest.role_permission:type_name -> permission.v1.RolePermission
+	70,  // 35: permission.v1.GrantRolePermissionResponse.role_permission:type_name -> permission.v1.RolePermission
+	70,  // 36: permission.v1.ListRolePermissionsResponse.role_permissions:type_name -> permission.v1.RolePermission
+	77,  // 37: permission.v1.GrantUserRoleRequest.user_role:type_name -> permission.v1.UserRole
+	77,  // 38: permission.v1.GrantUserRoleResponse.user_role:type_name -> permission.v1.UserRole
+	77,  // 39: permission.v1.ListUserRolesResponse.user_roles:type_name -> permission.v1.UserRole
+	84,  // 40: permission.v1.GrantUserPermissionRequest.user_permission:type_name -> permission.v1.UserPermission
+	84,  // 41: permission.v1.GrantUserPermissionResponse.user_permission:type_name -> permission.v1.UserPermission
+	84,  // 42: permission.v1.ListUserPermissionsResponse.user_permissions:type_name -> permission.v1.UserPermission
+	91,  // 43: permission.v1.CreateSoDConstraintRequest.constraint:type_name -> permission.v1.SoDConstraint
+	91,  // 44: permission.v1.CreateSoDConstraintResponse.constraint:type_name -> permission.v1.SoDConstraint
+	91,  // 45: permission.v1.GetSoDConstraintResponse.constraint:type_name -> permission.v1.SoDConstraint
+	91,  // 46: permission.v1.ListSoDConstraintsResponse.constraints:type_name -> permission.v1.SoDConstraint
+	6,   // 47: permission.v1.RBACService.CreateBusinessConfig:input_type -> permission.v1.CreateBusinessConfigRequest
+	8,   // 48: permission.v1.RBACService.GetBusinessConfig:input_type -> permission.v1.GetBusinessConfigRequest
+	10,  // 49: permission.v1.RBACService.UpdateBusinessConfig:input_type -> permission.v1.UpdateBusinessConfigRequest
+	12,  // 50: permission.v1.RBACService.DeleteBusinessConfig:input_type -> permission.v1.DeleteBusinessConfigRequest
+	14,  // 51: permission.v1.RBACService.ListBusinessConfigs:input_type -> permission.v1.ListBusinessConfigsRequest
+	16,  // 52: permission.v1.RBACService.CreateResource:input_type -> permission.v1.CreateResourceRequest
+	18,  // 53: permission.v1.RBACService.GetResource:input_type -> permission.v1.GetResourceRequest
+	20,  // 54: permission.v1.RBACService.UpdateResource:input_type -> permission.v1.UpdateResourceRequest
+	22,  // 55: permission.v1.RBACService.DeleteResource:input_type -> permission.v1.DeleteResourceRequest
+	24,  // 56: permission.v1.RBACService.ListResources:input_type -> permission.v1.ListResourcesRequest
+	26,  // 57: permission.v1.RBACService.CreatePermission:input_type -> permission.v1.CreatePermissionRequest
+	28,  // 58: permission.v1.RBACService.GetPermission:input_type -> permission.v1.GetPermissionRequest
+	30,  // 59: permission.v1.RBACService.UpdatePermission:input_type -> permission.v1.UpdatePermissionRequest
+	32,  // 60: permission.v1.RBACService.DeletePermission:input_type -> permission.v1.DeletePermissionRequest
+	34,  // 61: permission.v1.RBACService.ListPermissions:input_type -> permission.v1.ListPermissionsRequest
+	37,  // 62: permission.v1.RBACService.CreateActionDefinition:input_type -> permission.v1.CreateActionDefinitionRequest
+	39,  // 63: permission.v1.RBACService.GetActionDefinition:input_type -> permission.v1.GetActionDefinitionRequest
+	41,  // 64: permission.v1.RBACService.UpdateActionDefinition:input_type -> permission.v1.UpdateActionDefinitionRequest
+	43,  // 65: permission.v1.RBACService.DeleteActionDefinition:input_type -> permission.v1.DeleteActionDefinitionRequest
+	45,  // 66: permission.v1.RBACService.ListActionDefinitions:input_type -> permission.v1.ListActionDefinitionsRequest
+	48,  // 67: permission.v1.RBACService.CreateRole:input_type -> permission.v1.CreateRoleRequest
+	50,  // 68: permission.v1.RBACService.GetRole:input_type -> permission.v1.GetRoleRequest
+	52,  // 69: permission.v1.RBACService.UpdateRole:input_type -> permission.v1.UpdateRoleRequest
+	54,  // 70: permission.v1.RBACService.DeleteRole:input_type -> permission.v1.DeleteRoleRequest
+	59,  // 71: permission.v1.RBACService.ListRoles:input_type -> permission.v1.ListRolesRequest
+	57,  // 72: permission.v1.RBACService.ListRoleUtilizations:input_type -> permission.v1.ListRoleUtilizationsRequest
+	62,  // 73: permission.v1.RBACService.CreateRoleInclusion:input_type -> permission.v1.CreateRoleInclusionRequest
+	64,  // 74: permission.v1.RBACService.GetRoleInclusion:input_type -> permission.v1.GetRoleInclusionRequest
+	66,  // 75: permission.v1.RBACService.DeleteRoleInclusion:input_type -> permission.v1.DeleteRoleInclusionRequest
+	68,  // 76: permission.v1.RBACService.ListRoleInclusions:input_type -> permission.v1.ListRoleInclusionsRequest
+	71,  // 77: permission.v1.RBACService.GrantRolePermission:input_type -> permission.v1.GrantRolePermissionRequest
+	73,  // 78: permission.v1.RBACService.RevokeRolePermission:input_type -> permission.v1.RevokeRolePermissionRequest
+	75,  // 79: permission.v1.RBACService.ListRolePermissions:input_type -> permission.v1.ListRolePermissionsRequest
+	78,  // 80: permission.v1.RBACService.GrantUserRole:input_type -> permission.v1.GrantUserRoleRequest
+	80,  // 81: permission.v1.RBACService.RevokeUserRole:input_type -> permission.v1.RevokeUserRoleRequest
+	82,  // 82: permission.v1.RBACService.ListUserRoles:input_type -> permission.v1.ListUserRolesRequest
+	85,  // 83: permission.v1.RBACService.GrantUserPermission:input_type -> permission.v1.GrantUserPermissionRequest
+	87,  // 84: permission.v1.RBACService.RevokeUserPermission:input_type -> permission.v1.RevokeUserPermissionRequest
+	89,  // 85: permission.v1.RBACService.ListUserPermissions:input_type -> permission.v1.ListUserPermissionsRequest
+	0,   // 86: permission.v1.RBACService.GetAllPermissions:input_type -> permission.v1.GetAllPermissionsRequest
+	2,   // 87: permission.v1.RBACService.ListSubjectsWithPermission:input_type -> permission.v1.ListSubjectsWithPermissionRequest
+	92,  // 88: permission.v1.RBACService.CreateSoDConstraint:input_type -> permission.v1.CreateSoDConstraintRequest
+	94,  // 89: permission.v1.RBACService.GetSoDConstraint:input_type -> permission.v1.GetSoDConstraintRequest
+	96,  // 90: permission.v1.RBACService.DeleteSoDConstraint:input_type -> permission.v1.DeleteSoDConstraintRequest
+	98,  // 91: permission.v1.RBACService.ListSoDConstraints:input_type -> permission.v1.ListSoDConstraintsRequest
+	100, // 92: permission.v1.RBACService.ActivateRoles:input_type -> permission.v1.ActivateRolesRequest
+	7,   // 93: permission.v1.RBACService.CreateBusinessConfig:output_type -> permission.v1.CreateBusinessConfigResponse
+	9,   // 94: permission.v1.RBACService.GetBusinessConfig:output_type -> permission.v1.GetBusinessConfigResponse
+	11,  // 95: permission.v1.RBACService.UpdateBusinessConfig:output_type -> permission.v1.UpdateBusinessConfigResponse
+	13,  // 96: permission.v1.RBACService.DeleteBusinessConfig:output_type -> permission.v1.DeleteBusinessConfigResponse
+	15,  // 97: permission.v1.RBACService.ListBusinessConfigs:output_type -> permission.v1.ListBusinessConfigsResponse
+	17,  // 98: permission.v1.RBACService.CreateResource:output_type -> permission.v1.CreateResourceResponse
+	19,  // 99: permission.v1.RBACService.GetResource:output_type -> permission.v1.GetResourceResponse
+	21,  // 100: permission.v1.RBACService.UpdateResource:output_type -> permission.v1.UpdateResourceResponse
+	23,  // 101: permission.v1.RBACService.DeleteResource:output_type -> permission.v1.DeleteResourceResponse
+	25,  // 102: permission.v1.RBACService.ListResources:output_type -> permission.v1.ListResourcesResponse
+	27,  // 103: permission.v1.RBACService.CreatePermission:output_type -> permission.v1.CreatePermissionResponse
+	29,  // 104: permission.v1.RBACService.GetPermission:output_type -> permission.v1.GetPermissionResponse
+	31,  // 105: permission.v1.RBACService.UpdatePermission:output_type -> permission.v1.UpdatePermissionResponse
+	33,  // 106: permission.v1.RBACService.DeletePermission:output_type -> permission.v1.DeletePermissionResponse
+	35,  // 107: permission.v1.RBACService.ListPermissions:output_type -> permission.v1.ListPermissionsResponse
+	38,  // 108: permission.v1.RBACService.CreateActionDefinition:output_type -> permission.v1.CreateActionDefinitionResponse
+	40,  // 109: permission.v1.RBACService.GetActionDefinition:output_type -> permission.v1.GetActionDefinitionResponse
+	42,  // 110: permission.v1.RBACService.UpdateActionDefinition:output_type -> permission.v1.UpdateActionDefinitionResponse
+	44,  // 111: permission.v1.RBACService.DeleteActionDefinition:output_type -> permission.v1.DeleteActionDefinitionResponse
+	46,  // 112: permission.v1.RBACService.ListActionDefinitions:output_type -> permission.v1.ListActionDefinitionsResponse
+	49,  // 113: permission.v1.RBACService.CreateRole:output_type -> permission.v1.CreateRoleResponse
+	51,  // 114: permission.v1.RBACService.GetRole:output_type -> permission.v1.GetRoleResponse
+	53,  // 115: permission.v1.RBACService.UpdateRole:output_type -> permission.v1.UpdateRoleResponse
+	55,  // 116: permission.v1.RBACService.DeleteRole:output_type -> permission.v1.DeleteRoleResponse
+	60,  // 117: permission.v1.RBACService.ListRoles:output_type -> permission.v1.ListRolesResponse
+	58,  // 118: permission.v1.RBACService.ListRoleUtilizations:output_type -> permission.v1.ListRoleUtilizationsResponse
+	63,  // 119: permission.v1.RBACService.CreateRoleInclusion:output_type -> permission.v1.CreateRoleInclusionResponse
+	65,  // 120: permission.v1.RBACService.GetRoleInclusion:output_type -> permission.v1.GetRoleInclusionResponse
+	67,  // 121: permission.v1.RBACService.DeleteRoleInclusion:output_type -> permission.v1.DeleteRoleInclusionResponse
+	69,  // 122: permission.v1.RBACService.ListRoleInclusions:output_type -> permission.v1.ListRoleInclusionsResponse
+	72,  // 123: permission.v1.RBACService.GrantRolePermission:output_type -> permission.v1.GrantRolePermissionResponse
+	74,  // 124: permission.v1.RBACService.RevokeRolePermission:output_type -> permission.v1.RevokeRolePermissionResponse
+	76,  // 125: permission.v1.RBACService.ListRolePermissions:output_type -> permission.v1.ListRolePermissionsResponse
+	79,  // 126: permission.v1.RBACService.GrantUserRole:output_type -> permission.v1.GrantUserRoleResponse
+	81,  // 127: permission.v1.RBACService.RevokeUserRole:output_type -> permission.v1.RevokeUserRoleResponse
+	83,  // 128: permission.v1.RBACService.ListUserRoles:output_type -> permission.v1.ListUserRolesResponse
+	86,  // 129: permission.v1.RBACService.GrantUserPermission:output_type -> permission.v1.GrantUserPermissionResponse
+	88,  // 130: permission.v1.RBACService.RevokeUserPermission:output_type -> permission.v1.RevokeUserPermissionResponse
+	90,  // 131: permission.v1.RBACService.ListUserPermissions:output_type -> permission.v1.ListUserPermissionsResponse
+	1,   // 132: permission.v1.RBACService.GetAllPermissions:output_type -> permission.v1.GetAllPermissionsResponse
+	4,   // 133: permission.v1.RBACService.ListSubjectsWithPermission:output_type -> permission.v1.ListSubjectsWithPermissionResponse
+	93,  // 134: permission.v1.RBACService.CreateSoDConstraint:output_type -> permission.v1.CreateSoDConstraintResponse
+	95,  // 135: permission.v1.RBACService.GetSoDConstraint:output_type -> permission.v1.GetSoDConstraintResponse
+	97,  // 136: permission.v1.RBACService.DeleteSoDConstraint:output_type -> permission.v1.DeleteSoDConstraintResponse
+	99,  // 137: permission.v1.RBACService.ListSoDConstraints:output_type -> permission.v1.ListSoDConstraintsResponse
+	101, // 138: permission.v1.RBACService.ActivateRoles:output_type -> permission.v1.ActivateRolesResponse
+	93,  // [93:139] is the sub-list for method output_type
+	47,  // [47:93] is the sub-list for method input_type
+	47,  // [47:47] is the sub-list for extension type_name
+	47,  // [47:47] is the sub-list for extension extendee
+	0,   // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_permission_v1_rbac_proto_init() }
@@ -4321,7 +6009,7 @@ func file_permission_v1_rbac_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permission_v1_rbac_proto_rawDesc), len(file_permission_v1_rbac_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   74,
+			NumMessages:   102,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
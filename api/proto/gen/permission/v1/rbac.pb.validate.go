@@ -277,54 +277,54 @@ var _ interface {
 	ErrorName() string
 } = GetAllPermissionsResponseValidationError{}
 
-// Validate checks the field values on BusinessConfig with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BusinessConfig) Validate() error {
+// Validate checks the field values on ListSubjectsWithPermissionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListSubjectsWithPermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BusinessConfig with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BusinessConfigMultiError,
-// or nil if none found.
-func (m *BusinessConfig) ValidateAll() error {
+// ValidateAll checks the field values on ListSubjectsWithPermissionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListSubjectsWithPermissionRequestMultiError, or nil if none found.
+func (m *ListSubjectsWithPermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BusinessConfig) validate(all bool) error {
+func (m *ListSubjectsWithPermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for OwnerId
+	// no validation rules for ResourceType
 
-	// no validation rules for OwnerType
+	// no validation rules for ResourceKey
 
-	// no validation rules for Name
+	// no validation rules for Action
 
-	// no validation rules for RateLimit
+	// no validation rules for Cursor
 
-	// no validation rules for Token
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return BusinessConfigMultiError(errors)
+		return ListSubjectsWithPermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BusinessConfigMultiError is an error wrapping multiple validation errors
-// returned by BusinessConfig.ValidateAll() if the designated constraints
-// aren't met.
-type BusinessConfigMultiError []error
+// ListSubjectsWithPermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListSubjectsWithPermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSubjectsWithPermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BusinessConfigMultiError) Error() string {
+func (m ListSubjectsWithPermissionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -333,11 +333,12 @@ func (m BusinessConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BusinessConfigMultiError) AllErrors() []error { return m }
+func (m ListSubjectsWithPermissionRequestMultiError) AllErrors() []error { return m }
 
-// BusinessConfigValidationError is the validation error returned by
-// BusinessConfig.Validate if the designated constraints aren't met.
-type BusinessConfigValidationError struct {
+// ListSubjectsWithPermissionRequestValidationError is the validation error
+// returned by ListSubjectsWithPermissionRequest.Validate if the designated
+// constraints aren't met.
+type ListSubjectsWithPermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -345,22 +346,24 @@ type BusinessConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e BusinessConfigValidationError) Field() string { return e.field }
+func (e ListSubjectsWithPermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BusinessConfigValidationError) Reason() string { return e.reason }
+func (e ListSubjectsWithPermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BusinessConfigValidationError) Cause() error { return e.cause }
+func (e ListSubjectsWithPermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BusinessConfigValidationError) Key() bool { return e.key }
+func (e ListSubjectsWithPermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BusinessConfigValidationError) ErrorName() string { return "BusinessConfigValidationError" }
+func (e ListSubjectsWithPermissionRequestValidationError) ErrorName() string {
+	return "ListSubjectsWithPermissionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BusinessConfigValidationError) Error() string {
+func (e ListSubjectsWithPermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -372,14 +375,14 @@ func (e BusinessConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBusinessConfig.%s: %s%s",
+		"invalid %sListSubjectsWithPermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BusinessConfigValidationError{}
+var _ error = ListSubjectsWithPermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -387,73 +390,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BusinessConfigValidationError{}
+} = ListSubjectsWithPermissionRequestValidationError{}
 
-// Validate checks the field values on CreateBusinessConfigRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBusinessConfigRequest) Validate() error {
+// Validate checks the field values on PermissionSubject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PermissionSubject) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBusinessConfigRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PermissionSubject with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBusinessConfigRequestMultiError, or nil if none found.
-func (m *CreateBusinessConfigRequest) ValidateAll() error {
+// PermissionSubjectMultiError, or nil if none found.
+func (m *PermissionSubject) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBusinessConfigRequest) validate(all bool) error {
+func (m *PermissionSubject) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBusinessConfigRequestValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for UserId
+
+	for idx, item := range m.GetSources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionSubjectValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionSubjectValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBusinessConfigRequestValidationError{
-					field:  "Config",
+				return PermissionSubjectValidationError{
+					field:  fmt.Sprintf("Sources[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateBusinessConfigRequestValidationError{
-				field:  "Config",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateBusinessConfigRequestMultiError(errors)
+		return PermissionSubjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBusinessConfigRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateBusinessConfigRequest.ValidateAll() if
-// the designated constraints aren't met.
-type CreateBusinessConfigRequestMultiError []error
+// PermissionSubjectMultiError is an error wrapping multiple validation errors
+// returned by PermissionSubject.ValidateAll() if the designated constraints
+// aren't met.
+type PermissionSubjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBusinessConfigRequestMultiError) Error() string {
+func (m PermissionSubjectMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -462,12 +472,11 @@ func (m CreateBusinessConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBusinessConfigRequestMultiError) AllErrors() []error { return m }
+func (m PermissionSubjectMultiError) AllErrors() []error { return m }
 
-// CreateBusinessConfigRequestValidationError is the validation error returned
-// by CreateBusinessConfigRequest.Validate if the designated constraints
-// aren't met.
-type CreateBusinessConfigRequestValidationError struct {
+// PermissionSubjectValidationError is the validation error returned by
+// PermissionSubject.Validate if the designated constraints aren't met.
+type PermissionSubjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -475,24 +484,24 @@ type CreateBusinessConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBusinessConfigRequestValidationError) Field() string { return e.field }
+func (e PermissionSubjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBusinessConfigRequestValidationError) Reason() string { return e.reason }
+func (e PermissionSubjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBusinessConfigRequestValidationError) Cause() error { return e.cause }
+func (e PermissionSubjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBusinessConfigRequestValidationError) Key() bool { return e.key }
+func (e PermissionSubjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBusinessConfigRequestValidationError) ErrorName() string {
-	return "CreateBusinessConfigRequestValidationError"
+func (e PermissionSubjectValidationError) ErrorName() string {
+	return "PermissionSubjectValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBusinessConfigRequestValidationError) Error() string {
+func (e PermissionSubjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -504,14 +513,14 @@ func (e CreateBusinessConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBusinessConfigRequest.%s: %s%s",
+		"invalid %sPermissionSubject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBusinessConfigRequestValidationError{}
+var _ error = PermissionSubjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -519,73 +528,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBusinessConfigRequestValidationError{}
+} = PermissionSubjectValidationError{}
 
-// Validate checks the field values on CreateBusinessConfigResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBusinessConfigResponse) Validate() error {
+// Validate checks the field values on ListSubjectsWithPermissionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListSubjectsWithPermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBusinessConfigResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateBusinessConfigResponseMultiError, or nil if none found.
-func (m *CreateBusinessConfigResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListSubjectsWithPermissionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListSubjectsWithPermissionResponseMultiError, or nil if none found.
+func (m *ListSubjectsWithPermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBusinessConfigResponse) validate(all bool) error {
+func (m *ListSubjectsWithPermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBusinessConfigResponseValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetSubjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSubjectsWithPermissionResponseValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSubjectsWithPermissionResponseValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBusinessConfigResponseValidationError{
-					field:  "Config",
+				return ListSubjectsWithPermissionResponseValidationError{
+					field:  fmt.Sprintf("Subjects[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateBusinessConfigResponseValidationError{
-				field:  "Config",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
-		return CreateBusinessConfigResponseMultiError(errors)
+		return ListSubjectsWithPermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBusinessConfigResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateBusinessConfigResponse.ValidateAll() if
-// the designated constraints aren't met.
-type CreateBusinessConfigResponseMultiError []error
+// ListSubjectsWithPermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListSubjectsWithPermissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSubjectsWithPermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBusinessConfigResponseMultiError) Error() string {
+func (m ListSubjectsWithPermissionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -594,12 +612,12 @@ func (m CreateBusinessConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBusinessConfigResponseMultiError) AllErrors() []error { return m }
+func (m ListSubjectsWithPermissionResponseMultiError) AllErrors() []error { return m }
 
-// CreateBusinessConfigResponseValidationError is the validation error returned
-// by CreateBusinessConfigResponse.Validate if the designated constraints
-// aren't met.
-type CreateBusinessConfigResponseValidationError struct {
+// ListSubjectsWithPermissionResponseValidationError is the validation error
+// returned by ListSubjectsWithPermissionResponse.Validate if the designated
+// constraints aren't met.
+type ListSubjectsWithPermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -607,24 +625,24 @@ type CreateBusinessConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBusinessConfigResponseValidationError) Field() string { return e.field }
+func (e ListSubjectsWithPermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBusinessConfigResponseValidationError) Reason() string { return e.reason }
+func (e ListSubjectsWithPermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBusinessConfigResponseValidationError) Cause() error { return e.cause }
+func (e ListSubjectsWithPermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBusinessConfigResponseValidationError) Key() bool { return e.key }
+func (e ListSubjectsWithPermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBusinessConfigResponseValidationError) ErrorName() string {
-	return "CreateBusinessConfigResponseValidationError"
+func (e ListSubjectsWithPermissionResponseValidationError) ErrorName() string {
+	return "ListSubjectsWithPermissionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBusinessConfigResponseValidationError) Error() string {
+func (e ListSubjectsWithPermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -636,14 +654,14 @@ func (e CreateBusinessConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBusinessConfigResponse.%s: %s%s",
+		"invalid %sListSubjectsWithPermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBusinessConfigResponseValidationError{}
+var _ error = ListSubjectsWithPermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -651,48 +669,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBusinessConfigResponseValidationError{}
+} = ListSubjectsWithPermissionResponseValidationError{}
 
-// Validate checks the field values on GetBusinessConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBusinessConfigRequest) Validate() error {
+// Validate checks the field values on BusinessConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BusinessConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBusinessConfigRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetBusinessConfigRequestMultiError, or nil if none found.
-func (m *GetBusinessConfigRequest) ValidateAll() error {
+// ValidateAll checks the field values on BusinessConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BusinessConfigMultiError,
+// or nil if none found.
+func (m *BusinessConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBusinessConfigRequest) validate(all bool) error {
+func (m *BusinessConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
 	// no validation rules for Id
 
+	// no validation rules for OwnerId
+
+	// no validation rules for OwnerType
+
+	// no validation rules for Name
+
+	// no validation rules for RateLimit
+
+	// no validation rules for Token
+
+	// no validation rules for Engine
+
+	// no validation rules for CombiningAlgorithm
+
+	// no validation rules for NoPolicyEffect
+
+	// no validation rules for InvalidAttributeAction
+
 	if len(errors) > 0 {
-		return GetBusinessConfigRequestMultiError(errors)
+		return BusinessConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBusinessConfigRequestMultiError is an error wrapping multiple validation
-// errors returned by GetBusinessConfigRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetBusinessConfigRequestMultiError []error
+// BusinessConfigMultiError is an error wrapping multiple validation errors
+// returned by BusinessConfig.ValidateAll() if the designated constraints
+// aren't met.
+type BusinessConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBusinessConfigRequestMultiError) Error() string {
+func (m BusinessConfigMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -701,11 +735,11 @@ func (m GetBusinessConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBusinessConfigRequestMultiError) AllErrors() []error { return m }
+func (m BusinessConfigMultiError) AllErrors() []error { return m }
 
-// GetBusinessConfigRequestValidationError is the validation error returned by
-// GetBusinessConfigRequest.Validate if the designated constraints aren't met.
-type GetBusinessConfigRequestValidationError struct {
+// BusinessConfigValidationError is the validation error returned by
+// BusinessConfig.Validate if the designated constraints aren't met.
+type BusinessConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -713,24 +747,22 @@ type GetBusinessConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBusinessConfigRequestValidationError) Field() string { return e.field }
+func (e BusinessConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBusinessConfigRequestValidationError) Reason() string { return e.reason }
+func (e BusinessConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBusinessConfigRequestValidationError) Cause() error { return e.cause }
+func (e BusinessConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBusinessConfigRequestValidationError) Key() bool { return e.key }
+func (e BusinessConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBusinessConfigRequestValidationError) ErrorName() string {
-	return "GetBusinessConfigRequestValidationError"
-}
+func (e BusinessConfigValidationError) ErrorName() string { return "BusinessConfigValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetBusinessConfigRequestValidationError) Error() string {
+func (e BusinessConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -742,14 +774,14 @@ func (e GetBusinessConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBusinessConfigRequest.%s: %s%s",
+		"invalid %sBusinessConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBusinessConfigRequestValidationError{}
+var _ error = BusinessConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -757,24 +789,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBusinessConfigRequestValidationError{}
+} = BusinessConfigValidationError{}
 
-// Validate checks the field values on GetBusinessConfigResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateBusinessConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBusinessConfigResponse) Validate() error {
+func (m *CreateBusinessConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBusinessConfigResponse with the
+// ValidateAll checks the field values on CreateBusinessConfigRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBusinessConfigResponseMultiError, or nil if none found.
-func (m *GetBusinessConfigResponse) ValidateAll() error {
+// CreateBusinessConfigRequestMultiError, or nil if none found.
+func (m *CreateBusinessConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBusinessConfigResponse) validate(all bool) error {
+func (m *CreateBusinessConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -785,7 +817,7 @@ func (m *GetBusinessConfigResponse) validate(all bool) error {
 		switch v := interface{}(m.GetConfig()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBusinessConfigResponseValidationError{
+				errors = append(errors, CreateBusinessConfigRequestValidationError{
 					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -793,7 +825,7 @@ func (m *GetBusinessConfigResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBusinessConfigResponseValidationError{
+				errors = append(errors, CreateBusinessConfigRequestValidationError{
 					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -802,7 +834,7 @@ func (m *GetBusinessConfigResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetBusinessConfigResponseValidationError{
+			return CreateBusinessConfigRequestValidationError{
 				field:  "Config",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -811,19 +843,19 @@ func (m *GetBusinessConfigResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetBusinessConfigResponseMultiError(errors)
+		return CreateBusinessConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBusinessConfigResponseMultiError is an error wrapping multiple validation
-// errors returned by GetBusinessConfigResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetBusinessConfigResponseMultiError []error
+// CreateBusinessConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateBusinessConfigRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateBusinessConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBusinessConfigResponseMultiError) Error() string {
+func (m CreateBusinessConfigRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -832,11 +864,12 @@ func (m GetBusinessConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBusinessConfigResponseMultiError) AllErrors() []error { return m }
+func (m CreateBusinessConfigRequestMultiError) AllErrors() []error { return m }
 
-// GetBusinessConfigResponseValidationError is the validation error returned by
-// GetBusinessConfigResponse.Validate if the designated constraints aren't met.
-type GetBusinessConfigResponseValidationError struct {
+// CreateBusinessConfigRequestValidationError is the validation error returned
+// by CreateBusinessConfigRequest.Validate if the designated constraints
+// aren't met.
+type CreateBusinessConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -844,24 +877,24 @@ type GetBusinessConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBusinessConfigResponseValidationError) Field() string { return e.field }
+func (e CreateBusinessConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBusinessConfigResponseValidationError) Reason() string { return e.reason }
+func (e CreateBusinessConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBusinessConfigResponseValidationError) Cause() error { return e.cause }
+func (e CreateBusinessConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBusinessConfigResponseValidationError) Key() bool { return e.key }
+func (e CreateBusinessConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBusinessConfigResponseValidationError) ErrorName() string {
-	return "GetBusinessConfigResponseValidationError"
+func (e CreateBusinessConfigRequestValidationError) ErrorName() string {
+	return "CreateBusinessConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBusinessConfigResponseValidationError) Error() string {
+func (e CreateBusinessConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -873,14 +906,14 @@ func (e GetBusinessConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBusinessConfigResponse.%s: %s%s",
+		"invalid %sCreateBusinessConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBusinessConfigResponseValidationError{}
+var _ error = CreateBusinessConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -888,24 +921,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBusinessConfigResponseValidationError{}
+} = CreateBusinessConfigRequestValidationError{}
 
-// Validate checks the field values on UpdateBusinessConfigRequest with the
+// Validate checks the field values on CreateBusinessConfigResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBusinessConfigRequest) Validate() error {
+func (m *CreateBusinessConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBusinessConfigRequest with the
+// ValidateAll checks the field values on CreateBusinessConfigResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateBusinessConfigRequestMultiError, or nil if none found.
-func (m *UpdateBusinessConfigRequest) ValidateAll() error {
+// CreateBusinessConfigResponseMultiError, or nil if none found.
+func (m *CreateBusinessConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBusinessConfigRequest) validate(all bool) error {
+func (m *CreateBusinessConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -916,7 +949,7 @@ func (m *UpdateBusinessConfigRequest) validate(all bool) error {
 		switch v := interface{}(m.GetConfig()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateBusinessConfigRequestValidationError{
+				errors = append(errors, CreateBusinessConfigResponseValidationError{
 					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -924,7 +957,7 @@ func (m *UpdateBusinessConfigRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateBusinessConfigRequestValidationError{
+				errors = append(errors, CreateBusinessConfigResponseValidationError{
 					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -933,7 +966,7 @@ func (m *UpdateBusinessConfigRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateBusinessConfigRequestValidationError{
+			return CreateBusinessConfigResponseValidationError{
 				field:  "Config",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -942,19 +975,19 @@ func (m *UpdateBusinessConfigRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateBusinessConfigRequestMultiError(errors)
+		return CreateBusinessConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBusinessConfigRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateBusinessConfigRequest.ValidateAll() if
+// CreateBusinessConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateBusinessConfigResponse.ValidateAll() if
 // the designated constraints aren't met.
-type UpdateBusinessConfigRequestMultiError []error
+type CreateBusinessConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBusinessConfigRequestMultiError) Error() string {
+func (m CreateBusinessConfigResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -963,12 +996,12 @@ func (m UpdateBusinessConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBusinessConfigRequestMultiError) AllErrors() []error { return m }
+func (m CreateBusinessConfigResponseMultiError) AllErrors() []error { return m }
 
-// UpdateBusinessConfigRequestValidationError is the validation error returned
-// by UpdateBusinessConfigRequest.Validate if the designated constraints
+// CreateBusinessConfigResponseValidationError is the validation error returned
+// by CreateBusinessConfigResponse.Validate if the designated constraints
 // aren't met.
-type UpdateBusinessConfigRequestValidationError struct {
+type CreateBusinessConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -976,24 +1009,24 @@ type UpdateBusinessConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBusinessConfigRequestValidationError) Field() string { return e.field }
+func (e CreateBusinessConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBusinessConfigRequestValidationError) Reason() string { return e.reason }
+func (e CreateBusinessConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBusinessConfigRequestValidationError) Cause() error { return e.cause }
+func (e CreateBusinessConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBusinessConfigRequestValidationError) Key() bool { return e.key }
+func (e CreateBusinessConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBusinessConfigRequestValidationError) ErrorName() string {
-	return "UpdateBusinessConfigRequestValidationError"
+func (e CreateBusinessConfigResponseValidationError) ErrorName() string {
+	return "CreateBusinessConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBusinessConfigRequestValidationError) Error() string {
+func (e CreateBusinessConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1005,14 +1038,14 @@ func (e UpdateBusinessConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBusinessConfigRequest.%s: %s%s",
+		"invalid %sCreateBusinessConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBusinessConfigRequestValidationError{}
+var _ error = CreateBusinessConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1020,46 +1053,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBusinessConfigRequestValidationError{}
+} = CreateBusinessConfigResponseValidationError{}
 
-// Validate checks the field values on UpdateBusinessConfigResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetBusinessConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBusinessConfigResponse) Validate() error {
+func (m *GetBusinessConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBusinessConfigResponse with the
+// ValidateAll checks the field values on GetBusinessConfigRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateBusinessConfigResponseMultiError, or nil if none found.
-func (m *UpdateBusinessConfigResponse) ValidateAll() error {
+// GetBusinessConfigRequestMultiError, or nil if none found.
+func (m *GetBusinessConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBusinessConfigResponse) validate(all bool) error {
+func (m *GetBusinessConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for BizId
+
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UpdateBusinessConfigResponseMultiError(errors)
+		return GetBusinessConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBusinessConfigResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateBusinessConfigResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateBusinessConfigResponseMultiError []error
+// GetBusinessConfigRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBusinessConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBusinessConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBusinessConfigResponseMultiError) Error() string {
+func (m GetBusinessConfigRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1068,12 +1103,11 @@ func (m UpdateBusinessConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBusinessConfigResponseMultiError) AllErrors() []error { return m }
+func (m GetBusinessConfigRequestMultiError) AllErrors() []error { return m }
 
-// UpdateBusinessConfigResponseValidationError is the validation error returned
-// by UpdateBusinessConfigResponse.Validate if the designated constraints
-// aren't met.
-type UpdateBusinessConfigResponseValidationError struct {
+// GetBusinessConfigRequestValidationError is the validation error returned by
+// GetBusinessConfigRequest.Validate if the designated constraints aren't met.
+type GetBusinessConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1081,24 +1115,24 @@ type UpdateBusinessConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBusinessConfigResponseValidationError) Field() string { return e.field }
+func (e GetBusinessConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBusinessConfigResponseValidationError) Reason() string { return e.reason }
+func (e GetBusinessConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBusinessConfigResponseValidationError) Cause() error { return e.cause }
+func (e GetBusinessConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBusinessConfigResponseValidationError) Key() bool { return e.key }
+func (e GetBusinessConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBusinessConfigResponseValidationError) ErrorName() string {
-	return "UpdateBusinessConfigResponseValidationError"
+func (e GetBusinessConfigRequestValidationError) ErrorName() string {
+	return "GetBusinessConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBusinessConfigResponseValidationError) Error() string {
+func (e GetBusinessConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1110,14 +1144,14 @@ func (e UpdateBusinessConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBusinessConfigResponse.%s: %s%s",
+		"invalid %sGetBusinessConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBusinessConfigResponseValidationError{}
+var _ error = GetBusinessConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1125,48 +1159,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBusinessConfigResponseValidationError{}
+} = GetBusinessConfigRequestValidationError{}
 
-// Validate checks the field values on DeleteBusinessConfigRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetBusinessConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBusinessConfigRequest) Validate() error {
+func (m *GetBusinessConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBusinessConfigRequest with the
+// ValidateAll checks the field values on GetBusinessConfigResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBusinessConfigRequestMultiError, or nil if none found.
-func (m *DeleteBusinessConfigRequest) ValidateAll() error {
+// GetBusinessConfigResponseMultiError, or nil if none found.
+func (m *GetBusinessConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBusinessConfigRequest) validate(all bool) error {
+func (m *GetBusinessConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBusinessConfigResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBusinessConfigResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBusinessConfigResponseValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteBusinessConfigRequestMultiError(errors)
+		return GetBusinessConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBusinessConfigRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteBusinessConfigRequest.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteBusinessConfigRequestMultiError []error
+// GetBusinessConfigResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBusinessConfigResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetBusinessConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBusinessConfigRequestMultiError) Error() string {
+func (m GetBusinessConfigResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1175,12 +1234,11 @@ func (m DeleteBusinessConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBusinessConfigRequestMultiError) AllErrors() []error { return m }
+func (m GetBusinessConfigResponseMultiError) AllErrors() []error { return m }
 
-// DeleteBusinessConfigRequestValidationError is the validation error returned
-// by DeleteBusinessConfigRequest.Validate if the designated constraints
-// aren't met.
-type DeleteBusinessConfigRequestValidationError struct {
+// GetBusinessConfigResponseValidationError is the validation error returned by
+// GetBusinessConfigResponse.Validate if the designated constraints aren't met.
+type GetBusinessConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1188,24 +1246,24 @@ type DeleteBusinessConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBusinessConfigRequestValidationError) Field() string { return e.field }
+func (e GetBusinessConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBusinessConfigRequestValidationError) Reason() string { return e.reason }
+func (e GetBusinessConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBusinessConfigRequestValidationError) Cause() error { return e.cause }
+func (e GetBusinessConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBusinessConfigRequestValidationError) Key() bool { return e.key }
+func (e GetBusinessConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBusinessConfigRequestValidationError) ErrorName() string {
-	return "DeleteBusinessConfigRequestValidationError"
+func (e GetBusinessConfigResponseValidationError) ErrorName() string {
+	return "GetBusinessConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBusinessConfigRequestValidationError) Error() string {
+func (e GetBusinessConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1217,14 +1275,14 @@ func (e DeleteBusinessConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBusinessConfigRequest.%s: %s%s",
+		"invalid %sGetBusinessConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBusinessConfigRequestValidationError{}
+var _ error = GetBusinessConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1232,46 +1290,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBusinessConfigRequestValidationError{}
+} = GetBusinessConfigResponseValidationError{}
 
-// Validate checks the field values on DeleteBusinessConfigResponse with the
+// Validate checks the field values on UpdateBusinessConfigRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBusinessConfigResponse) Validate() error {
+func (m *UpdateBusinessConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBusinessConfigResponse with the
+// ValidateAll checks the field values on UpdateBusinessConfigRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBusinessConfigResponseMultiError, or nil if none found.
-func (m *DeleteBusinessConfigResponse) ValidateAll() error {
+// UpdateBusinessConfigRequestMultiError, or nil if none found.
+func (m *UpdateBusinessConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBusinessConfigResponse) validate(all bool) error {
+func (m *UpdateBusinessConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBusinessConfigRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBusinessConfigRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBusinessConfigRequestValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteBusinessConfigResponseMultiError(errors)
+		return UpdateBusinessConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBusinessConfigResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteBusinessConfigResponse.ValidateAll() if
+// UpdateBusinessConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateBusinessConfigRequest.ValidateAll() if
 // the designated constraints aren't met.
-type DeleteBusinessConfigResponseMultiError []error
+type UpdateBusinessConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBusinessConfigResponseMultiError) Error() string {
+func (m UpdateBusinessConfigRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1280,12 +1365,12 @@ func (m DeleteBusinessConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBusinessConfigResponseMultiError) AllErrors() []error { return m }
+func (m UpdateBusinessConfigRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBusinessConfigResponseValidationError is the validation error returned
-// by DeleteBusinessConfigResponse.Validate if the designated constraints
+// UpdateBusinessConfigRequestValidationError is the validation error returned
+// by UpdateBusinessConfigRequest.Validate if the designated constraints
 // aren't met.
-type DeleteBusinessConfigResponseValidationError struct {
+type UpdateBusinessConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1293,24 +1378,24 @@ type DeleteBusinessConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBusinessConfigResponseValidationError) Field() string { return e.field }
+func (e UpdateBusinessConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBusinessConfigResponseValidationError) Reason() string { return e.reason }
+func (e UpdateBusinessConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBusinessConfigResponseValidationError) Cause() error { return e.cause }
+func (e UpdateBusinessConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBusinessConfigResponseValidationError) Key() bool { return e.key }
+func (e UpdateBusinessConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBusinessConfigResponseValidationError) ErrorName() string {
-	return "DeleteBusinessConfigResponseValidationError"
+func (e UpdateBusinessConfigRequestValidationError) ErrorName() string {
+	return "UpdateBusinessConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBusinessConfigResponseValidationError) Error() string {
+func (e UpdateBusinessConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1322,14 +1407,14 @@ func (e DeleteBusinessConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBusinessConfigResponse.%s: %s%s",
+		"invalid %sUpdateBusinessConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBusinessConfigResponseValidationError{}
+var _ error = UpdateBusinessConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1337,48 +1422,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBusinessConfigResponseValidationError{}
+} = UpdateBusinessConfigRequestValidationError{}
 
-// Validate checks the field values on ListBusinessConfigsRequest with the
+// Validate checks the field values on UpdateBusinessConfigResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBusinessConfigsRequest) Validate() error {
+func (m *UpdateBusinessConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBusinessConfigsRequest with the
+// ValidateAll checks the field values on UpdateBusinessConfigResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBusinessConfigsRequestMultiError, or nil if none found.
-func (m *ListBusinessConfigsRequest) ValidateAll() error {
+// UpdateBusinessConfigResponseMultiError, or nil if none found.
+func (m *UpdateBusinessConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBusinessConfigsRequest) validate(all bool) error {
+func (m *UpdateBusinessConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Offset
-
-	// no validation rules for Limit
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return ListBusinessConfigsRequestMultiError(errors)
+		return UpdateBusinessConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBusinessConfigsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListBusinessConfigsRequest.ValidateAll() if
+// UpdateBusinessConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateBusinessConfigResponse.ValidateAll() if
 // the designated constraints aren't met.
-type ListBusinessConfigsRequestMultiError []error
+type UpdateBusinessConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBusinessConfigsRequestMultiError) Error() string {
+func (m UpdateBusinessConfigResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1387,11 +1470,12 @@ func (m ListBusinessConfigsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBusinessConfigsRequestMultiError) AllErrors() []error { return m }
+func (m UpdateBusinessConfigResponseMultiError) AllErrors() []error { return m }
 
-// ListBusinessConfigsRequestValidationError is the validation error returned
-// by ListBusinessConfigsRequest.Validate if the designated constraints aren't met.
-type ListBusinessConfigsRequestValidationError struct {
+// UpdateBusinessConfigResponseValidationError is the validation error returned
+// by UpdateBusinessConfigResponse.Validate if the designated constraints
+// aren't met.
+type UpdateBusinessConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1399,24 +1483,24 @@ type ListBusinessConfigsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBusinessConfigsRequestValidationError) Field() string { return e.field }
+func (e UpdateBusinessConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBusinessConfigsRequestValidationError) Reason() string { return e.reason }
+func (e UpdateBusinessConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBusinessConfigsRequestValidationError) Cause() error { return e.cause }
+func (e UpdateBusinessConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBusinessConfigsRequestValidationError) Key() bool { return e.key }
+func (e UpdateBusinessConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBusinessConfigsRequestValidationError) ErrorName() string {
-	return "ListBusinessConfigsRequestValidationError"
+func (e UpdateBusinessConfigResponseValidationError) ErrorName() string {
+	return "UpdateBusinessConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBusinessConfigsRequestValidationError) Error() string {
+func (e UpdateBusinessConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1428,14 +1512,14 @@ func (e ListBusinessConfigsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBusinessConfigsRequest.%s: %s%s",
+		"invalid %sUpdateBusinessConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBusinessConfigsRequestValidationError{}
+var _ error = UpdateBusinessConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1443,78 +1527,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBusinessConfigsRequestValidationError{}
+} = UpdateBusinessConfigResponseValidationError{}
 
-// Validate checks the field values on ListBusinessConfigsResponse with the
+// Validate checks the field values on DeleteBusinessConfigRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBusinessConfigsResponse) Validate() error {
+func (m *DeleteBusinessConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBusinessConfigsResponse with the
+// ValidateAll checks the field values on DeleteBusinessConfigRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBusinessConfigsResponseMultiError, or nil if none found.
-func (m *ListBusinessConfigsResponse) ValidateAll() error {
+// DeleteBusinessConfigRequestMultiError, or nil if none found.
+func (m *DeleteBusinessConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBusinessConfigsResponse) validate(all bool) error {
+func (m *DeleteBusinessConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConfigs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBusinessConfigsResponseValidationError{
-						field:  fmt.Sprintf("Configs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBusinessConfigsResponseValidationError{
-						field:  fmt.Sprintf("Configs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBusinessConfigsResponseValidationError{
-					field:  fmt.Sprintf("Configs[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for BizId
 
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListBusinessConfigsResponseMultiError(errors)
+		return DeleteBusinessConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBusinessConfigsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListBusinessConfigsResponse.ValidateAll() if
+// DeleteBusinessConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteBusinessConfigRequest.ValidateAll() if
 // the designated constraints aren't met.
-type ListBusinessConfigsResponseMultiError []error
+type DeleteBusinessConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBusinessConfigsResponseMultiError) Error() string {
+func (m DeleteBusinessConfigRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1523,12 +1577,12 @@ func (m ListBusinessConfigsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBusinessConfigsResponseMultiError) AllErrors() []error { return m }
+func (m DeleteBusinessConfigRequestMultiError) AllErrors() []error { return m }
 
-// ListBusinessConfigsResponseValidationError is the validation error returned
-// by ListBusinessConfigsResponse.Validate if the designated constraints
+// DeleteBusinessConfigRequestValidationError is the validation error returned
+// by DeleteBusinessConfigRequest.Validate if the designated constraints
 // aren't met.
-type ListBusinessConfigsResponseValidationError struct {
+type DeleteBusinessConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1536,24 +1590,24 @@ type ListBusinessConfigsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBusinessConfigsResponseValidationError) Field() string { return e.field }
+func (e DeleteBusinessConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBusinessConfigsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteBusinessConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBusinessConfigsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteBusinessConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBusinessConfigsResponseValidationError) Key() bool { return e.key }
+func (e DeleteBusinessConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBusinessConfigsResponseValidationError) ErrorName() string {
-	return "ListBusinessConfigsResponseValidationError"
+func (e DeleteBusinessConfigRequestValidationError) ErrorName() string {
+	return "DeleteBusinessConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBusinessConfigsResponseValidationError) Error() string {
+func (e DeleteBusinessConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1565,14 +1619,14 @@ func (e ListBusinessConfigsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBusinessConfigsResponse.%s: %s%s",
+		"invalid %sDeleteBusinessConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBusinessConfigsResponseValidationError{}
+var _ error = DeleteBusinessConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1580,73 +1634,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBusinessConfigsResponseValidationError{}
+} = DeleteBusinessConfigRequestValidationError{}
 
-// Validate checks the field values on CreateResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteBusinessConfigResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateResourceRequest) Validate() error {
+func (m *DeleteBusinessConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteBusinessConfigResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateResourceRequestMultiError, or nil if none found.
-func (m *CreateResourceRequest) ValidateAll() error {
+// DeleteBusinessConfigResponseMultiError, or nil if none found.
+func (m *DeleteBusinessConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateResourceRequest) validate(all bool) error {
+func (m *DeleteBusinessConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateResourceRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateResourceRequestValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateResourceRequestValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return CreateResourceRequestMultiError(errors)
+		return DeleteBusinessConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateResourceRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateResourceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateResourceRequestMultiError []error
+// DeleteBusinessConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteBusinessConfigResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteBusinessConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateResourceRequestMultiError) Error() string {
+func (m DeleteBusinessConfigResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1655,11 +1682,12 @@ func (m CreateResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateResourceRequestMultiError) AllErrors() []error { return m }
+func (m DeleteBusinessConfigResponseMultiError) AllErrors() []error { return m }
 
-// CreateResourceRequestValidationError is the validation error returned by
-// CreateResourceRequest.Validate if the designated constraints aren't met.
-type CreateResourceRequestValidationError struct {
+// DeleteBusinessConfigResponseValidationError is the validation error returned
+// by DeleteBusinessConfigResponse.Validate if the designated constraints
+// aren't met.
+type DeleteBusinessConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1667,24 +1695,24 @@ type CreateResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateResourceRequestValidationError) Field() string { return e.field }
+func (e DeleteBusinessConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateResourceRequestValidationError) Reason() string { return e.reason }
+func (e DeleteBusinessConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateResourceRequestValidationError) Cause() error { return e.cause }
+func (e DeleteBusinessConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateResourceRequestValidationError) Key() bool { return e.key }
+func (e DeleteBusinessConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateResourceRequestValidationError) ErrorName() string {
-	return "CreateResourceRequestValidationError"
+func (e DeleteBusinessConfigResponseValidationError) ErrorName() string {
+	return "DeleteBusinessConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateResourceRequestValidationError) Error() string {
+func (e DeleteBusinessConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1696,14 +1724,14 @@ func (e CreateResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateResourceRequest.%s: %s%s",
+		"invalid %sDeleteBusinessConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateResourceRequestValidationError{}
+var _ error = DeleteBusinessConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1711,73 +1739,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateResourceRequestValidationError{}
+} = DeleteBusinessConfigResponseValidationError{}
 
-// Validate checks the field values on CreateResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListBusinessConfigsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateResourceResponse) Validate() error {
+func (m *ListBusinessConfigsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListBusinessConfigsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateResourceResponseMultiError, or nil if none found.
-func (m *CreateResourceResponse) ValidateAll() error {
+// ListBusinessConfigsRequestMultiError, or nil if none found.
+func (m *ListBusinessConfigsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateResourceResponse) validate(all bool) error {
+func (m *ListBusinessConfigsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateResourceResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateResourceResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateResourceResponseValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Offset
+
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return CreateResourceResponseMultiError(errors)
+		return ListBusinessConfigsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateResourceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateResourceResponseMultiError []error
+// ListBusinessConfigsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListBusinessConfigsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListBusinessConfigsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateResourceResponseMultiError) Error() string {
+func (m ListBusinessConfigsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1786,11 +1789,11 @@ func (m CreateResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateResourceResponseMultiError) AllErrors() []error { return m }
+func (m ListBusinessConfigsRequestMultiError) AllErrors() []error { return m }
 
-// CreateResourceResponseValidationError is the validation error returned by
-// CreateResourceResponse.Validate if the designated constraints aren't met.
-type CreateResourceResponseValidationError struct {
+// ListBusinessConfigsRequestValidationError is the validation error returned
+// by ListBusinessConfigsRequest.Validate if the designated constraints aren't met.
+type ListBusinessConfigsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1798,24 +1801,24 @@ type CreateResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateResourceResponseValidationError) Field() string { return e.field }
+func (e ListBusinessConfigsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateResourceResponseValidationError) Reason() string { return e.reason }
+func (e ListBusinessConfigsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateResourceResponseValidationError) Cause() error { return e.cause }
+func (e ListBusinessConfigsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateResourceResponseValidationError) Key() bool { return e.key }
+func (e ListBusinessConfigsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateResourceResponseValidationError) ErrorName() string {
-	return "CreateResourceResponseValidationError"
+func (e ListBusinessConfigsRequestValidationError) ErrorName() string {
+	return "ListBusinessConfigsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateResourceResponseValidationError) Error() string {
+func (e ListBusinessConfigsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1827,14 +1830,14 @@ func (e CreateResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateResourceResponse.%s: %s%s",
+		"invalid %sListBusinessConfigsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateResourceResponseValidationError{}
+var _ error = ListBusinessConfigsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1842,48 +1845,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateResourceResponseValidationError{}
+} = ListBusinessConfigsRequestValidationError{}
 
-// Validate checks the field values on GetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListBusinessConfigsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResourceRequest) Validate() error {
+func (m *ListBusinessConfigsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListBusinessConfigsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetResourceRequestMultiError, or nil if none found.
-func (m *GetResourceRequest) ValidateAll() error {
+// ListBusinessConfigsResponseMultiError, or nil if none found.
+func (m *ListBusinessConfigsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResourceRequest) validate(all bool) error {
+func (m *ListBusinessConfigsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
+	for idx, item := range m.GetConfigs() {
+		_, _ = idx, item
 
-	// no validation rules for Id
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBusinessConfigsResponseValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBusinessConfigsResponseValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBusinessConfigsResponseValidationError{
+					field:  fmt.Sprintf("Configs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetResourceRequestMultiError(errors)
+		return ListBusinessConfigsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResourceRequestMultiError is an error wrapping multiple validation errors
-// returned by GetResourceRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetResourceRequestMultiError []error
+// ListBusinessConfigsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListBusinessConfigsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListBusinessConfigsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResourceRequestMultiError) Error() string {
+func (m ListBusinessConfigsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1892,11 +1925,12 @@ func (m GetResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResourceRequestMultiError) AllErrors() []error { return m }
+func (m ListBusinessConfigsResponseMultiError) AllErrors() []error { return m }
 
-// GetResourceRequestValidationError is the validation error returned by
-// GetResourceRequest.Validate if the designated constraints aren't met.
-type GetResourceRequestValidationError struct {
+// ListBusinessConfigsResponseValidationError is the validation error returned
+// by ListBusinessConfigsResponse.Validate if the designated constraints
+// aren't met.
+type ListBusinessConfigsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1904,24 +1938,24 @@ type GetResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResourceRequestValidationError) Field() string { return e.field }
+func (e ListBusinessConfigsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResourceRequestValidationError) Reason() string { return e.reason }
+func (e ListBusinessConfigsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResourceRequestValidationError) Cause() error { return e.cause }
+func (e ListBusinessConfigsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResourceRequestValidationError) Key() bool { return e.key }
+func (e ListBusinessConfigsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResourceRequestValidationError) ErrorName() string {
-	return "GetResourceRequestValidationError"
+func (e ListBusinessConfigsResponseValidationError) ErrorName() string {
+	return "ListBusinessConfigsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetResourceRequestValidationError) Error() string {
+func (e ListBusinessConfigsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1933,14 +1967,14 @@ func (e GetResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResourceRequest.%s: %s%s",
+		"invalid %sListBusinessConfigsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResourceRequestValidationError{}
+var _ error = ListBusinessConfigsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1948,24 +1982,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResourceRequestValidationError{}
+} = ListBusinessConfigsResponseValidationError{}
 
-// Validate checks the field values on GetResourceResponse with the rules
+// Validate checks the field values on CreateResourceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResourceResponse) Validate() error {
+func (m *CreateResourceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResourceResponse with the rules
+// ValidateAll checks the field values on CreateResourceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetResourceResponseMultiError, or nil if none found.
-func (m *GetResourceResponse) ValidateAll() error {
+// CreateResourceRequestMultiError, or nil if none found.
+func (m *CreateResourceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResourceResponse) validate(all bool) error {
+func (m *CreateResourceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1976,7 +2010,7 @@ func (m *GetResourceResponse) validate(all bool) error {
 		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetResourceResponseValidationError{
+				errors = append(errors, CreateResourceRequestValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1984,7 +2018,7 @@ func (m *GetResourceResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetResourceResponseValidationError{
+				errors = append(errors, CreateResourceRequestValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1993,7 +2027,7 @@ func (m *GetResourceResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetResourceResponseValidationError{
+			return CreateResourceRequestValidationError{
 				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2002,19 +2036,19 @@ func (m *GetResourceResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetResourceResponseMultiError(errors)
+		return CreateResourceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by GetResourceResponse.ValidateAll() if the designated
+// CreateResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateResourceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetResourceResponseMultiError []error
+type CreateResourceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResourceResponseMultiError) Error() string {
+func (m CreateResourceRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2023,11 +2057,11 @@ func (m GetResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResourceResponseMultiError) AllErrors() []error { return m }
+func (m CreateResourceRequestMultiError) AllErrors() []error { return m }
 
-// GetResourceResponseValidationError is the validation error returned by
-// GetResourceResponse.Validate if the designated constraints aren't met.
-type GetResourceResponseValidationError struct {
+// CreateResourceRequestValidationError is the validation error returned by
+// CreateResourceRequest.Validate if the designated constraints aren't met.
+type CreateResourceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2035,24 +2069,24 @@ type GetResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResourceResponseValidationError) Field() string { return e.field }
+func (e CreateResourceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResourceResponseValidationError) Reason() string { return e.reason }
+func (e CreateResourceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResourceResponseValidationError) Cause() error { return e.cause }
+func (e CreateResourceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResourceResponseValidationError) Key() bool { return e.key }
+func (e CreateResourceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResourceResponseValidationError) ErrorName() string {
-	return "GetResourceResponseValidationError"
+func (e CreateResourceRequestValidationError) ErrorName() string {
+	return "CreateResourceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetResourceResponseValidationError) Error() string {
+func (e CreateResourceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2064,14 +2098,14 @@ func (e GetResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResourceResponse.%s: %s%s",
+		"invalid %sCreateResourceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResourceResponseValidationError{}
+var _ error = CreateResourceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2079,24 +2113,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResourceResponseValidationError{}
+} = CreateResourceRequestValidationError{}
 
-// Validate checks the field values on UpdateResourceRequest with the rules
+// Validate checks the field values on CreateResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateResourceRequest) Validate() error {
+func (m *CreateResourceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateResourceRequest with the rules
+// ValidateAll checks the field values on CreateResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateResourceRequestMultiError, or nil if none found.
-func (m *UpdateResourceRequest) ValidateAll() error {
+// CreateResourceResponseMultiError, or nil if none found.
+func (m *CreateResourceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateResourceRequest) validate(all bool) error {
+func (m *CreateResourceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2107,7 +2141,7 @@ func (m *UpdateResourceRequest) validate(all bool) error {
 		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateResourceRequestValidationError{
+				errors = append(errors, CreateResourceResponseValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2115,7 +2149,7 @@ func (m *UpdateResourceRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateResourceRequestValidationError{
+				errors = append(errors, CreateResourceResponseValidationError{
 					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2124,7 +2158,7 @@ func (m *UpdateResourceRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateResourceRequestValidationError{
+			return CreateResourceResponseValidationError{
 				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2133,19 +2167,19 @@ func (m *UpdateResourceRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateResourceRequestMultiError(errors)
+		return CreateResourceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateResourceRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateResourceRequest.ValidateAll() if the designated
+// CreateResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateResourceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateResourceRequestMultiError []error
+type CreateResourceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateResourceRequestMultiError) Error() string {
+func (m CreateResourceResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2154,11 +2188,11 @@ func (m UpdateResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateResourceRequestMultiError) AllErrors() []error { return m }
+func (m CreateResourceResponseMultiError) AllErrors() []error { return m }
 
-// UpdateResourceRequestValidationError is the validation error returned by
-// UpdateResourceRequest.Validate if the designated constraints aren't met.
-type UpdateResourceRequestValidationError struct {
+// CreateResourceResponseValidationError is the validation error returned by
+// CreateResourceResponse.Validate if the designated constraints aren't met.
+type CreateResourceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2166,24 +2200,24 @@ type UpdateResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateResourceRequestValidationError) Field() string { return e.field }
+func (e CreateResourceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateResourceRequestValidationError) Reason() string { return e.reason }
+func (e CreateResourceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateResourceRequestValidationError) Cause() error { return e.cause }
+func (e CreateResourceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateResourceRequestValidationError) Key() bool { return e.key }
+func (e CreateResourceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateResourceRequestValidationError) ErrorName() string {
-	return "UpdateResourceRequestValidationError"
+func (e CreateResourceResponseValidationError) ErrorName() string {
+	return "CreateResourceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateResourceRequestValidationError) Error() string {
+func (e CreateResourceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2195,14 +2229,14 @@ func (e UpdateResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateResourceRequest.%s: %s%s",
+		"invalid %sCreateResourceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateResourceRequestValidationError{}
+var _ error = CreateResourceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2210,46 +2244,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateResourceRequestValidationError{}
+} = CreateResourceResponseValidationError{}
 
-// Validate checks the field values on UpdateResourceResponse with the rules
+// Validate checks the field values on GetResourceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateResourceResponse) Validate() error {
+func (m *GetResourceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateResourceResponse with the rules
+// ValidateAll checks the field values on GetResourceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateResourceResponseMultiError, or nil if none found.
-func (m *UpdateResourceResponse) ValidateAll() error {
+// GetResourceRequestMultiError, or nil if none found.
+func (m *GetResourceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateResourceResponse) validate(all bool) error {
+func (m *GetResourceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for BizId
+
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UpdateResourceResponseMultiError(errors)
+		return GetResourceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateResourceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateResourceResponseMultiError []error
+// GetResourceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetResourceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetResourceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateResourceResponseMultiError) Error() string {
+func (m GetResourceRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2258,11 +2294,11 @@ func (m UpdateResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateResourceResponseMultiError) AllErrors() []error { return m }
+func (m GetResourceRequestMultiError) AllErrors() []error { return m }
 
-// UpdateResourceResponseValidationError is the validation error returned by
-// UpdateResourceResponse.Validate if the designated constraints aren't met.
-type UpdateResourceResponseValidationError struct {
+// GetResourceRequestValidationError is the validation error returned by
+// GetResourceRequest.Validate if the designated constraints aren't met.
+type GetResourceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2270,24 +2306,24 @@ type UpdateResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateResourceResponseValidationError) Field() string { return e.field }
+func (e GetResourceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateResourceResponseValidationError) Reason() string { return e.reason }
+func (e GetResourceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateResourceResponseValidationError) Cause() error { return e.cause }
+func (e GetResourceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateResourceResponseValidationError) Key() bool { return e.key }
+func (e GetResourceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateResourceResponseValidationError) ErrorName() string {
-	return "UpdateResourceResponseValidationError"
+func (e GetResourceRequestValidationError) ErrorName() string {
+	return "GetResourceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateResourceResponseValidationError) Error() string {
+func (e GetResourceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2299,14 +2335,14 @@ func (e UpdateResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateResourceResponse.%s: %s%s",
+		"invalid %sGetResourceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateResourceResponseValidationError{}
+var _ error = GetResourceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2314,48 +2350,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateResourceResponseValidationError{}
+} = GetResourceRequestValidationError{}
 
-// Validate checks the field values on DeleteResourceRequest with the rules
+// Validate checks the field values on GetResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteResourceRequest) Validate() error {
+func (m *GetResourceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteResourceRequest with the rules
+// ValidateAll checks the field values on GetResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteResourceRequestMultiError, or nil if none found.
-func (m *DeleteResourceRequest) ValidateAll() error {
+// GetResourceResponseMultiError, or nil if none found.
+func (m *GetResourceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteResourceRequest) validate(all bool) error {
+func (m *GetResourceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteResourceRequestMultiError(errors)
+		return GetResourceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteResourceRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteResourceRequest.ValidateAll() if the designated
+// GetResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetResourceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteResourceRequestMultiError []error
+type GetResourceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteResourceRequestMultiError) Error() string {
+func (m GetResourceResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2364,11 +2425,11 @@ func (m DeleteResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteResourceRequestMultiError) AllErrors() []error { return m }
+func (m GetResourceResponseMultiError) AllErrors() []error { return m }
 
-// DeleteResourceRequestValidationError is the validation error returned by
-// DeleteResourceRequest.Validate if the designated constraints aren't met.
-type DeleteResourceRequestValidationError struct {
+// GetResourceResponseValidationError is the validation error returned by
+// GetResourceResponse.Validate if the designated constraints aren't met.
+type GetResourceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2376,24 +2437,24 @@ type DeleteResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteResourceRequestValidationError) Field() string { return e.field }
+func (e GetResourceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteResourceRequestValidationError) Reason() string { return e.reason }
+func (e GetResourceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteResourceRequestValidationError) Cause() error { return e.cause }
+func (e GetResourceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteResourceRequestValidationError) Key() bool { return e.key }
+func (e GetResourceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteResourceRequestValidationError) ErrorName() string {
-	return "DeleteResourceRequestValidationError"
+func (e GetResourceResponseValidationError) ErrorName() string {
+	return "GetResourceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteResourceRequestValidationError) Error() string {
+func (e GetResourceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2405,14 +2466,14 @@ func (e DeleteResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteResourceRequest.%s: %s%s",
+		"invalid %sGetResourceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteResourceRequestValidationError{}
+var _ error = GetResourceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2420,46 +2481,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteResourceRequestValidationError{}
+} = GetResourceResponseValidationError{}
 
-// Validate checks the field values on DeleteResourceResponse with the rules
+// Validate checks the field values on UpdateResourceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteResourceResponse) Validate() error {
+func (m *UpdateResourceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteResourceResponse with the rules
+// ValidateAll checks the field values on UpdateResourceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteResourceResponseMultiError, or nil if none found.
-func (m *DeleteResourceResponse) ValidateAll() error {
+// UpdateResourceRequestMultiError, or nil if none found.
+func (m *UpdateResourceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteResourceResponse) validate(all bool) error {
+func (m *UpdateResourceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
-
-	if len(errors) > 0 {
-		return DeleteResourceResponseMultiError(errors)
-	}
-
-	return nil
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateResourceRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateResourceRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateResourceRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateResourceRequestMultiError(errors)
+	}
+
+	return nil
 }
 
-// DeleteResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteResourceResponse.ValidateAll() if the designated
+// UpdateResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateResourceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteResourceResponseMultiError []error
+type UpdateResourceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteResourceResponseMultiError) Error() string {
+func (m UpdateResourceRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2468,11 +2556,11 @@ func (m DeleteResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteResourceResponseMultiError) AllErrors() []error { return m }
+func (m UpdateResourceRequestMultiError) AllErrors() []error { return m }
 
-// DeleteResourceResponseValidationError is the validation error returned by
-// DeleteResourceResponse.Validate if the designated constraints aren't met.
-type DeleteResourceResponseValidationError struct {
+// UpdateResourceRequestValidationError is the validation error returned by
+// UpdateResourceRequest.Validate if the designated constraints aren't met.
+type UpdateResourceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2480,24 +2568,24 @@ type DeleteResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteResourceResponseValidationError) Field() string { return e.field }
+func (e UpdateResourceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteResourceResponseValidationError) Reason() string { return e.reason }
+func (e UpdateResourceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteResourceResponseValidationError) Cause() error { return e.cause }
+func (e UpdateResourceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteResourceResponseValidationError) Key() bool { return e.key }
+func (e UpdateResourceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteResourceResponseValidationError) ErrorName() string {
-	return "DeleteResourceResponseValidationError"
+func (e UpdateResourceRequestValidationError) ErrorName() string {
+	return "UpdateResourceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteResourceResponseValidationError) Error() string {
+func (e UpdateResourceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2509,14 +2597,14 @@ func (e DeleteResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteResourceResponse.%s: %s%s",
+		"invalid %sUpdateResourceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteResourceResponseValidationError{}
+var _ error = UpdateResourceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2524,50 +2612,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteResourceResponseValidationError{}
+} = UpdateResourceRequestValidationError{}
 
-// Validate checks the field values on ListResourcesRequest with the rules
+// Validate checks the field values on UpdateResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesRequest) Validate() error {
+func (m *UpdateResourceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesRequest with the rules
+// ValidateAll checks the field values on UpdateResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListResourcesRequestMultiError, or nil if none found.
-func (m *ListResourcesRequest) ValidateAll() error {
+// UpdateResourceResponseMultiError, or nil if none found.
+func (m *UpdateResourceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesRequest) validate(all bool) error {
+func (m *UpdateResourceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
-	// no validation rules for Offset
-
-	// no validation rules for Limit
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return ListResourcesRequestMultiError(errors)
+		return UpdateResourceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesRequest.ValidateAll() if the designated
+// UpdateResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateResourceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListResourcesRequestMultiError []error
+type UpdateResourceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesRequestMultiError) Error() string {
+func (m UpdateResourceResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2576,11 +2660,11 @@ func (m ListResourcesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+func (m UpdateResourceResponseMultiError) AllErrors() []error { return m }
 
-// ListResourcesRequestValidationError is the validation error returned by
-// ListResourcesRequest.Validate if the designated constraints aren't met.
-type ListResourcesRequestValidationError struct {
+// UpdateResourceResponseValidationError is the validation error returned by
+// UpdateResourceResponse.Validate if the designated constraints aren't met.
+type UpdateResourceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2588,24 +2672,24 @@ type ListResourcesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesRequestValidationError) Field() string { return e.field }
+func (e UpdateResourceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+func (e UpdateResourceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+func (e UpdateResourceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+func (e UpdateResourceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesRequestValidationError) ErrorName() string {
-	return "ListResourcesRequestValidationError"
+func (e UpdateResourceResponseValidationError) ErrorName() string {
+	return "UpdateResourceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesRequestValidationError) Error() string {
+func (e UpdateResourceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2617,14 +2701,14 @@ func (e ListResourcesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesRequest.%s: %s%s",
+		"invalid %sUpdateResourceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesRequestValidationError{}
+var _ error = UpdateResourceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2632,78 +2716,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesRequestValidationError{}
+} = UpdateResourceResponseValidationError{}
 
-// Validate checks the field values on ListResourcesResponse with the rules
+// Validate checks the field values on DeleteResourceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesResponse) Validate() error {
+func (m *DeleteResourceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesResponse with the rules
+// ValidateAll checks the field values on DeleteResourceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListResourcesResponseMultiError, or nil if none found.
-func (m *ListResourcesResponse) ValidateAll() error {
+// DeleteResourceRequestMultiError, or nil if none found.
+func (m *DeleteResourceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesResponse) validate(all bool) error {
+func (m *DeleteResourceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResources() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListResourcesResponseValidationError{
-					field:  fmt.Sprintf("Resources[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for BizId
 
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListResourcesResponseMultiError(errors)
+		return DeleteResourceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesResponse.ValidateAll() if the designated
+// DeleteResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteResourceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListResourcesResponseMultiError []error
+type DeleteResourceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesResponseMultiError) Error() string {
+func (m DeleteResourceRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2712,11 +2766,11 @@ func (m ListResourcesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteResourceRequestMultiError) AllErrors() []error { return m }
 
-// ListResourcesResponseValidationError is the validation error returned by
-// ListResourcesResponse.Validate if the designated constraints aren't met.
-type ListResourcesResponseValidationError struct {
+// DeleteResourceRequestValidationError is the validation error returned by
+// DeleteResourceRequest.Validate if the designated constraints aren't met.
+type DeleteResourceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2724,24 +2778,24 @@ type ListResourcesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesResponseValidationError) Field() string { return e.field }
+func (e DeleteResourceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteResourceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteResourceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesResponseValidationError) Key() bool { return e.key }
+func (e DeleteResourceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesResponseValidationError) ErrorName() string {
-	return "ListResourcesResponseValidationError"
+func (e DeleteResourceRequestValidationError) ErrorName() string {
+	return "DeleteResourceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesResponseValidationError) Error() string {
+func (e DeleteResourceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2753,14 +2807,14 @@ func (e ListResourcesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesResponse.%s: %s%s",
+		"invalid %sDeleteResourceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesResponseValidationError{}
+var _ error = DeleteResourceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2768,73 +2822,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesResponseValidationError{}
+} = DeleteResourceRequestValidationError{}
 
-// Validate checks the field values on CreatePermissionRequest with the rules
+// Validate checks the field values on DeleteResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePermissionRequest) Validate() error {
+func (m *DeleteResourceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePermissionRequestMultiError, or nil if none found.
-func (m *CreatePermissionRequest) ValidateAll() error {
+// DeleteResourceResponseMultiError, or nil if none found.
+func (m *DeleteResourceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePermissionRequest) validate(all bool) error {
+func (m *DeleteResourceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPermission()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreatePermissionRequestValidationError{
-					field:  "Permission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreatePermissionRequestValidationError{
-					field:  "Permission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreatePermissionRequestValidationError{
-				field:  "Permission",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return CreatePermissionRequestMultiError(errors)
+		return DeleteResourceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePermissionRequestMultiError is an error wrapping multiple validation
-// errors returned by CreatePermissionRequest.ValidateAll() if the designated
+// DeleteResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteResourceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreatePermissionRequestMultiError []error
+type DeleteResourceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePermissionRequestMultiError) Error() string {
+func (m DeleteResourceResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2843,11 +2870,11 @@ func (m CreatePermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePermissionRequestMultiError) AllErrors() []error { return m }
+func (m DeleteResourceResponseMultiError) AllErrors() []error { return m }
 
-// CreatePermissionRequestValidationError is the validation error returned by
-// CreatePermissionRequest.Validate if the designated constraints aren't met.
-type CreatePermissionRequestValidationError struct {
+// DeleteResourceResponseValidationError is the validation error returned by
+// DeleteResourceResponse.Validate if the designated constraints aren't met.
+type DeleteResourceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2855,24 +2882,24 @@ type CreatePermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePermissionRequestValidationError) Field() string { return e.field }
+func (e DeleteResourceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePermissionRequestValidationError) Reason() string { return e.reason }
+func (e DeleteResourceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePermissionRequestValidationError) Cause() error { return e.cause }
+func (e DeleteResourceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePermissionRequestValidationError) Key() bool { return e.key }
+func (e DeleteResourceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePermissionRequestValidationError) ErrorName() string {
-	return "CreatePermissionRequestValidationError"
+func (e DeleteResourceResponseValidationError) ErrorName() string {
+	return "DeleteResourceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePermissionRequestValidationError) Error() string {
+func (e DeleteResourceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2884,14 +2911,14 @@ func (e CreatePermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePermissionRequest.%s: %s%s",
+		"invalid %sDeleteResourceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePermissionRequestValidationError{}
+var _ error = DeleteResourceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2899,73 +2926,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePermissionRequestValidationError{}
+} = DeleteResourceResponseValidationError{}
 
-// Validate checks the field values on CreatePermissionResponse with the rules
+// Validate checks the field values on ListResourcesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreatePermissionResponse) Validate() error {
+func (m *ListResourcesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreatePermissionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreatePermissionResponseMultiError, or nil if none found.
-func (m *CreatePermissionResponse) ValidateAll() error {
+// ListResourcesRequestMultiError, or nil if none found.
+func (m *ListResourcesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreatePermissionResponse) validate(all bool) error {
+func (m *ListResourcesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPermission()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreatePermissionResponseValidationError{
-					field:  "Permission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreatePermissionResponseValidationError{
-					field:  "Permission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreatePermissionResponseValidationError{
-				field:  "Permission",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for BizId
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return CreatePermissionResponseMultiError(errors)
+		return ListResourcesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreatePermissionResponseMultiError is an error wrapping multiple validation
-// errors returned by CreatePermissionResponse.ValidateAll() if the designated
+// ListResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreatePermissionResponseMultiError []error
+type ListResourcesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreatePermissionResponseMultiError) Error() string {
+func (m ListResourcesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2974,11 +2978,11 @@ func (m CreatePermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreatePermissionResponseMultiError) AllErrors() []error { return m }
+func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
 
-// CreatePermissionResponseValidationError is the validation error returned by
-// CreatePermissionResponse.Validate if the designated constraints aren't met.
-type CreatePermissionResponseValidationError struct {
+// ListResourcesRequestValidationError is the validation error returned by
+// ListResourcesRequest.Validate if the designated constraints aren't met.
+type ListResourcesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2986,24 +2990,24 @@ type CreatePermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreatePermissionResponseValidationError) Field() string { return e.field }
+func (e ListResourcesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreatePermissionResponseValidationError) Reason() string { return e.reason }
+func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreatePermissionResponseValidationError) Cause() error { return e.cause }
+func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreatePermissionResponseValidationError) Key() bool { return e.key }
+func (e ListResourcesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreatePermissionResponseValidationError) ErrorName() string {
-	return "CreatePermissionResponseValidationError"
+func (e ListResourcesRequestValidationError) ErrorName() string {
+	return "ListResourcesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreatePermissionResponseValidationError) Error() string {
+func (e ListResourcesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3015,14 +3019,14 @@ func (e CreatePermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreatePermissionResponse.%s: %s%s",
+		"invalid %sListResourcesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreatePermissionResponseValidationError{}
+var _ error = ListResourcesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3030,48 +3034,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreatePermissionResponseValidationError{}
+} = ListResourcesRequestValidationError{}
 
-// Validate checks the field values on GetPermissionRequest with the rules
+// Validate checks the field values on ListResourcesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPermissionRequest) Validate() error {
+func (m *ListResourcesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPermissionRequest with the rules
+// ValidateAll checks the field values on ListResourcesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPermissionRequestMultiError, or nil if none found.
-func (m *GetPermissionRequest) ValidateAll() error {
+// ListResourcesResponseMultiError, or nil if none found.
+func (m *ListResourcesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPermissionRequest) validate(all bool) error {
+func (m *ListResourcesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
 
-	// no validation rules for Id
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListResourcesResponseValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetPermissionRequestMultiError(errors)
+		return ListResourcesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPermissionRequestMultiError is an error wrapping multiple validation
-// errors returned by GetPermissionRequest.ValidateAll() if the designated
+// ListResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetPermissionRequestMultiError []error
+type ListResourcesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPermissionRequestMultiError) Error() string {
+func (m ListResourcesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3080,11 +3114,11 @@ func (m GetPermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPermissionRequestMultiError) AllErrors() []error { return m }
+func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
 
-// GetPermissionRequestValidationError is the validation error returned by
-// GetPermissionRequest.Validate if the designated constraints aren't met.
-type GetPermissionRequestValidationError struct {
+// ListResourcesResponseValidationError is the validation error returned by
+// ListResourcesResponse.Validate if the designated constraints aren't met.
+type ListResourcesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3092,24 +3126,24 @@ type GetPermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPermissionRequestValidationError) Field() string { return e.field }
+func (e ListResourcesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPermissionRequestValidationError) Reason() string { return e.reason }
+func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPermissionRequestValidationError) Cause() error { return e.cause }
+func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPermissionRequestValidationError) Key() bool { return e.key }
+func (e ListResourcesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPermissionRequestValidationError) ErrorName() string {
-	return "GetPermissionRequestValidationError"
+func (e ListResourcesResponseValidationError) ErrorName() string {
+	return "ListResourcesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPermissionRequestValidationError) Error() string {
+func (e ListResourcesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3121,14 +3155,14 @@ func (e GetPermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPermissionRequest.%s: %s%s",
+		"invalid %sListResourcesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPermissionRequestValidationError{}
+var _ error = ListResourcesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3136,24 +3170,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPermissionRequestValidationError{}
+} = ListResourcesResponseValidationError{}
 
-// Validate checks the field values on GetPermissionResponse with the rules
+// Validate checks the field values on CreatePermissionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPermissionResponse) Validate() error {
+func (m *CreatePermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPermissionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreatePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPermissionResponseMultiError, or nil if none found.
-func (m *GetPermissionResponse) ValidateAll() error {
+// CreatePermissionRequestMultiError, or nil if none found.
+func (m *CreatePermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPermissionResponse) validate(all bool) error {
+func (m *CreatePermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3164,7 +3198,7 @@ func (m *GetPermissionResponse) validate(all bool) error {
 		switch v := interface{}(m.GetPermission()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetPermissionResponseValidationError{
+				errors = append(errors, CreatePermissionRequestValidationError{
 					field:  "Permission",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3172,7 +3206,7 @@ func (m *GetPermissionResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetPermissionResponseValidationError{
+				errors = append(errors, CreatePermissionRequestValidationError{
 					field:  "Permission",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3181,7 +3215,7 @@ func (m *GetPermissionResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetPermissionResponseValidationError{
+			return CreatePermissionRequestValidationError{
 				field:  "Permission",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3190,19 +3224,19 @@ func (m *GetPermissionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetPermissionResponseMultiError(errors)
+		return CreatePermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPermissionResponseMultiError is an error wrapping multiple validation
-// errors returned by GetPermissionResponse.ValidateAll() if the designated
+// CreatePermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePermissionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetPermissionResponseMultiError []error
+type CreatePermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPermissionResponseMultiError) Error() string {
+func (m CreatePermissionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3211,11 +3245,11 @@ func (m GetPermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPermissionResponseMultiError) AllErrors() []error { return m }
+func (m CreatePermissionRequestMultiError) AllErrors() []error { return m }
 
-// GetPermissionResponseValidationError is the validation error returned by
-// GetPermissionResponse.Validate if the designated constraints aren't met.
-type GetPermissionResponseValidationError struct {
+// CreatePermissionRequestValidationError is the validation error returned by
+// CreatePermissionRequest.Validate if the designated constraints aren't met.
+type CreatePermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3223,24 +3257,24 @@ type GetPermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPermissionResponseValidationError) Field() string { return e.field }
+func (e CreatePermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPermissionResponseValidationError) Reason() string { return e.reason }
+func (e CreatePermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPermissionResponseValidationError) Cause() error { return e.cause }
+func (e CreatePermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPermissionResponseValidationError) Key() bool { return e.key }
+func (e CreatePermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPermissionResponseValidationError) ErrorName() string {
-	return "GetPermissionResponseValidationError"
+func (e CreatePermissionRequestValidationError) ErrorName() string {
+	return "CreatePermissionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPermissionResponseValidationError) Error() string {
+func (e CreatePermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3252,14 +3286,14 @@ func (e GetPermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPermissionResponse.%s: %s%s",
+		"invalid %sCreatePermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPermissionResponseValidationError{}
+var _ error = CreatePermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3267,24 +3301,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPermissionResponseValidationError{}
+} = CreatePermissionRequestValidationError{}
 
-// Validate checks the field values on UpdatePermissionRequest with the rules
+// Validate checks the field values on CreatePermissionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePermissionRequest) Validate() error {
+func (m *CreatePermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePermissionRequest with the
+// ValidateAll checks the field values on CreatePermissionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePermissionRequestMultiError, or nil if none found.
-func (m *UpdatePermissionRequest) ValidateAll() error {
+// CreatePermissionResponseMultiError, or nil if none found.
+func (m *CreatePermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePermissionRequest) validate(all bool) error {
+func (m *CreatePermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3295,7 +3329,7 @@ func (m *UpdatePermissionRequest) validate(all bool) error {
 		switch v := interface{}(m.GetPermission()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdatePermissionRequestValidationError{
+				errors = append(errors, CreatePermissionResponseValidationError{
 					field:  "Permission",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3303,7 +3337,7 @@ func (m *UpdatePermissionRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdatePermissionRequestValidationError{
+				errors = append(errors, CreatePermissionResponseValidationError{
 					field:  "Permission",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3312,7 +3346,7 @@ func (m *UpdatePermissionRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdatePermissionRequestValidationError{
+			return CreatePermissionResponseValidationError{
 				field:  "Permission",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3321,19 +3355,19 @@ func (m *UpdatePermissionRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdatePermissionRequestMultiError(errors)
+		return CreatePermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePermissionRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdatePermissionRequest.ValidateAll() if the designated
+// CreatePermissionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePermissionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdatePermissionRequestMultiError []error
+type CreatePermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePermissionRequestMultiError) Error() string {
+func (m CreatePermissionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3342,11 +3376,11 @@ func (m UpdatePermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePermissionRequestMultiError) AllErrors() []error { return m }
+func (m CreatePermissionResponseMultiError) AllErrors() []error { return m }
 
-// UpdatePermissionRequestValidationError is the validation error returned by
-// UpdatePermissionRequest.Validate if the designated constraints aren't met.
-type UpdatePermissionRequestValidationError struct {
+// CreatePermissionResponseValidationError is the validation error returned by
+// CreatePermissionResponse.Validate if the designated constraints aren't met.
+type CreatePermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3354,24 +3388,24 @@ type UpdatePermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePermissionRequestValidationError) Field() string { return e.field }
+func (e CreatePermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePermissionRequestValidationError) Reason() string { return e.reason }
+func (e CreatePermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePermissionRequestValidationError) Cause() error { return e.cause }
+func (e CreatePermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePermissionRequestValidationError) Key() bool { return e.key }
+func (e CreatePermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePermissionRequestValidationError) ErrorName() string {
-	return "UpdatePermissionRequestValidationError"
+func (e CreatePermissionResponseValidationError) ErrorName() string {
+	return "CreatePermissionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePermissionRequestValidationError) Error() string {
+func (e CreatePermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3383,14 +3417,14 @@ func (e UpdatePermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePermissionRequest.%s: %s%s",
+		"invalid %sCreatePermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePermissionRequestValidationError{}
+var _ error = CreatePermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3398,46 +3432,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePermissionRequestValidationError{}
+} = CreatePermissionResponseValidationError{}
 
-// Validate checks the field values on UpdatePermissionResponse with the rules
+// Validate checks the field values on GetPermissionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePermissionResponse) Validate() error {
+func (m *GetPermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePermissionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetPermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePermissionResponseMultiError, or nil if none found.
-func (m *UpdatePermissionResponse) ValidateAll() error {
+// GetPermissionRequestMultiError, or nil if none found.
+func (m *GetPermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePermissionResponse) validate(all bool) error {
+func (m *GetPermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for BizId
+
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UpdatePermissionResponseMultiError(errors)
+		return GetPermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePermissionResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdatePermissionResponse.ValidateAll() if the designated
+// GetPermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPermissionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdatePermissionResponseMultiError []error
+type GetPermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePermissionResponseMultiError) Error() string {
+func (m GetPermissionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3446,11 +3482,11 @@ func (m UpdatePermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePermissionResponseMultiError) AllErrors() []error { return m }
+func (m GetPermissionRequestMultiError) AllErrors() []error { return m }
 
-// UpdatePermissionResponseValidationError is the validation error returned by
-// UpdatePermissionResponse.Validate if the designated constraints aren't met.
-type UpdatePermissionResponseValidationError struct {
+// GetPermissionRequestValidationError is the validation error returned by
+// GetPermissionRequest.Validate if the designated constraints aren't met.
+type GetPermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3458,24 +3494,24 @@ type UpdatePermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePermissionResponseValidationError) Field() string { return e.field }
+func (e GetPermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePermissionResponseValidationError) Reason() string { return e.reason }
+func (e GetPermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePermissionResponseValidationError) Cause() error { return e.cause }
+func (e GetPermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePermissionResponseValidationError) Key() bool { return e.key }
+func (e GetPermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePermissionResponseValidationError) ErrorName() string {
-	return "UpdatePermissionResponseValidationError"
+func (e GetPermissionRequestValidationError) ErrorName() string {
+	return "GetPermissionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePermissionResponseValidationError) Error() string {
+func (e GetPermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3487,14 +3523,14 @@ func (e UpdatePermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePermissionResponse.%s: %s%s",
+		"invalid %sGetPermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePermissionResponseValidationError{}
+var _ error = GetPermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3502,48 +3538,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePermissionResponseValidationError{}
+} = GetPermissionRequestValidationError{}
 
-// Validate checks the field values on DeletePermissionRequest with the rules
+// Validate checks the field values on GetPermissionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeletePermissionRequest) Validate() error {
+func (m *GetPermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetPermissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeletePermissionRequestMultiError, or nil if none found.
-func (m *DeletePermissionRequest) ValidateAll() error {
+// GetPermissionResponseMultiError, or nil if none found.
+func (m *GetPermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePermissionRequest) validate(all bool) error {
+func (m *GetPermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPermissionResponseValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPermissionResponseValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPermissionResponseValidationError{
+				field:  "Permission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeletePermissionRequestMultiError(errors)
+		return GetPermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePermissionRequestMultiError is an error wrapping multiple validation
-// errors returned by DeletePermissionRequest.ValidateAll() if the designated
+// GetPermissionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPermissionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeletePermissionRequestMultiError []error
+type GetPermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePermissionRequestMultiError) Error() string {
+func (m GetPermissionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3552,11 +3613,11 @@ func (m DeletePermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePermissionRequestMultiError) AllErrors() []error { return m }
+func (m GetPermissionResponseMultiError) AllErrors() []error { return m }
 
-// DeletePermissionRequestValidationError is the validation error returned by
-// DeletePermissionRequest.Validate if the designated constraints aren't met.
-type DeletePermissionRequestValidationError struct {
+// GetPermissionResponseValidationError is the validation error returned by
+// GetPermissionResponse.Validate if the designated constraints aren't met.
+type GetPermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3564,24 +3625,24 @@ type DeletePermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePermissionRequestValidationError) Field() string { return e.field }
+func (e GetPermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePermissionRequestValidationError) Reason() string { return e.reason }
+func (e GetPermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePermissionRequestValidationError) Cause() error { return e.cause }
+func (e GetPermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePermissionRequestValidationError) Key() bool { return e.key }
+func (e GetPermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePermissionRequestValidationError) ErrorName() string {
-	return "DeletePermissionRequestValidationError"
+func (e GetPermissionResponseValidationError) ErrorName() string {
+	return "GetPermissionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeletePermissionRequestValidationError) Error() string {
+func (e GetPermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3593,14 +3654,14 @@ func (e DeletePermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePermissionRequest.%s: %s%s",
+		"invalid %sGetPermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePermissionRequestValidationError{}
+var _ error = GetPermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3608,46 +3669,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePermissionRequestValidationError{}
+} = GetPermissionResponseValidationError{}
 
-// Validate checks the field values on DeletePermissionResponse with the rules
+// Validate checks the field values on UpdatePermissionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeletePermissionResponse) Validate() error {
+func (m *UpdatePermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePermissionResponse with the
+// ValidateAll checks the field values on UpdatePermissionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeletePermissionResponseMultiError, or nil if none found.
-func (m *DeletePermissionResponse) ValidateAll() error {
+// UpdatePermissionRequestMultiError, or nil if none found.
+func (m *UpdatePermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePermissionResponse) validate(all bool) error {
+func (m *UpdatePermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	if all {
+		switch v := interface{}(m.GetPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePermissionRequestValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePermissionRequestValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePermissionRequestValidationError{
+				field:  "Permission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeletePermissionResponseMultiError(errors)
+		return UpdatePermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePermissionResponseMultiError is an error wrapping multiple validation
-// errors returned by DeletePermissionResponse.ValidateAll() if the designated
+// UpdatePermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePermissionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeletePermissionResponseMultiError []error
+type UpdatePermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePermissionResponseMultiError) Error() string {
+func (m UpdatePermissionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3656,11 +3744,11 @@ func (m DeletePermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePermissionResponseMultiError) AllErrors() []error { return m }
+func (m UpdatePermissionRequestMultiError) AllErrors() []error { return m }
 
-// DeletePermissionResponseValidationError is the validation error returned by
-// DeletePermissionResponse.Validate if the designated constraints aren't met.
-type DeletePermissionResponseValidationError struct {
+// UpdatePermissionRequestValidationError is the validation error returned by
+// UpdatePermissionRequest.Validate if the designated constraints aren't met.
+type UpdatePermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3668,24 +3756,24 @@ type DeletePermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePermissionResponseValidationError) Field() string { return e.field }
+func (e UpdatePermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePermissionResponseValidationError) Reason() string { return e.reason }
+func (e UpdatePermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePermissionResponseValidationError) Cause() error { return e.cause }
+func (e UpdatePermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePermissionResponseValidationError) Key() bool { return e.key }
+func (e UpdatePermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePermissionResponseValidationError) ErrorName() string {
-	return "DeletePermissionResponseValidationError"
+func (e UpdatePermissionRequestValidationError) ErrorName() string {
+	return "UpdatePermissionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeletePermissionResponseValidationError) Error() string {
+func (e UpdatePermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3697,14 +3785,14 @@ func (e DeletePermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePermissionResponse.%s: %s%s",
+		"invalid %sUpdatePermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePermissionResponseValidationError{}
+var _ error = UpdatePermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3712,50 +3800,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePermissionResponseValidationError{}
+} = UpdatePermissionRequestValidationError{}
 
-// Validate checks the field values on ListPermissionsRequest with the rules
+// Validate checks the field values on UpdatePermissionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPermissionsRequest) Validate() error {
+func (m *UpdatePermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPermissionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdatePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPermissionsRequestMultiError, or nil if none found.
-func (m *ListPermissionsRequest) ValidateAll() error {
+// UpdatePermissionResponseMultiError, or nil if none found.
+func (m *UpdatePermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPermissionsRequest) validate(all bool) error {
+func (m *UpdatePermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
-	// no validation rules for Offset
-
-	// no validation rules for Limit
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return ListPermissionsRequestMultiError(errors)
+		return UpdatePermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPermissionsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListPermissionsRequest.ValidateAll() if the designated
+// UpdatePermissionResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePermissionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListPermissionsRequestMultiError []error
+type UpdatePermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPermissionsRequestMultiError) Error() string {
+func (m UpdatePermissionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3764,11 +3848,11 @@ func (m ListPermissionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPermissionsRequestMultiError) AllErrors() []error { return m }
+func (m UpdatePermissionResponseMultiError) AllErrors() []error { return m }
 
-// ListPermissionsRequestValidationError is the validation error returned by
-// ListPermissionsRequest.Validate if the designated constraints aren't met.
-type ListPermissionsRequestValidationError struct {
+// UpdatePermissionResponseValidationError is the validation error returned by
+// UpdatePermissionResponse.Validate if the designated constraints aren't met.
+type UpdatePermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3776,24 +3860,24 @@ type ListPermissionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPermissionsRequestValidationError) Field() string { return e.field }
+func (e UpdatePermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPermissionsRequestValidationError) Reason() string { return e.reason }
+func (e UpdatePermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPermissionsRequestValidationError) Cause() error { return e.cause }
+func (e UpdatePermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPermissionsRequestValidationError) Key() bool { return e.key }
+func (e UpdatePermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPermissionsRequestValidationError) ErrorName() string {
-	return "ListPermissionsRequestValidationError"
+func (e UpdatePermissionResponseValidationError) ErrorName() string {
+	return "UpdatePermissionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPermissionsRequestValidationError) Error() string {
+func (e UpdatePermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3805,14 +3889,14 @@ func (e ListPermissionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPermissionsRequest.%s: %s%s",
+		"invalid %sUpdatePermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPermissionsRequestValidationError{}
+var _ error = UpdatePermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3820,78 +3904,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPermissionsRequestValidationError{}
+} = UpdatePermissionResponseValidationError{}
 
-// Validate checks the field values on ListPermissionsResponse with the rules
+// Validate checks the field values on DeletePermissionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPermissionsResponse) Validate() error {
+func (m *DeletePermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPermissionsResponse with the
+// ValidateAll checks the field values on DeletePermissionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPermissionsResponseMultiError, or nil if none found.
-func (m *ListPermissionsResponse) ValidateAll() error {
+// DeletePermissionRequestMultiError, or nil if none found.
+func (m *DeletePermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPermissionsResponse) validate(all bool) error {
+func (m *DeletePermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPermissions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPermissionsResponseValidationError{
-						field:  fmt.Sprintf("Permissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPermissionsResponseValidationError{
-						field:  fmt.Sprintf("Permissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListPermissionsResponseValidationError{
-					field:  fmt.Sprintf("Permissions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for BizId
 
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListPermissionsResponseMultiError(errors)
+		return DeletePermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPermissionsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListPermissionsResponse.ValidateAll() if the designated
+// DeletePermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePermissionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListPermissionsResponseMultiError []error
+type DeletePermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPermissionsResponseMultiError) Error() string {
+func (m DeletePermissionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3900,11 +3954,11 @@ func (m ListPermissionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPermissionsResponseMultiError) AllErrors() []error { return m }
+func (m DeletePermissionRequestMultiError) AllErrors() []error { return m }
 
-// ListPermissionsResponseValidationError is the validation error returned by
-// ListPermissionsResponse.Validate if the designated constraints aren't met.
-type ListPermissionsResponseValidationError struct {
+// DeletePermissionRequestValidationError is the validation error returned by
+// DeletePermissionRequest.Validate if the designated constraints aren't met.
+type DeletePermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3912,24 +3966,24 @@ type ListPermissionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPermissionsResponseValidationError) Field() string { return e.field }
+func (e DeletePermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPermissionsResponseValidationError) Reason() string { return e.reason }
+func (e DeletePermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPermissionsResponseValidationError) Cause() error { return e.cause }
+func (e DeletePermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPermissionsResponseValidationError) Key() bool { return e.key }
+func (e DeletePermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPermissionsResponseValidationError) ErrorName() string {
-	return "ListPermissionsResponseValidationError"
+func (e DeletePermissionRequestValidationError) ErrorName() string {
+	return "DeletePermissionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPermissionsResponseValidationError) Error() string {
+func (e DeletePermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3941,14 +3995,14 @@ func (e ListPermissionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPermissionsResponse.%s: %s%s",
+		"invalid %sDeletePermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPermissionsResponseValidationError{}
+var _ error = DeletePermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3956,54 +4010,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPermissionsResponseValidationError{}
+} = DeletePermissionRequestValidationError{}
 
-// Validate checks the field values on Role with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Role) Validate() error {
+// Validate checks the field values on DeletePermissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Role with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RoleMultiError, or nil if none found.
-func (m *Role) ValidateAll() error {
+// ValidateAll checks the field values on DeletePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePermissionResponseMultiError, or nil if none found.
+func (m *DeletePermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Role) validate(all bool) error {
+func (m *DeletePermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for BizId
-
-	// no validation rules for Type
-
-	// no validation rules for Name
-
-	// no validation rules for Description
-
-	// no validation rules for Metadata
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return RoleMultiError(errors)
+		return DeletePermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RoleMultiError is an error wrapping multiple validation errors returned by
-// Role.ValidateAll() if the designated constraints aren't met.
-type RoleMultiError []error
+// DeletePermissionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeletePermissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RoleMultiError) Error() string {
+func (m DeletePermissionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4012,11 +4058,11 @@ func (m RoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RoleMultiError) AllErrors() []error { return m }
+func (m DeletePermissionResponseMultiError) AllErrors() []error { return m }
 
-// RoleValidationError is the validation error returned by Role.Validate if the
-// designated constraints aren't met.
-type RoleValidationError struct {
+// DeletePermissionResponseValidationError is the validation error returned by
+// DeletePermissionResponse.Validate if the designated constraints aren't met.
+type DeletePermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4024,22 +4070,24 @@ type RoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e RoleValidationError) Field() string { return e.field }
+func (e DeletePermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RoleValidationError) Reason() string { return e.reason }
+func (e DeletePermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RoleValidationError) Cause() error { return e.cause }
+func (e DeletePermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RoleValidationError) Key() bool { return e.key }
+func (e DeletePermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RoleValidationError) ErrorName() string { return "RoleValidationError" }
+func (e DeletePermissionResponseValidationError) ErrorName() string {
+	return "DeletePermissionResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RoleValidationError) Error() string {
+func (e DeletePermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4051,14 +4099,14 @@ func (e RoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRole.%s: %s%s",
+		"invalid %sDeletePermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RoleValidationError{}
+var _ error = DeletePermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4066,73 +4114,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RoleValidationError{}
+} = DeletePermissionResponseValidationError{}
 
-// Validate checks the field values on CreateRoleRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateRoleRequest) Validate() error {
+// Validate checks the field values on ListPermissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPermissionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRoleRequest with the rules
+// ValidateAll checks the field values on ListPermissionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateRoleRequestMultiError, or nil if none found.
-func (m *CreateRoleRequest) ValidateAll() error {
+// ListPermissionsRequestMultiError, or nil if none found.
+func (m *ListPermissionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRoleRequest) validate(all bool) error {
+func (m *ListPermissionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRole()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRoleRequestValidationError{
-					field:  "Role",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRoleRequestValidationError{
-					field:  "Role",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateRoleRequestValidationError{
-				field:  "Role",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for BizId
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return CreateRoleRequestMultiError(errors)
+		return ListPermissionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRoleRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateRoleRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateRoleRequestMultiError []error
+// ListPermissionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPermissionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPermissionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRoleRequestMultiError) Error() string {
+func (m ListPermissionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4141,11 +4166,11 @@ func (m CreateRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRoleRequestMultiError) AllErrors() []error { return m }
+func (m ListPermissionsRequestMultiError) AllErrors() []error { return m }
 
-// CreateRoleRequestValidationError is the validation error returned by
-// CreateRoleRequest.Validate if the designated constraints aren't met.
-type CreateRoleRequestValidationError struct {
+// ListPermissionsRequestValidationError is the validation error returned by
+// ListPermissionsRequest.Validate if the designated constraints aren't met.
+type ListPermissionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4153,24 +4178,24 @@ type CreateRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRoleRequestValidationError) Field() string { return e.field }
+func (e ListPermissionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRoleRequestValidationError) Reason() string { return e.reason }
+func (e ListPermissionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRoleRequestValidationError) Cause() error { return e.cause }
+func (e ListPermissionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRoleRequestValidationError) Key() bool { return e.key }
+func (e ListPermissionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRoleRequestValidationError) ErrorName() string {
-	return "CreateRoleRequestValidationError"
+func (e ListPermissionsRequestValidationError) ErrorName() string {
+	return "ListPermissionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateRoleRequestValidationError) Error() string {
+func (e ListPermissionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4182,14 +4207,14 @@ func (e CreateRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRoleRequest.%s: %s%s",
+		"invalid %sListPermissionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRoleRequestValidationError{}
+var _ error = ListPermissionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4197,73 +4222,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRoleRequestValidationError{}
+} = ListPermissionsRequestValidationError{}
 
-// Validate checks the field values on CreateRoleResponse with the rules
+// Validate checks the field values on ListPermissionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRoleResponse) Validate() error {
+func (m *ListPermissionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListPermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateRoleResponseMultiError, or nil if none found.
-func (m *CreateRoleResponse) ValidateAll() error {
+// ListPermissionsResponseMultiError, or nil if none found.
+func (m *ListPermissionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRoleResponse) validate(all bool) error {
+func (m *ListPermissionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRole()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRoleResponseValidationError{
-					field:  "Role",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPermissionsResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPermissionsResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRoleResponseValidationError{
-					field:  "Role",
+				return ListPermissionsResponseValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateRoleResponseValidationError{
-				field:  "Role",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateRoleResponseMultiError(errors)
+		return ListPermissionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRoleResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateRoleResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CreateRoleResponseMultiError []error
+// ListPermissionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPermissionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPermissionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRoleResponseMultiError) Error() string {
+func (m ListPermissionsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4272,11 +4302,11 @@ func (m CreateRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRoleResponseMultiError) AllErrors() []error { return m }
+func (m ListPermissionsResponseMultiError) AllErrors() []error { return m }
 
-// CreateRoleResponseValidationError is the validation error returned by
-// CreateRoleResponse.Validate if the designated constraints aren't met.
-type CreateRoleResponseValidationError struct {
+// ListPermissionsResponseValidationError is the validation error returned by
+// ListPermissionsResponse.Validate if the designated constraints aren't met.
+type ListPermissionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4284,24 +4314,24 @@ type CreateRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRoleResponseValidationError) Field() string { return e.field }
+func (e ListPermissionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRoleResponseValidationError) Reason() string { return e.reason }
+func (e ListPermissionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRoleResponseValidationError) Cause() error { return e.cause }
+func (e ListPermissionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRoleResponseValidationError) Key() bool { return e.key }
+func (e ListPermissionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRoleResponseValidationError) ErrorName() string {
-	return "CreateRoleResponseValidationError"
+func (e ListPermissionsResponseValidationError) ErrorName() string {
+	return "ListPermissionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateRoleResponseValidationError) Error() string {
+func (e ListPermissionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4313,14 +4343,14 @@ func (e CreateRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRoleResponse.%s: %s%s",
+		"invalid %sListPermissionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRoleResponseValidationError{}
+var _ error = ListPermissionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4328,48 +4358,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRoleResponseValidationError{}
+} = ListPermissionsResponseValidationError{}
 
-// Validate checks the field values on GetRoleRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetRoleRequest) Validate() error {
+// Validate checks the field values on ActionDefinition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActionDefinition) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoleRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetRoleRequestMultiError,
-// or nil if none found.
-func (m *GetRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on ActionDefinition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActionDefinitionMultiError, or nil if none found.
+func (m *ActionDefinition) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoleRequest) validate(all bool) error {
+func (m *ActionDefinition) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	// no validation rules for BizId
 
-	// no validation rules for Id
+	// no validation rules for Name
+
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return GetRoleRequestMultiError(errors)
+		return ActionDefinitionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoleRequestMultiError is an error wrapping multiple validation errors
-// returned by GetRoleRequest.ValidateAll() if the designated constraints
+// ActionDefinitionMultiError is an error wrapping multiple validation errors
+// returned by ActionDefinition.ValidateAll() if the designated constraints
 // aren't met.
-type GetRoleRequestMultiError []error
+type ActionDefinitionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleRequestMultiError) Error() string {
+func (m ActionDefinitionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4378,11 +4412,11 @@ func (m GetRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleRequestMultiError) AllErrors() []error { return m }
+func (m ActionDefinitionMultiError) AllErrors() []error { return m }
 
-// GetRoleRequestValidationError is the validation error returned by
-// GetRoleRequest.Validate if the designated constraints aren't met.
-type GetRoleRequestValidationError struct {
+// ActionDefinitionValidationError is the validation error returned by
+// ActionDefinition.Validate if the designated constraints aren't met.
+type ActionDefinitionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4390,22 +4424,22 @@ type GetRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoleRequestValidationError) Field() string { return e.field }
+func (e ActionDefinitionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleRequestValidationError) Reason() string { return e.reason }
+func (e ActionDefinitionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleRequestValidationError) Cause() error { return e.cause }
+func (e ActionDefinitionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleRequestValidationError) Key() bool { return e.key }
+func (e ActionDefinitionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleRequestValidationError) ErrorName() string { return "GetRoleRequestValidationError" }
+func (e ActionDefinitionValidationError) ErrorName() string { return "ActionDefinitionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetRoleRequestValidationError) Error() string {
+func (e ActionDefinitionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4417,14 +4451,14 @@ func (e GetRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoleRequest.%s: %s%s",
+		"invalid %sActionDefinition.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleRequestValidationError{}
+var _ error = ActionDefinitionValidationError{}
 
 var _ interface {
 	Field() string
@@ -4432,24 +4466,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleRequestValidationError{}
+} = ActionDefinitionValidationError{}
 
-// Validate checks the field values on GetRoleResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetRoleResponse) Validate() error {
+// Validate checks the field values on CreateActionDefinitionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateActionDefinitionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetRoleResponseMultiError, or nil if none found.
-func (m *GetRoleResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateActionDefinitionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateActionDefinitionRequestMultiError, or nil if none found.
+func (m *CreateActionDefinitionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoleResponse) validate(all bool) error {
+func (m *CreateActionDefinitionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4457,28 +4491,28 @@ func (m *GetRoleResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetRole()).(type) {
+		switch v := interface{}(m.GetActionDefinition()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRoleResponseValidationError{
-					field:  "Role",
+				errors = append(errors, CreateActionDefinitionRequestValidationError{
+					field:  "ActionDefinition",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRoleResponseValidationError{
-					field:  "Role",
+				errors = append(errors, CreateActionDefinitionRequestValidationError{
+					field:  "ActionDefinition",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetActionDefinition()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetRoleResponseValidationError{
-				field:  "Role",
+			return CreateActionDefinitionRequestValidationError{
+				field:  "ActionDefinition",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4486,19 +4520,19 @@ func (m *GetRoleResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetRoleResponseMultiError(errors)
+		return CreateActionDefinitionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoleResponseMultiError is an error wrapping multiple validation errors
-// returned by GetRoleResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetRoleResponseMultiError []error
+// CreateActionDefinitionRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateActionDefinitionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateActionDefinitionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleResponseMultiError) Error() string {
+func (m CreateActionDefinitionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4507,11 +4541,12 @@ func (m GetRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleResponseMultiError) AllErrors() []error { return m }
+func (m CreateActionDefinitionRequestMultiError) AllErrors() []error { return m }
 
-// GetRoleResponseValidationError is the validation error returned by
-// GetRoleResponse.Validate if the designated constraints aren't met.
-type GetRoleResponseValidationError struct {
+// CreateActionDefinitionRequestValidationError is the validation error
+// returned by CreateActionDefinitionRequest.Validate if the designated
+// constraints aren't met.
+type CreateActionDefinitionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4519,22 +4554,24 @@ type GetRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoleResponseValidationError) Field() string { return e.field }
+func (e CreateActionDefinitionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleResponseValidationError) Reason() string { return e.reason }
+func (e CreateActionDefinitionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleResponseValidationError) Cause() error { return e.cause }
+func (e CreateActionDefinitionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleResponseValidationError) Key() bool { return e.key }
+func (e CreateActionDefinitionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleResponseValidationError) ErrorName() string { return "GetRoleResponseValidationError" }
+func (e CreateActionDefinitionRequestValidationError) ErrorName() string {
+	return "CreateActionDefinitionRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetRoleResponseValidationError) Error() string {
+func (e CreateActionDefinitionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4546,14 +4583,14 @@ func (e GetRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoleResponse.%s: %s%s",
+		"invalid %sCreateActionDefinitionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleResponseValidationError{}
+var _ error = CreateActionDefinitionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4561,24 +4598,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleResponseValidationError{}
+} = CreateActionDefinitionRequestValidationError{}
 
-// Validate checks the field values on UpdateRoleRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRoleRequest) Validate() error {
+// Validate checks the field values on CreateActionDefinitionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateActionDefinitionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRoleRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateRoleRequestMultiError, or nil if none found.
-func (m *UpdateRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateActionDefinitionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateActionDefinitionResponseMultiError, or nil if none found.
+func (m *CreateActionDefinitionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRoleRequest) validate(all bool) error {
+func (m *CreateActionDefinitionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4586,28 +4623,28 @@ func (m *UpdateRoleRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetRole()).(type) {
+		switch v := interface{}(m.GetActionDefinition()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateRoleRequestValidationError{
-					field:  "Role",
+				errors = append(errors, CreateActionDefinitionResponseValidationError{
+					field:  "ActionDefinition",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateRoleRequestValidationError{
-					field:  "Role",
+				errors = append(errors, CreateActionDefinitionResponseValidationError{
+					field:  "ActionDefinition",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetActionDefinition()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateRoleRequestValidationError{
-				field:  "Role",
+			return CreateActionDefinitionResponseValidationError{
+				field:  "ActionDefinition",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4615,19 +4652,19 @@ func (m *UpdateRoleRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateRoleRequestMultiError(errors)
+		return CreateActionDefinitionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRoleRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateRoleRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateRoleRequestMultiError []error
+// CreateActionDefinitionResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateActionDefinitionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateActionDefinitionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRoleRequestMultiError) Error() string {
+func (m CreateActionDefinitionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4636,11 +4673,12 @@ func (m UpdateRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRoleRequestMultiError) AllErrors() []error { return m }
+func (m CreateActionDefinitionResponseMultiError) AllErrors() []error { return m }
 
-// UpdateRoleRequestValidationError is the validation error returned by
-// UpdateRoleRequest.Validate if the designated constraints aren't met.
-type UpdateRoleRequestValidationError struct {
+// CreateActionDefinitionResponseValidationError is the validation error
+// returned by CreateActionDefinitionResponse.Validate if the designated
+// constraints aren't met.
+type CreateActionDefinitionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4648,24 +4686,24 @@ type UpdateRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRoleRequestValidationError) Field() string { return e.field }
+func (e CreateActionDefinitionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRoleRequestValidationError) Reason() string { return e.reason }
+func (e CreateActionDefinitionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRoleRequestValidationError) Cause() error { return e.cause }
+func (e CreateActionDefinitionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRoleRequestValidationError) Key() bool { return e.key }
+func (e CreateActionDefinitionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRoleRequestValidationError) ErrorName() string {
-	return "UpdateRoleRequestValidationError"
+func (e CreateActionDefinitionResponseValidationError) ErrorName() string {
+	return "CreateActionDefinitionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRoleRequestValidationError) Error() string {
+func (e CreateActionDefinitionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4677,14 +4715,14 @@ func (e UpdateRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRoleRequest.%s: %s%s",
+		"invalid %sCreateActionDefinitionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRoleRequestValidationError{}
+var _ error = CreateActionDefinitionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4692,46 +4730,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRoleRequestValidationError{}
+} = CreateActionDefinitionResponseValidationError{}
 
-// Validate checks the field values on UpdateRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetActionDefinitionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRoleResponse) Validate() error {
+func (m *GetActionDefinitionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetActionDefinitionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRoleResponseMultiError, or nil if none found.
-func (m *UpdateRoleResponse) ValidateAll() error {
+// GetActionDefinitionRequestMultiError, or nil if none found.
+func (m *GetActionDefinitionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRoleResponse) validate(all bool) error {
+func (m *GetActionDefinitionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for BizId
+
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return UpdateRoleResponseMultiError(errors)
+		return GetActionDefinitionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRoleResponseMultiError is an error wrapping multiple validation errors
-// returned by UpdateRoleResponse.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateRoleResponseMultiError []error
+// GetActionDefinitionRequestMultiError is an error wrapping multiple
+// validation errors returned by GetActionDefinitionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetActionDefinitionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRoleResponseMultiError) Error() string {
+func (m GetActionDefinitionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4740,11 +4780,11 @@ func (m UpdateRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRoleResponseMultiError) AllErrors() []error { return m }
+func (m GetActionDefinitionRequestMultiError) AllErrors() []error { return m }
 
-// UpdateRoleResponseValidationError is the validation error returned by
-// UpdateRoleResponse.Validate if the designated constraints aren't met.
-type UpdateRoleResponseValidationError struct {
+// GetActionDefinitionRequestValidationError is the validation error returned
+// by GetActionDefinitionRequest.Validate if the designated constraints aren't met.
+type GetActionDefinitionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4752,24 +4792,24 @@ type UpdateRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRoleResponseValidationError) Field() string { return e.field }
+func (e GetActionDefinitionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRoleResponseValidationError) Reason() string { return e.reason }
+func (e GetActionDefinitionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRoleResponseValidationError) Cause() error { return e.cause }
+func (e GetActionDefinitionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRoleResponseValidationError) Key() bool { return e.key }
+func (e GetActionDefinitionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRoleResponseValidationError) ErrorName() string {
-	return "UpdateRoleResponseValidationError"
+func (e GetActionDefinitionRequestValidationError) ErrorName() string {
+	return "GetActionDefinitionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRoleResponseValidationError) Error() string {
+func (e GetActionDefinitionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4781,14 +4821,14 @@ func (e UpdateRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRoleResponse.%s: %s%s",
+		"invalid %sGetActionDefinitionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRoleResponseValidationError{}
+var _ error = GetActionDefinitionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4796,48 +4836,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRoleResponseValidationError{}
+} = GetActionDefinitionRequestValidationError{}
 
-// Validate checks the field values on DeleteRoleRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRoleRequest) Validate() error {
+// Validate checks the field values on GetActionDefinitionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetActionDefinitionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRoleRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetActionDefinitionResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteRoleRequestMultiError, or nil if none found.
-func (m *DeleteRoleRequest) ValidateAll() error {
+// GetActionDefinitionResponseMultiError, or nil if none found.
+func (m *GetActionDefinitionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRoleRequest) validate(all bool) error {
+func (m *GetActionDefinitionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetActionDefinition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetActionDefinitionResponseValidationError{
+					field:  "ActionDefinition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetActionDefinitionResponseValidationError{
+					field:  "ActionDefinition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActionDefinition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetActionDefinitionResponseValidationError{
+				field:  "ActionDefinition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteRoleRequestMultiError(errors)
+		return GetActionDefinitionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRoleRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteRoleRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteRoleRequestMultiError []error
+// GetActionDefinitionResponseMultiError is an error wrapping multiple
+// validation errors returned by GetActionDefinitionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetActionDefinitionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRoleRequestMultiError) Error() string {
+func (m GetActionDefinitionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4846,11 +4911,12 @@ func (m DeleteRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRoleRequestMultiError) AllErrors() []error { return m }
+func (m GetActionDefinitionResponseMultiError) AllErrors() []error { return m }
 
-// DeleteRoleRequestValidationError is the validation error returned by
-// DeleteRoleRequest.Validate if the designated constraints aren't met.
-type DeleteRoleRequestValidationError struct {
+// GetActionDefinitionResponseValidationError is the validation error returned
+// by GetActionDefinitionResponse.Validate if the designated constraints
+// aren't met.
+type GetActionDefinitionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4858,24 +4924,24 @@ type DeleteRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRoleRequestValidationError) Field() string { return e.field }
+func (e GetActionDefinitionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRoleRequestValidationError) Reason() string { return e.reason }
+func (e GetActionDefinitionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRoleRequestValidationError) Cause() error { return e.cause }
+func (e GetActionDefinitionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRoleRequestValidationError) Key() bool { return e.key }
+func (e GetActionDefinitionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRoleRequestValidationError) ErrorName() string {
-	return "DeleteRoleRequestValidationError"
+func (e GetActionDefinitionResponseValidationError) ErrorName() string {
+	return "GetActionDefinitionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteRoleRequestValidationError) Error() string {
+func (e GetActionDefinitionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4887,14 +4953,14 @@ func (e DeleteRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRoleRequest.%s: %s%s",
+		"invalid %sGetActionDefinitionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRoleRequestValidationError{}
+var _ error = GetActionDefinitionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4902,46 +4968,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRoleRequestValidationError{}
+} = GetActionDefinitionResponseValidationError{}
 
-// Validate checks the field values on DeleteRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateActionDefinitionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRoleResponse) Validate() error {
+func (m *UpdateActionDefinitionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteRoleResponseMultiError, or nil if none found.
-func (m *DeleteRoleResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateActionDefinitionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateActionDefinitionRequestMultiError, or nil if none found.
+func (m *UpdateActionDefinitionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRoleResponse) validate(all bool) error {
+func (m *UpdateActionDefinitionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	if all {
+		switch v := interface{}(m.GetActionDefinition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateActionDefinitionRequestValidationError{
+					field:  "ActionDefinition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateActionDefinitionRequestValidationError{
+					field:  "ActionDefinition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActionDefinition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateActionDefinitionRequestValidationError{
+				field:  "ActionDefinition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteRoleResponseMultiError(errors)
+		return UpdateActionDefinitionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRoleResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteRoleResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteRoleResponseMultiError []error
+// UpdateActionDefinitionRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateActionDefinitionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateActionDefinitionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRoleResponseMultiError) Error() string {
+func (m UpdateActionDefinitionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4950,11 +5043,12 @@ func (m DeleteRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRoleResponseMultiError) AllErrors() []error { return m }
+func (m UpdateActionDefinitionRequestMultiError) AllErrors() []error { return m }
 
-// DeleteRoleResponseValidationError is the validation error returned by
-// DeleteRoleResponse.Validate if the designated constraints aren't met.
-type DeleteRoleResponseValidationError struct {
+// UpdateActionDefinitionRequestValidationError is the validation error
+// returned by UpdateActionDefinitionRequest.Validate if the designated
+// constraints aren't met.
+type UpdateActionDefinitionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4962,24 +5056,24 @@ type DeleteRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRoleResponseValidationError) Field() string { return e.field }
+func (e UpdateActionDefinitionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRoleResponseValidationError) Reason() string { return e.reason }
+func (e UpdateActionDefinitionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRoleResponseValidationError) Cause() error { return e.cause }
+func (e UpdateActionDefinitionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRoleResponseValidationError) Key() bool { return e.key }
+func (e UpdateActionDefinitionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRoleResponseValidationError) ErrorName() string {
-	return "DeleteRoleResponseValidationError"
+func (e UpdateActionDefinitionRequestValidationError) ErrorName() string {
+	return "UpdateActionDefinitionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteRoleResponseValidationError) Error() string {
+func (e UpdateActionDefinitionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4991,14 +5085,14 @@ func (e DeleteRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRoleResponse.%s: %s%s",
+		"invalid %sUpdateActionDefinitionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRoleResponseValidationError{}
+var _ error = UpdateActionDefinitionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5006,52 +5100,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRoleResponseValidationError{}
+} = UpdateActionDefinitionRequestValidationError{}
 
-// Validate checks the field values on ListRolesRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListRolesRequest) Validate() error {
+// Validate checks the field values on UpdateActionDefinitionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateActionDefinitionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRolesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListRolesRequestMultiError, or nil if none found.
-func (m *ListRolesRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateActionDefinitionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateActionDefinitionResponseMultiError, or nil if none found.
+func (m *UpdateActionDefinitionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRolesRequest) validate(all bool) error {
+func (m *UpdateActionDefinitionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
-	// no validation rules for Type
-
-	// no validation rules for Offset
-
-	// no validation rules for Limit
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return ListRolesRequestMultiError(errors)
+		return UpdateActionDefinitionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRolesRequestMultiError is an error wrapping multiple validation errors
-// returned by ListRolesRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListRolesRequestMultiError []error
+// UpdateActionDefinitionResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateActionDefinitionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateActionDefinitionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRolesRequestMultiError) Error() string {
+func (m UpdateActionDefinitionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5060,11 +5148,12 @@ func (m ListRolesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRolesRequestMultiError) AllErrors() []error { return m }
+func (m UpdateActionDefinitionResponseMultiError) AllErrors() []error { return m }
 
-// ListRolesRequestValidationError is the validation error returned by
-// ListRolesRequest.Validate if the designated constraints aren't met.
-type ListRolesRequestValidationError struct {
+// UpdateActionDefinitionResponseValidationError is the validation error
+// returned by UpdateActionDefinitionResponse.Validate if the designated
+// constraints aren't met.
+type UpdateActionDefinitionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5072,22 +5161,24 @@ type ListRolesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRolesRequestValidationError) Field() string { return e.field }
+func (e UpdateActionDefinitionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRolesRequestValidationError) Reason() string { return e.reason }
+func (e UpdateActionDefinitionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRolesRequestValidationError) Cause() error { return e.cause }
+func (e UpdateActionDefinitionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRolesRequestValidationError) Key() bool { return e.key }
+func (e UpdateActionDefinitionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRolesRequestValidationError) ErrorName() string { return "ListRolesRequestValidationError" }
+func (e UpdateActionDefinitionResponseValidationError) ErrorName() string {
+	return "UpdateActionDefinitionResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListRolesRequestValidationError) Error() string {
+func (e UpdateActionDefinitionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5099,14 +5190,14 @@ func (e ListRolesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRolesRequest.%s: %s%s",
+		"invalid %sUpdateActionDefinitionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRolesRequestValidationError{}
+var _ error = UpdateActionDefinitionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5114,78 +5205,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRolesRequestValidationError{}
+} = UpdateActionDefinitionResponseValidationError{}
 
-// Validate checks the field values on ListRolesResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListRolesResponse) Validate() error {
+// Validate checks the field values on DeleteActionDefinitionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteActionDefinitionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRolesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListRolesResponseMultiError, or nil if none found.
-func (m *ListRolesResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteActionDefinitionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteActionDefinitionRequestMultiError, or nil if none found.
+func (m *DeleteActionDefinitionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRolesResponse) validate(all bool) error {
+func (m *DeleteActionDefinitionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRoles() {
-		_, _ = idx, item
+	// no validation rules for BizId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListRolesResponseValidationError{
-						field:  fmt.Sprintf("Roles[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListRolesResponseValidationError{
-						field:  fmt.Sprintf("Roles[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListRolesResponseValidationError{
-					field:  fmt.Sprintf("Roles[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListRolesResponseMultiError(errors)
+		return DeleteActionDefinitionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRolesResponseMultiError is an error wrapping multiple validation errors
-// returned by ListRolesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListRolesResponseMultiError []error
+// DeleteActionDefinitionRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteActionDefinitionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteActionDefinitionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRolesResponseMultiError) Error() string {
+func (m DeleteActionDefinitionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5194,11 +5255,12 @@ func (m ListRolesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRolesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteActionDefinitionRequestMultiError) AllErrors() []error { return m }
 
-// ListRolesResponseValidationError is the validation error returned by
-// ListRolesResponse.Validate if the designated constraints aren't met.
-type ListRolesResponseValidationError struct {
+// DeleteActionDefinitionRequestValidationError is the validation error
+// returned by DeleteActionDefinitionRequest.Validate if the designated
+// constraints aren't met.
+type DeleteActionDefinitionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5206,24 +5268,24 @@ type ListRolesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRolesResponseValidationError) Field() string { return e.field }
+func (e DeleteActionDefinitionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRolesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteActionDefinitionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRolesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteActionDefinitionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRolesResponseValidationError) Key() bool { return e.key }
+func (e DeleteActionDefinitionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRolesResponseValidationError) ErrorName() string {
-	return "ListRolesResponseValidationError"
+func (e DeleteActionDefinitionRequestValidationError) ErrorName() string {
+	return "DeleteActionDefinitionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRolesResponseValidationError) Error() string {
+func (e DeleteActionDefinitionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5235,14 +5297,14 @@ func (e ListRolesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRolesResponse.%s: %s%s",
+		"invalid %sDeleteActionDefinitionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRolesResponseValidationError{}
+var _ error = DeleteActionDefinitionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5250,60 +5312,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRolesResponseValidationError{}
+} = DeleteActionDefinitionRequestValidationError{}
 
-// Validate checks the field values on RoleInclusion with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RoleInclusion) Validate() error {
+// Validate checks the field values on DeleteActionDefinitionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteActionDefinitionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RoleInclusion with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RoleInclusionMultiError, or
-// nil if none found.
-func (m *RoleInclusion) ValidateAll() error {
+// ValidateAll checks the field values on DeleteActionDefinitionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteActionDefinitionResponseMultiError, or nil if none found.
+func (m *DeleteActionDefinitionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RoleInclusion) validate(all bool) error {
+func (m *DeleteActionDefinitionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for BizId
-
-	// no validation rules for IncludingRoleId
-
-	// no validation rules for IncludingRoleType
-
-	// no validation rules for IncludingRoleName
-
-	// no validation rules for IncludedRoleId
-
-	// no validation rules for IncludedRoleType
-
-	// no validation rules for IncludedRoleName
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return RoleInclusionMultiError(errors)
+		return DeleteActionDefinitionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RoleInclusionMultiError is an error wrapping multiple validation errors
-// returned by RoleInclusion.ValidateAll() if the designated constraints
-// aren't met.
-type RoleInclusionMultiError []error
+// DeleteActionDefinitionResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteActionDefinitionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteActionDefinitionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RoleInclusionMultiError) Error() string {
+func (m DeleteActionDefinitionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5312,11 +5360,12 @@ func (m RoleInclusionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RoleInclusionMultiError) AllErrors() []error { return m }
+func (m DeleteActionDefinitionResponseMultiError) AllErrors() []error { return m }
 
-// RoleInclusionValidationError is the validation error returned by
-// RoleInclusion.Validate if the designated constraints aren't met.
-type RoleInclusionValidationError struct {
+// DeleteActionDefinitionResponseValidationError is the validation error
+// returned by DeleteActionDefinitionResponse.Validate if the designated
+// constraints aren't met.
+type DeleteActionDefinitionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5324,22 +5373,24 @@ type RoleInclusionValidationError struct {
 }
 
 // Field function returns field value.
-func (e RoleInclusionValidationError) Field() string { return e.field }
+func (e DeleteActionDefinitionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RoleInclusionValidationError) Reason() string { return e.reason }
+func (e DeleteActionDefinitionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RoleInclusionValidationError) Cause() error { return e.cause }
+func (e DeleteActionDefinitionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RoleInclusionValidationError) Key() bool { return e.key }
+func (e DeleteActionDefinitionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RoleInclusionValidationError) ErrorName() string { return "RoleInclusionValidationError" }
+func (e DeleteActionDefinitionResponseValidationError) ErrorName() string {
+	return "DeleteActionDefinitionResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RoleInclusionValidationError) Error() string {
+func (e DeleteActionDefinitionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5351,14 +5402,14 @@ func (e RoleInclusionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRoleInclusion.%s: %s%s",
+		"invalid %sDeleteActionDefinitionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RoleInclusionValidationError{}
+var _ error = DeleteActionDefinitionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5366,73 +5417,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RoleInclusionValidationError{}
+} = DeleteActionDefinitionResponseValidationError{}
 
-// Validate checks the field values on CreateRoleInclusionRequest with the
+// Validate checks the field values on ListActionDefinitionsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRoleInclusionRequest) Validate() error {
+func (m *ListActionDefinitionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRoleInclusionRequest with the
+// ValidateAll checks the field values on ListActionDefinitionsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateRoleInclusionRequestMultiError, or nil if none found.
-func (m *CreateRoleInclusionRequest) ValidateAll() error {
+// ListActionDefinitionsRequestMultiError, or nil if none found.
+func (m *ListActionDefinitionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRoleInclusionRequest) validate(all bool) error {
+func (m *ListActionDefinitionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRoleInclusion()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRoleInclusionRequestValidationError{
-					field:  "RoleInclusion",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRoleInclusionRequestValidationError{
-					field:  "RoleInclusion",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRoleInclusion()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateRoleInclusionRequestValidationError{
-				field:  "RoleInclusion",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for BizId
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return CreateRoleInclusionRequestMultiError(errors)
+		return ListActionDefinitionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRoleInclusionRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateRoleInclusionRequest.ValidateAll() if
+// ListActionDefinitionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListActionDefinitionsRequest.ValidateAll() if
 // the designated constraints aren't met.
-type CreateRoleInclusionRequestMultiError []error
+type ListActionDefinitionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRoleInclusionRequestMultiError) Error() string {
+func (m ListActionDefinitionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5441,11 +5469,12 @@ func (m CreateRoleInclusionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRoleInclusionRequestMultiError) AllErrors() []error { return m }
+func (m ListActionDefinitionsRequestMultiError) AllErrors() []error { return m }
 
-// CreateRoleInclusionRequestValidationError is the validation error returned
-// by CreateRoleInclusionRequest.Validate if the designated constraints aren't met.
-type CreateRoleInclusionRequestValidationError struct {
+// ListActionDefinitionsRequestValidationError is the validation error returned
+// by ListActionDefinitionsRequest.Validate if the designated constraints
+// aren't met.
+type ListActionDefinitionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5453,24 +5482,24 @@ type CreateRoleInclusionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRoleInclusionRequestValidationError) Field() string { return e.field }
+func (e ListActionDefinitionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRoleInclusionRequestValidationError) Reason() string { return e.reason }
+func (e ListActionDefinitionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRoleInclusionRequestValidationError) Cause() error { return e.cause }
+func (e ListActionDefinitionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRoleInclusionRequestValidationError) Key() bool { return e.key }
+func (e ListActionDefinitionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRoleInclusionRequestValidationError) ErrorName() string {
-	return "CreateRoleInclusionRequestValidationError"
+func (e ListActionDefinitionsRequestValidationError) ErrorName() string {
+	return "ListActionDefinitionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateRoleInclusionRequestValidationError) Error() string {
+func (e ListActionDefinitionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5482,14 +5511,14 @@ func (e CreateRoleInclusionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRoleInclusionRequest.%s: %s%s",
+		"invalid %sListActionDefinitionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRoleInclusionRequestValidationError{}
+var _ error = ListActionDefinitionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5497,73 +5526,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRoleInclusionRequestValidationError{}
+} = ListActionDefinitionsRequestValidationError{}
 
-// Validate checks the field values on CreateRoleInclusionResponse with the
+// Validate checks the field values on ListActionDefinitionsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateRoleInclusionResponse) Validate() error {
+func (m *ListActionDefinitionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateRoleInclusionResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateRoleInclusionResponseMultiError, or nil if none found.
-func (m *CreateRoleInclusionResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListActionDefinitionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListActionDefinitionsResponseMultiError, or nil if none found.
+func (m *ListActionDefinitionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateRoleInclusionResponse) validate(all bool) error {
+func (m *ListActionDefinitionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRoleInclusion()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateRoleInclusionResponseValidationError{
-					field:  "RoleInclusion",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetActionDefinitions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListActionDefinitionsResponseValidationError{
+						field:  fmt.Sprintf("ActionDefinitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListActionDefinitionsResponseValidationError{
+						field:  fmt.Sprintf("ActionDefinitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateRoleInclusionResponseValidationError{
-					field:  "RoleInclusion",
+				return ListActionDefinitionsResponseValidationError{
+					field:  fmt.Sprintf("ActionDefinitions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRoleInclusion()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateRoleInclusionResponseValidationError{
-				field:  "RoleInclusion",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateRoleInclusionResponseMultiError(errors)
+		return ListActionDefinitionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateRoleInclusionResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateRoleInclusionResponse.ValidateAll() if
-// the designated constraints aren't met.
-type CreateRoleInclusionResponseMultiError []error
+// ListActionDefinitionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListActionDefinitionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListActionDefinitionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateRoleInclusionResponseMultiError) Error() string {
+func (m ListActionDefinitionsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5572,12 +5606,12 @@ func (m CreateRoleInclusionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateRoleInclusionResponseMultiError) AllErrors() []error { return m }
+func (m ListActionDefinitionsResponseMultiError) AllErrors() []error { return m }
 
-// CreateRoleInclusionResponseValidationError is the validation error returned
-// by CreateRoleInclusionResponse.Validate if the designated constraints
-// aren't met.
-type CreateRoleInclusionResponseValidationError struct {
+// ListActionDefinitionsResponseValidationError is the validation error
+// returned by ListActionDefinitionsResponse.Validate if the designated
+// constraints aren't met.
+type ListActionDefinitionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5585,24 +5619,24 @@ type CreateRoleInclusionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateRoleInclusionResponseValidationError) Field() string { return e.field }
+func (e ListActionDefinitionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateRoleInclusionResponseValidationError) Reason() string { return e.reason }
+func (e ListActionDefinitionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateRoleInclusionResponseValidationError) Cause() error { return e.cause }
+func (e ListActionDefinitionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateRoleInclusionResponseValidationError) Key() bool { return e.key }
+func (e ListActionDefinitionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateRoleInclusionResponseValidationError) ErrorName() string {
-	return "CreateRoleInclusionResponseValidationError"
+func (e ListActionDefinitionsResponseValidationError) ErrorName() string {
+	return "ListActionDefinitionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateRoleInclusionResponseValidationError) Error() string {
+func (e ListActionDefinitionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5614,14 +5648,14 @@ func (e CreateRoleInclusionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateRoleInclusionResponse.%s: %s%s",
+		"invalid %sListActionDefinitionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateRoleInclusionResponseValidationError{}
+var _ error = ListActionDefinitionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5629,48 +5663,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateRoleInclusionResponseValidationError{}
+} = ListActionDefinitionsResponseValidationError{}
 
-// Validate checks the field values on GetRoleInclusionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRoleInclusionRequest) Validate() error {
+// Validate checks the field values on Role with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Role) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoleInclusionRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetRoleInclusionRequestMultiError, or nil if none found.
-func (m *GetRoleInclusionRequest) ValidateAll() error {
+// ValidateAll checks the field values on Role with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RoleMultiError, or nil if none found.
+func (m *Role) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoleInclusionRequest) validate(all bool) error {
+func (m *Role) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	// no validation rules for BizId
 
-	// no validation rules for Id
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Metadata
+
+	// no validation rules for MaxMembers
+
+	// no validation rules for MaxRolesPerUser
 
 	if len(errors) > 0 {
-		return GetRoleInclusionRequestMultiError(errors)
+		return RoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoleInclusionRequestMultiError is an error wrapping multiple validation
-// errors returned by GetRoleInclusionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetRoleInclusionRequestMultiError []error
+// RoleMultiError is an error wrapping multiple validation errors returned by
+// Role.ValidateAll() if the designated constraints aren't met.
+type RoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleInclusionRequestMultiError) Error() string {
+func (m RoleMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5679,36 +5723,34 @@ func (m GetRoleInclusionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleInclusionRequestMultiError) AllErrors() []error { return m }
+func (m RoleMultiError) AllErrors() []error { return m }
 
-// GetRoleInclusionRequestValidationError is the validation error returned by
-// GetRoleInclusionRequest.Validate if the designated constraints aren't met.
-type GetRoleInclusionRequestValidationError struct {
-	field  string
+// RoleValidationError is the validation error returned by Role.Validate if the
+// designated constraints aren't met.
+type RoleValidationError struct {
+	field  string
 	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e GetRoleInclusionRequestValidationError) Field() string { return e.field }
+func (e RoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleInclusionRequestValidationError) Reason() string { return e.reason }
+func (e RoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleInclusionRequestValidationError) Cause() error { return e.cause }
+func (e RoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleInclusionRequestValidationError) Key() bool { return e.key }
+func (e RoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleInclusionRequestValidationError) ErrorName() string {
-	return "GetRoleInclusionRequestValidationError"
-}
+func (e RoleValidationError) ErrorName() string { return "RoleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetRoleInclusionRequestValidationError) Error() string {
+func (e RoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5720,14 +5762,14 @@ func (e GetRoleInclusionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoleInclusionRequest.%s: %s%s",
+		"invalid %sRole.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleInclusionRequestValidationError{}
+var _ error = RoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -5735,24 +5777,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleInclusionRequestValidationError{}
+} = RoleValidationError{}
 
-// Validate checks the field values on GetRoleInclusionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRoleInclusionResponse) Validate() error {
+// Validate checks the field values on CreateRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoleInclusionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRoleInclusionResponseMultiError, or nil if none found.
-func (m *GetRoleInclusionResponse) ValidateAll() error {
+// CreateRoleRequestMultiError, or nil if none found.
+func (m *CreateRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoleInclusionResponse) validate(all bool) error {
+func (m *CreateRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5760,28 +5802,28 @@ func (m *GetRoleInclusionResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetRoleInclusion()).(type) {
+		switch v := interface{}(m.GetRole()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRoleInclusionResponseValidationError{
-					field:  "RoleInclusion",
+				errors = append(errors, CreateRoleRequestValidationError{
+					field:  "Role",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRoleInclusionResponseValidationError{
-					field:  "RoleInclusion",
+				errors = append(errors, CreateRoleRequestValidationError{
+					field:  "Role",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRoleInclusion()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetRoleInclusionResponseValidationError{
-				field:  "RoleInclusion",
+			return CreateRoleRequestValidationError{
+				field:  "Role",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5789,19 +5831,19 @@ func (m *GetRoleInclusionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetRoleInclusionResponseMultiError(errors)
+		return CreateRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoleInclusionResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRoleInclusionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetRoleInclusionResponseMultiError []error
+// CreateRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleInclusionResponseMultiError) Error() string {
+func (m CreateRoleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5810,11 +5852,11 @@ func (m GetRoleInclusionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleInclusionResponseMultiError) AllErrors() []error { return m }
+func (m CreateRoleRequestMultiError) AllErrors() []error { return m }
 
-// GetRoleInclusionResponseValidationError is the validation error returned by
-// GetRoleInclusionResponse.Validate if the designated constraints aren't met.
-type GetRoleInclusionResponseValidationError struct {
+// CreateRoleRequestValidationError is the validation error returned by
+// CreateRoleRequest.Validate if the designated constraints aren't met.
+type CreateRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5822,24 +5864,24 @@ type GetRoleInclusionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoleInclusionResponseValidationError) Field() string { return e.field }
+func (e CreateRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleInclusionResponseValidationError) Reason() string { return e.reason }
+func (e CreateRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleInclusionResponseValidationError) Cause() error { return e.cause }
+func (e CreateRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleInclusionResponseValidationError) Key() bool { return e.key }
+func (e CreateRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleInclusionResponseValidationError) ErrorName() string {
-	return "GetRoleInclusionResponseValidationError"
+func (e CreateRoleRequestValidationError) ErrorName() string {
+	return "CreateRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRoleInclusionResponseValidationError) Error() string {
+func (e CreateRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5851,14 +5893,14 @@ func (e GetRoleInclusionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoleInclusionResponse.%s: %s%s",
+		"invalid %sCreateRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleInclusionResponseValidationError{}
+var _ error = CreateRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5866,48 +5908,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleInclusionResponseValidationError{}
+} = CreateRoleRequestValidationError{}
 
-// Validate checks the field values on DeleteRoleInclusionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRoleInclusionRequest) Validate() error {
+func (m *CreateRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRoleInclusionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteRoleInclusionRequestMultiError, or nil if none found.
-func (m *DeleteRoleInclusionRequest) ValidateAll() error {
+// CreateRoleResponseMultiError, or nil if none found.
+func (m *CreateRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRoleInclusionRequest) validate(all bool) error {
+func (m *CreateRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteRoleInclusionRequestMultiError(errors)
+		return CreateRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRoleInclusionRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteRoleInclusionRequest.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteRoleInclusionRequestMultiError []error
+// CreateRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRoleInclusionRequestMultiError) Error() string {
+func (m CreateRoleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5916,11 +5983,11 @@ func (m DeleteRoleInclusionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRoleInclusionRequestMultiError) AllErrors() []error { return m }
+func (m CreateRoleResponseMultiError) AllErrors() []error { return m }
 
-// DeleteRoleInclusionRequestValidationError is the validation error returned
-// by DeleteRoleInclusionRequest.Validate if the designated constraints aren't met.
-type DeleteRoleInclusionRequestValidationError struct {
+// CreateRoleResponseValidationError is the validation error returned by
+// CreateRoleResponse.Validate if the designated constraints aren't met.
+type CreateRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5928,24 +5995,24 @@ type DeleteRoleInclusionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRoleInclusionRequestValidationError) Field() string { return e.field }
+func (e CreateRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRoleInclusionRequestValidationError) Reason() string { return e.reason }
+func (e CreateRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRoleInclusionRequestValidationError) Cause() error { return e.cause }
+func (e CreateRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRoleInclusionRequestValidationError) Key() bool { return e.key }
+func (e CreateRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRoleInclusionRequestValidationError) ErrorName() string {
-	return "DeleteRoleInclusionRequestValidationError"
+func (e CreateRoleResponseValidationError) ErrorName() string {
+	return "CreateRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteRoleInclusionRequestValidationError) Error() string {
+func (e CreateRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5957,14 +6024,14 @@ func (e DeleteRoleInclusionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRoleInclusionRequest.%s: %s%s",
+		"invalid %sCreateRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRoleInclusionRequestValidationError{}
+var _ error = CreateRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5972,46 +6039,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRoleInclusionRequestValidationError{}
+} = CreateRoleResponseValidationError{}
 
-// Validate checks the field values on DeleteRoleInclusionResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteRoleInclusionResponse) Validate() error {
+// Validate checks the field values on GetRoleRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRoleInclusionResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteRoleInclusionResponseMultiError, or nil if none found.
-func (m *DeleteRoleInclusionResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRoleRequestMultiError,
+// or nil if none found.
+func (m *GetRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRoleInclusionResponse) validate(all bool) error {
+func (m *GetRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for BizId
+
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return DeleteRoleInclusionResponseMultiError(errors)
+		return GetRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRoleInclusionResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteRoleInclusionResponse.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteRoleInclusionResponseMultiError []error
+// GetRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRoleInclusionResponseMultiError) Error() string {
+func (m GetRoleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6020,12 +6089,11 @@ func (m DeleteRoleInclusionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRoleInclusionResponseMultiError) AllErrors() []error { return m }
+func (m GetRoleRequestMultiError) AllErrors() []error { return m }
 
-// DeleteRoleInclusionResponseValidationError is the validation error returned
-// by DeleteRoleInclusionResponse.Validate if the designated constraints
-// aren't met.
-type DeleteRoleInclusionResponseValidationError struct {
+// GetRoleRequestValidationError is the validation error returned by
+// GetRoleRequest.Validate if the designated constraints aren't met.
+type GetRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6033,24 +6101,22 @@ type DeleteRoleInclusionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRoleInclusionResponseValidationError) Field() string { return e.field }
+func (e GetRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRoleInclusionResponseValidationError) Reason() string { return e.reason }
+func (e GetRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRoleInclusionResponseValidationError) Cause() error { return e.cause }
+func (e GetRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRoleInclusionResponseValidationError) Key() bool { return e.key }
+func (e GetRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRoleInclusionResponseValidationError) ErrorName() string {
-	return "DeleteRoleInclusionResponseValidationError"
-}
+func (e GetRoleRequestValidationError) ErrorName() string { return "GetRoleRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteRoleInclusionResponseValidationError) Error() string {
+func (e GetRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6062,14 +6128,14 @@ func (e DeleteRoleInclusionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRoleInclusionResponse.%s: %s%s",
+		"invalid %sGetRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRoleInclusionResponseValidationError{}
+var _ error = GetRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6077,50 +6143,3423 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRoleInclusionResponseValidationError{}
+} = GetRoleRequestValidationError{}
 
-// Validate checks the field values on ListRoleInclusionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRoleInclusionsRequest) Validate() error {
-	return m.validate(false)
-}
+// Validate checks the field values on GetRoleResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleResponseMultiError, or nil if none found.
+func (m *GetRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRoleResponseValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRoleResponseValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by GetRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleResponseMultiError) AllErrors() []error { return m }
+
+// GetRoleResponseValidationError is the validation error returned by
+// GetRoleResponse.Validate if the designated constraints aren't met.
+type GetRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleResponseValidationError) ErrorName() string { return "GetRoleResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleResponseValidationError{}
+
+// Validate checks the field values on UpdateRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleRequestMultiError, or nil if none found.
+func (m *UpdateRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRoleRequestValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRoleRequestValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRoleRequestValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRoleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRoleRequestValidationError is the validation error returned by
+// UpdateRoleRequest.Validate if the designated constraints aren't met.
+type UpdateRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRoleRequestValidationError) ErrorName() string {
+	return "UpdateRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRoleRequestValidationError{}
+
+// Validate checks the field values on UpdateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleResponseMultiError, or nil if none found.
+func (m *UpdateRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return UpdateRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRoleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateRoleResponseValidationError is the validation error returned by
+// UpdateRoleResponse.Validate if the designated constraints aren't met.
+type UpdateRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRoleResponseValidationError) ErrorName() string {
+	return "UpdateRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRoleResponseValidationError{}
+
+// Validate checks the field values on DeleteRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleRequestMultiError, or nil if none found.
+func (m *DeleteRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRoleRequestValidationError is the validation error returned by
+// DeleteRoleRequest.Validate if the designated constraints aren't met.
+type DeleteRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleRequestValidationError) ErrorName() string {
+	return "DeleteRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleRequestValidationError{}
+
+// Validate checks the field values on DeleteRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleResponseMultiError, or nil if none found.
+func (m *DeleteRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteRoleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleResponseMultiError) AllErrors() []error { return m }
+
+// DeleteRoleResponseValidationError is the validation error returned by
+// DeleteRoleResponse.Validate if the designated constraints aren't met.
+type DeleteRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleResponseValidationError) ErrorName() string {
+	return "DeleteRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleResponseValidationError{}
+
+// Validate checks the field values on RoleUtilization with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RoleUtilization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleUtilization with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RoleUtilizationMultiError, or nil if none found.
+func (m *RoleUtilization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleUtilization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoleUtilizationValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoleUtilizationValidationError{
+					field:  "Role",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoleUtilizationValidationError{
+				field:  "Role",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Members
+
+	if len(errors) > 0 {
+		return RoleUtilizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleUtilizationMultiError is an error wrapping multiple validation errors
+// returned by RoleUtilization.ValidateAll() if the designated constraints
+// aren't met.
+type RoleUtilizationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleUtilizationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleUtilizationMultiError) AllErrors() []error { return m }
+
+// RoleUtilizationValidationError is the validation error returned by
+// RoleUtilization.Validate if the designated constraints aren't met.
+type RoleUtilizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleUtilizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleUtilizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleUtilizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleUtilizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleUtilizationValidationError) ErrorName() string { return "RoleUtilizationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleUtilizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleUtilization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleUtilizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleUtilizationValidationError{}
+
+// Validate checks the field values on ListRoleUtilizationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleUtilizationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleUtilizationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleUtilizationsRequestMultiError, or nil if none found.
+func (m *ListRoleUtilizationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleUtilizationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListRoleUtilizationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleUtilizationsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListRoleUtilizationsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListRoleUtilizationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleUtilizationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleUtilizationsRequestMultiError) AllErrors() []error { return m }
+
+// ListRoleUtilizationsRequestValidationError is the validation error returned
+// by ListRoleUtilizationsRequest.Validate if the designated constraints
+// aren't met.
+type ListRoleUtilizationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleUtilizationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleUtilizationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleUtilizationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleUtilizationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleUtilizationsRequestValidationError) ErrorName() string {
+	return "ListRoleUtilizationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleUtilizationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleUtilizationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleUtilizationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleUtilizationsRequestValidationError{}
+
+// Validate checks the field values on ListRoleUtilizationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleUtilizationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleUtilizationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleUtilizationsResponseMultiError, or nil if none found.
+func (m *ListRoleUtilizationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleUtilizationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUtilizations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRoleUtilizationsResponseValidationError{
+						field:  fmt.Sprintf("Utilizations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRoleUtilizationsResponseValidationError{
+						field:  fmt.Sprintf("Utilizations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRoleUtilizationsResponseValidationError{
+					field:  fmt.Sprintf("Utilizations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRoleUtilizationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleUtilizationsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRoleUtilizationsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRoleUtilizationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleUtilizationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleUtilizationsResponseMultiError) AllErrors() []error { return m }
+
+// ListRoleUtilizationsResponseValidationError is the validation error returned
+// by ListRoleUtilizationsResponse.Validate if the designated constraints
+// aren't met.
+type ListRoleUtilizationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleUtilizationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleUtilizationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleUtilizationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleUtilizationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleUtilizationsResponseValidationError) ErrorName() string {
+	return "ListRoleUtilizationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleUtilizationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleUtilizationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleUtilizationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleUtilizationsResponseValidationError{}
+
+// Validate checks the field values on ListRolesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRolesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRolesRequestMultiError, or nil if none found.
+func (m *ListRolesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRolesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for Type
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListRolesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRolesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRolesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRolesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRolesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRolesRequestMultiError) AllErrors() []error { return m }
+
+// ListRolesRequestValidationError is the validation error returned by
+// ListRolesRequest.Validate if the designated constraints aren't met.
+type ListRolesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRolesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRolesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRolesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRolesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRolesRequestValidationError) ErrorName() string { return "ListRolesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRolesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRolesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRolesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRolesRequestValidationError{}
+
+// Validate checks the field values on ListRolesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRolesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRolesResponseMultiError, or nil if none found.
+func (m *ListRolesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRolesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRolesResponseValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRolesResponseValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRolesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRolesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRolesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRolesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRolesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRolesResponseMultiError) AllErrors() []error { return m }
+
+// ListRolesResponseValidationError is the validation error returned by
+// ListRolesResponse.Validate if the designated constraints aren't met.
+type ListRolesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRolesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRolesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRolesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRolesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRolesResponseValidationError) ErrorName() string {
+	return "ListRolesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRolesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRolesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRolesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRolesResponseValidationError{}
+
+// Validate checks the field values on RoleInclusion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleInclusion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleInclusion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleInclusionMultiError, or
+// nil if none found.
+func (m *RoleInclusion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleInclusion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for BizId
+
+	// no validation rules for IncludingRoleId
+
+	// no validation rules for IncludingRoleType
+
+	// no validation rules for IncludingRoleName
+
+	// no validation rules for IncludedRoleId
+
+	// no validation rules for IncludedRoleType
+
+	// no validation rules for IncludedRoleName
+
+	if len(errors) > 0 {
+		return RoleInclusionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleInclusionMultiError is an error wrapping multiple validation errors
+// returned by RoleInclusion.ValidateAll() if the designated constraints
+// aren't met.
+type RoleInclusionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleInclusionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleInclusionMultiError) AllErrors() []error { return m }
+
+// RoleInclusionValidationError is the validation error returned by
+// RoleInclusion.Validate if the designated constraints aren't met.
+type RoleInclusionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleInclusionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleInclusionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleInclusionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleInclusionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleInclusionValidationError) ErrorName() string { return "RoleInclusionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleInclusionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleInclusion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleInclusionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleInclusionValidationError{}
+
+// Validate checks the field values on CreateRoleInclusionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleInclusionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoleInclusionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoleInclusionRequestMultiError, or nil if none found.
+func (m *CreateRoleInclusionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoleInclusionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRoleInclusion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoleInclusionRequestValidationError{
+					field:  "RoleInclusion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoleInclusionRequestValidationError{
+					field:  "RoleInclusion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoleInclusion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoleInclusionRequestValidationError{
+				field:  "RoleInclusion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRoleInclusionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoleInclusionRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateRoleInclusionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateRoleInclusionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoleInclusionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoleInclusionRequestMultiError) AllErrors() []error { return m }
+
+// CreateRoleInclusionRequestValidationError is the validation error returned
+// by CreateRoleInclusionRequest.Validate if the designated constraints aren't met.
+type CreateRoleInclusionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoleInclusionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoleInclusionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoleInclusionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoleInclusionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoleInclusionRequestValidationError) ErrorName() string {
+	return "CreateRoleInclusionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoleInclusionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoleInclusionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoleInclusionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoleInclusionRequestValidationError{}
+
+// Validate checks the field values on CreateRoleInclusionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoleInclusionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoleInclusionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoleInclusionResponseMultiError, or nil if none found.
+func (m *CreateRoleInclusionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoleInclusionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRoleInclusion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoleInclusionResponseValidationError{
+					field:  "RoleInclusion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoleInclusionResponseValidationError{
+					field:  "RoleInclusion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoleInclusion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoleInclusionResponseValidationError{
+				field:  "RoleInclusion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRoleInclusionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoleInclusionResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateRoleInclusionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateRoleInclusionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoleInclusionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoleInclusionResponseMultiError) AllErrors() []error { return m }
+
+// CreateRoleInclusionResponseValidationError is the validation error returned
+// by CreateRoleInclusionResponse.Validate if the designated constraints
+// aren't met.
+type CreateRoleInclusionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoleInclusionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoleInclusionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoleInclusionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoleInclusionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoleInclusionResponseValidationError) ErrorName() string {
+	return "CreateRoleInclusionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoleInclusionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoleInclusionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoleInclusionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoleInclusionResponseValidationError{}
+
+// Validate checks the field values on GetRoleInclusionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleInclusionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleInclusionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleInclusionRequestMultiError, or nil if none found.
+func (m *GetRoleInclusionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleInclusionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetRoleInclusionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoleInclusionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRoleInclusionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRoleInclusionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleInclusionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleInclusionRequestMultiError) AllErrors() []error { return m }
+
+// GetRoleInclusionRequestValidationError is the validation error returned by
+// GetRoleInclusionRequest.Validate if the designated constraints aren't met.
+type GetRoleInclusionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleInclusionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleInclusionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleInclusionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleInclusionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleInclusionRequestValidationError) ErrorName() string {
+	return "GetRoleInclusionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRoleInclusionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleInclusionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleInclusionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleInclusionRequestValidationError{}
+
+// Validate checks the field values on GetRoleInclusionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleInclusionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleInclusionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleInclusionResponseMultiError, or nil if none found.
+func (m *GetRoleInclusionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleInclusionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRoleInclusion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRoleInclusionResponseValidationError{
+					field:  "RoleInclusion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRoleInclusionResponseValidationError{
+					field:  "RoleInclusion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoleInclusion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRoleInclusionResponseValidationError{
+				field:  "RoleInclusion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRoleInclusionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoleInclusionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRoleInclusionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRoleInclusionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleInclusionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleInclusionResponseMultiError) AllErrors() []error { return m }
+
+// GetRoleInclusionResponseValidationError is the validation error returned by
+// GetRoleInclusionResponse.Validate if the designated constraints aren't met.
+type GetRoleInclusionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleInclusionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleInclusionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleInclusionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleInclusionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleInclusionResponseValidationError) ErrorName() string {
+	return "GetRoleInclusionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRoleInclusionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleInclusionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleInclusionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleInclusionResponseValidationError{}
+
+// Validate checks the field values on DeleteRoleInclusionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleInclusionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleInclusionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleInclusionRequestMultiError, or nil if none found.
+func (m *DeleteRoleInclusionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleInclusionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteRoleInclusionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleInclusionRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteRoleInclusionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteRoleInclusionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleInclusionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleInclusionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRoleInclusionRequestValidationError is the validation error returned
+// by DeleteRoleInclusionRequest.Validate if the designated constraints aren't met.
+type DeleteRoleInclusionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleInclusionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleInclusionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleInclusionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleInclusionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleInclusionRequestValidationError) ErrorName() string {
+	return "DeleteRoleInclusionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleInclusionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleInclusionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleInclusionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleInclusionRequestValidationError{}
+
+// Validate checks the field values on DeleteRoleInclusionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleInclusionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleInclusionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleInclusionResponseMultiError, or nil if none found.
+func (m *DeleteRoleInclusionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleInclusionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteRoleInclusionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleInclusionResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteRoleInclusionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteRoleInclusionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleInclusionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleInclusionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteRoleInclusionResponseValidationError is the validation error returned
+// by DeleteRoleInclusionResponse.Validate if the designated constraints
+// aren't met.
+type DeleteRoleInclusionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleInclusionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleInclusionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleInclusionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleInclusionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleInclusionResponseValidationError) ErrorName() string {
+	return "DeleteRoleInclusionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleInclusionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleInclusionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleInclusionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleInclusionResponseValidationError{}
+
+// Validate checks the field values on ListRoleInclusionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleInclusionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleInclusionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleInclusionsRequestMultiError, or nil if none found.
+func (m *ListRoleInclusionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleInclusionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListRoleInclusionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleInclusionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRoleInclusionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListRoleInclusionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleInclusionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleInclusionsRequestMultiError) AllErrors() []error { return m }
+
+// ListRoleInclusionsRequestValidationError is the validation error returned by
+// ListRoleInclusionsRequest.Validate if the designated constraints aren't met.
+type ListRoleInclusionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleInclusionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleInclusionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleInclusionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleInclusionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleInclusionsRequestValidationError) ErrorName() string {
+	return "ListRoleInclusionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleInclusionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleInclusionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleInclusionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleInclusionsRequestValidationError{}
+
+// Validate checks the field values on ListRoleInclusionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRoleInclusionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoleInclusionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoleInclusionsResponseMultiError, or nil if none found.
+func (m *ListRoleInclusionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoleInclusionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoleInclusions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRoleInclusionsResponseValidationError{
+						field:  fmt.Sprintf("RoleInclusions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRoleInclusionsResponseValidationError{
+						field:  fmt.Sprintf("RoleInclusions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRoleInclusionsResponseValidationError{
+					field:  fmt.Sprintf("RoleInclusions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRoleInclusionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoleInclusionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRoleInclusionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRoleInclusionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoleInclusionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoleInclusionsResponseMultiError) AllErrors() []error { return m }
+
+// ListRoleInclusionsResponseValidationError is the validation error returned
+// by ListRoleInclusionsResponse.Validate if the designated constraints aren't met.
+type ListRoleInclusionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoleInclusionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoleInclusionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoleInclusionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoleInclusionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoleInclusionsResponseValidationError) ErrorName() string {
+	return "ListRoleInclusionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoleInclusionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoleInclusionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoleInclusionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoleInclusionsResponseValidationError{}
+
+// Validate checks the field values on RolePermission with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RolePermission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolePermission with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RolePermissionMultiError,
+// or nil if none found.
+func (m *RolePermission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolePermission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for BizId
+
+	// no validation rules for RoleId
+
+	// no validation rules for PermissionId
+
+	// no validation rules for RoleName
+
+	// no validation rules for RoleType
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceKey
+
+	// no validation rules for PermissionAction
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Effect
+
+	if len(errors) > 0 {
+		return RolePermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolePermissionMultiError is an error wrapping multiple validation errors
+// returned by RolePermission.ValidateAll() if the designated constraints
+// aren't met.
+type RolePermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolePermissionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolePermissionMultiError) AllErrors() []error { return m }
+
+// RolePermissionValidationError is the validation error returned by
+// RolePermission.Validate if the designated constraints aren't met.
+type RolePermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolePermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolePermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolePermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolePermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolePermissionValidationError) ErrorName() string { return "RolePermissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolePermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolePermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolePermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolePermissionValidationError{}
+
+// Validate checks the field values on GrantRolePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantRolePermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantRolePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GrantRolePermissionRequestMultiError, or nil if none found.
+func (m *GrantRolePermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantRolePermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRolePermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantRolePermissionRequestValidationError{
+					field:  "RolePermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantRolePermissionRequestValidationError{
+					field:  "RolePermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRolePermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantRolePermissionRequestValidationError{
+				field:  "RolePermission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GrantRolePermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GrantRolePermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by GrantRolePermissionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GrantRolePermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantRolePermissionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantRolePermissionRequestMultiError) AllErrors() []error { return m }
+
+// GrantRolePermissionRequestValidationError is the validation error returned
+// by GrantRolePermissionRequest.Validate if the designated constraints aren't met.
+type GrantRolePermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantRolePermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantRolePermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantRolePermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantRolePermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantRolePermissionRequestValidationError) ErrorName() string {
+	return "GrantRolePermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantRolePermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantRolePermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantRolePermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantRolePermissionRequestValidationError{}
+
+// Validate checks the field values on GrantRolePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantRolePermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantRolePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GrantRolePermissionResponseMultiError, or nil if none found.
+func (m *GrantRolePermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantRolePermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRolePermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantRolePermissionResponseValidationError{
+					field:  "RolePermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantRolePermissionResponseValidationError{
+					field:  "RolePermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRolePermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantRolePermissionResponseValidationError{
+				field:  "RolePermission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GrantRolePermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GrantRolePermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by GrantRolePermissionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GrantRolePermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantRolePermissionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantRolePermissionResponseMultiError) AllErrors() []error { return m }
+
+// GrantRolePermissionResponseValidationError is the validation error returned
+// by GrantRolePermissionResponse.Validate if the designated constraints
+// aren't met.
+type GrantRolePermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantRolePermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantRolePermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantRolePermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantRolePermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantRolePermissionResponseValidationError) ErrorName() string {
+	return "GrantRolePermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantRolePermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantRolePermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantRolePermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantRolePermissionResponseValidationError{}
+
+// Validate checks the field values on RevokeRolePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeRolePermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeRolePermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeRolePermissionRequestMultiError, or nil if none found.
+func (m *RevokeRolePermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeRolePermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RevokeRolePermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeRolePermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeRolePermissionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeRolePermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRolePermissionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRolePermissionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeRolePermissionRequestValidationError is the validation error returned
+// by RevokeRolePermissionRequest.Validate if the designated constraints
+// aren't met.
+type RevokeRolePermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRolePermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRolePermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRolePermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRolePermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRolePermissionRequestValidationError) ErrorName() string {
+	return "RevokeRolePermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeRolePermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRolePermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRolePermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRolePermissionRequestValidationError{}
+
+// Validate checks the field values on RevokeRolePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeRolePermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeRolePermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeRolePermissionResponseMultiError, or nil if none found.
+func (m *RevokeRolePermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeRolePermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return RevokeRolePermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeRolePermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeRolePermissionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeRolePermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRolePermissionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRolePermissionResponseMultiError) AllErrors() []error { return m }
+
+// RevokeRolePermissionResponseValidationError is the validation error returned
+// by RevokeRolePermissionResponse.Validate if the designated constraints
+// aren't met.
+type RevokeRolePermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRolePermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRolePermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRolePermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRolePermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRolePermissionResponseValidationError) ErrorName() string {
+	return "RevokeRolePermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeRolePermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRolePermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRolePermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRolePermissionResponseValidationError{}
+
+// Validate checks the field values on ListRolePermissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRolePermissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRolePermissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRolePermissionsRequestMultiError, or nil if none found.
+func (m *ListRolePermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRolePermissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	if len(errors) > 0 {
+		return ListRolePermissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRolePermissionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListRolePermissionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListRolePermissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRolePermissionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRolePermissionsRequestMultiError) AllErrors() []error { return m }
+
+// ListRolePermissionsRequestValidationError is the validation error returned
+// by ListRolePermissionsRequest.Validate if the designated constraints aren't met.
+type ListRolePermissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRolePermissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRolePermissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRolePermissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRolePermissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRolePermissionsRequestValidationError) ErrorName() string {
+	return "ListRolePermissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRolePermissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRolePermissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRolePermissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRolePermissionsRequestValidationError{}
+
+// Validate checks the field values on ListRolePermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRolePermissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRolePermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRolePermissionsResponseMultiError, or nil if none found.
+func (m *ListRolePermissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRolePermissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRolePermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRolePermissionsResponseValidationError{
+						field:  fmt.Sprintf("RolePermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRolePermissionsResponseValidationError{
+						field:  fmt.Sprintf("RolePermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRolePermissionsResponseValidationError{
+					field:  fmt.Sprintf("RolePermissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRolePermissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRolePermissionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRolePermissionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRolePermissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRolePermissionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRolePermissionsResponseMultiError) AllErrors() []error { return m }
+
+// ListRolePermissionsResponseValidationError is the validation error returned
+// by ListRolePermissionsResponse.Validate if the designated constraints
+// aren't met.
+type ListRolePermissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRolePermissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRolePermissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRolePermissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRolePermissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRolePermissionsResponseValidationError) ErrorName() string {
+	return "ListRolePermissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRolePermissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRolePermissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRolePermissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRolePermissionsResponseValidationError{}
+
+// Validate checks the field values on UserRole with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserRole) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserRole with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserRoleMultiError, or nil
+// if none found.
+func (m *UserRole) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserRole) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for BizId
+
+	// no validation rules for UserId
+
+	// no validation rules for RoleId
+
+	// no validation rules for RoleName
+
+	// no validation rules for RoleType
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	if len(errors) > 0 {
+		return UserRoleMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserRoleMultiError is an error wrapping multiple validation errors returned
+// by UserRole.ValidateAll() if the designated constraints aren't met.
+type UserRoleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserRoleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserRoleMultiError) AllErrors() []error { return m }
+
+// UserRoleValidationError is the validation error returned by
+// UserRole.Validate if the designated constraints aren't met.
+type UserRoleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserRoleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserRoleValidationError) Reason() string { return e.reason }
 
-// ValidateAll checks the field values on ListRoleInclusionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Cause function returns cause value.
+func (e UserRoleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserRoleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserRoleValidationError) ErrorName() string { return "UserRoleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserRoleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserRole.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserRoleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserRoleValidationError{}
+
+// Validate checks the field values on GrantUserRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantUserRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantUserRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListRoleInclusionsRequestMultiError, or nil if none found.
-func (m *ListRoleInclusionsRequest) ValidateAll() error {
+// GrantUserRoleRequestMultiError, or nil if none found.
+func (m *GrantUserRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRoleInclusionsRequest) validate(all bool) error {
+func (m *GrantUserRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
+	if all {
+		switch v := interface{}(m.GetUserRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantUserRoleRequestValidationError{
+					field:  "UserRole",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantUserRoleRequestValidationError{
+					field:  "UserRole",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantUserRoleRequestValidationError{
+				field:  "UserRole",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Offset
+	if len(errors) > 0 {
+		return GrantUserRoleRequestMultiError(errors)
+	}
 
-	// no validation rules for Limit
+	return nil
+}
+
+// GrantUserRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by GrantUserRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GrantUserRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantUserRoleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantUserRoleRequestMultiError) AllErrors() []error { return m }
+
+// GrantUserRoleRequestValidationError is the validation error returned by
+// GrantUserRoleRequest.Validate if the designated constraints aren't met.
+type GrantUserRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantUserRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantUserRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantUserRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantUserRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantUserRoleRequestValidationError) ErrorName() string {
+	return "GrantUserRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GrantUserRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantUserRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantUserRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantUserRoleRequestValidationError{}
+
+// Validate checks the field values on GrantUserRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GrantUserRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantUserRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GrantUserRoleResponseMultiError, or nil if none found.
+func (m *GrantUserRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantUserRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUserRole()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantUserRoleResponseValidationError{
+					field:  "UserRole",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantUserRoleResponseValidationError{
+					field:  "UserRole",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserRole()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantUserRoleResponseValidationError{
+				field:  "UserRole",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListRoleInclusionsRequestMultiError(errors)
+		return GrantUserRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRoleInclusionsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListRoleInclusionsRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListRoleInclusionsRequestMultiError []error
+// GrantUserRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by GrantUserRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GrantUserRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRoleInclusionsRequestMultiError) Error() string {
+func (m GrantUserRoleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6129,11 +9568,11 @@ func (m ListRoleInclusionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRoleInclusionsRequestMultiError) AllErrors() []error { return m }
+func (m GrantUserRoleResponseMultiError) AllErrors() []error { return m }
 
-// ListRoleInclusionsRequestValidationError is the validation error returned by
-// ListRoleInclusionsRequest.Validate if the designated constraints aren't met.
-type ListRoleInclusionsRequestValidationError struct {
+// GrantUserRoleResponseValidationError is the validation error returned by
+// GrantUserRoleResponse.Validate if the designated constraints aren't met.
+type GrantUserRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6141,24 +9580,24 @@ type ListRoleInclusionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRoleInclusionsRequestValidationError) Field() string { return e.field }
+func (e GrantUserRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRoleInclusionsRequestValidationError) Reason() string { return e.reason }
+func (e GrantUserRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRoleInclusionsRequestValidationError) Cause() error { return e.cause }
+func (e GrantUserRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRoleInclusionsRequestValidationError) Key() bool { return e.key }
+func (e GrantUserRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRoleInclusionsRequestValidationError) ErrorName() string {
-	return "ListRoleInclusionsRequestValidationError"
+func (e GrantUserRoleResponseValidationError) ErrorName() string {
+	return "GrantUserRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRoleInclusionsRequestValidationError) Error() string {
+func (e GrantUserRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6170,14 +9609,14 @@ func (e ListRoleInclusionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRoleInclusionsRequest.%s: %s%s",
+		"invalid %sGrantUserRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRoleInclusionsRequestValidationError{}
+var _ error = GrantUserRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6185,78 +9624,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRoleInclusionsRequestValidationError{}
+} = GrantUserRoleResponseValidationError{}
 
-// Validate checks the field values on ListRoleInclusionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeUserRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRoleInclusionsResponse) Validate() error {
+func (m *RevokeUserRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRoleInclusionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeUserRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListRoleInclusionsResponseMultiError, or nil if none found.
-func (m *ListRoleInclusionsResponse) ValidateAll() error {
+// RevokeUserRoleRequestMultiError, or nil if none found.
+func (m *RevokeUserRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRoleInclusionsResponse) validate(all bool) error {
+func (m *RevokeUserRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRoleInclusions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListRoleInclusionsResponseValidationError{
-						field:  fmt.Sprintf("RoleInclusions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListRoleInclusionsResponseValidationError{
-						field:  fmt.Sprintf("RoleInclusions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListRoleInclusionsResponseValidationError{
-					field:  fmt.Sprintf("RoleInclusions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for BizId
 
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListRoleInclusionsResponseMultiError(errors)
+		return RevokeUserRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRoleInclusionsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListRoleInclusionsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListRoleInclusionsResponseMultiError []error
+// RevokeUserRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeUserRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeUserRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRoleInclusionsResponseMultiError) Error() string {
+func (m RevokeUserRoleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6265,11 +9674,11 @@ func (m ListRoleInclusionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRoleInclusionsResponseMultiError) AllErrors() []error { return m }
+func (m RevokeUserRoleRequestMultiError) AllErrors() []error { return m }
 
-// ListRoleInclusionsResponseValidationError is the validation error returned
-// by ListRoleInclusionsResponse.Validate if the designated constraints aren't met.
-type ListRoleInclusionsResponseValidationError struct {
+// RevokeUserRoleRequestValidationError is the validation error returned by
+// RevokeUserRoleRequest.Validate if the designated constraints aren't met.
+type RevokeUserRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6277,24 +9686,24 @@ type ListRoleInclusionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRoleInclusionsResponseValidationError) Field() string { return e.field }
+func (e RevokeUserRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRoleInclusionsResponseValidationError) Reason() string { return e.reason }
+func (e RevokeUserRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRoleInclusionsResponseValidationError) Cause() error { return e.cause }
+func (e RevokeUserRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRoleInclusionsResponseValidationError) Key() bool { return e.key }
+func (e RevokeUserRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRoleInclusionsResponseValidationError) ErrorName() string {
-	return "ListRoleInclusionsResponseValidationError"
+func (e RevokeUserRoleRequestValidationError) ErrorName() string {
+	return "RevokeUserRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRoleInclusionsResponseValidationError) Error() string {
+func (e RevokeUserRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6306,14 +9715,14 @@ func (e ListRoleInclusionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRoleInclusionsResponse.%s: %s%s",
+		"invalid %sRevokeUserRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRoleInclusionsResponseValidationError{}
+var _ error = RevokeUserRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6321,62 +9730,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRoleInclusionsResponseValidationError{}
+} = RevokeUserRoleRequestValidationError{}
 
-// Validate checks the field values on RolePermission with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RolePermission) Validate() error {
+// Validate checks the field values on RevokeUserRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeUserRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RolePermission with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RolePermissionMultiError,
-// or nil if none found.
-func (m *RolePermission) ValidateAll() error {
+// ValidateAll checks the field values on RevokeUserRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeUserRoleResponseMultiError, or nil if none found.
+func (m *RevokeUserRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RolePermission) validate(all bool) error {
+func (m *RevokeUserRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for BizId
-
-	// no validation rules for RoleId
-
-	// no validation rules for PermissionId
-
-	// no validation rules for RoleName
-
-	// no validation rules for RoleType
-
-	// no validation rules for ResourceType
-
-	// no validation rules for ResourceKey
-
-	// no validation rules for PermissionAction
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return RolePermissionMultiError(errors)
+		return RevokeUserRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RolePermissionMultiError is an error wrapping multiple validation errors
-// returned by RolePermission.ValidateAll() if the designated constraints
-// aren't met.
-type RolePermissionMultiError []error
+// RevokeUserRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeUserRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeUserRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RolePermissionMultiError) Error() string {
+func (m RevokeUserRoleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6385,11 +9778,11 @@ func (m RolePermissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RolePermissionMultiError) AllErrors() []error { return m }
+func (m RevokeUserRoleResponseMultiError) AllErrors() []error { return m }
 
-// RolePermissionValidationError is the validation error returned by
-// RolePermission.Validate if the designated constraints aren't met.
-type RolePermissionValidationError struct {
+// RevokeUserRoleResponseValidationError is the validation error returned by
+// RevokeUserRoleResponse.Validate if the designated constraints aren't met.
+type RevokeUserRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6397,22 +9790,24 @@ type RolePermissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e RolePermissionValidationError) Field() string { return e.field }
+func (e RevokeUserRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RolePermissionValidationError) Reason() string { return e.reason }
+func (e RevokeUserRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RolePermissionValidationError) Cause() error { return e.cause }
+func (e RevokeUserRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RolePermissionValidationError) Key() bool { return e.key }
+func (e RevokeUserRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RolePermissionValidationError) ErrorName() string { return "RolePermissionValidationError" }
+func (e RevokeUserRoleResponseValidationError) ErrorName() string {
+	return "RevokeUserRoleResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RolePermissionValidationError) Error() string {
+func (e RevokeUserRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6424,14 +9819,14 @@ func (e RolePermissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRolePermission.%s: %s%s",
+		"invalid %sRevokeUserRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RolePermissionValidationError{}
+var _ error = RevokeUserRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6439,73 +9834,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RolePermissionValidationError{}
+} = RevokeUserRoleResponseValidationError{}
 
-// Validate checks the field values on GrantRolePermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListUserRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GrantRolePermissionRequest) Validate() error {
+func (m *ListUserRolesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GrantRolePermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListUserRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GrantRolePermissionRequestMultiError, or nil if none found.
-func (m *GrantRolePermissionRequest) ValidateAll() error {
+// ListUserRolesRequestMultiError, or nil if none found.
+func (m *ListUserRolesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GrantRolePermissionRequest) validate(all bool) error {
+func (m *ListUserRolesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRolePermission()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GrantRolePermissionRequestValidationError{
-					field:  "RolePermission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GrantRolePermissionRequestValidationError{
-					field:  "RolePermission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRolePermission()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GrantRolePermissionRequestValidationError{
-				field:  "RolePermission",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for BizId
 
 	if len(errors) > 0 {
-		return GrantRolePermissionRequestMultiError(errors)
+		return ListUserRolesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GrantRolePermissionRequestMultiError is an error wrapping multiple
-// validation errors returned by GrantRolePermissionRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GrantRolePermissionRequestMultiError []error
+// ListUserRolesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserRolesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserRolesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GrantRolePermissionRequestMultiError) Error() string {
+func (m ListUserRolesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6514,11 +9882,11 @@ func (m GrantRolePermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GrantRolePermissionRequestMultiError) AllErrors() []error { return m }
+func (m ListUserRolesRequestMultiError) AllErrors() []error { return m }
 
-// GrantRolePermissionRequestValidationError is the validation error returned
-// by GrantRolePermissionRequest.Validate if the designated constraints aren't met.
-type GrantRolePermissionRequestValidationError struct {
+// ListUserRolesRequestValidationError is the validation error returned by
+// ListUserRolesRequest.Validate if the designated constraints aren't met.
+type ListUserRolesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6526,24 +9894,24 @@ type GrantRolePermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GrantRolePermissionRequestValidationError) Field() string { return e.field }
+func (e ListUserRolesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GrantRolePermissionRequestValidationError) Reason() string { return e.reason }
+func (e ListUserRolesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GrantRolePermissionRequestValidationError) Cause() error { return e.cause }
+func (e ListUserRolesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GrantRolePermissionRequestValidationError) Key() bool { return e.key }
+func (e ListUserRolesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GrantRolePermissionRequestValidationError) ErrorName() string {
-	return "GrantRolePermissionRequestValidationError"
+func (e ListUserRolesRequestValidationError) ErrorName() string {
+	return "ListUserRolesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GrantRolePermissionRequestValidationError) Error() string {
+func (e ListUserRolesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6555,14 +9923,14 @@ func (e GrantRolePermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGrantRolePermissionRequest.%s: %s%s",
+		"invalid %sListUserRolesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GrantRolePermissionRequestValidationError{}
+var _ error = ListUserRolesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6570,73 +9938,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GrantRolePermissionRequestValidationError{}
+} = ListUserRolesRequestValidationError{}
 
-// Validate checks the field values on GrantRolePermissionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListUserRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GrantRolePermissionResponse) Validate() error {
+func (m *ListUserRolesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GrantRolePermissionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListUserRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GrantRolePermissionResponseMultiError, or nil if none found.
-func (m *GrantRolePermissionResponse) ValidateAll() error {
+// ListUserRolesResponseMultiError, or nil if none found.
+func (m *ListUserRolesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GrantRolePermissionResponse) validate(all bool) error {
+func (m *ListUserRolesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRolePermission()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GrantRolePermissionResponseValidationError{
-					field:  "RolePermission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetUserRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserRolesResponseValidationError{
+						field:  fmt.Sprintf("UserRoles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserRolesResponseValidationError{
+						field:  fmt.Sprintf("UserRoles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GrantRolePermissionResponseValidationError{
-					field:  "RolePermission",
+				return ListUserRolesResponseValidationError{
+					field:  fmt.Sprintf("UserRoles[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRolePermission()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GrantRolePermissionResponseValidationError{
-				field:  "RolePermission",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GrantRolePermissionResponseMultiError(errors)
+		return ListUserRolesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GrantRolePermissionResponseMultiError is an error wrapping multiple
-// validation errors returned by GrantRolePermissionResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GrantRolePermissionResponseMultiError []error
+// ListUserRolesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUserRolesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserRolesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GrantRolePermissionResponseMultiError) Error() string {
+func (m ListUserRolesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6645,12 +10018,11 @@ func (m GrantRolePermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GrantRolePermissionResponseMultiError) AllErrors() []error { return m }
+func (m ListUserRolesResponseMultiError) AllErrors() []error { return m }
 
-// GrantRolePermissionResponseValidationError is the validation error returned
-// by GrantRolePermissionResponse.Validate if the designated constraints
-// aren't met.
-type GrantRolePermissionResponseValidationError struct {
+// ListUserRolesResponseValidationError is the validation error returned by
+// ListUserRolesResponse.Validate if the designated constraints aren't met.
+type ListUserRolesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6658,24 +10030,24 @@ type GrantRolePermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GrantRolePermissionResponseValidationError) Field() string { return e.field }
+func (e ListUserRolesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GrantRolePermissionResponseValidationError) Reason() string { return e.reason }
+func (e ListUserRolesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GrantRolePermissionResponseValidationError) Cause() error { return e.cause }
+func (e ListUserRolesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GrantRolePermissionResponseValidationError) Key() bool { return e.key }
+func (e ListUserRolesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GrantRolePermissionResponseValidationError) ErrorName() string {
-	return "GrantRolePermissionResponseValidationError"
+func (e ListUserRolesResponseValidationError) ErrorName() string {
+	return "ListUserRolesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GrantRolePermissionResponseValidationError) Error() string {
+func (e ListUserRolesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6687,14 +10059,14 @@ func (e GrantRolePermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGrantRolePermissionResponse.%s: %s%s",
+		"invalid %sListUserRolesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GrantRolePermissionResponseValidationError{}
+var _ error = ListUserRolesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6702,48 +10074,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GrantRolePermissionResponseValidationError{}
+} = ListUserRolesResponseValidationError{}
 
-// Validate checks the field values on RevokeRolePermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeRolePermissionRequest) Validate() error {
+// Validate checks the field values on UserPermission with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserPermission) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeRolePermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RevokeRolePermissionRequestMultiError, or nil if none found.
-func (m *RevokeRolePermissionRequest) ValidateAll() error {
+// ValidateAll checks the field values on UserPermission with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserPermissionMultiError,
+// or nil if none found.
+func (m *UserPermission) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeRolePermissionRequest) validate(all bool) error {
+func (m *UserPermission) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	// no validation rules for BizId
 
-	// no validation rules for Id
+	// no validation rules for UserId
+
+	// no validation rules for PermissionId
+
+	// no validation rules for PermissionName
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceKey
+
+	// no validation rules for PermissionAction
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Effect
 
 	if len(errors) > 0 {
-		return RevokeRolePermissionRequestMultiError(errors)
+		return UserPermissionMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeRolePermissionRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeRolePermissionRequest.ValidateAll() if
-// the designated constraints aren't met.
-type RevokeRolePermissionRequestMultiError []error
+// UserPermissionMultiError is an error wrapping multiple validation errors
+// returned by UserPermission.ValidateAll() if the designated constraints
+// aren't met.
+type UserPermissionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeRolePermissionRequestMultiError) Error() string {
+func (m UserPermissionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6752,12 +10142,11 @@ func (m RevokeRolePermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeRolePermissionRequestMultiError) AllErrors() []error { return m }
+func (m UserPermissionMultiError) AllErrors() []error { return m }
 
-// RevokeRolePermissionRequestValidationError is the validation error returned
-// by RevokeRolePermissionRequest.Validate if the designated constraints
-// aren't met.
-type RevokeRolePermissionRequestValidationError struct {
+// UserPermissionValidationError is the validation error returned by
+// UserPermission.Validate if the designated constraints aren't met.
+type UserPermissionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6765,24 +10154,22 @@ type RevokeRolePermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeRolePermissionRequestValidationError) Field() string { return e.field }
+func (e UserPermissionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeRolePermissionRequestValidationError) Reason() string { return e.reason }
+func (e UserPermissionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeRolePermissionRequestValidationError) Cause() error { return e.cause }
+func (e UserPermissionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeRolePermissionRequestValidationError) Key() bool { return e.key }
+func (e UserPermissionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeRolePermissionRequestValidationError) ErrorName() string {
-	return "RevokeRolePermissionRequestValidationError"
-}
+func (e UserPermissionValidationError) ErrorName() string { return "UserPermissionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RevokeRolePermissionRequestValidationError) Error() string {
+func (e UserPermissionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6794,14 +10181,14 @@ func (e RevokeRolePermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeRolePermissionRequest.%s: %s%s",
+		"invalid %sUserPermission.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeRolePermissionRequestValidationError{}
+var _ error = UserPermissionValidationError{}
 
 var _ interface {
 	Field() string
@@ -6809,46 +10196,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeRolePermissionRequestValidationError{}
+} = UserPermissionValidationError{}
 
-// Validate checks the field values on RevokeRolePermissionResponse with the
+// Validate checks the field values on GrantUserPermissionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeRolePermissionResponse) Validate() error {
+func (m *GrantUserPermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeRolePermissionResponse with the
+// ValidateAll checks the field values on GrantUserPermissionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RevokeRolePermissionResponseMultiError, or nil if none found.
-func (m *RevokeRolePermissionResponse) ValidateAll() error {
+// GrantUserPermissionRequestMultiError, or nil if none found.
+func (m *GrantUserPermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeRolePermissionResponse) validate(all bool) error {
+func (m *GrantUserPermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	if all {
+		switch v := interface{}(m.GetUserPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantUserPermissionRequestValidationError{
+					field:  "UserPermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantUserPermissionRequestValidationError{
+					field:  "UserPermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantUserPermissionRequestValidationError{
+				field:  "UserPermission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RevokeRolePermissionResponseMultiError(errors)
+		return GrantUserPermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeRolePermissionResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeRolePermissionResponse.ValidateAll() if
+// GrantUserPermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by GrantUserPermissionRequest.ValidateAll() if
 // the designated constraints aren't met.
-type RevokeRolePermissionResponseMultiError []error
+type GrantUserPermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeRolePermissionResponseMultiError) Error() string {
+func (m GrantUserPermissionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6857,12 +10271,11 @@ func (m RevokeRolePermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeRolePermissionResponseMultiError) AllErrors() []error { return m }
+func (m GrantUserPermissionRequestMultiError) AllErrors() []error { return m }
 
-// RevokeRolePermissionResponseValidationError is the validation error returned
-// by RevokeRolePermissionResponse.Validate if the designated constraints
-// aren't met.
-type RevokeRolePermissionResponseValidationError struct {
+// GrantUserPermissionRequestValidationError is the validation error returned
+// by GrantUserPermissionRequest.Validate if the designated constraints aren't met.
+type GrantUserPermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6870,24 +10283,24 @@ type RevokeRolePermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeRolePermissionResponseValidationError) Field() string { return e.field }
+func (e GrantUserPermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeRolePermissionResponseValidationError) Reason() string { return e.reason }
+func (e GrantUserPermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeRolePermissionResponseValidationError) Cause() error { return e.cause }
+func (e GrantUserPermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeRolePermissionResponseValidationError) Key() bool { return e.key }
+func (e GrantUserPermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeRolePermissionResponseValidationError) ErrorName() string {
-	return "RevokeRolePermissionResponseValidationError"
+func (e GrantUserPermissionRequestValidationError) ErrorName() string {
+	return "GrantUserPermissionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeRolePermissionResponseValidationError) Error() string {
+func (e GrantUserPermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6899,14 +10312,14 @@ func (e RevokeRolePermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeRolePermissionResponse.%s: %s%s",
+		"invalid %sGrantUserPermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeRolePermissionResponseValidationError{}
+var _ error = GrantUserPermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6914,46 +10327,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeRolePermissionResponseValidationError{}
+} = GrantUserPermissionRequestValidationError{}
 
-// Validate checks the field values on ListRolePermissionsRequest with the
+// Validate checks the field values on GrantUserPermissionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRolePermissionsRequest) Validate() error {
+func (m *GrantUserPermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRolePermissionsRequest with the
+// ValidateAll checks the field values on GrantUserPermissionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListRolePermissionsRequestMultiError, or nil if none found.
-func (m *ListRolePermissionsRequest) ValidateAll() error {
+// GrantUserPermissionResponseMultiError, or nil if none found.
+func (m *GrantUserPermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRolePermissionsRequest) validate(all bool) error {
+func (m *GrantUserPermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
+	if all {
+		switch v := interface{}(m.GetUserPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GrantUserPermissionResponseValidationError{
+					field:  "UserPermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GrantUserPermissionResponseValidationError{
+					field:  "UserPermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GrantUserPermissionResponseValidationError{
+				field:  "UserPermission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListRolePermissionsRequestMultiError(errors)
+		return GrantUserPermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRolePermissionsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListRolePermissionsRequest.ValidateAll() if
+// GrantUserPermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by GrantUserPermissionResponse.ValidateAll() if
 // the designated constraints aren't met.
-type ListRolePermissionsRequestMultiError []error
+type GrantUserPermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRolePermissionsRequestMultiError) Error() string {
+func (m GrantUserPermissionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6962,11 +10402,12 @@ func (m ListRolePermissionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRolePermissionsRequestMultiError) AllErrors() []error { return m }
+func (m GrantUserPermissionResponseMultiError) AllErrors() []error { return m }
 
-// ListRolePermissionsRequestValidationError is the validation error returned
-// by ListRolePermissionsRequest.Validate if the designated constraints aren't met.
-type ListRolePermissionsRequestValidationError struct {
+// GrantUserPermissionResponseValidationError is the validation error returned
+// by GrantUserPermissionResponse.Validate if the designated constraints
+// aren't met.
+type GrantUserPermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6974,24 +10415,24 @@ type ListRolePermissionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRolePermissionsRequestValidationError) Field() string { return e.field }
+func (e GrantUserPermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRolePermissionsRequestValidationError) Reason() string { return e.reason }
+func (e GrantUserPermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRolePermissionsRequestValidationError) Cause() error { return e.cause }
+func (e GrantUserPermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRolePermissionsRequestValidationError) Key() bool { return e.key }
+func (e GrantUserPermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRolePermissionsRequestValidationError) ErrorName() string {
-	return "ListRolePermissionsRequestValidationError"
+func (e GrantUserPermissionResponseValidationError) ErrorName() string {
+	return "GrantUserPermissionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRolePermissionsRequestValidationError) Error() string {
+func (e GrantUserPermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7003,14 +10444,14 @@ func (e ListRolePermissionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRolePermissionsRequest.%s: %s%s",
+		"invalid %sGrantUserPermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRolePermissionsRequestValidationError{}
+var _ error = GrantUserPermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7018,78 +10459,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRolePermissionsRequestValidationError{}
+} = GrantUserPermissionResponseValidationError{}
 
-// Validate checks the field values on ListRolePermissionsResponse with the
+// Validate checks the field values on RevokeUserPermissionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListRolePermissionsResponse) Validate() error {
+func (m *RevokeUserPermissionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListRolePermissionsResponse with the
+// ValidateAll checks the field values on RevokeUserPermissionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListRolePermissionsResponseMultiError, or nil if none found.
-func (m *ListRolePermissionsResponse) ValidateAll() error {
+// RevokeUserPermissionRequestMultiError, or nil if none found.
+func (m *RevokeUserPermissionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListRolePermissionsResponse) validate(all bool) error {
+func (m *RevokeUserPermissionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRolePermissions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListRolePermissionsResponseValidationError{
-						field:  fmt.Sprintf("RolePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListRolePermissionsResponseValidationError{
-						field:  fmt.Sprintf("RolePermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListRolePermissionsResponseValidationError{
-					field:  fmt.Sprintf("RolePermissions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for BizId
 
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListRolePermissionsResponseMultiError(errors)
+		return RevokeUserPermissionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListRolePermissionsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListRolePermissionsResponse.ValidateAll() if
+// RevokeUserPermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeUserPermissionRequest.ValidateAll() if
 // the designated constraints aren't met.
-type ListRolePermissionsResponseMultiError []error
+type RevokeUserPermissionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListRolePermissionsResponseMultiError) Error() string {
+func (m RevokeUserPermissionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7098,12 +10509,12 @@ func (m ListRolePermissionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListRolePermissionsResponseMultiError) AllErrors() []error { return m }
+func (m RevokeUserPermissionRequestMultiError) AllErrors() []error { return m }
 
-// ListRolePermissionsResponseValidationError is the validation error returned
-// by ListRolePermissionsResponse.Validate if the designated constraints
+// RevokeUserPermissionRequestValidationError is the validation error returned
+// by RevokeUserPermissionRequest.Validate if the designated constraints
 // aren't met.
-type ListRolePermissionsResponseValidationError struct {
+type RevokeUserPermissionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7111,24 +10522,24 @@ type ListRolePermissionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRolePermissionsResponseValidationError) Field() string { return e.field }
+func (e RevokeUserPermissionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRolePermissionsResponseValidationError) Reason() string { return e.reason }
+func (e RevokeUserPermissionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRolePermissionsResponseValidationError) Cause() error { return e.cause }
+func (e RevokeUserPermissionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRolePermissionsResponseValidationError) Key() bool { return e.key }
+func (e RevokeUserPermissionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRolePermissionsResponseValidationError) ErrorName() string {
-	return "ListRolePermissionsResponseValidationError"
+func (e RevokeUserPermissionRequestValidationError) ErrorName() string {
+	return "RevokeUserPermissionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRolePermissionsResponseValidationError) Error() string {
+func (e RevokeUserPermissionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7140,14 +10551,14 @@ func (e ListRolePermissionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRolePermissionsResponse.%s: %s%s",
+		"invalid %sRevokeUserPermissionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRolePermissionsResponseValidationError{}
+var _ error = RevokeUserPermissionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7155,59 +10566,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRolePermissionsResponseValidationError{}
+} = RevokeUserPermissionRequestValidationError{}
 
-// Validate checks the field values on UserRole with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UserRole) Validate() error {
+// Validate checks the field values on RevokeUserPermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeUserPermissionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserRole with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserRoleMultiError, or nil
-// if none found.
-func (m *UserRole) ValidateAll() error {
+// ValidateAll checks the field values on RevokeUserPermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeUserPermissionResponseMultiError, or nil if none found.
+func (m *RevokeUserPermissionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserRole) validate(all bool) error {
+func (m *RevokeUserPermissionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for BizId
-
-	// no validation rules for UserId
-
-	// no validation rules for RoleId
-
-	// no validation rules for RoleName
-
-	// no validation rules for RoleType
-
-	// no validation rules for StartTime
-
-	// no validation rules for EndTime
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return UserRoleMultiError(errors)
+		return RevokeUserPermissionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserRoleMultiError is an error wrapping multiple validation errors returned
-// by UserRole.ValidateAll() if the designated constraints aren't met.
-type UserRoleMultiError []error
+// RevokeUserPermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeUserPermissionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeUserPermissionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserRoleMultiError) Error() string {
+func (m RevokeUserPermissionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7216,11 +10614,12 @@ func (m UserRoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserRoleMultiError) AllErrors() []error { return m }
+func (m RevokeUserPermissionResponseMultiError) AllErrors() []error { return m }
 
-// UserRoleValidationError is the validation error returned by
-// UserRole.Validate if the designated constraints aren't met.
-type UserRoleValidationError struct {
+// RevokeUserPermissionResponseValidationError is the validation error returned
+// by RevokeUserPermissionResponse.Validate if the designated constraints
+// aren't met.
+type RevokeUserPermissionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7228,22 +10627,24 @@ type UserRoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserRoleValidationError) Field() string { return e.field }
+func (e RevokeUserPermissionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserRoleValidationError) Reason() string { return e.reason }
+func (e RevokeUserPermissionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserRoleValidationError) Cause() error { return e.cause }
+func (e RevokeUserPermissionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserRoleValidationError) Key() bool { return e.key }
+func (e RevokeUserPermissionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserRoleValidationError) ErrorName() string { return "UserRoleValidationError" }
+func (e RevokeUserPermissionResponseValidationError) ErrorName() string {
+	return "RevokeUserPermissionResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserRoleValidationError) Error() string {
+func (e RevokeUserPermissionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7255,14 +10656,14 @@ func (e UserRoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserRole.%s: %s%s",
+		"invalid %sRevokeUserPermissionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserRoleValidationError{}
+var _ error = RevokeUserPermissionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7270,73 +10671,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserRoleValidationError{}
+} = RevokeUserPermissionResponseValidationError{}
 
-// Validate checks the field values on GrantUserRoleRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListUserPermissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GrantUserRoleRequest) Validate() error {
+func (m *ListUserPermissionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GrantUserRoleRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListUserPermissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GrantUserRoleRequestMultiError, or nil if none found.
-func (m *GrantUserRoleRequest) ValidateAll() error {
+// ListUserPermissionsRequestMultiError, or nil if none found.
+func (m *ListUserPermissionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GrantUserRoleRequest) validate(all bool) error {
+func (m *ListUserPermissionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUserRole()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GrantUserRoleRequestValidationError{
-					field:  "UserRole",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GrantUserRoleRequestValidationError{
-					field:  "UserRole",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUserRole()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GrantUserRoleRequestValidationError{
-				field:  "UserRole",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for BizId
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return GrantUserRoleRequestMultiError(errors)
+		return ListUserPermissionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GrantUserRoleRequestMultiError is an error wrapping multiple validation
-// errors returned by GrantUserRoleRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GrantUserRoleRequestMultiError []error
+// ListUserPermissionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListUserPermissionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListUserPermissionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GrantUserRoleRequestMultiError) Error() string {
+func (m ListUserPermissionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7345,11 +10723,11 @@ func (m GrantUserRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GrantUserRoleRequestMultiError) AllErrors() []error { return m }
+func (m ListUserPermissionsRequestMultiError) AllErrors() []error { return m }
 
-// GrantUserRoleRequestValidationError is the validation error returned by
-// GrantUserRoleRequest.Validate if the designated constraints aren't met.
-type GrantUserRoleRequestValidationError struct {
+// ListUserPermissionsRequestValidationError is the validation error returned
+// by ListUserPermissionsRequest.Validate if the designated constraints aren't met.
+type ListUserPermissionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7357,24 +10735,24 @@ type GrantUserRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GrantUserRoleRequestValidationError) Field() string { return e.field }
+func (e ListUserPermissionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GrantUserRoleRequestValidationError) Reason() string { return e.reason }
+func (e ListUserPermissionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GrantUserRoleRequestValidationError) Cause() error { return e.cause }
+func (e ListUserPermissionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GrantUserRoleRequestValidationError) Key() bool { return e.key }
+func (e ListUserPermissionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GrantUserRoleRequestValidationError) ErrorName() string {
-	return "GrantUserRoleRequestValidationError"
+func (e ListUserPermissionsRequestValidationError) ErrorName() string {
+	return "ListUserPermissionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GrantUserRoleRequestValidationError) Error() string {
+func (e ListUserPermissionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7386,14 +10764,14 @@ func (e GrantUserRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGrantUserRoleRequest.%s: %s%s",
+		"invalid %sListUserPermissionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GrantUserRoleRequestValidationError{}
+var _ error = ListUserPermissionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7401,73 +10779,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GrantUserRoleRequestValidationError{}
+} = ListUserPermissionsRequestValidationError{}
 
-// Validate checks the field values on GrantUserRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListUserPermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GrantUserRoleResponse) Validate() error {
+func (m *ListUserPermissionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GrantUserRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListUserPermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GrantUserRoleResponseMultiError, or nil if none found.
-func (m *GrantUserRoleResponse) ValidateAll() error {
+// ListUserPermissionsResponseMultiError, or nil if none found.
+func (m *ListUserPermissionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GrantUserRoleResponse) validate(all bool) error {
+func (m *ListUserPermissionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUserRole()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GrantUserRoleResponseValidationError{
-					field:  "UserRole",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetUserPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserPermissionsResponseValidationError{
+						field:  fmt.Sprintf("UserPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserPermissionsResponseValidationError{
+						field:  fmt.Sprintf("UserPermissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GrantUserRoleResponseValidationError{
-					field:  "UserRole",
+				return ListUserPermissionsResponseValidationError{
+					field:  fmt.Sprintf("UserPermissions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUserRole()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GrantUserRoleResponseValidationError{
-				field:  "UserRole",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GrantUserRoleResponseMultiError(errors)
+		return ListUserPermissionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GrantUserRoleResponseMultiError is an error wrapping multiple validation
-// errors returned by GrantUserRoleResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GrantUserRoleResponseMultiError []error
+// ListUserPermissionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListUserPermissionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListUserPermissionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GrantUserRoleResponseMultiError) Error() string {
+func (m ListUserPermissionsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7476,11 +10859,12 @@ func (m GrantUserRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GrantUserRoleResponseMultiError) AllErrors() []error { return m }
+func (m ListUserPermissionsResponseMultiError) AllErrors() []error { return m }
 
-// GrantUserRoleResponseValidationError is the validation error returned by
-// GrantUserRoleResponse.Validate if the designated constraints aren't met.
-type GrantUserRoleResponseValidationError struct {
+// ListUserPermissionsResponseValidationError is the validation error returned
+// by ListUserPermissionsResponse.Validate if the designated constraints
+// aren't met.
+type ListUserPermissionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7488,24 +10872,24 @@ type GrantUserRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GrantUserRoleResponseValidationError) Field() string { return e.field }
+func (e ListUserPermissionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GrantUserRoleResponseValidationError) Reason() string { return e.reason }
+func (e ListUserPermissionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GrantUserRoleResponseValidationError) Cause() error { return e.cause }
+func (e ListUserPermissionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GrantUserRoleResponseValidationError) Key() bool { return e.key }
+func (e ListUserPermissionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GrantUserRoleResponseValidationError) ErrorName() string {
-	return "GrantUserRoleResponseValidationError"
+func (e ListUserPermissionsResponseValidationError) ErrorName() string {
+	return "ListUserPermissionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GrantUserRoleResponseValidationError) Error() string {
+func (e ListUserPermissionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7517,14 +10901,14 @@ func (e GrantUserRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGrantUserRoleResponse.%s: %s%s",
+		"invalid %sListUserPermissionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GrantUserRoleResponseValidationError{}
+var _ error = ListUserPermissionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7532,48 +10916,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GrantUserRoleResponseValidationError{}
+} = ListUserPermissionsResponseValidationError{}
 
-// Validate checks the field values on RevokeUserRoleRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeUserRoleRequest) Validate() error {
+// Validate checks the field values on SoDConstraint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SoDConstraint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeUserRoleRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RevokeUserRoleRequestMultiError, or nil if none found.
-func (m *RevokeUserRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on SoDConstraint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SoDConstraintMultiError, or
+// nil if none found.
+func (m *SoDConstraint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeUserRoleRequest) validate(all bool) error {
+func (m *SoDConstraint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	// no validation rules for BizId
 
-	// no validation rules for Id
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Type
 
 	if len(errors) > 0 {
-		return RevokeUserRoleRequestMultiError(errors)
+		return SoDConstraintMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeUserRoleRequestMultiError is an error wrapping multiple validation
-// errors returned by RevokeUserRoleRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RevokeUserRoleRequestMultiError []error
+// SoDConstraintMultiError is an error wrapping multiple validation errors
+// returned by SoDConstraint.ValidateAll() if the designated constraints
+// aren't met.
+type SoDConstraintMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeUserRoleRequestMultiError) Error() string {
+func (m SoDConstraintMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7582,11 +10972,11 @@ func (m RevokeUserRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeUserRoleRequestMultiError) AllErrors() []error { return m }
+func (m SoDConstraintMultiError) AllErrors() []error { return m }
 
-// RevokeUserRoleRequestValidationError is the validation error returned by
-// RevokeUserRoleRequest.Validate if the designated constraints aren't met.
-type RevokeUserRoleRequestValidationError struct {
+// SoDConstraintValidationError is the validation error returned by
+// SoDConstraint.Validate if the designated constraints aren't met.
+type SoDConstraintValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7594,24 +10984,22 @@ type RevokeUserRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeUserRoleRequestValidationError) Field() string { return e.field }
+func (e SoDConstraintValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeUserRoleRequestValidationError) Reason() string { return e.reason }
+func (e SoDConstraintValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeUserRoleRequestValidationError) Cause() error { return e.cause }
+func (e SoDConstraintValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeUserRoleRequestValidationError) Key() bool { return e.key }
+func (e SoDConstraintValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeUserRoleRequestValidationError) ErrorName() string {
-	return "RevokeUserRoleRequestValidationError"
-}
+func (e SoDConstraintValidationError) ErrorName() string { return "SoDConstraintValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RevokeUserRoleRequestValidationError) Error() string {
+func (e SoDConstraintValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7623,14 +11011,14 @@ func (e RevokeUserRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeUserRoleRequest.%s: %s%s",
+		"invalid %sSoDConstraint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeUserRoleRequestValidationError{}
+var _ error = SoDConstraintValidationError{}
 
 var _ interface {
 	Field() string
@@ -7638,46 +11026,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeUserRoleRequestValidationError{}
+} = SoDConstraintValidationError{}
 
-// Validate checks the field values on RevokeUserRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateSoDConstraintRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeUserRoleResponse) Validate() error {
+func (m *CreateSoDConstraintRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeUserRoleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateSoDConstraintRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RevokeUserRoleResponseMultiError, or nil if none found.
-func (m *RevokeUserRoleResponse) ValidateAll() error {
+// CreateSoDConstraintRequestMultiError, or nil if none found.
+func (m *CreateSoDConstraintRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeUserRoleResponse) validate(all bool) error {
+func (m *CreateSoDConstraintRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	if all {
+		switch v := interface{}(m.GetConstraint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSoDConstraintRequestValidationError{
+					field:  "Constraint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSoDConstraintRequestValidationError{
+					field:  "Constraint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConstraint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSoDConstraintRequestValidationError{
+				field:  "Constraint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RevokeUserRoleResponseMultiError(errors)
+		return CreateSoDConstraintRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeUserRoleResponseMultiError is an error wrapping multiple validation
-// errors returned by RevokeUserRoleResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RevokeUserRoleResponseMultiError []error
+// CreateSoDConstraintRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateSoDConstraintRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateSoDConstraintRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeUserRoleResponseMultiError) Error() string {
+func (m CreateSoDConstraintRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7686,11 +11101,11 @@ func (m RevokeUserRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeUserRoleResponseMultiError) AllErrors() []error { return m }
+func (m CreateSoDConstraintRequestMultiError) AllErrors() []error { return m }
 
-// RevokeUserRoleResponseValidationError is the validation error returned by
-// RevokeUserRoleResponse.Validate if the designated constraints aren't met.
-type RevokeUserRoleResponseValidationError struct {
+// CreateSoDConstraintRequestValidationError is the validation error returned
+// by CreateSoDConstraintRequest.Validate if the designated constraints aren't met.
+type CreateSoDConstraintRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7698,24 +11113,24 @@ type RevokeUserRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeUserRoleResponseValidationError) Field() string { return e.field }
+func (e CreateSoDConstraintRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeUserRoleResponseValidationError) Reason() string { return e.reason }
+func (e CreateSoDConstraintRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeUserRoleResponseValidationError) Cause() error { return e.cause }
+func (e CreateSoDConstraintRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeUserRoleResponseValidationError) Key() bool { return e.key }
+func (e CreateSoDConstraintRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeUserRoleResponseValidationError) ErrorName() string {
-	return "RevokeUserRoleResponseValidationError"
+func (e CreateSoDConstraintRequestValidationError) ErrorName() string {
+	return "CreateSoDConstraintRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeUserRoleResponseValidationError) Error() string {
+func (e CreateSoDConstraintRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7727,14 +11142,14 @@ func (e RevokeUserRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeUserRoleResponse.%s: %s%s",
+		"invalid %sCreateSoDConstraintRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeUserRoleResponseValidationError{}
+var _ error = CreateSoDConstraintRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7742,46 +11157,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeUserRoleResponseValidationError{}
+} = CreateSoDConstraintRequestValidationError{}
 
-// Validate checks the field values on ListUserRolesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateSoDConstraintResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListUserRolesRequest) Validate() error {
+func (m *CreateSoDConstraintResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUserRolesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateSoDConstraintResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListUserRolesRequestMultiError, or nil if none found.
-func (m *ListUserRolesRequest) ValidateAll() error {
+// CreateSoDConstraintResponseMultiError, or nil if none found.
+func (m *CreateSoDConstraintResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUserRolesRequest) validate(all bool) error {
+func (m *CreateSoDConstraintResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
+	if all {
+		switch v := interface{}(m.GetConstraint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSoDConstraintResponseValidationError{
+					field:  "Constraint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSoDConstraintResponseValidationError{
+					field:  "Constraint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConstraint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSoDConstraintResponseValidationError{
+				field:  "Constraint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListUserRolesRequestMultiError(errors)
+		return CreateSoDConstraintResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUserRolesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListUserRolesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListUserRolesRequestMultiError []error
+// CreateSoDConstraintResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateSoDConstraintResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateSoDConstraintResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUserRolesRequestMultiError) Error() string {
+func (m CreateSoDConstraintResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7790,11 +11232,12 @@ func (m ListUserRolesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUserRolesRequestMultiError) AllErrors() []error { return m }
+func (m CreateSoDConstraintResponseMultiError) AllErrors() []error { return m }
 
-// ListUserRolesRequestValidationError is the validation error returned by
-// ListUserRolesRequest.Validate if the designated constraints aren't met.
-type ListUserRolesRequestValidationError struct {
+// CreateSoDConstraintResponseValidationError is the validation error returned
+// by CreateSoDConstraintResponse.Validate if the designated constraints
+// aren't met.
+type CreateSoDConstraintResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7802,24 +11245,24 @@ type ListUserRolesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUserRolesRequestValidationError) Field() string { return e.field }
+func (e CreateSoDConstraintResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUserRolesRequestValidationError) Reason() string { return e.reason }
+func (e CreateSoDConstraintResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUserRolesRequestValidationError) Cause() error { return e.cause }
+func (e CreateSoDConstraintResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUserRolesRequestValidationError) Key() bool { return e.key }
+func (e CreateSoDConstraintResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUserRolesRequestValidationError) ErrorName() string {
-	return "ListUserRolesRequestValidationError"
+func (e CreateSoDConstraintResponseValidationError) ErrorName() string {
+	return "CreateSoDConstraintResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListUserRolesRequestValidationError) Error() string {
+func (e CreateSoDConstraintResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7831,14 +11274,14 @@ func (e ListUserRolesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUserRolesRequest.%s: %s%s",
+		"invalid %sCreateSoDConstraintResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUserRolesRequestValidationError{}
+var _ error = CreateSoDConstraintResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7846,78 +11289,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUserRolesRequestValidationError{}
+} = CreateSoDConstraintResponseValidationError{}
 
-// Validate checks the field values on ListUserRolesResponse with the rules
+// Validate checks the field values on GetSoDConstraintRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListUserRolesResponse) Validate() error {
+func (m *GetSoDConstraintRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUserRolesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetSoDConstraintRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListUserRolesResponseMultiError, or nil if none found.
-func (m *ListUserRolesResponse) ValidateAll() error {
+// GetSoDConstraintRequestMultiError, or nil if none found.
+func (m *GetSoDConstraintRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUserRolesResponse) validate(all bool) error {
+func (m *GetSoDConstraintRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUserRoles() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListUserRolesResponseValidationError{
-						field:  fmt.Sprintf("UserRoles[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListUserRolesResponseValidationError{
-						field:  fmt.Sprintf("UserRoles[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListUserRolesResponseValidationError{
-					field:  fmt.Sprintf("UserRoles[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for BizId
 
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return ListUserRolesResponseMultiError(errors)
+		return GetSoDConstraintRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUserRolesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListUserRolesResponse.ValidateAll() if the designated
+// GetSoDConstraintRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSoDConstraintRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListUserRolesResponseMultiError []error
+type GetSoDConstraintRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUserRolesResponseMultiError) Error() string {
+func (m GetSoDConstraintRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7926,11 +11339,11 @@ func (m ListUserRolesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUserRolesResponseMultiError) AllErrors() []error { return m }
+func (m GetSoDConstraintRequestMultiError) AllErrors() []error { return m }
 
-// ListUserRolesResponseValidationError is the validation error returned by
-// ListUserRolesResponse.Validate if the designated constraints aren't met.
-type ListUserRolesResponseValidationError struct {
+// GetSoDConstraintRequestValidationError is the validation error returned by
+// GetSoDConstraintRequest.Validate if the designated constraints aren't met.
+type GetSoDConstraintRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7938,24 +11351,24 @@ type ListUserRolesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUserRolesResponseValidationError) Field() string { return e.field }
+func (e GetSoDConstraintRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUserRolesResponseValidationError) Reason() string { return e.reason }
+func (e GetSoDConstraintRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUserRolesResponseValidationError) Cause() error { return e.cause }
+func (e GetSoDConstraintRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUserRolesResponseValidationError) Key() bool { return e.key }
+func (e GetSoDConstraintRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUserRolesResponseValidationError) ErrorName() string {
-	return "ListUserRolesResponseValidationError"
+func (e GetSoDConstraintRequestValidationError) ErrorName() string {
+	return "GetSoDConstraintRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListUserRolesResponseValidationError) Error() string {
+func (e GetSoDConstraintRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7967,14 +11380,14 @@ func (e ListUserRolesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUserRolesResponse.%s: %s%s",
+		"invalid %sGetSoDConstraintRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUserRolesResponseValidationError{}
+var _ error = GetSoDConstraintRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7982,66 +11395,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUserRolesResponseValidationError{}
+} = GetSoDConstraintRequestValidationError{}
 
-// Validate checks the field values on UserPermission with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UserPermission) Validate() error {
+// Validate checks the field values on GetSoDConstraintResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSoDConstraintResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserPermission with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserPermissionMultiError,
-// or nil if none found.
-func (m *UserPermission) ValidateAll() error {
+// ValidateAll checks the field values on GetSoDConstraintResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSoDConstraintResponseMultiError, or nil if none found.
+func (m *GetSoDConstraintResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserPermission) validate(all bool) error {
+func (m *GetSoDConstraintResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for BizId
-
-	// no validation rules for UserId
-
-	// no validation rules for PermissionId
-
-	// no validation rules for PermissionName
-
-	// no validation rules for ResourceType
-
-	// no validation rules for ResourceKey
-
-	// no validation rules for PermissionAction
-
-	// no validation rules for StartTime
-
-	// no validation rules for EndTime
-
-	// no validation rules for Effect
+	if all {
+		switch v := interface{}(m.GetConstraint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSoDConstraintResponseValidationError{
+					field:  "Constraint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSoDConstraintResponseValidationError{
+					field:  "Constraint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConstraint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSoDConstraintResponseValidationError{
+				field:  "Constraint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UserPermissionMultiError(errors)
+		return GetSoDConstraintResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserPermissionMultiError is an error wrapping multiple validation errors
-// returned by UserPermission.ValidateAll() if the designated constraints
-// aren't met.
-type UserPermissionMultiError []error
+// GetSoDConstraintResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSoDConstraintResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSoDConstraintResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserPermissionMultiError) Error() string {
+func (m GetSoDConstraintResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8050,11 +11470,11 @@ func (m UserPermissionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserPermissionMultiError) AllErrors() []error { return m }
+func (m GetSoDConstraintResponseMultiError) AllErrors() []error { return m }
 
-// UserPermissionValidationError is the validation error returned by
-// UserPermission.Validate if the designated constraints aren't met.
-type UserPermissionValidationError struct {
+// GetSoDConstraintResponseValidationError is the validation error returned by
+// GetSoDConstraintResponse.Validate if the designated constraints aren't met.
+type GetSoDConstraintResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8062,22 +11482,24 @@ type UserPermissionValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserPermissionValidationError) Field() string { return e.field }
+func (e GetSoDConstraintResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserPermissionValidationError) Reason() string { return e.reason }
+func (e GetSoDConstraintResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserPermissionValidationError) Cause() error { return e.cause }
+func (e GetSoDConstraintResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserPermissionValidationError) Key() bool { return e.key }
+func (e GetSoDConstraintResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserPermissionValidationError) ErrorName() string { return "UserPermissionValidationError" }
+func (e GetSoDConstraintResponseValidationError) ErrorName() string {
+	return "GetSoDConstraintResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserPermissionValidationError) Error() string {
+func (e GetSoDConstraintResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8089,14 +11511,14 @@ func (e UserPermissionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserPermission.%s: %s%s",
+		"invalid %sGetSoDConstraintResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserPermissionValidationError{}
+var _ error = GetSoDConstraintResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8104,73 +11526,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserPermissionValidationError{}
+} = GetSoDConstraintResponseValidationError{}
 
-// Validate checks the field values on GrantUserPermissionRequest with the
+// Validate checks the field values on DeleteSoDConstraintRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GrantUserPermissionRequest) Validate() error {
+func (m *DeleteSoDConstraintRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GrantUserPermissionRequest with the
+// ValidateAll checks the field values on DeleteSoDConstraintRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GrantUserPermissionRequestMultiError, or nil if none found.
-func (m *GrantUserPermissionRequest) ValidateAll() error {
+// DeleteSoDConstraintRequestMultiError, or nil if none found.
+func (m *DeleteSoDConstraintRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GrantUserPermissionRequest) validate(all bool) error {
+func (m *DeleteSoDConstraintRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUserPermission()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GrantUserPermissionRequestValidationError{
-					field:  "UserPermission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GrantUserPermissionRequestValidationError{
-					field:  "UserPermission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUserPermission()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GrantUserPermissionRequestValidationError{
-				field:  "UserPermission",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for BizId
+
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GrantUserPermissionRequestMultiError(errors)
+		return DeleteSoDConstraintRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GrantUserPermissionRequestMultiError is an error wrapping multiple
-// validation errors returned by GrantUserPermissionRequest.ValidateAll() if
+// DeleteSoDConstraintRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteSoDConstraintRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GrantUserPermissionRequestMultiError []error
+type DeleteSoDConstraintRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GrantUserPermissionRequestMultiError) Error() string {
+func (m DeleteSoDConstraintRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8179,11 +11576,11 @@ func (m GrantUserPermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GrantUserPermissionRequestMultiError) AllErrors() []error { return m }
+func (m DeleteSoDConstraintRequestMultiError) AllErrors() []error { return m }
 
-// GrantUserPermissionRequestValidationError is the validation error returned
-// by GrantUserPermissionRequest.Validate if the designated constraints aren't met.
-type GrantUserPermissionRequestValidationError struct {
+// DeleteSoDConstraintRequestValidationError is the validation error returned
+// by DeleteSoDConstraintRequest.Validate if the designated constraints aren't met.
+type DeleteSoDConstraintRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8191,24 +11588,24 @@ type GrantUserPermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GrantUserPermissionRequestValidationError) Field() string { return e.field }
+func (e DeleteSoDConstraintRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GrantUserPermissionRequestValidationError) Reason() string { return e.reason }
+func (e DeleteSoDConstraintRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GrantUserPermissionRequestValidationError) Cause() error { return e.cause }
+func (e DeleteSoDConstraintRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GrantUserPermissionRequestValidationError) Key() bool { return e.key }
+func (e DeleteSoDConstraintRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GrantUserPermissionRequestValidationError) ErrorName() string {
-	return "GrantUserPermissionRequestValidationError"
+func (e DeleteSoDConstraintRequestValidationError) ErrorName() string {
+	return "DeleteSoDConstraintRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GrantUserPermissionRequestValidationError) Error() string {
+func (e DeleteSoDConstraintRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8220,14 +11617,14 @@ func (e GrantUserPermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGrantUserPermissionRequest.%s: %s%s",
+		"invalid %sDeleteSoDConstraintRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GrantUserPermissionRequestValidationError{}
+var _ error = DeleteSoDConstraintRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8235,73 +11632,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GrantUserPermissionRequestValidationError{}
+} = DeleteSoDConstraintRequestValidationError{}
 
-// Validate checks the field values on GrantUserPermissionResponse with the
+// Validate checks the field values on DeleteSoDConstraintResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GrantUserPermissionResponse) Validate() error {
+func (m *DeleteSoDConstraintResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GrantUserPermissionResponse with the
+// ValidateAll checks the field values on DeleteSoDConstraintResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GrantUserPermissionResponseMultiError, or nil if none found.
-func (m *GrantUserPermissionResponse) ValidateAll() error {
+// DeleteSoDConstraintResponseMultiError, or nil if none found.
+func (m *DeleteSoDConstraintResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GrantUserPermissionResponse) validate(all bool) error {
+func (m *DeleteSoDConstraintResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUserPermission()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GrantUserPermissionResponseValidationError{
-					field:  "UserPermission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GrantUserPermissionResponseValidationError{
-					field:  "UserPermission",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUserPermission()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GrantUserPermissionResponseValidationError{
-				field:  "UserPermission",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return GrantUserPermissionResponseMultiError(errors)
+		return DeleteSoDConstraintResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GrantUserPermissionResponseMultiError is an error wrapping multiple
-// validation errors returned by GrantUserPermissionResponse.ValidateAll() if
+// DeleteSoDConstraintResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteSoDConstraintResponse.ValidateAll() if
 // the designated constraints aren't met.
-type GrantUserPermissionResponseMultiError []error
+type DeleteSoDConstraintResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GrantUserPermissionResponseMultiError) Error() string {
+func (m DeleteSoDConstraintResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8310,12 +11680,12 @@ func (m GrantUserPermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GrantUserPermissionResponseMultiError) AllErrors() []error { return m }
+func (m DeleteSoDConstraintResponseMultiError) AllErrors() []error { return m }
 
-// GrantUserPermissionResponseValidationError is the validation error returned
-// by GrantUserPermissionResponse.Validate if the designated constraints
+// DeleteSoDConstraintResponseValidationError is the validation error returned
+// by DeleteSoDConstraintResponse.Validate if the designated constraints
 // aren't met.
-type GrantUserPermissionResponseValidationError struct {
+type DeleteSoDConstraintResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8323,24 +11693,24 @@ type GrantUserPermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GrantUserPermissionResponseValidationError) Field() string { return e.field }
+func (e DeleteSoDConstraintResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GrantUserPermissionResponseValidationError) Reason() string { return e.reason }
+func (e DeleteSoDConstraintResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GrantUserPermissionResponseValidationError) Cause() error { return e.cause }
+func (e DeleteSoDConstraintResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GrantUserPermissionResponseValidationError) Key() bool { return e.key }
+func (e DeleteSoDConstraintResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GrantUserPermissionResponseValidationError) ErrorName() string {
-	return "GrantUserPermissionResponseValidationError"
+func (e DeleteSoDConstraintResponseValidationError) ErrorName() string {
+	return "DeleteSoDConstraintResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GrantUserPermissionResponseValidationError) Error() string {
+func (e DeleteSoDConstraintResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8352,14 +11722,14 @@ func (e GrantUserPermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGrantUserPermissionResponse.%s: %s%s",
+		"invalid %sDeleteSoDConstraintResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GrantUserPermissionResponseValidationError{}
+var _ error = DeleteSoDConstraintResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8367,24 +11737,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GrantUserPermissionResponseValidationError{}
+} = DeleteSoDConstraintResponseValidationError{}
 
-// Validate checks the field values on RevokeUserPermissionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListSoDConstraintsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeUserPermissionRequest) Validate() error {
+func (m *ListSoDConstraintsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeUserPermissionRequest with the
+// ValidateAll checks the field values on ListSoDConstraintsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RevokeUserPermissionRequestMultiError, or nil if none found.
-func (m *RevokeUserPermissionRequest) ValidateAll() error {
+// ListSoDConstraintsRequestMultiError, or nil if none found.
+func (m *ListSoDConstraintsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeUserPermissionRequest) validate(all bool) error {
+func (m *ListSoDConstraintsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8393,22 +11763,20 @@ func (m *RevokeUserPermissionRequest) validate(all bool) error {
 
 	// no validation rules for BizId
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return RevokeUserPermissionRequestMultiError(errors)
+		return ListSoDConstraintsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeUserPermissionRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeUserPermissionRequest.ValidateAll() if
-// the designated constraints aren't met.
-type RevokeUserPermissionRequestMultiError []error
+// ListSoDConstraintsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSoDConstraintsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListSoDConstraintsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeUserPermissionRequestMultiError) Error() string {
+func (m ListSoDConstraintsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8417,12 +11785,11 @@ func (m RevokeUserPermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeUserPermissionRequestMultiError) AllErrors() []error { return m }
+func (m ListSoDConstraintsRequestMultiError) AllErrors() []error { return m }
 
-// RevokeUserPermissionRequestValidationError is the validation error returned
-// by RevokeUserPermissionRequest.Validate if the designated constraints
-// aren't met.
-type RevokeUserPermissionRequestValidationError struct {
+// ListSoDConstraintsRequestValidationError is the validation error returned by
+// ListSoDConstraintsRequest.Validate if the designated constraints aren't met.
+type ListSoDConstraintsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8430,24 +11797,24 @@ type RevokeUserPermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeUserPermissionRequestValidationError) Field() string { return e.field }
+func (e ListSoDConstraintsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeUserPermissionRequestValidationError) Reason() string { return e.reason }
+func (e ListSoDConstraintsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeUserPermissionRequestValidationError) Cause() error { return e.cause }
+func (e ListSoDConstraintsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeUserPermissionRequestValidationError) Key() bool { return e.key }
+func (e ListSoDConstraintsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeUserPermissionRequestValidationError) ErrorName() string {
-	return "RevokeUserPermissionRequestValidationError"
+func (e ListSoDConstraintsRequestValidationError) ErrorName() string {
+	return "ListSoDConstraintsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeUserPermissionRequestValidationError) Error() string {
+func (e ListSoDConstraintsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8459,14 +11826,14 @@ func (e RevokeUserPermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeUserPermissionRequest.%s: %s%s",
+		"invalid %sListSoDConstraintsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeUserPermissionRequestValidationError{}
+var _ error = ListSoDConstraintsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8474,46 +11841,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeUserPermissionRequestValidationError{}
+} = ListSoDConstraintsRequestValidationError{}
 
-// Validate checks the field values on RevokeUserPermissionResponse with the
+// Validate checks the field values on ListSoDConstraintsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeUserPermissionResponse) Validate() error {
+func (m *ListSoDConstraintsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeUserPermissionResponse with the
+// ValidateAll checks the field values on ListSoDConstraintsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RevokeUserPermissionResponseMultiError, or nil if none found.
-func (m *RevokeUserPermissionResponse) ValidateAll() error {
+// ListSoDConstraintsResponseMultiError, or nil if none found.
+func (m *ListSoDConstraintsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeUserPermissionResponse) validate(all bool) error {
+func (m *ListSoDConstraintsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	for idx, item := range m.GetConstraints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSoDConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSoDConstraintsResponseValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSoDConstraintsResponseValidationError{
+					field:  fmt.Sprintf("Constraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return RevokeUserPermissionResponseMultiError(errors)
+		return ListSoDConstraintsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeUserPermissionResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeUserPermissionResponse.ValidateAll() if
+// ListSoDConstraintsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListSoDConstraintsResponse.ValidateAll() if
 // the designated constraints aren't met.
-type RevokeUserPermissionResponseMultiError []error
+type ListSoDConstraintsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeUserPermissionResponseMultiError) Error() string {
+func (m ListSoDConstraintsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8522,12 +11921,11 @@ func (m RevokeUserPermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeUserPermissionResponseMultiError) AllErrors() []error { return m }
+func (m ListSoDConstraintsResponseMultiError) AllErrors() []error { return m }
 
-// RevokeUserPermissionResponseValidationError is the validation error returned
-// by RevokeUserPermissionResponse.Validate if the designated constraints
-// aren't met.
-type RevokeUserPermissionResponseValidationError struct {
+// ListSoDConstraintsResponseValidationError is the validation error returned
+// by ListSoDConstraintsResponse.Validate if the designated constraints aren't met.
+type ListSoDConstraintsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8535,24 +11933,24 @@ type RevokeUserPermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeUserPermissionResponseValidationError) Field() string { return e.field }
+func (e ListSoDConstraintsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeUserPermissionResponseValidationError) Reason() string { return e.reason }
+func (e ListSoDConstraintsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeUserPermissionResponseValidationError) Cause() error { return e.cause }
+func (e ListSoDConstraintsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeUserPermissionResponseValidationError) Key() bool { return e.key }
+func (e ListSoDConstraintsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeUserPermissionResponseValidationError) ErrorName() string {
-	return "RevokeUserPermissionResponseValidationError"
+func (e ListSoDConstraintsResponseValidationError) ErrorName() string {
+	return "ListSoDConstraintsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeUserPermissionResponseValidationError) Error() string {
+func (e ListSoDConstraintsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8564,14 +11962,14 @@ func (e RevokeUserPermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeUserPermissionResponse.%s: %s%s",
+		"invalid %sListSoDConstraintsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeUserPermissionResponseValidationError{}
+var _ error = ListSoDConstraintsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8579,50 +11977,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeUserPermissionResponseValidationError{}
+} = ListSoDConstraintsResponseValidationError{}
 
-// Validate checks the field values on ListUserPermissionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ActivateRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListUserPermissionsRequest) Validate() error {
+func (m *ActivateRolesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUserPermissionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ActivateRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListUserPermissionsRequestMultiError, or nil if none found.
-func (m *ListUserPermissionsRequest) ValidateAll() error {
+// ActivateRolesRequestMultiError, or nil if none found.
+func (m *ActivateRolesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUserPermissionsRequest) validate(all bool) error {
+func (m *ActivateRolesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BizId
-
-	// no validation rules for Offset
-
-	// no validation rules for Limit
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
-		return ListUserPermissionsRequestMultiError(errors)
+		return ActivateRolesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUserPermissionsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListUserPermissionsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListUserPermissionsRequestMultiError []error
+// ActivateRolesRequestMultiError is an error wrapping multiple validation
+// errors returned by ActivateRolesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ActivateRolesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUserPermissionsRequestMultiError) Error() string {
+func (m ActivateRolesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8631,11 +12025,11 @@ func (m ListUserPermissionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUserPermissionsRequestMultiError) AllErrors() []error { return m }
+func (m ActivateRolesRequestMultiError) AllErrors() []error { return m }
 
-// ListUserPermissionsRequestValidationError is the validation error returned
-// by ListUserPermissionsRequest.Validate if the designated constraints aren't met.
-type ListUserPermissionsRequestValidationError struct {
+// ActivateRolesRequestValidationError is the validation error returned by
+// ActivateRolesRequest.Validate if the designated constraints aren't met.
+type ActivateRolesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8643,24 +12037,24 @@ type ListUserPermissionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUserPermissionsRequestValidationError) Field() string { return e.field }
+func (e ActivateRolesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUserPermissionsRequestValidationError) Reason() string { return e.reason }
+func (e ActivateRolesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUserPermissionsRequestValidationError) Cause() error { return e.cause }
+func (e ActivateRolesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUserPermissionsRequestValidationError) Key() bool { return e.key }
+func (e ActivateRolesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUserPermissionsRequestValidationError) ErrorName() string {
-	return "ListUserPermissionsRequestValidationError"
+func (e ActivateRolesRequestValidationError) ErrorName() string {
+	return "ActivateRolesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListUserPermissionsRequestValidationError) Error() string {
+func (e ActivateRolesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8672,14 +12066,14 @@ func (e ListUserPermissionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUserPermissionsRequest.%s: %s%s",
+		"invalid %sActivateRolesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUserPermissionsRequestValidationError{}
+var _ error = ActivateRolesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8687,78 +12081,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUserPermissionsRequestValidationError{}
+} = ActivateRolesRequestValidationError{}
 
-// Validate checks the field values on ListUserPermissionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ActivateRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListUserPermissionsResponse) Validate() error {
+func (m *ActivateRolesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUserPermissionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ActivateRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListUserPermissionsResponseMultiError, or nil if none found.
-func (m *ListUserPermissionsResponse) ValidateAll() error {
+// ActivateRolesResponseMultiError, or nil if none found.
+func (m *ActivateRolesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUserPermissionsResponse) validate(all bool) error {
+func (m *ActivateRolesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUserPermissions() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListUserPermissionsResponseValidationError{
-						field:  fmt.Sprintf("UserPermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListUserPermissionsResponseValidationError{
-						field:  fmt.Sprintf("UserPermissions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListUserPermissionsResponseValidationError{
-					field:  fmt.Sprintf("UserPermissions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListUserPermissionsResponseMultiError(errors)
+		return ActivateRolesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUserPermissionsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListUserPermissionsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListUserPermissionsResponseMultiError []error
+// ActivateRolesResponseMultiError is an error wrapping multiple validation
+// errors returned by ActivateRolesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ActivateRolesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUserPermissionsResponseMultiError) Error() string {
+func (m ActivateRolesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8767,12 +12127,11 @@ func (m ListUserPermissionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUserPermissionsResponseMultiError) AllErrors() []error { return m }
+func (m ActivateRolesResponseMultiError) AllErrors() []error { return m }
 
-// ListUserPermissionsResponseValidationError is the validation error returned
-// by ListUserPermissionsResponse.Validate if the designated constraints
-// aren't met.
-type ListUserPermissionsResponseValidationError struct {
+// ActivateRolesResponseValidationError is the validation error returned by
+// ActivateRolesResponse.Validate if the designated constraints aren't met.
+type ActivateRolesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8780,24 +12139,24 @@ type ListUserPermissionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUserPermissionsResponseValidationError) Field() string { return e.field }
+func (e ActivateRolesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUserPermissionsResponseValidationError) Reason() string { return e.reason }
+func (e ActivateRolesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUserPermissionsResponseValidationError) Cause() error { return e.cause }
+func (e ActivateRolesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUserPermissionsResponseValidationError) Key() bool { return e.key }
+func (e ActivateRolesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUserPermissionsResponseValidationError) ErrorName() string {
-	return "ListUserPermissionsResponseValidationError"
+func (e ActivateRolesResponseValidationError) ErrorName() string {
+	return "ActivateRolesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListUserPermissionsResponseValidationError) Error() string {
+func (e ActivateRolesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8809,14 +12168,14 @@ func (e ListUserPermissionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUserPermissionsResponse.%s: %s%s",
+		"invalid %sActivateRolesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUserPermissionsResponseValidationError{}
+var _ error = ActivateRolesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8824,4 +12183,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUserPermissionsResponseValidationError{}
+} = ActivateRolesResponseValidationError{}
@@ -20,40 +20,52 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RBACService_CreateBusinessConfig_FullMethodName = "/permission.v1.RBACService/CreateBusinessConfig"
-	RBACService_GetBusinessConfig_FullMethodName    = "/permission.v1.RBACService/GetBusinessConfig"
-	RBACService_UpdateBusinessConfig_FullMethodName = "/permission.v1.RBACService/UpdateBusinessConfig"
-	RBACService_DeleteBusinessConfig_FullMethodName = "/permission.v1.RBACService/DeleteBusinessConfig"
-	RBACService_ListBusinessConfigs_FullMethodName  = "/permission.v1.RBACService/ListBusinessConfigs"
-	RBACService_CreateResource_FullMethodName       = "/permission.v1.RBACService/CreateResource"
-	RBACService_GetResource_FullMethodName          = "/permission.v1.RBACService/GetResource"
-	RBACService_UpdateResource_FullMethodName       = "/permission.v1.RBACService/UpdateResource"
-	RBACService_DeleteResource_FullMethodName       = "/permission.v1.RBACService/DeleteResource"
-	RBACService_ListResources_FullMethodName        = "/permission.v1.RBACService/ListResources"
-	RBACService_CreatePermission_FullMethodName     = "/permission.v1.RBACService/CreatePermission"
-	RBACService_GetPermission_FullMethodName        = "/permission.v1.RBACService/GetPermission"
-	RBACService_UpdatePermission_FullMethodName     = "/permission.v1.RBACService/UpdatePermission"
-	RBACService_DeletePermission_FullMethodName     = "/permission.v1.RBACService/DeletePermission"
-	RBACService_ListPermissions_FullMethodName      = "/permission.v1.RBACService/ListPermissions"
-	RBACService_CreateRole_FullMethodName           = "/permission.v1.RBACService/CreateRole"
-	RBACService_GetRole_FullMethodName              = "/permission.v1.RBACService/GetRole"
-	RBACService_UpdateRole_FullMethodName           = "/permission.v1.RBACService/UpdateRole"
-	RBACService_DeleteRole_FullMethodName           = "/permission.v1.RBACService/DeleteRole"
-	RBACService_ListRoles_FullMethodName            = "/permission.v1.RBACService/ListRoles"
-	RBACService_CreateRoleInclusion_FullMethodName  = "/permission.v1.RBACService/CreateRoleInclusion"
-	RBACService_GetRoleInclusion_FullMethodName     = "/permission.v1.RBACService/GetRoleInclusion"
-	RBACService_DeleteRoleInclusion_FullMethodName  = "/permission.v1.RBACService/DeleteRoleInclusion"
-	RBACService_ListRoleInclusions_FullMethodName   = "/permission.v1.RBACService/ListRoleInclusions"
-	RBACService_GrantRolePermission_FullMethodName  = "/permission.v1.RBACService/GrantRolePermission"
-	RBACService_RevokeRolePermission_FullMethodName = "/permission.v1.RBACService/RevokeRolePermission"
-	RBACService_ListRolePermissions_FullMethodName  = "/permission.v1.RBACService/ListRolePermissions"
-	RBACService_GrantUserRole_FullMethodName        = "/permission.v1.RBACService/GrantUserRole"
-	RBACService_RevokeUserRole_FullMethodName       = "/permission.v1.RBACService/RevokeUserRole"
-	RBACService_ListUserRoles_FullMethodName        = "/permission.v1.RBACService/ListUserRoles"
-	RBACService_GrantUserPermission_FullMethodName  = "/permission.v1.RBACService/GrantUserPermission"
-	RBACService_RevokeUserPermission_FullMethodName = "/permission.v1.RBACService/RevokeUserPermission"
-	RBACService_ListUserPermissions_FullMethodName  = "/permission.v1.RBACService/ListUserPermissions"
-	RBACService_GetAllPermissions_FullMethodName    = "/permission.v1.RBACService/GetAllPermissions"
+	RBACService_CreateBusinessConfig_FullMethodName       = "/permission.v1.RBACService/CreateBusinessConfig"
+	RBACService_GetBusinessConfig_FullMethodName          = "/permission.v1.RBACService/GetBusinessConfig"
+	RBACService_UpdateBusinessConfig_FullMethodName       = "/permission.v1.RBACService/UpdateBusinessConfig"
+	RBACService_DeleteBusinessConfig_FullMethodName       = "/permission.v1.RBACService/DeleteBusinessConfig"
+	RBACService_ListBusinessConfigs_FullMethodName        = "/permission.v1.RBACService/ListBusinessConfigs"
+	RBACService_CreateResource_FullMethodName             = "/permission.v1.RBACService/CreateResource"
+	RBACService_GetResource_FullMethodName                = "/permission.v1.RBACService/GetResource"
+	RBACService_UpdateResource_FullMethodName             = "/permission.v1.RBACService/UpdateResource"
+	RBACService_DeleteResource_FullMethodName             = "/permission.v1.RBACService/DeleteResource"
+	RBACService_ListResources_FullMethodName              = "/permission.v1.RBACService/ListResources"
+	RBACService_CreatePermission_FullMethodName           = "/permission.v1.RBACService/CreatePermission"
+	RBACService_GetPermission_FullMethodName              = "/permission.v1.RBACService/GetPermission"
+	RBACService_UpdatePermission_FullMethodName           = "/permission.v1.RBACService/UpdatePermission"
+	RBACService_DeletePermission_FullMethodName           = "/permission.v1.RBACService/DeletePermission"
+	RBACService_ListPermissions_FullMethodName            = "/permission.v1.RBACService/ListPermissions"
+	RBACService_CreateActionDefinition_FullMethodName     = "/permission.v1.RBACService/CreateActionDefinition"
+	RBACService_GetActionDefinition_FullMethodName        = "/permission.v1.RBACService/GetActionDefinition"
+	RBACService_UpdateActionDefinition_FullMethodName     = "/permission.v1.RBACService/UpdateActionDefinition"
+	RBACService_DeleteActionDefinition_FullMethodName     = "/permission.v1.RBACService/DeleteActionDefinition"
+	RBACService_ListActionDefinitions_FullMethodName      = "/permission.v1.RBACService/ListActionDefinitions"
+	RBACService_CreateRole_FullMethodName                 = "/permission.v1.RBACService/CreateRole"
+	RBACService_GetRole_FullMethodName                    = "/permission.v1.RBACService/GetRole"
+	RBACService_UpdateRole_FullMethodName                 = "/permission.v1.RBACService/UpdateRole"
+	RBACService_DeleteRole_FullMethodName                 = "/permission.v1.RBACService/DeleteRole"
+	RBACService_ListRoles_FullMethodName                  = "/permission.v1.RBACService/ListRoles"
+	RBACService_ListRoleUtilizations_FullMethodName       = "/permission.v1.RBACService/ListRoleUtilizations"
+	RBACService_CreateRoleInclusion_FullMethodName        = "/permission.v1.RBACService/CreateRoleInclusion"
+	RBACService_GetRoleInclusion_FullMethodName           = "/permission.v1.RBACService/GetRoleInclusion"
+	RBACService_DeleteRoleInclusion_FullMethodName        = "/permission.v1.RBACService/DeleteRoleInclusion"
+	RBACService_ListRoleInclusions_FullMethodName         = "/permission.v1.RBACService/ListRoleInclusions"
+	RBACService_GrantRolePermission_FullMethodName        = "/permission.v1.RBACService/GrantRolePermission"
+	RBACService_RevokeRolePermission_FullMethodName       = "/permission.v1.RBACService/RevokeRolePermission"
+	RBACService_ListRolePermissions_FullMethodName        = "/permission.v1.RBACService/ListRolePermissions"
+	RBACService_GrantUserRole_FullMethodName              = "/permission.v1.RBACService/GrantUserRole"
+	RBACService_RevokeUserRole_FullMethodName             = "/permission.v1.RBACService/RevokeUserRole"
+	RBACService_ListUserRoles_FullMethodName              = "/permission.v1.RBACService/ListUserRoles"
+	RBACService_GrantUserPermission_FullMethodName        = "/permission.v1.RBACService/GrantUserPermission"
+	RBACService_RevokeUserPermission_FullMethodName       = "/permission.v1.RBACService/RevokeUserPermission"
+	RBACService_ListUserPermissions_FullMethodName        = "/permission.v1.RBACService/ListUserPermissions"
+	RBACService_GetAllPermissions_FullMethodName          = "/permission.v1.RBACService/GetAllPermissions"
+	RBACService_ListSubjectsWithPermission_FullMethodName = "/permission.v1.RBACService/ListSubjectsWithPermission"
+	RBACService_CreateSoDConstraint_FullMethodName        = "/permission.v1.RBACService/CreateSoDConstraint"
+	RBACService_GetSoDConstraint_FullMethodName           = "/permission.v1.RBACService/GetSoDConstraint"
+	RBACService_DeleteSoDConstraint_FullMethodName        = "/permission.v1.RBACService/DeleteSoDConstraint"
+	RBACService_ListSoDConstraints_FullMethodName         = "/permission.v1.RBACService/ListSoDConstraints"
+	RBACService_ActivateRoles_FullMethodName              = "/permission.v1.RBACService/ActivateRoles"
 )
 
 // RBACServiceClient is the client API for RBACService service.
@@ -78,12 +90,20 @@ type RBACServiceClient interface {
 	UpdatePermission(ctx context.Context, in *UpdatePermissionRequest, opts ...grpc.CallOption) (*UpdatePermissionResponse, error)
 	DeletePermission(ctx context.Context, in *DeletePermissionRequest, opts ...grpc.CallOption) (*DeletePermissionResponse, error)
 	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	// 操作定义相关接口
+	CreateActionDefinition(ctx context.Context, in *CreateActionDefinitionRequest, opts ...grpc.CallOption) (*CreateActionDefinitionResponse, error)
+	GetActionDefinition(ctx context.Context, in *GetActionDefinitionRequest, opts ...grpc.CallOption) (*GetActionDefinitionResponse, error)
+	UpdateActionDefinition(ctx context.Context, in *UpdateActionDefinitionRequest, opts ...grpc.CallOption) (*UpdateActionDefinitionResponse, error)
+	DeleteActionDefinition(ctx context.Context, in *DeleteActionDefinitionRequest, opts ...grpc.CallOption) (*DeleteActionDefinitionResponse, error)
+	ListActionDefinitions(ctx context.Context, in *ListActionDefinitionsRequest, opts ...grpc.CallOption) (*ListActionDefinitionsResponse, error)
 	// 角色相关接口
 	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
 	GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*GetRoleResponse, error)
 	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
 	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
 	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	// 查看角色的授予人数以及上限
+	ListRoleUtilizations(ctx context.Context, in *ListRoleUtilizationsRequest, opts ...grpc.CallOption) (*ListRoleUtilizationsResponse, error)
 	// 角色包含关系相关接口
 	CreateRoleInclusion(ctx context.Context, in *CreateRoleInclusionRequest, opts ...grpc.CallOption) (*CreateRoleInclusionResponse, error)
 	GetRoleInclusion(ctx context.Context, in *GetRoleInclusionRequest, opts ...grpc.CallOption) (*GetRoleInclusionResponse, error)
@@ -103,6 +123,15 @@ type RBACServiceClient interface {
 	ListUserPermissions(ctx context.Context, in *ListUserPermissionsRequest, opts ...grpc.CallOption) (*ListUserPermissionsResponse, error)
 	// 获取用户所有权限
 	GetAllPermissions(ctx context.Context, in *GetAllPermissionsRequest, opts ...grpc.CallOption) (*GetAllPermissionsResponse, error)
+	// 反向查找可以在资源上执行操作的用户，判定规则和 CheckPermission 的 RBAC 部分一致
+	ListSubjectsWithPermission(ctx context.Context, in *ListSubjectsWithPermissionRequest, opts ...grpc.CallOption) (*ListSubjectsWithPermissionResponse, error)
+	// 职责分离约束相关接口，GrantUserRole、CreateRoleInclusion 违反静态约束时返回 FailedPrecondition
+	CreateSoDConstraint(ctx context.Context, in *CreateSoDConstraintRequest, opts ...grpc.CallOption) (*CreateSoDConstraintResponse, error)
+	GetSoDConstraint(ctx context.Context, in *GetSoDConstraintRequest, opts ...grpc.CallOption) (*GetSoDConstraintResponse, error)
+	DeleteSoDConstraint(ctx context.Context, in *DeleteSoDConstraintRequest, opts ...grpc.CallOption) (*DeleteSoDConstraintResponse, error)
+	ListSoDConstraints(ctx context.Context, in *ListSoDConstraintsRequest, opts ...grpc.CallOption) (*ListSoDConstraintsResponse, error)
+	// 激活用户的这些角色，覆盖之前的激活。同时拥有动态约束里的多个角色时只有激活了的那一个生效，同时激活违反动态约束时返回 FailedPrecondition
+	ActivateRoles(ctx context.Context, in *ActivateRolesRequest, opts ...grpc.CallOption) (*ActivateRolesResponse, error)
 }
 
 type rBACServiceClient struct {
@@ -263,6 +292,56 @@ func (c *rBACServiceClient) ListPermissions(ctx context.Context, in *ListPermiss
 	return out, nil
 }
 
+func (c *rBACServiceClient) CreateActionDefinition(ctx context.Context, in *CreateActionDefinitionRequest, opts ...grpc.CallOption) (*CreateActionDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateActionDefinitionResponse)
+	err := c.cc.Invoke(ctx, RBACService_CreateActionDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) GetActionDefinition(ctx context.Context, in *GetActionDefinitionRequest, opts ...grpc.CallOption) (*GetActionDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActionDefinitionResponse)
+	err := c.cc.Invoke(ctx, RBACService_GetActionDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) UpdateActionDefinition(ctx context.Context, in *UpdateActionDefinitionRequest, opts ...grpc.CallOption) (*UpdateActionDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateActionDefinitionResponse)
+	err := c.cc.Invoke(ctx, RBACService_UpdateActionDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) DeleteActionDefinition(ctx context.Context, in *DeleteActionDefinitionRequest, opts ...grpc.CallOption) (*DeleteActionDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteActionDefinitionResponse)
+	err := c.cc.Invoke(ctx, RBACService_DeleteActionDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) ListActionDefinitions(ctx context.Context, in *ListActionDefinitionsRequest, opts ...grpc.CallOption) (*ListActionDefinitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListActionDefinitionsResponse)
+	err := c.cc.Invoke(ctx, RBACService_ListActionDefinitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rBACServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateRoleResponse)
@@ -313,6 +392,16 @@ func (c *rBACServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest,
 	return out, nil
 }
 
+func (c *rBACServiceClient) ListRoleUtilizations(ctx context.Context, in *ListRoleUtilizationsRequest, opts ...grpc.CallOption) (*ListRoleUtilizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoleUtilizationsResponse)
+	err := c.cc.Invoke(ctx, RBACService_ListRoleUtilizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rBACServiceClient) CreateRoleInclusion(ctx context.Context, in *CreateRoleInclusionRequest, opts ...grpc.CallOption) (*CreateRoleInclusionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateRoleInclusionResponse)
@@ -453,6 +542,66 @@ func (c *rBACServiceClient) GetAllPermissions(ctx context.Context, in *GetAllPer
 	return out, nil
 }
 
+func (c *rBACServiceClient) ListSubjectsWithPermission(ctx context.Context, in *ListSubjectsWithPermissionRequest, opts ...grpc.CallOption) (*ListSubjectsWithPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubjectsWithPermissionResponse)
+	err := c.cc.Invoke(ctx, RBACService_ListSubjectsWithPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) CreateSoDConstraint(ctx context.Context, in *CreateSoDConstraintRequest, opts ...grpc.CallOption) (*CreateSoDConstraintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSoDConstraintResponse)
+	err := c.cc.Invoke(ctx, RBACService_CreateSoDConstraint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) GetSoDConstraint(ctx context.Context, in *GetSoDConstraintRequest, opts ...grpc.CallOption) (*GetSoDConstraintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSoDConstraintResponse)
+	err := c.cc.Invoke(ctx, RBACService_GetSoDConstraint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) DeleteSoDConstraint(ctx context.Context, in *DeleteSoDConstraintRequest, opts ...grpc.CallOption) (*DeleteSoDConstraintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSoDConstraintResponse)
+	err := c.cc.Invoke(ctx, RBACService_DeleteSoDConstraint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) ListSoDConstraints(ctx context.Context, in *ListSoDConstraintsRequest, opts ...grpc.CallOption) (*ListSoDConstraintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSoDConstraintsResponse)
+	err := c.cc.Invoke(ctx, RBACService_ListSoDConstraints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) ActivateRoles(ctx context.Context, in *ActivateRolesRequest, opts ...grpc.CallOption) (*ActivateRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateRolesResponse)
+	err := c.cc.Invoke(ctx, RBACService_ActivateRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RBACServiceServer is the server API for RBACService service.
 // All implementations should embed UnimplementedRBACServiceServer
 // for forward compatibility.
@@ -475,12 +624,20 @@ type RBACServiceServer interface {
 	UpdatePermission(context.Context, *UpdatePermissionRequest) (*UpdatePermissionResponse, error)
 	DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error)
 	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	// 操作定义相关接口
+	CreateActionDefinition(context.Context, *CreateActionDefinitionRequest) (*CreateActionDefinitionResponse, error)
+	GetActionDefinition(context.Context, *GetActionDefinitionRequest) (*GetActionDefinitionResponse, error)
+	UpdateActionDefinition(context.Context, *UpdateActionDefinitionRequest) (*UpdateActionDefinitionResponse, error)
+	DeleteActionDefinition(context.Context, *DeleteActionDefinitionRequest) (*DeleteActionDefinitionResponse, error)
+	ListActionDefinitions(context.Context, *ListActionDefinitionsRequest) (*ListActionDefinitionsResponse, error)
 	// 角色相关接口
 	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
 	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
 	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
 	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
 	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	// 查看角色的授予人数以及上限
+	ListRoleUtilizations(context.Context, *ListRoleUtilizationsRequest) (*ListRoleUtilizationsResponse, error)
 	// 角色包含关系相关接口
 	CreateRoleInclusion(context.Context, *CreateRoleInclusionRequest) (*CreateRoleInclusionResponse, error)
 	GetRoleInclusion(context.Context, *GetRoleInclusionRequest) (*GetRoleInclusionResponse, error)
@@ -500,6 +657,15 @@ type RBACServiceServer interface {
 	ListUserPermissions(context.Context, *ListUserPermissionsRequest) (*ListUserPermissionsResponse, error)
 	// 获取用户所有权限
 	GetAllPermissions(context.Context, *GetAllPermissionsRequest) (*GetAllPermissionsResponse, error)
+	// 反向查找可以在资源上执行操作的用户，判定规则和 CheckPermission 的 RBAC 部分一致
+	ListSubjectsWithPermission(context.Context, *ListSubjectsWithPermissionRequest) (*ListSubjectsWithPermissionResponse, error)
+	// 职责分离约束相关接口，GrantUserRole、CreateRoleInclusion 违反静态约束时返回 FailedPrecondition
+	CreateSoDConstraint(context.Context, *CreateSoDConstraintRequest) (*CreateSoDConstraintResponse, error)
+	GetSoDConstraint(context.Context, *GetSoDConstraintRequest) (*GetSoDConstraintResponse, error)
+	DeleteSoDConstraint(context.Context, *DeleteSoDConstraintRequest) (*DeleteSoDConstraintResponse, error)
+	ListSoDConstraints(context.Context, *ListSoDConstraintsRequest) (*ListSoDConstraintsResponse, error)
+	// 激活用户的这些角色，覆盖之前的激活。同时拥有动态约束里的多个角色时只有激活了的那一个生效，同时激活违反动态约束时返回 FailedPrecondition
+	ActivateRoles(context.Context, *ActivateRolesRequest) (*ActivateRolesResponse, error)
 }
 
 // UnimplementedRBACServiceServer should be embedded to have
@@ -512,138 +678,141 @@ type UnimplementedRBACServiceServer struct{}
 func (UnimplementedRBACServiceServer) CreateBusinessConfig(context.Context, *CreateBusinessConfigRequest) (*CreateBusinessConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBusinessConfig not implemented")
 }
-
 func (UnimplementedRBACServiceServer) GetBusinessConfig(context.Context, *GetBusinessConfigRequest) (*GetBusinessConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBusinessConfig not implemented")
 }
-
 func (UnimplementedRBACServiceServer) UpdateBusinessConfig(context.Context, *UpdateBusinessConfigRequest) (*UpdateBusinessConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBusinessConfig not implemented")
 }
-
 func (UnimplementedRBACServiceServer) DeleteBusinessConfig(context.Context, *DeleteBusinessConfigRequest) (*DeleteBusinessConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBusinessConfig not implemented")
 }
-
 func (UnimplementedRBACServiceServer) ListBusinessConfigs(context.Context, *ListBusinessConfigsRequest) (*ListBusinessConfigsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBusinessConfigs not implemented")
 }
-
 func (UnimplementedRBACServiceServer) CreateResource(context.Context, *CreateResourceRequest) (*CreateResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateResource not implemented")
 }
-
 func (UnimplementedRBACServiceServer) GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
 }
-
 func (UnimplementedRBACServiceServer) UpdateResource(context.Context, *UpdateResourceRequest) (*UpdateResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateResource not implemented")
 }
-
 func (UnimplementedRBACServiceServer) DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteResource not implemented")
 }
-
 func (UnimplementedRBACServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
 }
-
 func (UnimplementedRBACServiceServer) CreatePermission(context.Context, *CreatePermissionRequest) (*CreatePermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePermission not implemented")
 }
-
 func (UnimplementedRBACServiceServer) GetPermission(context.Context, *GetPermissionRequest) (*GetPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPermission not implemented")
 }
-
 func (UnimplementedRBACServiceServer) UpdatePermission(context.Context, *UpdatePermissionRequest) (*UpdatePermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePermission not implemented")
 }
-
 func (UnimplementedRBACServiceServer) DeletePermission(context.Context, *DeletePermissionRequest) (*DeletePermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePermission not implemented")
 }
-
 func (UnimplementedRBACServiceServer) ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
 }
-
+func (UnimplementedRBACServiceServer) CreateActionDefinition(context.Context, *CreateActionDefinitionRequest) (*CreateActionDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateActionDefinition not implemented")
+}
+func (UnimplementedRBACServiceServer) GetActionDefinition(context.Context, *GetActionDefinitionRequest) (*GetActionDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActionDefinition not implemented")
+}
+func (UnimplementedRBACServiceServer) UpdateActionDefinition(context.Context, *UpdateActionDefinitionRequest) (*UpdateActionDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActionDefinition not implemented")
+}
+func (UnimplementedRBACServiceServer) DeleteActionDefinition(context.Context, *DeleteActionDefinitionRequest) (*DeleteActionDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteActionDefinition not implemented")
+}
+func (UnimplementedRBACServiceServer) ListActionDefinitions(context.Context, *ListActionDefinitionsRequest) (*ListActionDefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActionDefinitions not implemented")
+}
 func (UnimplementedRBACServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
 }
-
 func (UnimplementedRBACServiceServer) GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
 }
-
 func (UnimplementedRBACServiceServer) UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
 }
-
 func (UnimplementedRBACServiceServer) DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
 }
-
 func (UnimplementedRBACServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
 }
-
+func (UnimplementedRBACServiceServer) ListRoleUtilizations(context.Context, *ListRoleUtilizationsRequest) (*ListRoleUtilizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleUtilizations not implemented")
+}
 func (UnimplementedRBACServiceServer) CreateRoleInclusion(context.Context, *CreateRoleInclusionRequest) (*CreateRoleInclusionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleInclusion not implemented")
 }
-
 func (UnimplementedRBACServiceServer) GetRoleInclusion(context.Context, *GetRoleInclusionRequest) (*GetRoleInclusionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoleInclusion not implemented")
 }
-
 func (UnimplementedRBACServiceServer) DeleteRoleInclusion(context.Context, *DeleteRoleInclusionRequest) (*DeleteRoleInclusionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleInclusion not implemented")
 }
-
 func (UnimplementedRBACServiceServer) ListRoleInclusions(context.Context, *ListRoleInclusionsRequest) (*ListRoleInclusionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRoleInclusions not implemented")
 }
-
 func (UnimplementedRBACServiceServer) GrantRolePermission(context.Context, *GrantRolePermissionRequest) (*GrantRolePermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GrantRolePermission not implemented")
 }
-
 func (UnimplementedRBACServiceServer) RevokeRolePermission(context.Context, *RevokeRolePermissionRequest) (*RevokeRolePermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeRolePermission not implemented")
 }
-
 func (UnimplementedRBACServiceServer) ListRolePermissions(context.Context, *ListRolePermissionsRequest) (*ListRolePermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRolePermissions not implemented")
 }
-
 func (UnimplementedRBACServiceServer) GrantUserRole(context.Context, *GrantUserRoleRequest) (*GrantUserRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GrantUserRole not implemented")
 }
-
 func (UnimplementedRBACServiceServer) RevokeUserRole(context.Context, *RevokeUserRoleRequest) (*RevokeUserRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserRole not implemented")
 }
-
 func (UnimplementedRBACServiceServer) ListUserRoles(context.Context, *ListUserRolesRequest) (*ListUserRolesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserRoles not implemented")
 }
-
 func (UnimplementedRBACServiceServer) GrantUserPermission(context.Context, *GrantUserPermissionRequest) (*GrantUserPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GrantUserPermission not implemented")
 }
-
 func (UnimplementedRBACServiceServer) RevokeUserPermission(context.Context, *RevokeUserPermissionRequest) (*RevokeUserPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserPermission not implemented")
 }
-
 func (UnimplementedRBACServiceServer) ListUserPermissions(context.Context, *ListUserPermissionsRequest) (*ListUserPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserPermissions not implemented")
 }
-
 func (UnimplementedRBACServiceServer) GetAllPermissions(context.Context, *GetAllPermissionsRequest) (*GetAllPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllPermissions not implemented")
 }
+func (UnimplementedRBACServiceServer) ListSubjectsWithPermission(context.Context, *ListSubjectsWithPermissionRequest) (*ListSubjectsWithPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubjectsWithPermission not implemented")
+}
+func (UnimplementedRBACServiceServer) CreateSoDConstraint(context.Context, *CreateSoDConstraintRequest) (*CreateSoDConstraintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSoDConstraint not implemented")
+}
+func (UnimplementedRBACServiceServer) GetSoDConstraint(context.Context, *GetSoDConstraintRequest) (*GetSoDConstraintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSoDConstraint not implemented")
+}
+func (UnimplementedRBACServiceServer) DeleteSoDConstraint(context.Context, *DeleteSoDConstraintRequest) (*DeleteSoDConstraintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSoDConstraint not implemented")
+}
+func (UnimplementedRBACServiceServer) ListSoDConstraints(context.Context, *ListSoDConstraintsRequest) (*ListSoDConstraintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSoDConstraints not implemented")
+}
+func (UnimplementedRBACServiceServer) ActivateRoles(context.Context, *ActivateRolesRequest) (*ActivateRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateRoles not implemented")
+}
 func (UnimplementedRBACServiceServer) testEmbeddedByValue() {}
 
 // UnsafeRBACServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -934,6 +1103,96 @@ func _RBACService_ListPermissions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RBACService_CreateActionDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateActionDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).CreateActionDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_CreateActionDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).CreateActionDefinition(ctx, req.(*CreateActionDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_GetActionDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActionDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).GetActionDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_GetActionDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).GetActionDefinition(ctx, req.(*GetActionDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_UpdateActionDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActionDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).UpdateActionDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_UpdateActionDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).UpdateActionDefinition(ctx, req.(*UpdateActionDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_DeleteActionDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteActionDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).DeleteActionDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_DeleteActionDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).DeleteActionDefinition(ctx, req.(*DeleteActionDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_ListActionDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActionDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).ListActionDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_ListActionDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).ListActionDefinitions(ctx, req.(*ListActionDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RBACService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRoleRequest)
 	if err := dec(in); err != nil {
@@ -1024,6 +1283,24 @@ func _RBACService_ListRoles_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RBACService_ListRoleUtilizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleUtilizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).ListRoleUtilizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_ListRoleUtilizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).ListRoleUtilizations(ctx, req.(*ListRoleUtilizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RBACService_CreateRoleInclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRoleInclusionRequest)
 	if err := dec(in); err != nil {
@@ -1276,6 +1553,114 @@ func _RBACService_GetAllPermissions_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RBACService_ListSubjectsWithPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubjectsWithPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).ListSubjectsWithPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_ListSubjectsWithPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).ListSubjectsWithPermission(ctx, req.(*ListSubjectsWithPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_CreateSoDConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSoDConstraintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).CreateSoDConstraint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_CreateSoDConstraint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).CreateSoDConstraint(ctx, req.(*CreateSoDConstraintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_GetSoDConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSoDConstraintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).GetSoDConstraint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_GetSoDConstraint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).GetSoDConstraint(ctx, req.(*GetSoDConstraintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_DeleteSoDConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSoDConstraintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).DeleteSoDConstraint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_DeleteSoDConstraint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).DeleteSoDConstraint(ctx, req.(*DeleteSoDConstraintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_ListSoDConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSoDConstraintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).ListSoDConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_ListSoDConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).ListSoDConstraints(ctx, req.(*ListSoDConstraintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_ActivateRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).ActivateRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_ActivateRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).ActivateRoles(ctx, req.(*ActivateRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RBACService_ServiceDesc is the grpc.ServiceDesc for RBACService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1343,6 +1728,26 @@ var RBACService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPermissions",
 			Handler:    _RBACService_ListPermissions_Handler,
 		},
+		{
+			MethodName: "CreateActionDefinition",
+			Handler:    _RBACService_CreateActionDefinition_Handler,
+		},
+		{
+			MethodName: "GetActionDefinition",
+			Handler:    _RBACService_GetActionDefinition_Handler,
+		},
+		{
+			MethodName: "UpdateActionDefinition",
+			Handler:    _RBACService_UpdateActionDefinition_Handler,
+		},
+		{
+			MethodName: "DeleteActionDefinition",
+			Handler:    _RBACService_DeleteActionDefinition_Handler,
+		},
+		{
+			MethodName: "ListActionDefinitions",
+			Handler:    _RBACService_ListActionDefinitions_Handler,
+		},
 		{
 			MethodName: "CreateRole",
 			Handler:    _RBACService_CreateRole_Handler,
@@ -1363,6 +1768,10 @@ var RBACService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRoles",
 			Handler:    _RBACService_ListRoles_Handler,
 		},
+		{
+			MethodName: "ListRoleUtilizations",
+			Handler:    _RBACService_ListRoleUtilizations_Handler,
+		},
 		{
 			MethodName: "CreateRoleInclusion",
 			Handler:    _RBACService_CreateRoleInclusion_Handler,
@@ -1419,6 +1828,30 @@ var RBACService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllPermissions",
 			Handler:    _RBACService_GetAllPermissions_Handler,
 		},
+		{
+			MethodName: "ListSubjectsWithPermission",
+			Handler:    _RBACService_ListSubjectsWithPermission_Handler,
+		},
+		{
+			MethodName: "CreateSoDConstraint",
+			Handler:    _RBACService_CreateSoDConstraint_Handler,
+		},
+		{
+			MethodName: "GetSoDConstraint",
+			Handler:    _RBACService_GetSoDConstraint_Handler,
+		},
+		{
+			MethodName: "DeleteSoDConstraint",
+			Handler:    _RBACService_DeleteSoDConstraint_Handler,
+		},
+		{
+			MethodName: "ListSoDConstraints",
+			Handler:    _RBACService_ListSoDConstraints_Handler,
+		},
+		{
+			MethodName: "ActivateRoles",
+			Handler:    _RBACService_ActivateRoles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "permission/v1/rbac.proto",
@@ -3,18 +3,32 @@
 package ioc
 
 import (
+	"time"
+
+	grpcapi "gitee.com/flycash/permission-platform/internal/api/grpc"
+	abacgrpc "gitee.com/flycash/permission-platform/internal/api/grpc/abac"
 	rbacgrpc "gitee.com/flycash/permission-platform/internal/api/grpc/rbac"
 	auditevt "gitee.com/flycash/permission-platform/internal/event/audit"
 	permissionevt "gitee.com/flycash/permission-platform/internal/event/permission"
 	"gitee.com/flycash/permission-platform/internal/ioc"
 	"gitee.com/flycash/permission-platform/internal/repository"
 	"gitee.com/flycash/permission-platform/internal/repository/cache"
+	"gitee.com/flycash/permission-platform/internal/repository/cache/local"
+	"gitee.com/flycash/permission-platform/internal/repository/cache/redisx"
 	"gitee.com/flycash/permission-platform/internal/repository/dao"
 	auditdao "gitee.com/flycash/permission-platform/internal/repository/dao/audit"
+	abacsvc "gitee.com/flycash/permission-platform/internal/service/abac"
+	"gitee.com/flycash/permission-platform/internal/service/abac/evaluator"
+	"gitee.com/flycash/permission-platform/internal/service/bizmodel"
+	"gitee.com/flycash/permission-platform/internal/service/hybrid"
 	rbacsvc "gitee.com/flycash/permission-platform/internal/service/rbac"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/ecodeclub/ecache"
+	ecacheredis "github.com/ecodeclub/ecache/redis"
+	"github.com/ego-component/eetcd"
 	"github.com/google/wire"
 	"github.com/gotomicro/ego/core/econf"
+	"github.com/redis/go-redis/v9"
 )
 
 var (
@@ -30,6 +44,7 @@ var (
 		ioc.InitMultipleLevelCache,
 		ioc.InitCacheKeyFunc,
 		ioc.InitKafkaProducer,
+		ioc.InitRateLimiter,
 		// local.NewLocalCache,
 		// redis.NewCache,
 	)
@@ -38,7 +53,10 @@ var (
 		rbacsvc.NewPermissionService,
 
 		dao.NewBusinessConfigDAO,
-		repository.NewBusinessConfigRepository,
+		repository.NewBusinessConfigDefaultRepository,
+		initBusinessConfigCache,
+		repository.NewBusinessConfigCachedRepository,
+		wire.Bind(new(repository.BusinessConfigRepository), new(*repository.BusinessConfigCachedRepository)),
 
 		dao.NewResourceDAO,
 		repository.NewResourceRepository,
@@ -46,6 +64,11 @@ var (
 		dao.NewPermissionDAO,
 		repository.NewPermissionRepository,
 
+		dao.NewActionDefinitionDAO,
+		repository.NewActionDefinitionDefaultRepository,
+		repository.NewActionDefinitionReloadCacheRepository,
+		wire.Bind(new(repository.ActionDefinitionRepository), new(*repository.ActionDefinitionReloadCacheRepository)),
+
 		dao.NewRoleDAO,
 		repository.NewRoleRepository,
 
@@ -67,6 +90,13 @@ var (
 		dao.NewUserPermissionDAO,
 		repository.NewUserPermissionDefaultRepository,
 
+		dao.NewSoDConstraintDAO,
+		dao.NewRoleActivationDAO,
+		auditdao.NewSoDViolationLogDAO,
+		repository.NewSoDConstraintDefaultRepository,
+		repository.NewSoDConstraintReloadCacheRepository,
+		wire.Bind(new(repository.SoDConstraintRepository), new(*repository.SoDConstraintReloadCacheRepository)),
+
 		cache.NewUserPermissionCache,
 		repository.NewUserPermissionCachedRepository,
 		wire.Bind(new(repository.UserPermissionRepository), new(*repository.UserPermissionCachedRepository)),
@@ -77,9 +107,60 @@ var (
 
 		initUserRoleBinlogEventConsumer,
 		initUserPermissionEventProducer,
+		initUserRoleExpirationTask,
+	)
+	abacSvcSet = wire.NewSet(
+		dao.NewAttributeDefinitionDAO,
+		dao.NewSubjectAttributeValueDAO,
+		dao.NewResourceAttributeValueDAO,
+		dao.NewEnvironmentAttributeDAO,
+		dao.NewPolicyDAO,
+		initAbacDefinitionRepo,
+		initAbacAttributeValueRepo,
+		initAbacPolicyRepo,
+
+		evaluator.NewSelector,
+		abacsvc.NewPolicyExecutor,
+		initRecentRequests,
+		abacsvc.NewPermissionSvc,
+		abacsvc.NewPolicySvc,
+		abacsvc.NewAttributeValueSvc,
+		abacsvc.NewAttributeDefinitionSvc,
+
+		initPolicyCron,
+		initAttributeValueTask,
 	)
 )
 
+func initAbacDefinitionRepo(definitionDAO dao.AttributeDefinitionDAO, localCache ecache.Cache, client *redis.Client) repository.AttributeDefinitionRepository {
+	return repository.NewAttributeDefinitionRepository(definitionDAO,
+		local.NewAbacDefLocalCache(localCache, client),
+		redisx.NewAbacDefCache(client))
+}
+
+func initAbacAttributeValueRepo(
+	envDAO dao.EnvironmentAttributeDAO,
+	resourceDAO dao.ResourceAttributeValueDAO,
+	subjectDAO dao.SubjectAttributeValueDAO,
+	definitionDAO dao.AttributeDefinitionDAO,
+	localCache ecache.Cache,
+	client *redis.Client,
+) repository.AttributeValueRepository {
+	return repository.NewAttributeValueRepository(envDAO, resourceDAO, subjectDAO, definitionDAO,
+		redisx.NewAbacAttributeValCache(client),
+		local.NewAbacAttributeValCache(localCache))
+}
+
+func initAbacPolicyRepo(policyDAO dao.PolicyDAO, localCache ecache.Cache, client *redis.Client) repository.PolicyRepo {
+	return repository.NewPolicyRepository(policyDAO,
+		redisx.NewAbacPolicy(client),
+		local.NewAbacPolicy(localCache))
+}
+
+func initBusinessConfigCache(client redis.Cmdable) cache.BusinessConfigCache {
+	return cache.NewBusinessConfigCache(ecacheredis.NewCache(client))
+}
+
 func initUserRoleBinlogEventConsumer(dao auditdao.UserRoleLogDAO) *auditevt.UserRoleBinlogEventConsumer {
 	type Consumer struct {
 		GroupID string `yaml:"groupId"`
@@ -119,6 +200,64 @@ func initUserPermissionEventProducer(producer *kafka.Producer) permissionevt.Use
 	return p
 }
 
+func initUserRoleExpirationTask(
+	repo *repository.UserRoleDefaultRepository,
+	cacheReloader repository.UserPermissionCacheReloader,
+) *rbacsvc.UserRoleExpirationTask {
+	type Config struct {
+		Interval  time.Duration `yaml:"interval"`
+		BatchSize int           `yaml:"batchSize"`
+	}
+	var cfg Config
+	err := econf.UnmarshalKey("userRoleExpiration", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return rbacsvc.NewUserRoleExpirationTask(repo, cacheReloader, cfg.Interval, cfg.BatchSize)
+}
+
+func initPolicyCron(client *eetcd.Component, repo repository.PolicyRepo, localCache ecache.Cache) *abacsvc.PolicyCron {
+	type Config struct {
+		Interval time.Duration `yaml:"interval"`
+	}
+	var cfg Config
+	err := econf.UnmarshalKey("abac.policyCron", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return abacsvc.NewPolicyCron(client, repo, local.NewAbacPolicy(localCache)).WithInterval(cfg.Interval)
+}
+
+func initRecentRequests() *abacsvc.RecentRequests {
+	var cfg abacsvc.RecentRequestsConfig
+	err := econf.UnmarshalKey("abac.recentRequests", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return abacsvc.NewRecentRequests(cfg)
+}
+
+func initAttributeValueTask(
+	repo repository.AttributeValueRepository,
+	localCache ecache.Cache,
+	client *redis.Client,
+	etcdClient *eetcd.Component,
+) *abacsvc.AttributeValueTask {
+	type Config struct {
+		ResourceKey string `yaml:"resourceKey"`
+		SubjectKey  string `yaml:"subjectKey"`
+	}
+	var cfg Config
+	err := econf.UnmarshalKey("abac.attributeValueTask", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return abacsvc.NewAttributeValueTask(repo,
+		local.NewAbacAttributeValCache(localCache),
+		redisx.NewAbacAttributeValCache(client),
+		cfg.ResourceKey, cfg.SubjectKey, etcdClient)
+}
+
 func InitApp() *ioc.App {
 	wire.Build(
 		// 基础设施
@@ -127,9 +266,23 @@ func InitApp() *ioc.App {
 		// RBAC 服务
 		rbacSvcSet,
 
+		// ABAC 服务
+		abacSvcSet,
+
+		// 按照业务方配置选择判定引擎
+		hybrid.NewBizEnginePermissionService,
+
+		// 授权模型的导出和导入
+		bizmodel.NewService,
+
 		// GRPC服务器
 		rbacgrpc.NewServer,
 		rbacgrpc.NewPermissionServiceServer,
+		grpcapi.NewBatchPermissionServer,
+		abacgrpc.NewABACPolicyServer,
+		abacgrpc.NewABACAttributeValServer,
+		abacgrpc.NewABACAttributeDefinitionServer,
+		grpcapi.NewBizModelServer,
 		ioc.InitGRPC,
 		ioc.InitTasks,
 		wire.Struct(new(ioc.App), "*"),
@@ -7,18 +7,31 @@
 package ioc
 
 import (
+	"gitee.com/flycash/permission-platform/internal/api/grpc"
+	abac2 "gitee.com/flycash/permission-platform/internal/api/grpc/abac"
 	rbac2 "gitee.com/flycash/permission-platform/internal/api/grpc/rbac"
 	audit2 "gitee.com/flycash/permission-platform/internal/event/audit"
 	"gitee.com/flycash/permission-platform/internal/event/permission"
 	"gitee.com/flycash/permission-platform/internal/ioc"
 	"gitee.com/flycash/permission-platform/internal/repository"
 	"gitee.com/flycash/permission-platform/internal/repository/cache"
+	"gitee.com/flycash/permission-platform/internal/repository/cache/local"
+	"gitee.com/flycash/permission-platform/internal/repository/cache/redisx"
 	"gitee.com/flycash/permission-platform/internal/repository/dao"
 	"gitee.com/flycash/permission-platform/internal/repository/dao/audit"
+	"gitee.com/flycash/permission-platform/internal/service/abac"
+	"gitee.com/flycash/permission-platform/internal/service/abac/evaluator"
+	"gitee.com/flycash/permission-platform/internal/service/bizmodel"
+	"gitee.com/flycash/permission-platform/internal/service/hybrid"
 	"gitee.com/flycash/permission-platform/internal/service/rbac"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/ecodeclub/ecache"
+	ecacheredis "github.com/ecodeclub/ecache/redis"
+	"github.com/ego-component/eetcd"
 	"github.com/google/wire"
 	"github.com/gotomicro/ego/core/econf"
+	"github.com/redis/go-redis/v9"
+	"time"
 )
 
 // Injectors from wire.go:
@@ -26,21 +39,23 @@ import (
 func InitApp() *ioc.App {
 	v := ioc.InitDB()
 	businessConfigDAO := dao.NewBusinessConfigDAO(v)
-	businessConfigRepository := repository.NewBusinessConfigRepository(businessConfigDAO)
+	businessConfigDefaultRepository := repository.NewBusinessConfigDefaultRepository(businessConfigDAO)
+	cmdable := ioc.InitRedisCmd()
+	businessConfigCache := initBusinessConfigCache(cmdable)
+	businessConfigCachedRepository := repository.NewBusinessConfigCachedRepository(businessConfigDefaultRepository, businessConfigCache)
 	resourceDAO := dao.NewResourceDAO(v)
 	resourceRepository := repository.NewResourceRepository(resourceDAO)
 	permissionDAO := dao.NewPermissionDAO(v)
 	permissionRepository := repository.NewPermissionRepository(permissionDAO)
-	roleDAO := dao.NewRoleDAO(v)
-	roleRepository := repository.NewRoleRepository(roleDAO)
-	roleInclusionDAO := dao.NewRoleInclusionDAO(v)
-	roleInclusionDefaultRepository := repository.NewRoleInclusionDefaultRepository(roleInclusionDAO)
+	actionDefinitionDAO := dao.NewActionDefinitionDAO(v)
+	actionDefinitionDefaultRepository := repository.NewActionDefinitionDefaultRepository(actionDefinitionDAO)
 	userRoleDAO := dao.NewUserRoleDAO(v)
-	userRoleDefaultRepository := repository.NewUserRoleDefaultRepository(userRoleDAO)
-	rolePermissionDAO := dao.NewRolePermissionDAO(v)
 	userPermissionDAO := dao.NewUserPermissionDAO(v)
-	userPermissionDefaultRepository := repository.NewUserPermissionDefaultRepository(roleInclusionDAO, rolePermissionDAO, userRoleDAO, userPermissionDAO)
-	cmdable := ioc.InitRedisCmd()
+	roleInclusionDAO := dao.NewRoleInclusionDAO(v)
+	rolePermissionDAO := dao.NewRolePermissionDAO(v)
+	soDConstraintDAO := dao.NewSoDConstraintDAO(v)
+	roleActivationDAO := dao.NewRoleActivationDAO(v)
+	userPermissionDefaultRepository := repository.NewUserPermissionDefaultRepository(actionDefinitionDAO, permissionDAO, roleInclusionDAO, rolePermissionDAO, userRoleDAO, userPermissionDAO, soDConstraintDAO, roleActivationDAO)
 	ecacheCache := ioc.InitLocalCache()
 	component := ioc.InitEtcdClient()
 	v2 := ioc.InitCacheKeyFunc()
@@ -49,20 +64,55 @@ func InitApp() *ioc.App {
 	producer := ioc.InitKafkaProducer()
 	userPermissionEventProducer := initUserPermissionEventProducer(producer)
 	userPermissionCachedRepository := repository.NewUserPermissionCachedRepository(userPermissionDefaultRepository, userPermissionCache, userPermissionEventProducer)
+	actionDefinitionReloadCacheRepository := repository.NewActionDefinitionReloadCacheRepository(actionDefinitionDefaultRepository, actionDefinitionDAO, permissionDAO, rolePermissionDAO, roleInclusionDAO, userRoleDAO, userPermissionDAO, userPermissionCachedRepository)
+	roleDAO := dao.NewRoleDAO(v)
+	roleRepository := repository.NewRoleRepository(roleDAO)
+	roleInclusionDefaultRepository := repository.NewRoleInclusionDefaultRepository(roleInclusionDAO)
+	userRoleDefaultRepository := repository.NewUserRoleDefaultRepository(userRoleDAO)
 	roleInclusionReloadCacheRepository := repository.NewRoleInclusionReloadCacheRepository(roleInclusionDefaultRepository, userRoleDefaultRepository, userPermissionCachedRepository)
 	rolePermissionDefaultRepository := repository.NewRolePermissionDefaultRepository(rolePermissionDAO)
 	rolePermissionReloadCacheRepository := repository.NewRolePermissionReloadCacheRepository(rolePermissionDefaultRepository, roleInclusionDAO, userRoleDAO, userPermissionCachedRepository)
 	userRoleReloadCacheRepository := repository.NewUserRoleReloadCacheRepository(userRoleDefaultRepository, userPermissionCachedRepository)
+	soDViolationLogDAO := audit.NewSoDViolationLogDAO(v)
+	soDConstraintDefaultRepository := repository.NewSoDConstraintDefaultRepository(soDConstraintDAO, roleInclusionDAO, userRoleDAO, roleActivationDAO, soDViolationLogDAO)
+	soDConstraintReloadCacheRepository := repository.NewSoDConstraintReloadCacheRepository(soDConstraintDefaultRepository, userRoleDefaultRepository, userPermissionCachedRepository)
 	token := ioc.InitJWTToken()
-	service := rbac.NewService(businessConfigRepository, resourceRepository, permissionRepository, roleRepository, roleInclusionReloadCacheRepository, rolePermissionReloadCacheRepository, userRoleReloadCacheRepository, userPermissionCachedRepository, token)
+	service := rbac.NewService(businessConfigCachedRepository, resourceRepository, permissionRepository, actionDefinitionReloadCacheRepository, roleRepository, roleInclusionReloadCacheRepository, rolePermissionReloadCacheRepository, userRoleReloadCacheRepository, userPermissionCachedRepository, soDConstraintReloadCacheRepository, token)
 	server := rbac2.NewServer(service)
 	permissionService := rbac.NewPermissionService(userPermissionCachedRepository)
-	permissionServiceServer := rbac2.NewPermissionServiceServer(permissionService)
+	policyDAO := dao.NewPolicyDAO(v)
+	client := ioc.InitRedisClient()
+	policyRepo := initAbacPolicyRepo(policyDAO, ecacheCache, client)
+	environmentAttributeDAO := dao.NewEnvironmentAttributeDAO(v)
+	resourceAttributeValueDAO := dao.NewResourceAttributeValueDAO(v)
+	subjectAttributeValueDAO := dao.NewSubjectAttributeValueDAO(v)
+	attributeDefinitionDAO := dao.NewAttributeDefinitionDAO(v)
+	attributeValueRepository := initAbacAttributeValueRepo(environmentAttributeDAO, resourceAttributeValueDAO, subjectAttributeValueDAO, attributeDefinitionDAO, ecacheCache, client)
+	attributeDefinitionRepository := initAbacDefinitionRepo(attributeDefinitionDAO, ecacheCache, client)
+	selector := evaluator.NewSelector()
+	policyExecutor := abac.NewPolicyExecutor(selector)
+	recentRequests := initRecentRequests()
+	permissionSvc := abac.NewPermissionSvc(businessConfigCachedRepository, permissionRepository, resourceRepository, policyRepo, attributeValueRepository, attributeDefinitionRepository, policyExecutor, recentRequests)
+	hybridPermissionService := hybrid.NewBizEnginePermissionService(businessConfigCachedRepository, permissionService, service, permissionSvc)
+	permissionServiceServer := rbac2.NewPermissionServiceServer(hybridPermissionService)
+	batchPermissionServer := grpc.NewBatchPermissionServer(hybridPermissionService)
+	policySvc := abac.NewPolicySvc(policyRepo, attributeDefinitionRepository, permissionSvc)
+	abacPolicyServer := abac2.NewABACPolicyServer(policySvc, permissionSvc)
+	attributeValueSvc := abac.NewAttributeValueSvc(attributeValueRepository, attributeDefinitionRepository)
+	abacAttributeValServer := abac2.NewABACAttributeValServer(attributeValueSvc)
+	attributeDefinitionSvc := abac.NewAttributeDefinitionSvc(attributeDefinitionRepository)
+	abacAttributeDefinitionServer := abac2.NewABACAttributeDefinitionServer(attributeDefinitionSvc)
+	bizmodelService := bizmodel.NewService(service, attributeDefinitionRepository, attributeValueRepository, policySvc)
+	bizModelServer := grpc.NewBizModelServer(bizmodelService)
 	operationLogDAO := audit.NewOperationLogDAO(v)
-	v3 := ioc.InitGRPC(server, permissionServiceServer, token, operationLogDAO)
+	limiter := ioc.InitRateLimiter(cmdable)
+	v3 := ioc.InitGRPC(server, permissionServiceServer, batchPermissionServer, abacPolicyServer, abacAttributeValServer, abacAttributeDefinitionServer, bizModelServer, token, operationLogDAO, businessConfigCachedRepository, limiter)
 	userRoleLogDAO := audit.NewUserRoleLogDAO(v)
 	userRoleBinlogEventConsumer := initUserRoleBinlogEventConsumer(userRoleLogDAO)
-	v4 := ioc.InitTasks(userRoleBinlogEventConsumer)
+	userRoleExpirationTask := initUserRoleExpirationTask(userRoleDefaultRepository, userPermissionCachedRepository)
+	policyCron := initPolicyCron(component, policyRepo, ecacheCache)
+	attributeValueTask := initAttributeValueTask(attributeValueRepository, ecacheCache, client, component)
+	v4 := ioc.InitTasks(userRoleBinlogEventConsumer, userRoleExpirationTask, policyCron, attributeValueTask)
 	app := &ioc.App{
 		GrpcServers: v3,
 		Tasks:       v4,
@@ -73,12 +123,41 @@ func InitApp() *ioc.App {
 // wire.go:
 
 var (
-	baseSet    = wire.NewSet(ioc.InitDB, ioc.InitEtcdClient, ioc.InitIDGenerator, ioc.InitRedisClient, ioc.InitLocalCache, ioc.InitRedisCmd, ioc.InitJWTToken, ioc.InitMultipleLevelCache, ioc.InitCacheKeyFunc, ioc.InitKafkaProducer)
-	rbacSvcSet = wire.NewSet(rbac.NewService, rbac.NewPermissionService, dao.NewBusinessConfigDAO, repository.NewBusinessConfigRepository, dao.NewResourceDAO, repository.NewResourceRepository, dao.NewPermissionDAO, repository.NewPermissionRepository, dao.NewRoleDAO, repository.NewRoleRepository, dao.NewRoleInclusionDAO, repository.NewRoleInclusionDefaultRepository, repository.NewRoleInclusionReloadCacheRepository, wire.Bind(new(repository.RoleInclusionRepository), new(*repository.RoleInclusionReloadCacheRepository)), dao.NewRolePermissionDAO, repository.NewRolePermissionDefaultRepository, repository.NewRolePermissionReloadCacheRepository, wire.Bind(new(repository.RolePermissionRepository), new(*repository.RolePermissionReloadCacheRepository)), dao.NewUserRoleDAO, repository.NewUserRoleDefaultRepository, repository.NewUserRoleReloadCacheRepository, wire.Bind(new(repository.UserRoleRepository), new(*repository.UserRoleReloadCacheRepository)), dao.NewUserPermissionDAO, repository.NewUserPermissionDefaultRepository, cache.NewUserPermissionCache, repository.NewUserPermissionCachedRepository, wire.Bind(new(repository.UserPermissionRepository), new(*repository.UserPermissionCachedRepository)), wire.Bind(new(repository.UserPermissionCacheReloader), new(*repository.UserPermissionCachedRepository)), audit.NewUserRoleLogDAO, audit.NewOperationLogDAO, initUserRoleBinlogEventConsumer,
+	baseSet    = wire.NewSet(ioc.InitDB, ioc.InitEtcdClient, ioc.InitIDGenerator, ioc.InitRedisClient, ioc.InitLocalCache, ioc.InitRedisCmd, ioc.InitJWTToken, ioc.InitMultipleLevelCache, ioc.InitCacheKeyFunc, ioc.InitKafkaProducer, ioc.InitRateLimiter)
+	rbacSvcSet = wire.NewSet(rbac.NewService, rbac.NewPermissionService, dao.NewBusinessConfigDAO, repository.NewBusinessConfigDefaultRepository, initBusinessConfigCache, repository.NewBusinessConfigCachedRepository, wire.Bind(new(repository.BusinessConfigRepository), new(*repository.BusinessConfigCachedRepository)), dao.NewResourceDAO, repository.NewResourceRepository, dao.NewPermissionDAO, repository.NewPermissionRepository, dao.NewActionDefinitionDAO, repository.NewActionDefinitionDefaultRepository, repository.NewActionDefinitionReloadCacheRepository, wire.Bind(new(repository.ActionDefinitionRepository), new(*repository.ActionDefinitionReloadCacheRepository)), dao.NewRoleDAO, repository.NewRoleRepository, dao.NewRoleInclusionDAO, repository.NewRoleInclusionDefaultRepository, repository.NewRoleInclusionReloadCacheRepository, wire.Bind(new(repository.RoleInclusionRepository), new(*repository.RoleInclusionReloadCacheRepository)), dao.NewRolePermissionDAO, repository.NewRolePermissionDefaultRepository, repository.NewRolePermissionReloadCacheRepository, wire.Bind(new(repository.RolePermissionRepository), new(*repository.RolePermissionReloadCacheRepository)), dao.NewUserRoleDAO, repository.NewUserRoleDefaultRepository, repository.NewUserRoleReloadCacheRepository, wire.Bind(new(repository.UserRoleRepository), new(*repository.UserRoleReloadCacheRepository)), dao.NewUserPermissionDAO, repository.NewUserPermissionDefaultRepository, dao.NewSoDConstraintDAO, dao.NewRoleActivationDAO, audit.NewSoDViolationLogDAO, repository.NewSoDConstraintDefaultRepository, repository.NewSoDConstraintReloadCacheRepository, wire.Bind(new(repository.SoDConstraintRepository), new(*repository.SoDConstraintReloadCacheRepository)), cache.NewUserPermissionCache, repository.NewUserPermissionCachedRepository, wire.Bind(new(repository.UserPermissionRepository), new(*repository.UserPermissionCachedRepository)), wire.Bind(new(repository.UserPermissionCacheReloader), new(*repository.UserPermissionCachedRepository)), audit.NewUserRoleLogDAO, audit.NewOperationLogDAO, initUserRoleBinlogEventConsumer,
 		initUserPermissionEventProducer,
+		initUserRoleExpirationTask,
+	)
+	abacSvcSet = wire.NewSet(dao.NewAttributeDefinitionDAO, dao.NewSubjectAttributeValueDAO, dao.NewResourceAttributeValueDAO, dao.NewEnvironmentAttributeDAO, dao.NewPolicyDAO, initAbacDefinitionRepo,
+		initAbacAttributeValueRepo,
+		initAbacPolicyRepo, evaluator.NewSelector, abac.NewPolicyExecutor, initRecentRequests, abac.NewPermissionSvc, abac.NewPolicySvc, abac.NewAttributeValueSvc, abac.NewAttributeDefinitionSvc, initPolicyCron,
+		initAttributeValueTask,
 	)
 )
 
+func initAbacDefinitionRepo(definitionDAO dao.AttributeDefinitionDAO, localCache ecache.Cache, client *redis.Client) repository.AttributeDefinitionRepository {
+	return repository.NewAttributeDefinitionRepository(definitionDAO, local.NewAbacDefLocalCache(localCache, client), redisx.NewAbacDefCache(client))
+}
+
+func initAbacAttributeValueRepo(
+	envDAO dao.EnvironmentAttributeDAO,
+	resourceDAO dao.ResourceAttributeValueDAO,
+	subjectDAO dao.SubjectAttributeValueDAO,
+	definitionDAO dao.AttributeDefinitionDAO,
+	localCache ecache.Cache,
+	client *redis.Client,
+) repository.AttributeValueRepository {
+	return repository.NewAttributeValueRepository(envDAO, resourceDAO, subjectDAO, definitionDAO, redisx.NewAbacAttributeValCache(client), local.NewAbacAttributeValCache(localCache))
+}
+
+func initAbacPolicyRepo(policyDAO dao.PolicyDAO, localCache ecache.Cache, client *redis.Client) repository.PolicyRepo {
+	return repository.NewPolicyRepository(policyDAO, redisx.NewAbacPolicy(client), local.NewAbacPolicy(localCache))
+}
+
+func initBusinessConfigCache(client redis.Cmdable) cache.BusinessConfigCache {
+	return cache.NewBusinessConfigCache(ecacheredis.NewCache(client))
+}
+
 func initUserRoleBinlogEventConsumer(dao2 audit.UserRoleLogDAO) *audit2.UserRoleBinlogEventConsumer {
 	type Consumer struct {
 		GroupID string `yaml:"groupId"`
@@ -114,3 +193,58 @@ func initUserPermissionEventProducer(producer *kafka.Producer) permission.UserPe
 	}
 	return p
 }
+
+func initUserRoleExpirationTask(
+	repo *repository.UserRoleDefaultRepository,
+	cacheReloader repository.UserPermissionCacheReloader,
+) *rbac.UserRoleExpirationTask {
+	type Config struct {
+		Interval  time.Duration `yaml:"interval"`
+		BatchSize int           `yaml:"batchSize"`
+	}
+	var cfg Config
+	err := econf.UnmarshalKey("userRoleExpiration", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return rbac.NewUserRoleExpirationTask(repo, cacheReloader, cfg.Interval, cfg.BatchSize)
+}
+
+func initPolicyCron(client *eetcd.Component, repo repository.PolicyRepo, localCache ecache.Cache) *abac.PolicyCron {
+	type Config struct {
+		Interval time.Duration `yaml:"interval"`
+	}
+	var cfg Config
+	err := econf.UnmarshalKey("abac.policyCron", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return abac.NewPolicyCron(client, repo, local.NewAbacPolicy(localCache)).WithInterval(cfg.Interval)
+}
+
+func initRecentRequests() *abac.RecentRequests {
+	var cfg abac.RecentRequestsConfig
+	err := econf.UnmarshalKey("abac.recentRequests", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return abac.NewRecentRequests(cfg)
+}
+
+func initAttributeValueTask(
+	repo repository.AttributeValueRepository,
+	localCache ecache.Cache,
+	client *redis.Client,
+	etcdClient *eetcd.Component,
+) *abac.AttributeValueTask {
+	type Config struct {
+		ResourceKey string `yaml:"resourceKey"`
+		SubjectKey  string `yaml:"subjectKey"`
+	}
+	var cfg Config
+	err := econf.UnmarshalKey("abac.attributeValueTask", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return abac.NewAttributeValueTask(repo, local.NewAbacAttributeValCache(localCache), redisx.NewAbacAttributeValCache(client), cfg.ResourceKey, cfg.SubjectKey, etcdClient)
+}
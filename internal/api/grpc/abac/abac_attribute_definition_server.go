@@ -26,7 +26,7 @@ func (a *ABACAttributeDefinitionServer) Save(ctx context.Context, request *permi
 	def := convertToDomainAttributeDefinition(request.Definition)
 	id, err := a.svc.Save(ctx, bizID, def)
 	if err != nil {
-		return nil, err
+		return nil, a.toStatusError(err)
 	}
 	return &permissionpb.AttributeDefinitionServiceSaveResponse{Id: id}, nil
 }
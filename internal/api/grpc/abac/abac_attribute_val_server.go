@@ -30,7 +30,7 @@ func (a *ABACAttributeValServer) SaveSubjectValue(ctx context.Context, request *
 	}
 	id, err := a.svc.SaveSubjectValue(ctx, bizID, request.SubjectId, val)
 	if err != nil {
-		return nil, err
+		return nil, a.toStatusError(err)
 	}
 	return &permissionpb.AttributeValueServiceSaveSubjectValueResponse{
 		Id: id,
@@ -79,7 +79,7 @@ func (a *ABACAttributeValServer) SaveResourceValue(ctx context.Context, request
 	}
 	id, err := a.svc.SaveResourceValue(ctx, bizID, request.ResourceId, val)
 	if err != nil {
-		return nil, err
+		return nil, a.toStatusError(err)
 	}
 	return &permissionpb.AttributeValueServiceSaveResourceValueResponse{
 		Id: id,
@@ -128,7 +128,7 @@ func (a *ABACAttributeValServer) SaveEnvironmentValue(ctx context.Context, reque
 	}
 	id, err := a.svc.SaveEnvironmentValue(ctx, bizID, val)
 	if err != nil {
-		return nil, err
+		return nil, a.toStatusError(err)
 	}
 	return &permissionpb.AttributeValueServiceSaveEnvironmentValueResponse{
 		Id: id,
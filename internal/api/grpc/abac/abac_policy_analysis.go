@@ -0,0 +1,39 @@
+package abac
+
+import (
+	"context"
+
+	permissionpb "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ABACPolicyServer) AnalyzePolicies(ctx context.Context, req *permissionpb.PolicyServiceAnalyzePoliciesRequest) (*permissionpb.PolicyServiceAnalyzePoliciesResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := s.svc.Analyze(ctx, bizID, req.PolicyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "分析策略失败: "+err.Error())
+	}
+	return &permissionpb.PolicyServiceAnalyzePoliciesResponse{
+		Issues: convertToProtoPolicyIssues(issues),
+	}, nil
+}
+
+func convertToProtoPolicyIssues(issues []domain.PolicyIssue) []*permissionpb.PolicyIssue {
+	return slice.Map(issues, func(_ int, src domain.PolicyIssue) *permissionpb.PolicyIssue {
+		return &permissionpb.PolicyIssue{
+			Level:           string(src.Level),
+			Kind:            string(src.Kind),
+			PolicyId:        src.PolicyID,
+			RuleId:          src.RuleID,
+			RelatedPolicyId: src.RelatedPolicyID,
+			PermissionId:    src.PermissionID,
+			Message:         src.Message,
+		}
+	})
+}
@@ -6,17 +6,21 @@ import (
 	permissionpb "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
 	"gitee.com/flycash/permission-platform/internal/domain"
 	abacSvc "gitee.com/flycash/permission-platform/internal/service/abac"
+	"github.com/ecodeclub/ekit/slice"
 )
 
 type ABACPolicyServer struct {
 	permissionpb.UnsafePolicyServiceServer
 	baseServer
 	svc abacSvc.PolicySvc
+	// permissionSvc 用于策略模拟
+	permissionSvc abacSvc.PermissionSvc
 }
 
-func NewABACPolicyServer(svc abacSvc.PolicySvc) *ABACPolicyServer {
+func NewABACPolicyServer(svc abacSvc.PolicySvc, permissionSvc abacSvc.PermissionSvc) *ABACPolicyServer {
 	return &ABACPolicyServer{
-		svc: svc,
+		svc:           svc,
+		permissionSvc: permissionSvc,
 	}
 }
 
@@ -27,12 +31,13 @@ func (s *ABACPolicyServer) Save(ctx context.Context, req *permissionpb.PolicySer
 	}
 	policy := convertToDomainPolicy(req.Policy)
 	policy.BizID = bizID
-	id, err := s.svc.Save(ctx, policy)
+	id, issues, err := s.svc.Save(ctx, policy)
 	if err != nil {
-		return nil, err
+		return nil, s.toStatusError(err)
 	}
 	return &permissionpb.PolicyServiceSaveResponse{
-		Id: id,
+		Id:     id,
+		Issues: convertToProtoPolicyIssues(issues),
 	}, nil
 }
 
@@ -70,7 +75,7 @@ func (s *ABACPolicyServer) SaveRule(ctx context.Context, req *permissionpb.Polic
 	rule := convertToDomainPolicyRule(req.Rule)
 	id, err := s.svc.SaveRule(ctx, bizID, req.PolicyId, rule) // Dereference the pointer
 	if err != nil {
-		return nil, err
+		return nil, s.toStatusError(err)
 	}
 	return &permissionpb.PolicyServiceSaveRuleResponse{
 		Id: id,
@@ -116,6 +121,48 @@ func (s *ABACPolicyServer) FindPolicies(ctx context.Context, req *permissionpb.P
 	}, nil
 }
 
+func (s *ABACPolicyServer) Publish(ctx context.Context, req *permissionpb.PolicyServicePublishRequest) (*permissionpb.PolicyServicePublishResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.svc.Publish(ctx, bizID, req.PolicyId)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	return &permissionpb.PolicyServicePublishResponse{
+		Version: version,
+	}, nil
+}
+
+func (s *ABACPolicyServer) Rollback(ctx context.Context, req *permissionpb.PolicyServiceRollbackRequest) (*permissionpb.PolicyServiceRollbackResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.svc.Rollback(ctx, bizID, req.PolicyId, req.Version)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	return &permissionpb.PolicyServiceRollbackResponse{
+		Version: version,
+	}, nil
+}
+
+func (s *ABACPolicyServer) FindVersions(ctx context.Context, req *permissionpb.PolicyServiceFindVersionsRequest) (*permissionpb.PolicyServiceFindVersionsResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.svc.FindVersions(ctx, bizID, req.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+	return &permissionpb.PolicyServiceFindVersionsResponse{
+		Versions: convertToProtoPolicyVersions(versions),
+	}, nil
+}
+
 // Helper functions to convert between domain and proto types
 func convertToDomainPolicy(p *permissionpb.Policy) domain.Policy {
 	if p == nil {
@@ -125,12 +172,10 @@ func convertToDomainPolicy(p *permissionpb.Policy) domain.Policy {
 		ID:          p.Id,
 		Name:        p.Name,
 		Description: p.Description,
+		ExecuteType: domain.ExecuteType(p.ExecuteType),
+		Expression:  p.Expression,
 		Status:      convertToDomainPolicyStatus(p.Status),
-		Permissions: []domain.UserPermission{
-			{
-				Effect: domain.Effect(p.Effect),
-			},
-		},
+		Permissions: convertToDomainPolicyPermissions(p),
 
 		Rules: convertToDomainPolicyRules(p.Rules),
 		Ctime: p.Ctime,
@@ -150,11 +195,49 @@ func convertToProtoPolicy(p domain.Policy) *permissionpb.Policy {
 		Status:      convertToProtoPolicyStatus(p.Status),
 		Effect:      convertToProtoEffect(effect),
 		Rules:       convertToProtoPolicyRules(p.Rules),
+		ExecuteType: p.ExecuteType.String(),
+		Expression:  p.Expression,
 		Ctime:       p.Ctime,
 		Utime:       p.Utime,
+
+		PublishedVersion: p.PublishedVersion,
+		Permissions: slice.Map(p.Permissions, func(_ int, src domain.UserPermission) *permissionpb.PolicyPermission {
+			return &permissionpb.PolicyPermission{
+				PermissionId: src.Permission.ID,
+				Effect:       convertToProtoEffect(src.Effect),
+			}
+		}),
 	}
 }
 
+// convertToDomainPolicyPermissions 没有传 permissions 的时候和之前一样只使用 effect
+func convertToDomainPolicyPermissions(p *permissionpb.Policy) []domain.UserPermission {
+	if len(p.Permissions) == 0 {
+		return []domain.UserPermission{
+			{
+				Effect: domain.Effect(p.Effect),
+			},
+		}
+	}
+	return slice.Map(p.Permissions, func(_ int, src *permissionpb.PolicyPermission) domain.UserPermission {
+		return domain.UserPermission{
+			Permission: domain.Permission{ID: src.PermissionId},
+			Effect:     convertToDomainEffect(src.Effect),
+		}
+	})
+}
+
+func convertToProtoPolicyVersions(versions []domain.PolicyVersion) []*permissionpb.PolicyVersion {
+	return slice.Map(versions, func(_ int, src domain.PolicyVersion) *permissionpb.PolicyVersion {
+		return &permissionpb.PolicyVersion{
+			PolicyId: src.PolicyID,
+			Version:  src.Version,
+			Policy:   convertToProtoPolicy(src.Policy),
+			Ctime:    src.Ctime,
+		}
+	})
+}
+
 func convertToDomainPolicyRules(rules []*permissionpb.PolicyRule) []domain.PolicyRule {
 	if rules == nil {
 		return nil
@@ -181,27 +264,45 @@ func convertToDomainPolicyRule(r *permissionpb.PolicyRule) domain.PolicyRule {
 	if r == nil {
 		return domain.PolicyRule{}
 	}
-	left := convertToDomainPolicyRule(r.LeftRule)
-	right := convertToDomainPolicyRule(r.RightRule)
-	return domain.PolicyRule{
-		ID:        r.Id,
-		AttrDef:   convertToDomainAttributeDefinition(r.AttributeDefinition),
-		Value:     r.Value,
-		Operator:  convertToDomainOperator(r.Operator),
-		LeftRule:  &left,
-		RightRule: &right,
+	res := domain.PolicyRule{
+		ID:       r.Id,
+		AttrDef:  convertToDomainAttributeDefinition(r.AttributeDefinition),
+		Value:    r.Value,
+		Operator: convertToDomainOperator(r.Operator),
 	}
+	// 叶子节点的左右规则为 nil，执行的时候依赖这一点区分叶子节点
+	if r.LeftRule != nil {
+		left := convertToDomainPolicyRule(r.LeftRule)
+		res.LeftRule = &left
+	}
+	if r.RightRule != nil {
+		right := convertToDomainPolicyRule(r.RightRule)
+		res.RightRule = &right
+	}
+	if r.ValueAttributeDefinition != nil {
+		def := convertToDomainAttributeDefinition(r.ValueAttributeDefinition)
+		res.ValueAttrDef = &def
+	}
+	return res
 }
 
 func convertToProtoPolicyRule(r domain.PolicyRule) *permissionpb.PolicyRule {
-	return &permissionpb.PolicyRule{
+	res := &permissionpb.PolicyRule{
 		Id:                  r.ID,
 		AttributeDefinition: convertToProtoAttributeDefinition(r.AttrDef),
 		Value:               r.Value,
 		Operator:            convertToProtoOperator(r.Operator),
-		LeftRule:            convertToProtoPolicyRule(r.SafeLeft()),
-		RightRule:           convertToProtoPolicyRule(r.SafeRight()),
 	}
+	if r.LeftRule != nil {
+		res.LeftRule = convertToProtoPolicyRule(*r.LeftRule)
+	}
+	if r.RightRule != nil {
+		res.RightRule = convertToProtoPolicyRule(*r.RightRule)
+	}
+	if r.ValueAttrDef != nil {
+		res.ValueAttributeDefinition = convertToProtoAttributeDefinition(*r.ValueAttrDef)
+	}
+	return res
 }
 
 func convertToDomainAttributeDefinition(d *permissionpb.AttributeDefinition) domain.AttributeDefinition {
@@ -289,6 +390,8 @@ func convertToDomainDataType(d permissionpb.DataType) domain.DataType {
 		return domain.DataTypeFloat
 	case permissionpb.DataType_DATA_TYPE_DATETIME:
 		return domain.DataTypeDatetime
+	case permissionpb.DataType_DATA_TYPE_IP:
+		return domain.DataTypeIP
 	default:
 		return domain.DataType("")
 	}
@@ -306,6 +409,8 @@ func convertToProtoDataType(d domain.DataType) permissionpb.DataType {
 		return permissionpb.DataType_DATA_TYPE_FLOAT
 	case domain.DataTypeDatetime:
 		return permissionpb.DataType_DATA_TYPE_DATETIME
+	case domain.DataTypeIP:
+		return permissionpb.DataType_DATA_TYPE_IP
 	default:
 		return permissionpb.DataType_DATA_TYPE_UNKNOWN
 	}
@@ -361,6 +466,20 @@ func convertToDomainOperator(o permissionpb.RuleOperator) domain.RuleOperator {
 		return domain.NotIn
 	case permissionpb.RuleOperator_RULE_OPERATOR_NOT:
 		return domain.NOT
+	case permissionpb.RuleOperator_RULE_OPERATOR_STARTS_WITH:
+		return domain.StartsWith
+	case permissionpb.RuleOperator_RULE_OPERATOR_ENDS_WITH:
+		return domain.EndsWith
+	case permissionpb.RuleOperator_RULE_OPERATOR_CONTAINS:
+		return domain.Contains
+	case permissionpb.RuleOperator_RULE_OPERATOR_MATCHES:
+		return domain.Matches
+	case permissionpb.RuleOperator_RULE_OPERATOR_EQUALS_IGNORE_CASE:
+		return domain.EqualsIgnoreCase
+	case permissionpb.RuleOperator_RULE_OPERATOR_IN_CIDR:
+		return domain.InCIDR
+	case permissionpb.RuleOperator_RULE_OPERATOR_NOT_IN_CIDR:
+		return domain.NotInCIDR
 	default:
 		return domain.RuleOperator("")
 	}
@@ -390,6 +509,20 @@ func convertToProtoOperator(o domain.RuleOperator) permissionpb.RuleOperator {
 		return permissionpb.RuleOperator_RULE_OPERATOR_NOT_IN
 	case domain.NOT:
 		return permissionpb.RuleOperator_RULE_OPERATOR_NOT
+	case domain.StartsWith:
+		return permissionpb.RuleOperator_RULE_OPERATOR_STARTS_WITH
+	case domain.EndsWith:
+		return permissionpb.RuleOperator_RULE_OPERATOR_ENDS_WITH
+	case domain.Contains:
+		return permissionpb.RuleOperator_RULE_OPERATOR_CONTAINS
+	case domain.Matches:
+		return permissionpb.RuleOperator_RULE_OPERATOR_MATCHES
+	case domain.EqualsIgnoreCase:
+		return permissionpb.RuleOperator_RULE_OPERATOR_EQUALS_IGNORE_CASE
+	case domain.InCIDR:
+		return permissionpb.RuleOperator_RULE_OPERATOR_IN_CIDR
+	case domain.NotInCIDR:
+		return permissionpb.RuleOperator_RULE_OPERATOR_NOT_IN_CIDR
 	default:
 		return permissionpb.RuleOperator_RULE_OPERATOR_UNKNOWN
 	}
@@ -0,0 +1,140 @@
+package abac
+
+import (
+	"context"
+
+	permissionpb "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 一次模拟最多重放的请求数量
+const maxSimulateRequests = 10000
+
+func (s *ABACPolicyServer) SimulatePolicy(ctx context.Context, req *permissionpb.PolicyServiceSimulatePolicyRequest) (*permissionpb.PolicyServiceSimulatePolicyResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.SampleSize < 0 || len(req.Requests)+int(req.SampleSize) > maxSimulateRequests {
+		return nil, status.Errorf(codes.InvalidArgument, "一次最多重放 %d 个请求", maxSimulateRequests)
+	}
+	requests := make([]domain.CheckRequest, 0, len(req.Requests)+int(req.SampleSize))
+	for _, r := range req.Requests {
+		if r.GetPermission() == nil {
+			return nil, status.Error(codes.InvalidArgument, "校验请求的权限不能为空")
+		}
+		requests = append(requests, convertToDomainCheckRequest(bizID, r))
+	}
+	requests = append(requests, s.permissionSvc.RecentRequests(bizID, int(req.SampleSize))...)
+	candidates := slice.Map(req.Policies, func(_ int, src *permissionpb.Policy) domain.Policy {
+		policy := convertToDomainPolicy(src)
+		policy.BizID = bizID
+		return policy
+	})
+	res, err := s.permissionSvc.Simulate(ctx, bizID, candidates, requests)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "模拟策略失败: "+err.Error())
+	}
+	return &permissionpb.PolicyServiceSimulatePolicyResponse{
+		Total: int32(res.Total),
+		Flips: slice.Map(res.Flips, func(_ int, src domain.DecisionFlip) *permissionpb.DecisionFlip {
+			return &permissionpb.DecisionFlip{
+				Request: convertToProtoCheckRequest(src.Request),
+				Before:  src.Before,
+				After:   src.After,
+				Changes: slice.Map(src.Changes, func(_ int, change domain.PolicyChange) *permissionpb.PolicyChange {
+					res := &permissionpb.PolicyChange{}
+					if change.Before != nil {
+						res.Before = ToPolicyTraceProto(*change.Before)
+					}
+					if change.After != nil {
+						res.After = ToPolicyTraceProto(*change.After)
+					}
+					return res
+				}),
+			}
+		}),
+		Failures: slice.Map(res.Failures, func(_ int, src domain.SimulationFailure) *permissionpb.SimulationFailure {
+			return &permissionpb.SimulationFailure{
+				Request: convertToProtoCheckRequest(src.Request),
+				Error:   src.Err,
+			}
+		}),
+	}, nil
+}
+
+func convertToDomainCheckRequest(bizID int64, r *permissionpb.CheckPermissionRequest) domain.CheckRequest {
+	return domain.CheckRequest{
+		UID: r.Uid,
+		Resource: domain.Resource{
+			BizID: bizID,
+			Type:  r.Permission.ResourceType,
+			Key:   r.Permission.ResourceKey,
+		},
+		Actions: r.Permission.Actions,
+		Attrs: domain.Attributes{
+			Subject:     r.SubjectAttributes,
+			Resource:    r.ResourceAttributes,
+			Environment: r.EnvironmentAttributes,
+		},
+	}
+}
+
+func convertToProtoCheckRequest(r domain.CheckRequest) *permissionpb.CheckPermissionRequest {
+	return &permissionpb.CheckPermissionRequest{
+		Uid: r.UID,
+		Permission: &permissionpb.Permission{
+			ResourceType: r.Resource.Type,
+			ResourceKey:  r.Resource.Key,
+			Actions:      r.Actions,
+		},
+		SubjectAttributes:     r.Attrs.Subject,
+		ResourceAttributes:    r.Attrs.Resource,
+		EnvironmentAttributes: r.Attrs.Environment,
+	}
+}
+
+// ToPolicyTraceProto 策略的判定过程，ExplainPermission 和 SimulatePolicy 共用
+func ToPolicyTraceProto(t domain.PolicyTrace) *permissionpb.PolicyTrace {
+	return &permissionpb.PolicyTrace{
+		PolicyId: t.Policy.ID,
+		Name:     t.Policy.Name,
+		Matched:  t.Matched,
+		Permissions: slice.Map(t.Policy.Permissions, func(_ int, src domain.UserPermission) *permissionpb.PolicyPermissionTrace {
+			return &permissionpb.PolicyPermissionTrace{
+				PermissionId: src.Permission.ID,
+				Effect:       src.Effect.String(),
+			}
+		}),
+		Rules: slice.Map(t.Rules, func(_ int, src domain.RuleTrace) *permissionpb.RuleTrace {
+			return toRuleTraceProto(src)
+		}),
+	}
+}
+
+func toRuleTraceProto(t domain.RuleTrace) *permissionpb.RuleTrace {
+	res := &permissionpb.RuleTrace{
+		RuleId:      t.Rule.ID,
+		Operator:    t.Rule.Operator.String(),
+		AttrDefId:   t.Rule.AttrDef.ID,
+		AttrName:    t.Rule.AttrDef.Name,
+		Value:       t.Rule.Value,
+		ActualValue: t.ActualValue,
+		Result:      t.Result,
+		Error:       t.Err,
+	}
+	if t.Rule.ValueAttrDef != nil {
+		res.ValueAttrDefId = t.Rule.ValueAttrDef.ID
+		res.ValueAttrName = t.Rule.ValueAttrDef.Name
+	}
+	if t.Left != nil {
+		res.Left = toRuleTraceProto(*t.Left)
+	}
+	if t.Right != nil {
+		res.Right = toRuleTraceProto(*t.Right)
+	}
+	return res
+}
@@ -0,0 +1,124 @@
+package abac
+
+import (
+	"context"
+
+	permissionpb "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ABACPolicyServer) SaveTestCase(ctx context.Context, req *permissionpb.PolicyServiceSaveTestCaseRequest) (*permissionpb.PolicyServiceSaveTestCaseResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTestCase() == nil {
+		return nil, status.Error(codes.InvalidArgument, "测试用例不能为空")
+	}
+	testCase := convertToDomainPolicyTestCase(req.TestCase)
+	testCase.BizID = bizID
+	testCase.Resource.BizID = bizID
+	id, err := s.svc.SaveTestCase(ctx, testCase)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	return &permissionpb.PolicyServiceSaveTestCaseResponse{
+		Id: id,
+	}, nil
+}
+
+func (s *ABACPolicyServer) DeleteTestCase(ctx context.Context, req *permissionpb.PolicyServiceDeleteTestCaseRequest) (*permissionpb.PolicyServiceDeleteTestCaseResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.svc.DeleteTestCase(ctx, bizID, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "删除测试用例失败: "+err.Error())
+	}
+	return &permissionpb.PolicyServiceDeleteTestCaseResponse{}, nil
+}
+
+func (s *ABACPolicyServer) FindTestCases(ctx context.Context, req *permissionpb.PolicyServiceFindTestCasesRequest) (*permissionpb.PolicyServiceFindTestCasesResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cases, err := s.svc.FindTestCases(ctx, bizID, req.PolicyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询测试用例失败: "+err.Error())
+	}
+	return &permissionpb.PolicyServiceFindTestCasesResponse{
+		TestCases: slice.Map(cases, func(_ int, src domain.PolicyTestCase) *permissionpb.PolicyTestCase {
+			return convertToProtoPolicyTestCase(src)
+		}),
+	}, nil
+}
+
+func (s *ABACPolicyServer) RunPolicyTests(ctx context.Context, req *permissionpb.PolicyServiceRunPolicyTestsRequest) (*permissionpb.PolicyServiceRunPolicyTestsResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.svc.RunTests(ctx, bizID, req.PolicyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "执行测试用例失败: "+err.Error())
+	}
+	res := &permissionpb.PolicyServiceRunPolicyTestsResponse{
+		Results: make([]*permissionpb.PolicyTestResult, 0, len(results)),
+	}
+	for idx := range results {
+		result := results[idx]
+		if result.Passed {
+			res.Passed++
+		} else {
+			res.Failed++
+		}
+		res.Results = append(res.Results, &permissionpb.PolicyTestResult{
+			TestCase: convertToProtoPolicyTestCase(result.Case),
+			Passed:   result.Passed,
+			Actual:   convertToProtoEffect(result.Actual),
+			Error:    result.Err,
+		})
+	}
+	return res, nil
+}
+
+func convertToDomainPolicyTestCase(c *permissionpb.PolicyTestCase) domain.PolicyTestCase {
+	return domain.PolicyTestCase{
+		ID:       c.Id,
+		PolicyID: c.PolicyId,
+		Name:     c.Name,
+		Resource: domain.Resource{
+			Type: c.ResourceType,
+			Key:  c.ResourceKey,
+		},
+		Action: c.Action,
+		Attrs: domain.Attributes{
+			Subject:     c.SubjectAttributes,
+			Resource:    c.ResourceAttributes,
+			Environment: c.EnvironmentAttributes,
+		},
+		Expected: convertToDomainEffect(c.Expected),
+	}
+}
+
+func convertToProtoPolicyTestCase(c domain.PolicyTestCase) *permissionpb.PolicyTestCase {
+	return &permissionpb.PolicyTestCase{
+		Id:                    c.ID,
+		PolicyId:              c.PolicyID,
+		Name:                  c.Name,
+		ResourceType:          c.Resource.Type,
+		ResourceKey:           c.Resource.Key,
+		Action:                c.Action,
+		SubjectAttributes:     c.Attrs.Subject,
+		ResourceAttributes:    c.Attrs.Resource,
+		EnvironmentAttributes: c.Attrs.Environment,
+		Expected:              convertToProtoEffect(c.Expected),
+		Ctime:                 c.Ctime,
+		Utime:                 c.Utime,
+	}
+}
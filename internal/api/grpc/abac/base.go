@@ -2,31 +2,35 @@ package abac
 
 import (
 	"context"
-	"strconv"
+	"errors"
 
+	"gitee.com/flycash/permission-platform/internal/api/grpc/interceptor/auth"
+	"gitee.com/flycash/permission-platform/internal/errs"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 type baseServer struct{}
 
-// 从gRPC上下文中获取业务ID
+// 从gRPC上下文中获取业务ID，业务ID由 auth 拦截器从 token 中解析出来
 func (s *baseServer) getBizIDFromContext(ctx context.Context) (int64, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return 0, status.Error(codes.InvalidArgument, "无法获取元数据")
-	}
-
-	bizIDValues := md.Get("biz-id")
-	if len(bizIDValues) == 0 {
-		return 0, status.Error(codes.InvalidArgument, "未提供业务ID")
-	}
+	return auth.GetBizIDFromContext(ctx)
+}
 
-	bizID, err := strconv.ParseInt(bizIDValues[0], 10, 64)
-	if err != nil {
-		return 0, status.Error(codes.InvalidArgument, "业务ID格式不正确")
+// toStatusError 参数或者属性值不合法的错误转换成 InvalidArgument，策略、版本或者测试用例不存在转换成 NotFound，
+// 测试用例没有通过转换成 FailedPrecondition，测试之后草稿被修改过转换成 Aborted，其它错误原样返回
+func (s *baseServer) toStatusError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter), errors.Is(err, errs.ErrInvalidAttributeValue):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, errs.ErrPolicyNotFound), errors.Is(err, errs.ErrPolicyVersionNotFound),
+		errors.Is(err, errs.ErrPolicyTestCaseNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errs.ErrPolicyTestFailed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errs.ErrPolicyDraftChanged):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return err
 	}
-
-	return bizID, nil
 }
@@ -16,6 +16,12 @@ type BatchPermissionServer struct {
 	permissionSvc hybrid.PermissionService
 }
 
+func NewBatchPermissionServer(permissionSvc hybrid.PermissionService) *BatchPermissionServer {
+	return &BatchPermissionServer{
+		permissionSvc: permissionSvc,
+	}
+}
+
 func (b *BatchPermissionServer) BatchCheckPermission(ctx context.Context, request *permissionv1.BatchCheckPermissionRequest) (*permissionv1.BatchCheckPermissionResponse, error) {
 	var eg errgroup.Group
 	reqs := request.GetRequests()
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	permissionv1 "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
+	"gitee.com/flycash/permission-platform/internal/api/grpc/interceptor/auth"
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"gitee.com/flycash/permission-platform/internal/service/bizmodel"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BizModelServer struct {
+	permissionv1.UnimplementedBizModelServiceServer
+	svc bizmodel.Service
+}
+
+func NewBizModelServer(svc bizmodel.Service) *BizModelServer {
+	return &BizModelServer{
+		svc: svc,
+	}
+}
+
+func (b *BizModelServer) ExportBizModel(ctx context.Context, request *permissionv1.ExportBizModelRequest) (*permissionv1.ExportBizModelResponse, error) {
+	bizID, err := auth.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	model, err := b.svc.Export(ctx, bizID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "导出授权模型失败: "+err.Error())
+	}
+	doc, err := bizmodel.Encode(model, bizmodel.Format(request.GetFormat()))
+	if err != nil {
+		return nil, b.toStatusError(err, "导出授权模型失败")
+	}
+	return &permissionv1.ExportBizModelResponse{
+		Document: doc,
+	}, nil
+}
+
+func (b *BizModelServer) ImportBizModel(ctx context.Context, request *permissionv1.ImportBizModelRequest) (*permissionv1.ImportBizModelResponse, error) {
+	bizID, err := auth.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	model, err := bizmodel.Decode(request.GetDocument(), bizmodel.Format(request.GetFormat()))
+	if err != nil {
+		return nil, b.toStatusError(err, "导入授权模型失败")
+	}
+	res, err := b.svc.Import(ctx, bizID, model, request.GetDryRun())
+	if errors.Is(err, errs.ErrBizModelImportIncomplete) {
+		// 已经写入了一部分数据，把已经导入的统计放到错误详情里面
+		st, err1 := status.New(codes.Aborted, err.Error()).WithDetails(b.toImportResponse(res))
+		if err1 != nil {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, st.Err()
+	}
+	if err != nil {
+		return nil, b.toStatusError(err, "导入授权模型失败")
+	}
+	return b.toImportResponse(res), nil
+}
+
+func (b *BizModelServer) toImportResponse(res domain.BizModelImportResult) *permissionv1.ImportBizModelResponse {
+	return &permissionv1.ImportBizModelResponse{
+		DryRun: res.DryRun,
+		Stats: slice.Map(res.Stats, func(_ int, src domain.BizModelImportStat) *permissionv1.BizModelImportStat {
+			return &permissionv1.BizModelImportStat{
+				Kind:      src.Kind,
+				Created:   int32(src.Created),
+				Updated:   int32(src.Updated),
+				Unchanged: int32(src.Unchanged),
+			}
+		}),
+	}
+}
+
+// toStatusError 文档不合法转换成 InvalidArgument，角色包含关系成环转换成 FailedPrecondition，其它错误转换成 Internal
+func (b *BizModelServer) toStatusError(err error, msg string) error {
+	if errors.Is(err, errs.ErrRoleInclusionCycle) || errors.Is(err, errs.ErrSoDViolation) ||
+		errors.Is(err, errs.ErrRoleMemberLimitExceeded) || errors.Is(err, errs.ErrUserRoleLimitExceeded) {
+		return status.Error(codes.FailedPrecondition, msg+": "+err.Error())
+	}
+	if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrInvalidAttributeValue) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, msg+": "+err.Error())
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitee.com/flycash/permission-platform/internal/api/grpc/interceptor/auth"
+	"gitee.com/flycash/permission-platform/internal/pkg/ratelimit"
+	"gitee.com/flycash/permission-platform/internal/repository"
+	"github.com/gotomicro/ego/core/elog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// InterceptorBuilder 按照 BusinessConfig.RateLimit 对业务方限流，它必须放在 auth 之后。
+// 业务配置由仓储缓存，修改和删除之后删除缓存，所以阈值的变更在所有实例上立刻生效
+type InterceptorBuilder struct {
+	repo    repository.BusinessConfigRepository
+	limiter ratelimit.Limiter
+	logger  *elog.Component
+}
+
+func New(repo repository.BusinessConfigRepository, limiter ratelimit.Limiter) *InterceptorBuilder {
+	return &InterceptorBuilder{
+		repo:    repo,
+		limiter: limiter,
+		logger:  elog.DefaultLogger.With(elog.FieldName("RateLimitInterceptor")),
+	}
+}
+
+func (b *InterceptorBuilder) Build() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		bizID, err := auth.GetBizIDFromContext(ctx)
+		if err != nil {
+			// 没有业务ID的请求不限流
+			return handler(ctx, req)
+		}
+		rate := b.getRate(ctx, bizID)
+		if rate > 0 {
+			limited, err := b.limiter.Limit(ctx, fmt.Sprintf("biz:%d", bizID), rate)
+			if err != nil {
+				// 限流器出错的时候放行，保证可用性
+				b.logger.Error("限流器判断失败", elog.FieldErr(err), elog.Int64("bizID", bizID))
+			} else if limited {
+				return nil, status.Errorf(codes.ResourceExhausted, "业务方 %d 触发限流", bizID)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// getRate 没有业务配置，或者获取业务配置失败的时候不限流
+func (b *InterceptorBuilder) getRate(ctx context.Context, bizID int64) int {
+	cfg, err := b.repo.FindByID(ctx, bizID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		b.logger.Error("获取业务配置失败", elog.FieldErr(err), elog.Int64("bizID", bizID))
+		return 0
+	}
+	return cfg.RateLimit
+}
@@ -2,25 +2,30 @@ package rbac
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	permissionpb "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
+	abacgrpc "gitee.com/flycash/permission-platform/internal/api/grpc/abac"
 	"gitee.com/flycash/permission-platform/internal/domain"
-	"gitee.com/flycash/permission-platform/internal/service/rbac"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"gitee.com/flycash/permission-platform/internal/service/hybrid"
+	"github.com/ecodeclub/ekit/slice"
 )
 
 type PermissionServiceServer struct {
 	permissionpb.UnimplementedPermissionServiceServer
 	baseServer
-	rbacService rbac.PermissionService
+	// 按照业务方配置的判定引擎校验权限
+	permissionSvc hybrid.PermissionService
 }
 
 // NewPermissionServiceServer 创建权限服务器实例
-func NewPermissionServiceServer(rbacService rbac.PermissionService) *PermissionServiceServer {
+func NewPermissionServiceServer(permissionSvc hybrid.PermissionService) *PermissionServiceServer {
 	return &PermissionServiceServer{
-		rbacService: rbacService,
+		permissionSvc: permissionSvc,
 	}
 }
 
@@ -41,11 +46,18 @@ func (s *PermissionServiceServer) CheckPermission(ctx context.Context, req *perm
 
 	// 检查所有action的权限
 	// 调用服务层检查权限
-	hasPermission, err1 := s.rbacService.Check(ctx, bizID, req.Uid, domain.Resource{
+	hasPermission, err1 := s.permissionSvc.Check(ctx, bizID, req.Uid, domain.Resource{
 		BizID: bizID,
 		Type:  req.Permission.ResourceType,
 		Key:   req.Permission.ResourceKey,
-	}, req.Permission.Actions)
+	}, req.Permission.Actions, domain.Attributes{
+		Subject:     req.SubjectAttributes,
+		Resource:    req.ResourceAttributes,
+		Environment: req.EnvironmentAttributes,
+	})
+	if errors.Is(err1, errs.ErrInvalidAttributeValue) {
+		return nil, status.Error(codes.InvalidArgument, err1.Error())
+	}
 	if err1 != nil {
 		return nil, status.Error(codes.Internal, "检查权限时发生错误")
 	}
@@ -55,3 +67,147 @@ func (s *PermissionServiceServer) CheckPermission(ctx context.Context, req *perm
 		Allowed: hasPermission,
 	}, nil
 }
+
+// ExplainPermission 解释用户对特定资源的特定操作权限的判定过程
+func (s *PermissionServiceServer) ExplainPermission(ctx context.Context, req *permissionpb.ExplainPermissionRequest) (*permissionpb.ExplainPermissionResponse, error) {
+	if req.Uid <= 0 || req.Permission == nil || req.Permission.ResourceKey == "" || len(req.Permission.Actions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "uid、资源标识和操作不能为空")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	trace, err := s.permissionSvc.Explain(ctx, bizID, req.Uid, domain.Resource{
+		BizID: bizID,
+		Type:  req.Permission.ResourceType,
+		Key:   req.Permission.ResourceKey,
+	}, req.Permission.Actions, domain.Attributes{
+		Subject:     req.SubjectAttributes,
+		Resource:    req.ResourceAttributes,
+		Environment: req.EnvironmentAttributes,
+	})
+	if errors.Is(err, errs.ErrInvalidAttributeValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "解释权限判定过程失败: "+err.Error())
+	}
+	return toExplainPermissionResponse(trace), nil
+}
+
+// ListAccessibleResources 用户在某一类资源的某个操作上可以访问的资源
+func (s *PermissionServiceServer) ListAccessibleResources(ctx context.Context, req *permissionpb.ListAccessibleResourcesRequest) (*permissionpb.ListAccessibleResourcesResponse, error) {
+	if req.Uid <= 0 || req.ResourceType == "" || req.Action == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid、资源类型和操作不能为空")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := s.permissionSvc.ListAccessibleResources(ctx, bizID, req.Uid, req.ResourceType, req.Action, domain.Attributes{
+		Subject:     req.SubjectAttributes,
+		Environment: req.EnvironmentAttributes,
+	})
+	if errors.Is(err, errs.ErrInvalidAttributeValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询可访问的资源失败: "+err.Error())
+	}
+	return toListAccessibleResourcesResponse(access), nil
+}
+
+func toListAccessibleResourcesResponse(access domain.AccessibleResources) *permissionpb.ListAccessibleResourcesResponse {
+	resp := &permissionpb.ListAccessibleResourcesResponse{}
+	if access.RBAC != nil {
+		resp.Rbac = &permissionpb.RBACAccess{
+			Keys: slice.Map(access.RBAC.Keys, func(_ int, src domain.AccessibleKey) *permissionpb.AccessibleKey {
+				return &permissionpb.AccessibleKey{Key: src.Key, Except: src.Except}
+			}),
+		}
+	}
+	if access.ABAC != nil {
+		resp.Abac = &permissionpb.ABACAccess{
+			Residuals: slice.Map(access.ABAC.Residuals, func(_ int, src domain.ResourceResidual) *permissionpb.ResourceResidual {
+				return &permissionpb.ResourceResidual{
+					ResourceKey: src.Key,
+					Allowed:     src.Residual.Value,
+					Condition:   toResidualConditionProto(src.Residual.Condition),
+				}
+			}),
+			Unresolved:     access.ABAC.Unresolved,
+			DefaultAllowed: access.ABAC.DefaultAllowed,
+		}
+	}
+	return resp
+}
+
+func toResidualConditionProto(c *domain.ResidualCondition) *permissionpb.ResidualCondition {
+	if c == nil {
+		return nil
+	}
+	return &permissionpb.ResidualCondition{
+		Operator:      c.Operator.String(),
+		AttrName:      c.Attr,
+		DataType:      c.DataType.String(),
+		Value:         c.Value,
+		ValueAttrName: c.ValueAttr,
+		Reversed:      c.Reversed,
+		Left:          toResidualConditionProto(c.Left),
+		Right:         toResidualConditionProto(c.Right),
+	}
+}
+
+func toExplainPermissionResponse(trace domain.PermissionTrace) *permissionpb.ExplainPermissionResponse {
+	resp := &permissionpb.ExplainPermissionResponse{Allowed: trace.Allowed}
+	if trace.RBAC != nil {
+		resp.Rbac = &permissionpb.RBACTrace{
+			Allowed: trace.RBAC.Allowed,
+			Matched: slice.Map(trace.RBAC.Matched, func(_ int, src domain.PermissionMatch) *permissionpb.PermissionMatch {
+				return toPermissionMatchProto(src)
+			}),
+		}
+		if trace.RBAC.Decision != nil {
+			resp.Rbac.Decision = toPermissionMatchProto(*trace.RBAC.Decision)
+		}
+	}
+	if trace.ABAC != nil {
+		resp.Abac = &permissionpb.ABACTrace{
+			Allowed: trace.ABAC.Allowed,
+			Attributes: slice.Map(trace.ABAC.Attributes, func(_ int, src domain.AttributeValue) *permissionpb.AttributeValueTrace {
+				return &permissionpb.AttributeValueTrace{
+					AttrDefId:  src.Definition.ID,
+					Name:       src.Definition.Name,
+					EntityType: src.Definition.EntityType.String(),
+					DataType:   src.Definition.DataType.String(),
+					Value:      src.Value,
+				}
+			}),
+			Policies: slice.Map(trace.ABAC.Policies, func(_ int, src domain.PolicyTrace) *permissionpb.PolicyTrace {
+				return abacgrpc.ToPolicyTraceProto(src)
+			}),
+		}
+	}
+	return resp
+}
+
+func toPermissionMatchProto(m domain.PermissionMatch) *permissionpb.PermissionMatch {
+	up := m.Source.UserPermission
+	return &permissionpb.PermissionMatch{
+		UserPermissionId: up.ID,
+		PermissionId:     up.Permission.ID,
+		ResourceType:     up.Permission.Resource.Type,
+		ResourceKey:      up.Permission.Resource.Key,
+		Action:           up.Permission.Action,
+		Effect:           up.Effect.String(),
+		StartTime:        up.StartTime,
+		EndTime:          up.EndTime,
+		Valid:            m.Valid,
+		RolePath:         m.Source.RolePath,
+		ImpliedBy:        m.Source.ImpliedBy,
+	}
+}
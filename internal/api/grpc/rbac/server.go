@@ -2,6 +2,7 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/ecodeclub/ekit/slice"
@@ -10,6 +11,7 @@ import (
 
 	permissionpb "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
 	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
 	"gitee.com/flycash/permission-platform/internal/service/rbac"
 )
 
@@ -32,6 +34,9 @@ func (s *Server) CreateBusinessConfig(ctx context.Context, req *permissionpb.Cre
 	if req.Config == nil {
 		return nil, status.Error(codes.InvalidArgument, "业务配置不能为空")
 	}
+	if err := s.validateBusinessConfig(req.Config); err != nil {
+		return nil, err
+	}
 
 	// 将proto中的业务配置转换为领域模型
 	req.Config.Id = 0
@@ -49,25 +54,51 @@ func (s *Server) CreateBusinessConfig(ctx context.Context, req *permissionpb.Cre
 	}, nil
 }
 
+func (s *Server) validateBusinessConfig(cfg *permissionpb.BusinessConfig) error {
+	if !domain.Engine(cfg.Engine).IsValid() {
+		return status.Error(codes.InvalidArgument, "未知的权限判定引擎")
+	}
+	if !domain.CombiningAlgorithm(cfg.CombiningAlgorithm).IsValid() {
+		return status.Error(codes.InvalidArgument, "未知的策略合并算法")
+	}
+	if !domain.InvalidAttributeAction(cfg.InvalidAttributeAction).IsValid() {
+		return status.Error(codes.InvalidArgument, "属性值不合法时的处理方式只能是 reject 或者 ignore")
+	}
+	switch domain.Effect(cfg.NoPolicyEffect) {
+	case "", domain.EffectAllow, domain.EffectDeny:
+		return nil
+	default:
+		return status.Error(codes.InvalidArgument, "没有策略时的结果只能是 allow 或者 deny")
+	}
+}
+
 func (s *Server) toBusinessConfigDomain(req *permissionpb.BusinessConfig) domain.BusinessConfig {
 	return domain.BusinessConfig{
-		ID:        req.Id,
-		OwnerID:   req.OwnerId,
-		OwnerType: req.OwnerType,
-		Name:      req.Name,
-		RateLimit: int(req.RateLimit),
-		Token:     req.Token,
+		ID:                     req.Id,
+		OwnerID:                req.OwnerId,
+		OwnerType:              req.OwnerType,
+		Name:                   req.Name,
+		RateLimit:              int(req.RateLimit),
+		Engine:                 domain.Engine(req.Engine),
+		CombiningAlgorithm:     domain.CombiningAlgorithm(req.CombiningAlgorithm),
+		NoPolicyEffect:         domain.Effect(req.NoPolicyEffect),
+		InvalidAttributeAction: domain.InvalidAttributeAction(req.InvalidAttributeAction),
+		Token:                  req.Token,
 	}
 }
 
 func (s *Server) toBusinessConfigProto(created domain.BusinessConfig) *permissionpb.BusinessConfig {
 	return &permissionpb.BusinessConfig{
-		Id:        created.ID,
-		OwnerId:   created.OwnerID,
-		OwnerType: created.OwnerType,
-		Name:      created.Name,
-		RateLimit: int32(created.RateLimit),
-		Token:     created.Token,
+		Id:                     created.ID,
+		OwnerId:                created.OwnerID,
+		OwnerType:              created.OwnerType,
+		Name:                   created.Name,
+		RateLimit:              int32(created.RateLimit),
+		Engine:                 created.Engine.String(),
+		CombiningAlgorithm:     created.CombiningAlgorithm.String(),
+		NoPolicyEffect:         created.NoPolicyEffect.String(),
+		InvalidAttributeAction: created.InvalidAttributeAction.String(),
+		Token:                  created.Token,
 	}
 }
 
@@ -90,6 +121,9 @@ func (s *Server) UpdateBusinessConfig(ctx context.Context, req *permissionpb.Upd
 	if req.Config == nil || req.Config.Id <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "业务配置不能为空且ID必须大于0")
 	}
+	if err := s.validateBusinessConfig(req.Config); err != nil {
+		return nil, err
+	}
 
 	// 将proto中的业务配置转换为领域模型
 	domainConfig := s.toBusinessConfigDomain(req.Config)
@@ -301,6 +335,9 @@ func (s *Server) CreatePermission(ctx context.Context, req *permissionpb.CreateP
 	if req.Permission == nil {
 		return nil, status.Error(codes.InvalidArgument, "权限不能为空")
 	}
+	if !domain.CombiningAlgorithm(req.Permission.CombiningAlgorithm).IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "未知的策略合并算法")
+	}
 
 	bizID, err := s.getBizIDFromContext(ctx)
 	if err != nil {
@@ -348,8 +385,9 @@ func (s *Server) toPermissionDomain(req *permissionpb.Permission) domain.Permiss
 			Type: req.ResourceType,
 			Key:  req.ResourceKey,
 		},
-		Action:   action,
-		Metadata: md,
+		Action:             action,
+		Metadata:           md,
+		CombiningAlgorithm: domain.CombiningAlgorithm(req.CombiningAlgorithm),
 	}
 }
 
@@ -361,15 +399,16 @@ func (s *Server) toPermissionProto(created domain.Permission) *permissionpb.Perm
 	}
 
 	return &permissionpb.Permission{
-		Id:           created.ID,
-		BizId:        created.BizID,
-		Name:         created.Name,
-		Description:  created.Description,
-		ResourceId:   created.Resource.ID,
-		ResourceType: created.Resource.Type,
-		ResourceKey:  created.Resource.Key,
-		Actions:      actions,
-		Metadata:     created.Metadata,
+		Id:                 created.ID,
+		BizId:              created.BizID,
+		Name:               created.Name,
+		Description:        created.Description,
+		ResourceId:         created.Resource.ID,
+		ResourceType:       created.Resource.Type,
+		ResourceKey:        created.Resource.Key,
+		Actions:            actions,
+		Metadata:           created.Metadata,
+		CombiningAlgorithm: created.CombiningAlgorithm.String(),
 	}
 }
 
@@ -399,6 +438,9 @@ func (s *Server) UpdatePermission(ctx context.Context, req *permissionpb.UpdateP
 	if req.Permission == nil || req.Permission.Id <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "权限不能为空且ID必须大于0")
 	}
+	if !domain.CombiningAlgorithm(req.Permission.CombiningAlgorithm).IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "未知的策略合并算法")
+	}
 
 	bizID, err := s.getBizIDFromContext(ctx)
 	if err != nil {
@@ -471,6 +513,166 @@ func (s *Server) ListPermissions(ctx context.Context, req *permissionpb.ListPerm
 	}, nil
 }
 
+// ==== 操作定义相关方法 ====
+
+// CreateActionDefinition 创建操作定义
+func (s *Server) CreateActionDefinition(ctx context.Context, req *permissionpb.CreateActionDefinitionRequest) (*permissionpb.CreateActionDefinitionResponse, error) {
+	if req.ActionDefinition == nil {
+		return nil, status.Error(codes.InvalidArgument, "操作定义不能为空")
+	}
+	if err := s.validateActionDefinition(req.ActionDefinition); err != nil {
+		return nil, err
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ActionDefinition.Id = 0
+	req.ActionDefinition.BizId = bizID
+	created, err := s.rbacService.CreateActionDefinition(ctx, s.toActionDefinitionDomain(req.ActionDefinition))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "创建操作定义失败: "+err.Error())
+	}
+
+	return &permissionpb.CreateActionDefinitionResponse{
+		ActionDefinition: s.toActionDefinitionProto(created),
+	}, nil
+}
+
+// validateActionDefinition 操作名称不能为空也不能是 *，蕴含的操作不能为空也不能是自身
+func (s *Server) validateActionDefinition(def *permissionpb.ActionDefinition) error {
+	if def.Name == "" || def.Name == domain.AnyAction {
+		return status.Error(codes.InvalidArgument, "操作名称不能为空也不能是 "+domain.AnyAction)
+	}
+	for _, implied := range def.Implies {
+		if implied == "" || implied == def.Name {
+			return status.Error(codes.InvalidArgument, "蕴含的操作不能为空也不能是操作自身")
+		}
+	}
+	return nil
+}
+
+func (s *Server) toActionDefinitionDomain(req *permissionpb.ActionDefinition) domain.ActionDefinition {
+	return domain.ActionDefinition{
+		ID:          req.Id,
+		BizID:       req.BizId,
+		Name:        req.Name,
+		Description: req.Description,
+		Implies:     req.Implies,
+	}
+}
+
+func (s *Server) toActionDefinitionProto(def domain.ActionDefinition) *permissionpb.ActionDefinition {
+	return &permissionpb.ActionDefinition{
+		Id:          def.ID,
+		BizId:       def.BizID,
+		Name:        def.Name,
+		Description: def.Description,
+		Implies:     def.Implies,
+	}
+}
+
+// GetActionDefinition 获取操作定义
+func (s *Server) GetActionDefinition(ctx context.Context, req *permissionpb.GetActionDefinitionRequest) (*permissionpb.GetActionDefinitionResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "操作定义ID必须大于0")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	def, err := s.rbacService.GetActionDefinition(ctx, bizID, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "获取操作定义失败: "+err.Error())
+	}
+
+	return &permissionpb.GetActionDefinitionResponse{
+		ActionDefinition: s.toActionDefinitionProto(def),
+	}, nil
+}
+
+// UpdateActionDefinition 更新操作定义，只能修改描述和蕴含的操作
+func (s *Server) UpdateActionDefinition(ctx context.Context, req *permissionpb.UpdateActionDefinitionRequest) (*permissionpb.UpdateActionDefinitionResponse, error) {
+	if req.ActionDefinition == nil || req.ActionDefinition.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "操作定义不能为空且ID必须大于0")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 名称创建后不可修改，用已有的名称校验蕴含的操作
+	old, err := s.rbacService.GetActionDefinition(ctx, bizID, req.ActionDefinition.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "更新操作定义失败: "+err.Error())
+	}
+	req.ActionDefinition.BizId = bizID
+	req.ActionDefinition.Name = old.Name
+	if err = s.validateActionDefinition(req.ActionDefinition); err != nil {
+		return nil, err
+	}
+
+	_, err = s.rbacService.UpdateActionDefinition(ctx, s.toActionDefinitionDomain(req.ActionDefinition))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "更新操作定义失败: "+err.Error())
+	}
+
+	return &permissionpb.UpdateActionDefinitionResponse{
+		Success: true,
+	}, nil
+}
+
+// DeleteActionDefinition 删除操作定义
+func (s *Server) DeleteActionDefinition(ctx context.Context, req *permissionpb.DeleteActionDefinitionRequest) (*permissionpb.DeleteActionDefinitionResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "操作定义ID必须大于0")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.rbacService.DeleteActionDefinition(ctx, bizID, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "删除操作定义失败: "+err.Error())
+	}
+
+	return &permissionpb.DeleteActionDefinitionResponse{
+		Success: true,
+	}, nil
+}
+
+// ListActionDefinitions 获取操作定义列表
+func (s *Server) ListActionDefinitions(ctx context.Context, req *permissionpb.ListActionDefinitionsRequest) (*permissionpb.ListActionDefinitionsResponse, error) {
+	offset := int(req.Offset)
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10 // 默认每页10条
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defs, err := s.rbacService.ListActionDefinitions(ctx, bizID, offset, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "获取操作定义列表失败: "+err.Error())
+	}
+
+	return &permissionpb.ListActionDefinitionsResponse{
+		ActionDefinitions: slice.Map(defs, func(_ int, src domain.ActionDefinition) *permissionpb.ActionDefinition {
+			return s.toActionDefinitionProto(src)
+		}),
+	}, nil
+}
+
 // ==== 角色相关接口实现 ====
 
 func (s *Server) CreateRole(ctx context.Context, req *permissionpb.CreateRoleRequest) (*permissionpb.CreateRoleResponse, error) {
@@ -490,6 +692,9 @@ func (s *Server) CreateRole(ctx context.Context, req *permissionpb.CreateRoleReq
 
 	// 调用服务创建角色
 	created, err := s.rbacService.CreateRole(ctx, domainRole)
+	if errors.Is(err, errs.ErrInvalidParameter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "创建角色失败: "+err.Error())
 	}
@@ -507,23 +712,27 @@ func (s *Server) toRoleDomain(req *permissionpb.Role) domain.Role {
 	}
 
 	return domain.Role{
-		ID:          req.Id,
-		BizID:       req.BizId,
-		Type:        req.Type,
-		Name:        req.Name,
-		Description: req.Description,
-		Metadata:    md,
+		ID:              req.Id,
+		BizID:           req.BizId,
+		Type:            req.Type,
+		Name:            req.Name,
+		Description:     req.Description,
+		Metadata:        md,
+		MaxMembers:      int(req.MaxMembers),
+		MaxRolesPerUser: int(req.MaxRolesPerUser),
 	}
 }
 
 func (s *Server) toRoleProto(created domain.Role) *permissionpb.Role {
 	return &permissionpb.Role{
-		Id:          created.ID,
-		BizId:       created.BizID,
-		Type:        created.Type,
-		Name:        created.Name,
-		Description: created.Description,
-		Metadata:    created.Metadata,
+		Id:              created.ID,
+		BizId:           created.BizID,
+		Type:            created.Type,
+		Name:            created.Name,
+		Description:     created.Description,
+		Metadata:        created.Metadata,
+		MaxMembers:      int32(created.MaxMembers),
+		MaxRolesPerUser: int32(created.MaxRolesPerUser),
 	}
 }
 
@@ -567,6 +776,9 @@ func (s *Server) UpdateRole(ctx context.Context, req *permissionpb.UpdateRoleReq
 
 	// 调用服务更新角色
 	_, err = s.rbacService.UpdateRole(ctx, domainRole)
+	if errors.Is(err, errs.ErrInvalidParameter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "更新角色失败: "+err.Error())
 	}
@@ -629,6 +841,34 @@ func (s *Server) ListRoles(ctx context.Context, req *permissionpb.ListRolesReque
 	}, nil
 }
 
+// ListRoleUtilizations 查看角色的授予人数以及上限
+func (s *Server) ListRoleUtilizations(ctx context.Context, req *permissionpb.ListRoleUtilizationsRequest) (*permissionpb.ListRoleUtilizationsResponse, error) {
+	offset := int(req.Offset)
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10 // 默认每页10条
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	utilizations, err := s.rbacService.ListRoleUtilizations(ctx, bizID, offset, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "获取角色使用情况失败: "+err.Error())
+	}
+
+	return &permissionpb.ListRoleUtilizationsResponse{
+		Utilizations: slice.Map(utilizations, func(_ int, src domain.RoleUtilization) *permissionpb.RoleUtilization {
+			return &permissionpb.RoleUtilization{
+				Role:    s.toRoleProto(src.Role),
+				Members: src.Members,
+			}
+		}),
+	}, nil
+}
+
 // ==== 角色包含关系相关方法 ====
 
 // CreateRoleInclusion 创建角色包含关系
@@ -649,6 +889,9 @@ func (s *Server) CreateRoleInclusion(ctx context.Context, req *permissionpb.Crea
 
 	// 调用服务创建角色包含关系
 	created, err := s.rbacService.CreateRoleInclusion(ctx, domainRoleInclusion)
+	if errors.Is(err, errs.ErrRoleInclusionCycle) || errors.Is(err, errs.ErrSoDViolation) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "创建角色包含关系失败: "+err.Error())
 	}
@@ -799,6 +1042,10 @@ func (s *Server) GrantRolePermission(ctx context.Context, req *permissionpb.Gran
 }
 
 func (s *Server) toRolePermissionDomain(req *permissionpb.RolePermission) domain.RolePermission {
+	effect := domain.EffectAllow // 默认为允许
+	if req.Effect == domain.EffectDeny.String() {
+		effect = domain.EffectDeny
+	}
 	return domain.RolePermission{
 		ID:    req.Id,
 		BizID: req.BizId,
@@ -815,6 +1062,9 @@ func (s *Server) toRolePermissionDomain(req *permissionpb.RolePermission) domain
 			},
 			Action: req.PermissionAction,
 		},
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Effect:    effect,
 	}
 }
 
@@ -829,6 +1079,9 @@ func (s *Server) toRolePermissionProto(created domain.RolePermission) *permissio
 		ResourceType:     created.Permission.Resource.Type,
 		ResourceKey:      created.Permission.Resource.Key,
 		PermissionAction: created.Permission.Action,
+		StartTime:        created.StartTime,
+		EndTime:          created.EndTime,
+		Effect:           created.Effect.String(),
 	}
 }
 
@@ -899,6 +1152,10 @@ func (s *Server) GrantUserRole(ctx context.Context, req *permissionpb.GrantUserR
 
 	// 调用服务授予用户角色
 	created, err := s.rbacService.GrantUserRole(ctx, domainUserRole)
+	if errors.Is(err, errs.ErrSoDViolation) ||
+		errors.Is(err, errs.ErrRoleMemberLimitExceeded) || errors.Is(err, errs.ErrUserRoleLimitExceeded) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "授予用户角色失败: "+err.Error())
 	}
@@ -1129,3 +1386,178 @@ func (s *Server) ListUserPermissions(ctx context.Context, req *permissionpb.List
 		}),
 	}, nil
 }
+
+func (s *Server) ListSubjectsWithPermission(ctx context.Context, req *permissionpb.ListSubjectsWithPermissionRequest) (*permissionpb.ListSubjectsWithPermissionResponse, error) {
+	if req.ResourceType == "" || req.ResourceKey == "" || req.Action == "" {
+		return nil, status.Error(codes.InvalidArgument, "资源类型、资源标识和操作不能为空")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10 // 默认每页10条
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subjects, next, err := s.rbacService.ListSubjectsWithPermission(ctx, bizID, domain.Resource{
+		BizID: bizID,
+		Type:  req.ResourceType,
+		Key:   req.ResourceKey,
+	}, req.Action, req.Cursor, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查找拥有权限的用户失败: "+err.Error())
+	}
+
+	return &permissionpb.ListSubjectsWithPermissionResponse{
+		Subjects: slice.Map(subjects, func(_ int, src domain.PermissionSubject) *permissionpb.PermissionSubject {
+			return &permissionpb.PermissionSubject{
+				UserId: src.UserID,
+				Sources: slice.Map(src.Sources, func(_ int, source domain.UserPermissionSource) *permissionpb.PermissionMatch {
+					return toPermissionMatchProto(domain.PermissionMatch{Source: source, Valid: true})
+				}),
+			}
+		}),
+		NextCursor: next,
+	}, nil
+}
+
+// ==== 职责分离约束相关方法 ====
+
+// CreateSoDConstraint 创建职责分离约束
+func (s *Server) CreateSoDConstraint(ctx context.Context, req *permissionpb.CreateSoDConstraintRequest) (*permissionpb.CreateSoDConstraintResponse, error) {
+	if req.Constraint == nil {
+		return nil, status.Error(codes.InvalidArgument, "职责分离约束不能为空")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Constraint.Id = 0
+	req.Constraint.BizId = bizID
+	created, err := s.rbacService.CreateSoDConstraint(ctx, s.toSoDConstraintDomain(req.Constraint))
+	if errors.Is(err, errs.ErrInvalidParameter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "创建职责分离约束失败: "+err.Error())
+	}
+
+	return &permissionpb.CreateSoDConstraintResponse{
+		Constraint: s.toSoDConstraintProto(created),
+	}, nil
+}
+
+// GetSoDConstraint 获取职责分离约束
+func (s *Server) GetSoDConstraint(ctx context.Context, req *permissionpb.GetSoDConstraintRequest) (*permissionpb.GetSoDConstraintResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "职责分离约束ID必须大于0")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	constraint, err := s.rbacService.GetSoDConstraint(ctx, bizID, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "获取职责分离约束失败: "+err.Error())
+	}
+
+	return &permissionpb.GetSoDConstraintResponse{
+		Constraint: s.toSoDConstraintProto(constraint),
+	}, nil
+}
+
+// DeleteSoDConstraint 删除职责分离约束
+func (s *Server) DeleteSoDConstraint(ctx context.Context, req *permissionpb.DeleteSoDConstraintRequest) (*permissionpb.DeleteSoDConstraintResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "职责分离约束ID必须大于0")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.rbacService.DeleteSoDConstraint(ctx, bizID, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "删除职责分离约束失败: "+err.Error())
+	}
+
+	return &permissionpb.DeleteSoDConstraintResponse{
+		Success: true,
+	}, nil
+}
+
+// ListSoDConstraints 获取职责分离约束列表
+func (s *Server) ListSoDConstraints(ctx context.Context, _ *permissionpb.ListSoDConstraintsRequest) (*permissionpb.ListSoDConstraintsResponse, error) {
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	constraints, err := s.rbacService.ListSoDConstraints(ctx, bizID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "获取职责分离约束列表失败: "+err.Error())
+	}
+
+	return &permissionpb.ListSoDConstraintsResponse{
+		Constraints: slice.Map(constraints, func(_ int, src domain.SoDConstraint) *permissionpb.SoDConstraint {
+			return s.toSoDConstraintProto(src)
+		}),
+	}, nil
+}
+
+// ActivateRoles 激活用户的角色
+func (s *Server) ActivateRoles(ctx context.Context, req *permissionpb.ActivateRolesRequest) (*permissionpb.ActivateRolesResponse, error) {
+	if req.UserId <= 0 || len(req.RoleIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "用户ID必须大于0，角色不能为空")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	effective, err := s.rbacService.ActivateRoles(ctx, bizID, req.UserId, req.RoleIds)
+	if errors.Is(err, errs.ErrSoDViolation) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, errs.ErrInvalidParameter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "激活角色失败: "+err.Error())
+	}
+
+	return &permissionpb.ActivateRolesResponse{
+		EffectiveRoleIds: effective,
+	}, nil
+}
+
+func (s *Server) toSoDConstraintDomain(c *permissionpb.SoDConstraint) domain.SoDConstraint {
+	return domain.SoDConstraint{
+		ID:          c.Id,
+		BizID:       c.BizId,
+		Name:        c.Name,
+		Description: c.Description,
+		Type:        domain.SoDType(c.Type),
+		RoleIDs:     c.RoleIds,
+	}
+}
+
+func (s *Server) toSoDConstraintProto(c domain.SoDConstraint) *permissionpb.SoDConstraint {
+	return &permissionpb.SoDConstraint{
+		Id:          c.ID,
+		BizId:       c.BizID,
+		Name:        c.Name,
+		Description: c.Description,
+		Type:        c.Type.String(),
+		RoleIds:     c.RoleIDs,
+	}
+}
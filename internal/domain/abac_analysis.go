@@ -0,0 +1,38 @@
+package domain
+
+// PolicyIssueLevel 问题的严重程度
+type PolicyIssueLevel string
+
+const (
+	// PolicyIssueError 肯定有问题，例如规则永远不会满足
+	PolicyIssueError PolicyIssueLevel = "error"
+	// PolicyIssueWarning 可能有问题，例如多余的规则
+	PolicyIssueWarning PolicyIssueLevel = "warning"
+)
+
+// PolicyIssueKind 问题的类型
+type PolicyIssueKind string
+
+const (
+	PolicyIssueUndefinedAttribute PolicyIssueKind = "undefined_attribute" // 引用的属性没有定义或者已经被删除
+	PolicyIssueTypeMismatch       PolicyIssueKind = "type_mismatch"       // 运算符不能用于属性的类型，或者两个属性的类型不一致
+	PolicyIssueInvalidValue       PolicyIssueKind = "invalid_value"       // 比较的值没有办法按照属性的类型解析
+	PolicyIssueInvalidRule        PolicyIssueKind = "invalid_rule"        // 规则的结构错误，例如逻辑运算符缺少子规则
+	PolicyIssueUnsatisfiable      PolicyIssueKind = "unsatisfiable"       // 永远不会满足
+	PolicyIssueAlwaysTrue         PolicyIssueKind = "always_true"         // 只要属性有取值就总是满足
+	PolicyIssueRedundant          PolicyIssueKind = "redundant"           // 去掉之后不影响判定结果
+	PolicyIssueConflict           PolicyIssueKind = "conflict"            // 同一个权限上允许和拒绝的策略条件有重叠
+)
+
+// PolicyIssue 静态分析策略发现的问题
+type PolicyIssue struct {
+	Level    PolicyIssueLevel
+	Kind     PolicyIssueKind
+	PolicyID int64
+	// RuleID 有问题的规则，为 0 的时候是整个策略
+	RuleID int64
+	// RelatedPolicyID PermissionID 冲突的另一个策略，以及两个策略都关联了的权限
+	RelatedPolicyID int64
+	PermissionID    int64
+	Message         string
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"errors"
+
 	"gitee.com/flycash/permission-platform/internal/errs"
 	"github.com/ecodeclub/ekit/slice"
 )
@@ -19,6 +21,20 @@ func (biz BizAttrDefinition) GetByDefID(id int64) (AttributeDefinition, bool) {
 	return def, ok
 }
 
+// DefsOf 返回 entityType 对应的属性定义
+func (biz BizAttrDefinition) DefsOf(entityType EntityType) AttrDefs {
+	switch entityType {
+	case EntityTypeSubject:
+		return biz.SubjectAttrDefs
+	case EntityTypeResource:
+		return biz.ResourceAttrDefs
+	case EntityTypeEnvironment:
+		return biz.EnvironmentAttrDefs
+	default:
+		return nil
+	}
+}
+
 type (
 	AttributeDataType string
 	AttributeType     string
@@ -84,15 +100,30 @@ func (s *ABACObject) ValuesMap() map[int64]AttributeValue {
 	})
 }
 
-func (s *ABACObject) MergeRealTimeAttrs(attrs AttrDefs, values map[string]string) {
+// MergeRealTimeAttrs 实时传入的属性值同样需要用 validate 校验，不合法的时候按照 action 处理。
+// 返回所有不合法的属性值的错误，是否因此拒绝本次校验由调用方按照 action 决定
+func (s *ABACObject) MergeRealTimeAttrs(attrs AttrDefs, values map[string]string, action InvalidAttributeAction,
+	validate func(def AttributeDefinition, val string) (string, error),
+) error {
+	var invalid []error
 	// 这里是用实时计算的覆盖了存储的
 	for key, val := range values {
 		def, ok := attrs.GetByName(key)
-		if ok {
-			s.SetAttributeVal(val, def)
+		if !ok {
+			// 如果不 OK，就是业务方传了一个属性，但是这个属性都不是我们内部的属性
+			continue
+		}
+		normalized, err := validate(def, val)
+		if err != nil {
+			invalid = append(invalid, err)
+			if action.OrDefault() != InvalidAttributeLog {
+				continue
+			}
+			normalized = val
 		}
-		// 如果不 OK，就是业务方传了一个属性，但是这个属性都不是我们内部的属性
+		s.SetAttributeVal(normalized, def)
 	}
+	return errors.Join(invalid...)
 }
 
 func (s *ABACObject) FillDefinitions(attrs AttrDefs) {
@@ -0,0 +1,106 @@
+package domain
+
+// CombiningAlgorithm 同一个权限上绑定了多个策略时，合并各个策略判定结果的算法，参考 XACML
+type CombiningAlgorithm string
+
+const (
+	DenyOverrides     CombiningAlgorithm = "deny_overrides"      // 任意一个拒绝就是拒绝，其次任意一个允许就是允许
+	PermitOverrides   CombiningAlgorithm = "permit_overrides"    // 任意一个允许就是允许，其次任意一个拒绝就是拒绝
+	FirstApplicable   CombiningAlgorithm = "first_applicable"    // 按照策略ID升序，第一个满足的策略决定结果
+	OnlyOneApplicable CombiningAlgorithm = "only_one_applicable" // 只能有一个满足的策略，多于一个视为无法判定
+	DenyUnlessPermit  CombiningAlgorithm = "deny_unless_permit"  // 任意一个允许就是允许，否则拒绝
+	PermitUnlessDeny  CombiningAlgorithm = "permit_unless_deny"  // 任意一个拒绝就是拒绝，否则允许
+)
+
+func (a CombiningAlgorithm) String() string {
+	return string(a)
+}
+
+// IsValid 空值视为合法，表示使用上一级的配置
+func (a CombiningAlgorithm) IsValid() bool {
+	switch a {
+	case "", DenyOverrides, PermitOverrides, FirstApplicable, OnlyOneApplicable, DenyUnlessPermit, PermitUnlessDeny:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrDefault 没有设置的时候使用 deny_overrides
+func (a CombiningAlgorithm) OrDefault() CombiningAlgorithm {
+	if a == "" {
+		return DenyOverrides
+	}
+	return a
+}
+
+// Decision 单个策略或者合并之后的判定结果
+type Decision string
+
+const (
+	DecisionNotApplicable Decision = "not_applicable" // 策略不满足，不参与判定
+	DecisionPermit        Decision = "permit"
+	DecisionDeny          Decision = "deny"
+	DecisionIndeterminate Decision = "indeterminate" // 无法判定，调用方应当视为拒绝
+)
+
+// DecisionOf 策略满足的时候由策略上的效果决定结果，否则不参与判定
+func DecisionOf(matched bool, effect Effect) Decision {
+	switch {
+	case !matched:
+		return DecisionNotApplicable
+	case effect.IsDeny():
+		return DecisionDeny
+	default:
+		return DecisionPermit
+	}
+}
+
+// Combine 合并各个策略的判定结果，decisions 需要按照策略ID升序排列
+func (a CombiningAlgorithm) Combine(decisions []Decision) Decision {
+	var hasPermit, hasDeny bool
+	applicable := 0
+	first := DecisionNotApplicable
+	for _, d := range decisions {
+		if d == DecisionNotApplicable {
+			continue
+		}
+		if applicable == 0 {
+			first = d
+		}
+		applicable++
+		switch d {
+		case DecisionPermit:
+			hasPermit = true
+		case DecisionDeny, DecisionIndeterminate:
+			hasDeny = true
+		}
+	}
+	switch a.OrDefault() {
+	case PermitOverrides:
+		return pick(hasPermit, DecisionPermit, hasDeny, DecisionDeny)
+	case FirstApplicable:
+		return first
+	case OnlyOneApplicable:
+		if applicable > 1 {
+			return DecisionIndeterminate
+		}
+		return first
+	case DenyUnlessPermit:
+		return pick(hasPermit, DecisionPermit, true, DecisionDeny)
+	case PermitUnlessDeny:
+		return pick(hasDeny, DecisionDeny, true, DecisionPermit)
+	default:
+		return pick(hasDeny, DecisionDeny, hasPermit, DecisionPermit)
+	}
+}
+
+func pick(cond1 bool, d1 Decision, cond2 bool, d2 Decision) Decision {
+	if cond1 {
+		return d1
+	}
+	if cond2 {
+		return d2
+	}
+	return DecisionNotApplicable
+}
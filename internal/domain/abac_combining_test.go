@@ -0,0 +1,45 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombiningAlgorithm_Combine(t *testing.T) {
+	t.Parallel()
+	const (
+		na     = DecisionNotApplicable
+		permit = DecisionPermit
+		deny   = DecisionDeny
+	)
+	tests := []struct {
+		name      string
+		algorithm CombiningAlgorithm
+		decisions []Decision
+		want      Decision
+	}{
+		{name: "默认拒绝优先", algorithm: "", decisions: []Decision{permit, deny}, want: deny},
+		{name: "拒绝优先-都不满足", algorithm: DenyOverrides, decisions: []Decision{na, na}, want: na},
+		{name: "拒绝优先-无法判定视为拒绝", algorithm: DenyOverrides, decisions: []Decision{permit, DecisionIndeterminate}, want: deny},
+		{name: "允许优先", algorithm: PermitOverrides, decisions: []Decision{deny, permit}, want: permit},
+		{name: "允许优先-只有拒绝", algorithm: PermitOverrides, decisions: []Decision{na, deny}, want: deny},
+		{name: "第一个满足的", algorithm: FirstApplicable, decisions: []Decision{na, permit, deny}, want: permit},
+		{name: "第一个满足的-都不满足", algorithm: FirstApplicable, decisions: []Decision{na}, want: na},
+		{name: "只能有一个-一个", algorithm: OnlyOneApplicable, decisions: []Decision{na, deny}, want: deny},
+		{name: "只能有一个-多个", algorithm: OnlyOneApplicable, decisions: []Decision{permit, permit}, want: DecisionIndeterminate},
+		{name: "除非允许否则拒绝", algorithm: DenyUnlessPermit, decisions: []Decision{na}, want: deny},
+		{name: "除非允许否则拒绝-有允许", algorithm: DenyUnlessPermit, decisions: []Decision{deny, permit}, want: permit},
+		{name: "除非拒绝否则允许", algorithm: PermitUnlessDeny, decisions: nil, want: permit},
+		{name: "除非拒绝否则允许-有拒绝", algorithm: PermitUnlessDeny, decisions: []Decision{permit, deny}, want: deny},
+	}
+	for idx := range tests {
+		tt := tests[idx]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.algorithm.Combine(tt.decisions))
+		})
+	}
+}
@@ -20,6 +20,7 @@ const (
 	DataTypeFloat             = "float"
 	DataTypeDatetime          = "datetime"
 	DataTypeArray             = "array"
+	DataTypeIP                = "ip" // IPv4 或者 IPv6 地址
 )
 
 func (d DataType) String() string {
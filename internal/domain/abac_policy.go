@@ -6,11 +6,30 @@ type Policy struct {
 	Name        string
 	Description string
 	ExecuteType ExecuteType
+	// Expression ExecuteType 为 ExpressionType 时的策略表达式，此时 Rules 为空
+	Expression  string
 	Status      PolicyStatus
 	Permissions []UserPermission
 	Rules       []PolicyRule
-	Ctime       int64
-	Utime       int64
+	// PublishedVersion 当前生效的版本，0 表示还没有发布过
+	PublishedVersion int64
+	Ctime            int64
+	Utime            int64
+}
+
+// PolicyVersion 策略发布之后的版本，不可修改
+type PolicyVersion struct {
+	PolicyID int64
+	Version  int64
+	// Policy 发布时的策略，包含规则和关联的权限
+	Policy Policy
+	Ctime  int64
+}
+
+// PolicyDraft 发布前读取的草稿。Snapshot 是读取时草稿的快照，发布的时候用来确认草稿没有被修改过
+type PolicyDraft struct {
+	Policy   Policy
+	Snapshot string
 }
 
 func (p Policy) ContainsAnyPermissions(permissionIDs []int64) bool {
@@ -18,7 +37,7 @@ func (p Policy) ContainsAnyPermissions(permissionIDs []int64) bool {
 		permissionID := permissionIDs[idx]
 		for jdx := range p.Permissions {
 			permission := p.Permissions[jdx]
-			if permission.ID == permissionID {
+			if permission.Permission.ID == permissionID {
 				return true
 			}
 		}
@@ -26,9 +45,31 @@ func (p Policy) ContainsAnyPermissions(permissionIDs []int64) bool {
 	return false
 }
 
+// EffectOf 策略绑定到权限 permissionID 上的效果，没有绑定返回 false
+func (p Policy) EffectOf(permissionID int64) (Effect, bool) {
+	for idx := range p.Permissions {
+		if p.Permissions[idx].Permission.ID == permissionID {
+			return p.Permissions[idx].Effect, true
+		}
+	}
+	return "", false
+}
+
 type ExecuteType string
 
-const LogicType ExecuteType = "logic" // 逻辑运算符执行方法
+const (
+	LogicType      ExecuteType = "logic"      // 逻辑运算符执行方法
+	ExpressionType ExecuteType = "expression" // 文本表达式执行方法
+)
+
+func (e ExecuteType) String() string {
+	return string(e)
+}
+
+// IsValid 为空的时候按照 LogicType 处理
+func (e ExecuteType) IsValid() bool {
+	return e == "" || e == LogicType || e == ExpressionType
+}
 
 type PolicyStatus string
 
@@ -38,14 +79,16 @@ const (
 )
 
 type PolicyRule struct {
-	ID        int64
-	AttrDef   AttributeDefinition
-	Value     string
-	LeftRule  *PolicyRule
-	RightRule *PolicyRule
-	Operator  RuleOperator
-	Ctime     int64
-	Utime     int64
+	ID      int64
+	AttrDef AttributeDefinition
+	Value   string
+	// ValueAttrDef 不为空的时候和这个属性的取值比较，此时不使用 Value
+	ValueAttrDef *AttributeDefinition
+	LeftRule     *PolicyRule
+	RightRule    *PolicyRule
+	Operator     RuleOperator
+	Ctime        int64
+	Utime        int64
 }
 
 func (p PolicyRule) SafeLeft() PolicyRule {
@@ -82,4 +125,67 @@ const (
 	NOT            RuleOperator = "NOT"
 	AllMatch       RuleOperator = "ALL MATCH"
 	AnyMatch       RuleOperator = "ANY MATCH"
+
+	// 下面是只能用于字符串的运算符
+	StartsWith       RuleOperator = "STARTS WITH"
+	EndsWith         RuleOperator = "ENDS WITH"
+	Contains         RuleOperator = "CONTAINS"
+	Matches          RuleOperator = "MATCHES" // 正则表达式，不要求完整匹配
+	EqualsIgnoreCase RuleOperator = "EQUALS IGNORE CASE"
+
+	// 下面是只能用于 IP 的运算符，比较的值是单个网段或者网段的 JSON 数组
+	InCIDR    RuleOperator = "IN CIDR"
+	NotInCIDR RuleOperator = "NOT IN CIDR"
 )
+
+// IsLogical 是否是连接子规则的逻辑运算符
+func (r RuleOperator) IsLogical() bool {
+	return r == AND || r == OR || r == NOT
+}
+
+// IsStringPattern 是否是只能用于字符串的运算符
+func (r RuleOperator) IsStringPattern() bool {
+	switch r {
+	case StartsWith, EndsWith, Contains, Matches, EqualsIgnoreCase:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsCIDR 是否是只能用于 IP 的网段运算符
+func (r RuleOperator) IsCIDR() bool {
+	return r == InCIDR || r == NotInCIDR
+}
+
+// SupportedBy 运算符能否用于这种类型的属性，和 evaluator 支持的运算符一致
+func (r RuleOperator) SupportedBy(dataType DataType) bool {
+	switch dataType {
+	case DataTypeString:
+		return r == Equals || r == NotEquals || r == IN || r == NotIn || r.IsStringPattern()
+	case DataTypeNumber, DataTypeFloat:
+		switch r {
+		case Equals, NotEquals, Greater, Less, GreaterOrEqual, LessOrEqual, IN, NotIn:
+			return true
+		}
+	case DataTypeBoolean:
+		return r == Equals || r == NotEquals
+	case DataTypeDatetime:
+		return r == Greater || r == Less || r == GreaterOrEqual || r == LessOrEqual
+	case DataTypeArray:
+		return r == AllMatch || r == AnyMatch
+	case DataTypeIP:
+		return r == Equals || r == NotEquals || r.IsCIDR()
+	}
+	return false
+}
+
+func (r RuleOperator) IsValid() bool {
+	switch r {
+	case Equals, NotEquals, Greater, Less, GreaterOrEqual, LessOrEqual,
+		AND, OR, IN, NotIn, NOT, AllMatch, AnyMatch:
+		return true
+	default:
+		return r.IsStringPattern() || r.IsCIDR()
+	}
+}
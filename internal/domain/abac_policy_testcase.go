@@ -0,0 +1,35 @@
+package domain
+
+// PolicyTestCase 策略的测试用例，只使用给定的属性校验权限，不会读取预存的属性值。
+// 发布策略之前会执行这个策略的全部测试用例，有失败的就不允许发布
+type PolicyTestCase struct {
+	ID       int64
+	BizID    int64
+	PolicyID int64
+	Name     string
+	// Resource Action 校验的权限
+	Resource Resource
+	Action   string
+	Attrs    Attributes
+	// Expected 预期的结果，allow 或者 deny
+	Expected Effect
+	Ctime    int64
+	Utime    int64
+}
+
+// PolicyTestResult 单个测试用例的执行结果
+type PolicyTestResult struct {
+	Case   PolicyTestCase
+	Passed bool
+	// Actual 实际的结果，执行出错的时候为空
+	Actual Effect
+	Err    string
+}
+
+// EffectOf 把判定结果转换成 Effect
+func EffectOf(allowed bool) Effect {
+	if allowed {
+		return EffectAllow
+	}
+	return EffectDeny
+}
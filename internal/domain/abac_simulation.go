@@ -0,0 +1,42 @@
+package domain
+
+// CheckRequest 一次权限校验的请求，用于策略模拟时重放
+type CheckRequest struct {
+	UID      int64
+	Resource Resource
+	Actions  []string
+	Attrs    Attributes
+}
+
+// PolicySimulation 用候选策略重放校验请求的结果
+type PolicySimulation struct {
+	// Total 重放的请求数量
+	Total int
+	// Flips 判定结果发生变化的请求
+	Flips []DecisionFlip
+	// Failures 无法判定的请求，例如资源已经被删除了
+	Failures []SimulationFailure
+}
+
+// DecisionFlip 判定结果发生变化的请求
+type DecisionFlip struct {
+	Request CheckRequest
+	// Before 当前生效的策略的判定结果
+	Before bool
+	// After 候选策略的判定结果
+	After bool
+	// Changes 导致结果变化的策略，也就是命中情况或者关联的权限发生变化的策略
+	Changes []PolicyChange
+}
+
+// PolicyChange 同一个策略在当前和候选策略中的判定过程，新增或者删除的策略另一边为 nil
+type PolicyChange struct {
+	Before *PolicyTrace
+	After  *PolicyTrace
+}
+
+// SimulationFailure 无法判定的请求以及原因
+type SimulationFailure struct {
+	Request CheckRequest
+	Err     string
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/errs"
+)
+
+// AttributeValidation 属性值的校验规则，保存在 AttributeDefinition.ValidationRule 里面。
+// ValidationRule 可以是 JSON 形式的规则，也可以整个是一个正则表达式，和之前的写法兼容。
+// 解析规则、编译正则表达式在 service 层完成，这里只负责按照规则校验单个值
+type AttributeValidation struct {
+	Regex string `json:"regex,omitempty"`
+	// Min Max 数字的取值范围，包含边界
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	// Enum 可选的取值，数组的每一个元素都必须是其中之一
+	Enum []string `json:"enum,omitempty"`
+	// ElementType 数组元素的类型
+	ElementType DataType `json:"elementType,omitempty"`
+	// TimeLayouts 时间允许的格式，使用 Go 的时间格式，例如 2006-01-02 15:04:05。
+	// 按照这些格式解析出来的时间会被转换成毫秒时间戳，毫秒时间戳总是合法的
+	TimeLayouts []string `json:"timeLayouts,omitempty"`
+}
+
+// Check 校验规则本身是否合法，不包括正则表达式
+func (a AttributeValidation) Check() error {
+	if a.Min != nil && a.Max != nil && *a.Min > *a.Max {
+		return fmt.Errorf("%w: 校验规则的 min 大于 max", errs.ErrInvalidParameter)
+	}
+	if a.ElementType == DataTypeArray {
+		return fmt.Errorf("%w: 数组元素不能是数组", errs.ErrInvalidParameter)
+	}
+	return nil
+}
+
+// ValidateScalar 校验单个值，不包括正则表达式，返回规范化之后的值。
+// 错误信息是给调用方拼接用的片段，不包含属性名和值
+func (a AttributeValidation) ValidateScalar(dataType DataType, val string) (string, error) {
+	if len(a.Enum) > 0 && !slices.Contains(a.Enum, val) {
+		return "", fmt.Errorf("不在可选值 %v 中", a.Enum)
+	}
+	switch dataType {
+	case DataTypeNumber:
+		num, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("不是整数")
+		}
+		return val, a.checkRange(float64(num))
+	case DataTypeFloat:
+		num, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return "", fmt.Errorf("不是浮点数")
+		}
+		return val, a.checkRange(num)
+	case DataTypeBoolean:
+		if _, err := strconv.ParseBool(val); err != nil {
+			return "", fmt.Errorf("不是布尔值")
+		}
+	case DataTypeDatetime:
+		return a.normalizeTime(val)
+	case DataTypeIP:
+		// 和判定时的解析保持一致：去掉首尾空白，IPv4-mapped IPv6 地址按照 IPv4 处理
+		addr, err := ParseIP(val)
+		if err != nil {
+			return "", fmt.Errorf("不是 IP 地址")
+		}
+		return addr.String(), nil
+	}
+	// 字符串以及没有声明类型的不需要解析
+	return val, nil
+}
+
+func (a AttributeValidation) checkRange(num float64) error {
+	if a.Min != nil && num < *a.Min {
+		return fmt.Errorf("小于最小值 %v", *a.Min)
+	}
+	if a.Max != nil && num > *a.Max {
+		return fmt.Errorf("大于最大值 %v", *a.Max)
+	}
+	return nil
+}
+
+func (a AttributeValidation) normalizeTime(val string) (string, error) {
+	if _, err := strconv.ParseInt(val, 10, 64); err == nil {
+		return val, nil
+	}
+	for _, layout := range a.TimeLayouts {
+		t, err := time.ParseInLocation(layout, val, time.Local)
+		if err == nil {
+			return strconv.FormatInt(t.UnixMilli(), 10), nil
+		}
+	}
+	if len(a.TimeLayouts) == 0 {
+		return "", fmt.Errorf("不是毫秒时间戳")
+	}
+	return "", fmt.Errorf("不是毫秒时间戳，也不符合格式 %v", a.TimeLayouts)
+}
+
+// InvalidAttributeAction 实时传入的属性值不合法时的处理方式
+type InvalidAttributeAction string
+
+const (
+	InvalidAttributeLog    InvalidAttributeAction = "log"    // 记录日志，仍然使用传入的原始值，和引入校验之前的行为一致
+	InvalidAttributeReject InvalidAttributeAction = "reject" // 直接返回错误，本次校验失败
+	InvalidAttributeIgnore InvalidAttributeAction = "ignore" // 忽略这个属性，就像没有传一样
+)
+
+func (a InvalidAttributeAction) String() string {
+	return string(a)
+}
+
+// IsValid 空值视为合法，表示使用默认的 log
+func (a InvalidAttributeAction) IsValid() bool {
+	switch a {
+	case "", InvalidAttributeLog, InvalidAttributeReject, InvalidAttributeIgnore:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrDefault 没有设置的时候只记录日志，拒绝需要业务方自己开启
+func (a InvalidAttributeAction) OrDefault() InvalidAttributeAction {
+	if a == "" {
+		return InvalidAttributeLog
+	}
+	return a
+}
+
+// ParseIP 解析 IP 地址，去掉首尾空白，IPv4-mapped IPv6 地址按照 IPv4 处理。
+// 校验属性值和判定时都用它解析，保证两边接受的值一致
+func ParseIP(val string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(val))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return addr.Unmap(), nil
+}
@@ -0,0 +1,72 @@
+//go:build unit
+
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestABACObject_MergeRealTimeAttrs(t *testing.T) {
+	t.Parallel()
+	defs := AttrDefs{
+		{ID: 1, Name: "age", DataType: DataTypeNumber},
+		{ID: 2, Name: "dept", DataType: DataTypeString},
+	}
+	validate := func(def AttributeDefinition, val string) (string, error) {
+		res, err := AttributeValidation{}.ValidateScalar(def.DataType, val)
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", errs.ErrInvalidAttributeValue, err)
+		}
+		return res, nil
+	}
+	tests := []struct {
+		name    string
+		action  InvalidAttributeAction
+		values  map[string]string
+		want    []AttributeValue
+		wantErr bool
+	}{
+		{
+			name:   "合法的值",
+			values: map[string]string{"age": "18"},
+			want:   []AttributeValue{{Definition: defs[0], Value: "18"}},
+		},
+		{
+			name:    "默认记录日志并且使用原始值",
+			values:  map[string]string{"age": "abc"},
+			want:    []AttributeValue{{Definition: defs[0], Value: "abc"}},
+			wantErr: true,
+		},
+		{
+			name:    "拒绝",
+			action:  InvalidAttributeReject,
+			values:  map[string]string{"age": "abc"},
+			wantErr: true,
+		},
+		{
+			name:    "忽略不合法的值",
+			action:  InvalidAttributeIgnore,
+			values:  map[string]string{"age": "abc", "dept": "研发"},
+			want:    []AttributeValue{{Definition: defs[1], Value: "研发"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			obj := ABACObject{}
+			err := obj.MergeRealTimeAttrs(defs, tt.values, tt.action, validate)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errs.ErrInvalidAttributeValue)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, obj.AttributeValues)
+		})
+	}
+}
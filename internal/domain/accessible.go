@@ -0,0 +1,103 @@
+package domain
+
+// AccessibleResources 用户在某一类资源的某个操作上可以访问的资源，没有使用的判定引擎对应的部分为 nil。
+// 两部分都不为 nil 的时候，要同时满足才可以访问
+type AccessibleResources struct {
+	RBAC *RBACAccess
+	ABAC *ABACAccess
+}
+
+// RBACAccess RBAC 允许访问的资源标识，资源标识匹配任意一个 Keys 就可以访问
+type RBACAccess struct {
+	Keys []AccessibleKey
+}
+
+// AccessibleKey 允许访问的资源标识，Key 可能带有通配段。
+// 资源标识匹配 Key、并且不匹配 Except 里的任何一个的时候才可以访问，
+// Except 是和 Key 一样具体或者更具体、会覆盖掉 Key 的拒绝
+type AccessibleKey struct {
+	Key    string
+	Except []string
+}
+
+// ABACAccess ABAC 下每个资源还要满足的条件
+type ABACAccess struct {
+	// Residuals 绑定了策略的资源上剩下的、只和资源属性有关的条件
+	Residuals []ResourceResidual
+	// Unresolved 策略太多、没有办法化简的资源，需要逐个调用校验接口
+	Unresolved []string
+	// DefaultAllowed 不在上面两个列表里的资源是否可以访问，由业务配置的 NoPolicyEffect 决定
+	DefaultAllowed bool
+}
+
+// ResourceResidual 某个资源上剩下的条件
+type ResourceResidual struct {
+	Key      string
+	Residual Residual
+}
+
+// Residual 部分求值的结果，Condition 为 nil 的时候结果已经确定，就是 Value
+type Residual struct {
+	Value     bool
+	Condition *ResidualCondition
+}
+
+// ResidualOf 已经确定的结果
+func ResidualOf(value bool) Residual {
+	return Residual{Value: value}
+}
+
+// IsDetermined 结果是否已经确定
+func (r Residual) IsDetermined() bool {
+	return r.Condition == nil
+}
+
+func (r Residual) And(other Residual) Residual {
+	switch {
+	case r.IsDetermined():
+		if r.Value {
+			return other
+		}
+		return r
+	case other.IsDetermined():
+		return other.And(r)
+	default:
+		return Residual{Condition: &ResidualCondition{Operator: AND, Left: r.Condition, Right: other.Condition}}
+	}
+}
+
+func (r Residual) Or(other Residual) Residual {
+	switch {
+	case r.IsDetermined():
+		if r.Value {
+			return r
+		}
+		return other
+	case other.IsDetermined():
+		return other.Or(r)
+	default:
+		return Residual{Condition: &ResidualCondition{Operator: OR, Left: r.Condition, Right: other.Condition}}
+	}
+}
+
+func (r Residual) Not() Residual {
+	if r.IsDetermined() {
+		return ResidualOf(!r.Value)
+	}
+	return Residual{Condition: &ResidualCondition{Operator: NOT, Right: r.Condition}}
+}
+
+// ResidualCondition 剩下的条件树，逻辑节点和 PolicyRule 一样，NOT 只有 Right。
+// 叶子节点是 Attr Operator Value，Attr 是资源属性名；ValueAttr 不为空的时候和另一个资源属性比较；
+// Reversed 表示属性在运算符的右边，即 Value Operator Attr。
+// 资源没有对应属性取值的时候，叶子节点的结果是 false，和校验时一致
+type ResidualCondition struct {
+	Operator  RuleOperator
+	Attr      string
+	DataType  DataType
+	Value     string
+	ValueAttr string
+	Reversed  bool
+	Left      *ResidualCondition
+	Right     *ResidualCondition
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"slices"
+
+	"gitee.com/flycash/permission-platform/pkg/reskey"
+)
+
+// AnyAction 授权时使用，表示所有操作
+const AnyAction = reskey.AnyAction
+
+// ActionDefinition 业务方定义的操作，以及它直接蕴含的操作。
+// 例如 admin 蕴含 write，write 蕴含 read，那么授予 write 的同时也就授予了 read
+type ActionDefinition struct {
+	ID          int64
+	BizID       int64
+	Name        string
+	Description string
+	Implies     []string // 直接蕴含的操作
+	Ctime       int64
+	Utime       int64
+}
+
+// ActionHierarchy 业务方的操作层级，key 是操作，value 是它直接蕴含的操作
+type ActionHierarchy map[string][]string
+
+// NewActionHierarchy 根据业务方的操作定义构建操作层级
+func NewActionHierarchy(defs []ActionDefinition) ActionHierarchy {
+	res := make(ActionHierarchy, len(defs))
+	for i := range defs {
+		res[defs[i].Name] = append(res[defs[i].Name], defs[i].Implies...)
+	}
+	return res
+}
+
+// Implied 返回 action 直接或者间接蕴含的所有操作，不包括 action 自身。
+// 操作定义之间出现环也能正常结束，环上的操作互相蕴含
+func (h ActionHierarchy) Implied(action string) []string {
+	visited := map[string]struct{}{action: {}}
+	var res []string
+	queue := []string{action}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range h[cur] {
+			if _, ok := visited[next]; ok {
+				continue
+			}
+			visited[next] = struct{}{}
+			res = append(res, next)
+			queue = append(queue, next)
+		}
+	}
+	return res
+}
+
+// Implying 返回直接或者间接蕴含 action 的所有操作，不包括 action 自身，结果按照操作名排序
+func (h ActionHierarchy) Implying(action string) []string {
+	var res []string
+	for name := range h {
+		if name != action && slices.Contains(h.Implied(name), action) {
+			res = append(res, name)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
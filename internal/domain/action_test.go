@@ -0,0 +1,110 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionHierarchy_Implied(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		defs   []ActionDefinition
+		action string
+		want   []string
+	}{
+		{
+			name:   "没有定义",
+			action: "read",
+			want:   nil,
+		},
+		{
+			name: "传递蕴含",
+			defs: []ActionDefinition{
+				{Name: "admin", Implies: []string{"write", "delete"}},
+				{Name: "write", Implies: []string{"read"}},
+			},
+			action: "admin",
+			want:   []string{"write", "delete", "read"},
+		},
+		{
+			name: "菱形只出现一次",
+			defs: []ActionDefinition{
+				{Name: "admin", Implies: []string{"write", "review"}},
+				{Name: "write", Implies: []string{"read"}},
+				{Name: "review", Implies: []string{"read"}},
+			},
+			action: "admin",
+			want:   []string{"write", "review", "read"},
+		},
+		{
+			name: "环",
+			defs: []ActionDefinition{
+				{Name: "edit", Implies: []string{"write"}},
+				{Name: "write", Implies: []string{"edit", "read"}},
+			},
+			action: "edit",
+			want:   []string{"write", "read"},
+		},
+	}
+	for idx := range tests {
+		tt := tests[idx]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, NewActionHierarchy(tt.defs).Implied(tt.action))
+		})
+	}
+}
+
+func TestActionHierarchy_Implying(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		defs   []ActionDefinition
+		action string
+		want   []string
+	}{
+		{
+			name:   "没有定义",
+			action: "read",
+			want:   nil,
+		},
+		{
+			name: "传递蕴含",
+			defs: []ActionDefinition{
+				{Name: "admin", Implies: []string{"write", "delete"}},
+				{Name: "write", Implies: []string{"read"}},
+			},
+			action: "read",
+			want:   []string{"admin", "write"},
+		},
+		{
+			name: "不相关的操作",
+			defs: []ActionDefinition{
+				{Name: "admin", Implies: []string{"write"}},
+				{Name: "review", Implies: []string{"read"}},
+			},
+			action: "write",
+			want:   []string{"admin"},
+		},
+		{
+			name: "环",
+			defs: []ActionDefinition{
+				{Name: "edit", Implies: []string{"write"}},
+				{Name: "write", Implies: []string{"edit", "read"}},
+			},
+			action: "edit",
+			want:   []string{"write"},
+		},
+	}
+	for idx := range tests {
+		tt := tests[idx]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, NewActionHierarchy(tt.defs).Implying(tt.action))
+		})
+	}
+}
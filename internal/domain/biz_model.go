@@ -0,0 +1,154 @@
+package domain
+
+// BizModelVersion 当前导出的授权模型文档的版本，导入的时候只接受这个版本
+const BizModelVersion = "v1"
+
+// BizModel 一个业务完整的授权模型，用于在不同环境之间迁移配置。
+// 文档里面的 ID 都是导出环境的 ID，导入的时候按照业务主键找到或者创建对应的数据，
+// 再把引用关系里面的 ID 替换成导入环境的 ID。用户 ID 和主体 ID 不做替换
+type BizModel struct {
+	Version                    string                        `json:"version" yaml:"version"`
+	BizID                      int64                         `json:"bizId" yaml:"bizId"`
+	Resources                  []BizModelResource            `json:"resources,omitempty" yaml:"resources,omitempty"`
+	ActionDefinitions          []BizModelActionDefinition    `json:"actionDefinitions,omitempty" yaml:"actionDefinitions,omitempty"`
+	Permissions                []BizModelPermission          `json:"permissions,omitempty" yaml:"permissions,omitempty"`
+	Roles                      []BizModelRole                `json:"roles,omitempty" yaml:"roles,omitempty"`
+	RoleInclusions             []BizModelRoleInclusion       `json:"roleInclusions,omitempty" yaml:"roleInclusions,omitempty"`
+	RolePermissions            []BizModelRolePermission      `json:"rolePermissions,omitempty" yaml:"rolePermissions,omitempty"`
+	UserRoles                  []BizModelUserRole            `json:"userRoles,omitempty" yaml:"userRoles,omitempty"`
+	UserPermissions            []BizModelUserPermission      `json:"userPermissions,omitempty" yaml:"userPermissions,omitempty"`
+	AttributeDefinitions       []BizModelAttributeDefinition `json:"attributeDefinitions,omitempty" yaml:"attributeDefinitions,omitempty"`
+	SubjectAttributeValues     []BizModelAttributeValue      `json:"subjectAttributeValues,omitempty" yaml:"subjectAttributeValues,omitempty"`
+	ResourceAttributeValues    []BizModelAttributeValue      `json:"resourceAttributeValues,omitempty" yaml:"resourceAttributeValues,omitempty"`
+	EnvironmentAttributeValues []BizModelAttributeValue      `json:"environmentAttributeValues,omitempty" yaml:"environmentAttributeValues,omitempty"`
+	Policies                   []BizModelPolicy              `json:"policies,omitempty" yaml:"policies,omitempty"`
+}
+
+// BizModelResource 业务主键是 type + key
+type BizModelResource struct {
+	ID          int64  `json:"id" yaml:"id"`
+	Type        string `json:"type" yaml:"type"`
+	Key         string `json:"key" yaml:"key"`
+	Name        string `json:"name,omitempty" yaml:"name,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Metadata    string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
+
+// BizModelActionDefinition 业务主键是 name
+type BizModelActionDefinition struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Implies     []string `json:"implies,omitempty" yaml:"implies,omitempty"`
+}
+
+// BizModelPermission 业务主键是资源 + action
+type BizModelPermission struct {
+	ID                 int64              `json:"id" yaml:"id"`
+	ResourceID         int64              `json:"resourceId" yaml:"resourceId"`
+	Action             string             `json:"action" yaml:"action"`
+	Name               string             `json:"name,omitempty" yaml:"name,omitempty"`
+	Description        string             `json:"description,omitempty" yaml:"description,omitempty"`
+	Metadata           string             `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	CombiningAlgorithm CombiningAlgorithm `json:"combiningAlgorithm,omitempty" yaml:"combiningAlgorithm,omitempty"`
+}
+
+// BizModelRole 业务主键是 type + name
+type BizModelRole struct {
+	ID              int64  `json:"id" yaml:"id"`
+	Type            string `json:"type" yaml:"type"`
+	Name            string `json:"name" yaml:"name"`
+	Description     string `json:"description,omitempty" yaml:"description,omitempty"`
+	Metadata        string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	MaxMembers      int    `json:"maxMembers,omitempty" yaml:"maxMembers,omitempty"`
+	MaxRolesPerUser int    `json:"maxRolesPerUser,omitempty" yaml:"maxRolesPerUser,omitempty"`
+}
+
+type BizModelRoleInclusion struct {
+	IncludingRoleID int64 `json:"includingRoleId" yaml:"includingRoleId"`
+	IncludedRoleID  int64 `json:"includedRoleId" yaml:"includedRoleId"`
+}
+
+type BizModelRolePermission struct {
+	RoleID       int64 `json:"roleId" yaml:"roleId"`
+	PermissionID int64 `json:"permissionId" yaml:"permissionId"`
+	StartTime    int64 `json:"startTime,omitempty" yaml:"startTime,omitempty"`
+	// EndTime 省略表示永不过期
+	EndTime int64  `json:"endTime,omitempty" yaml:"endTime,omitempty"`
+	Effect  Effect `json:"effect" yaml:"effect"`
+}
+
+type BizModelUserRole struct {
+	UserID    int64 `json:"userId" yaml:"userId"`
+	RoleID    int64 `json:"roleId" yaml:"roleId"`
+	StartTime int64 `json:"startTime,omitempty" yaml:"startTime,omitempty"`
+	// EndTime 省略表示永不过期
+	EndTime int64 `json:"endTime,omitempty" yaml:"endTime,omitempty"`
+}
+
+type BizModelUserPermission struct {
+	UserID       int64 `json:"userId" yaml:"userId"`
+	PermissionID int64 `json:"permissionId" yaml:"permissionId"`
+	StartTime    int64 `json:"startTime,omitempty" yaml:"startTime,omitempty"`
+	// EndTime 省略表示永不过期
+	EndTime int64  `json:"endTime,omitempty" yaml:"endTime,omitempty"`
+	Effect  Effect `json:"effect" yaml:"effect"`
+}
+
+// BizModelAttributeDefinition 业务主键是 name
+type BizModelAttributeDefinition struct {
+	ID             int64      `json:"id" yaml:"id"`
+	Name           string     `json:"name" yaml:"name"`
+	Description    string     `json:"description,omitempty" yaml:"description,omitempty"`
+	DataType       DataType   `json:"dataType" yaml:"dataType"`
+	EntityType     EntityType `json:"entityType" yaml:"entityType"`
+	ValidationRule string     `json:"validationRule,omitempty" yaml:"validationRule,omitempty"`
+}
+
+// BizModelAttributeValue ObjectID 对于主体是主体 ID，对于资源是资源 ID，环境属性没有 ObjectID
+type BizModelAttributeValue struct {
+	ObjectID  int64  `json:"objectId,omitempty" yaml:"objectId,omitempty"`
+	AttrDefID int64  `json:"attrDefId" yaml:"attrDefId"`
+	Value     string `json:"value" yaml:"value"`
+}
+
+// BizModelPolicy 业务主键是 name。导入之后有变化或者还没有发布过的策略会在通过测试用例之后发布
+type BizModelPolicy struct {
+	ID          int64                      `json:"id" yaml:"id"`
+	Name        string                     `json:"name" yaml:"name"`
+	Description string                     `json:"description,omitempty" yaml:"description,omitempty"`
+	ExecuteType ExecuteType                `json:"executeType" yaml:"executeType"`
+	Expression  string                     `json:"expression,omitempty" yaml:"expression,omitempty"`
+	Status      PolicyStatus               `json:"status,omitempty" yaml:"status,omitempty"`
+	Rules       []BizModelPolicyRule       `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Permissions []BizModelPolicyPermission `json:"permissions,omitempty" yaml:"permissions,omitempty"`
+}
+
+// BizModelPolicyRule 策略的一条规则，Left 和 Right 是同一个策略里面子规则的 ID
+type BizModelPolicyRule struct {
+	ID             int64        `json:"id" yaml:"id"`
+	AttrDefID      int64        `json:"attrDefId,omitempty" yaml:"attrDefId,omitempty"`
+	ValueAttrDefID int64        `json:"valueAttrDefId,omitempty" yaml:"valueAttrDefId,omitempty"`
+	Value          string       `json:"value,omitempty" yaml:"value,omitempty"`
+	Operator       RuleOperator `json:"operator" yaml:"operator"`
+	Left           int64        `json:"left,omitempty" yaml:"left,omitempty"`
+	Right          int64        `json:"right,omitempty" yaml:"right,omitempty"`
+}
+
+type BizModelPolicyPermission struct {
+	PermissionID int64  `json:"permissionId" yaml:"permissionId"`
+	Effect       Effect `json:"effect" yaml:"effect"`
+}
+
+// BizModelImportStat 导入的时候一类数据的统计
+type BizModelImportStat struct {
+	Kind      string
+	Created   int
+	Updated   int
+	Unchanged int
+}
+
+// BizModelImportResult 导入的结果，DryRun 的时候只统计，不会写入任何数据
+type BizModelImportResult struct {
+	DryRun bool
+	Stats  []BizModelImportStat
+}
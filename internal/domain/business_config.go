@@ -7,7 +7,54 @@ type BusinessConfig struct {
 	OwnerType string // 业务方类型
 	Name      string // 业务名称
 	RateLimit int    // 每秒最大请求数
-	Token     string // 业务方Token，内部包含bizID也就是上方的ID，需要先插入一个空的Token获取ID，再根据ID生成token再更新
-	Ctime     int64
-	Utime     int64
+	Engine    Engine // 权限判定引擎
+	// CombiningAlgorithm ABAC 默认的策略合并算法，权限上可以单独配置
+	CombiningAlgorithm CombiningAlgorithm
+	// NoPolicyEffect ABAC 校验的权限上没有绑定任何策略时的结果，为空表示允许
+	NoPolicyEffect Effect
+	// InvalidAttributeAction ABAC 校验时实时传入的属性值不合法的处理方式，为空表示只记录日志
+	InvalidAttributeAction InvalidAttributeAction
+	Token                  string // 业务方Token，内部包含bizID也就是上方的ID，需要先插入一个空的Token获取ID，再根据ID生成token再更新
+	Ctime                  int64
+	Utime                  int64
+}
+
+// Engine 权限判定引擎
+type Engine string
+
+const (
+	EngineRBAC            Engine = "rbac"              // 纯 RBAC
+	EngineABAC            Engine = "abac"              // 纯 ABAC
+	EngineRBACThenABAC    Engine = "rbac_abac"         // 先 RBAC 再 ABAC，两者都通过才算通过
+	EngineRoleAsAttribute Engine = "role_as_attribute" // 把用户的角色作为主体属性，再走 ABAC
+)
+
+func (e Engine) String() string {
+	return string(e)
+}
+
+// IsValid 空值视为合法，表示使用默认的 RBAC
+func (e Engine) IsValid() bool {
+	switch e {
+	case "", EngineRBAC, EngineABAC, EngineRBACThenABAC, EngineRoleAsAttribute:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrDefault 没有设置的时候使用 RBAC
+func (e Engine) OrDefault() Engine {
+	if e == "" {
+		return EngineRBAC
+	}
+	return e
+}
+
+// NoPolicyEffectOrDefault 没有设置的时候允许，和没有引入这个配置之前的行为一致
+func (c BusinessConfig) NoPolicyEffectOrDefault() Effect {
+	if c.NoPolicyEffect == "" {
+		return EffectAllow
+	}
+	return c.NoPolicyEffect
 }
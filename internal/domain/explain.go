@@ -0,0 +1,72 @@
+package domain
+
+// UserPermissionSource 用户权限以及它的来源
+type UserPermissionSource struct {
+	UserPermission UserPermission
+	// RolePath 为空表示直接授予用户的权限，
+	// 否则是从用户直接拥有的角色开始，沿着角色包含关系一路走到授予该权限的角色
+	RolePath []int64
+	// ImpliedBy 不为空表示这条权限是按照操作层级，从授权时的操作 ImpliedBy 展开出来的
+	ImpliedBy string
+}
+
+// IsDirect 是否是直接授予用户的权限
+func (s UserPermissionSource) IsDirect() bool {
+	return len(s.RolePath) == 0
+}
+
+// PermissionSubject 拥有某个资源上某个操作权限的用户
+type PermissionSubject struct {
+	UserID int64
+	// Sources 这个用户匹配上的授权以及各自的来源
+	Sources []UserPermissionSource
+}
+
+// RBACTrace RBAC 的判定过程
+type RBACTrace struct {
+	Allowed bool
+	// Matched 资源和操作都匹配上的权限，包括不在生效期内的
+	Matched []PermissionMatch
+	// Decision 决定了最终结果的权限，拒绝优先；没有任何生效的权限时为 nil
+	Decision *PermissionMatch
+}
+
+// PermissionMatch 一条匹配上的权限
+type PermissionMatch struct {
+	Source UserPermissionSource
+	Valid  bool // 判定时是否处于生效期内
+}
+
+// ABACTrace ABAC 的判定过程
+type ABACTrace struct {
+	Allowed bool
+	// Attributes 合并了预存属性和实时属性之后，参与判定的属性值
+	Attributes []AttributeValue
+	Policies   []PolicyTrace
+}
+
+// PolicyTrace 单个策略的判定过程
+type PolicyTrace struct {
+	Policy  Policy
+	Matched bool
+	Rules   []RuleTrace
+}
+
+// RuleTrace 规则树上单个节点的判定过程
+type RuleTrace struct {
+	Rule   PolicyRule
+	Result bool
+	// ActualValue 叶子节点实际使用的属性值
+	ActualValue string
+	// Err 叶子节点求值失败的原因，失败的节点视为不满足
+	Err   string
+	Left  *RuleTrace
+	Right *RuleTrace
+}
+
+// PermissionTrace 一次权限校验的完整判定过程，没有执行的部分为 nil
+type PermissionTrace struct {
+	Allowed bool
+	RBAC    *RBACTrace
+	ABAC    *ABACTrace
+}
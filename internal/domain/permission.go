@@ -8,6 +8,8 @@ type Permission struct {
 	Resource    Resource `json:"resource,omitzero"`
 	Action      string   `json:"action,omitzero"`
 	Metadata    string   `json:"metadata,omitzero"`
-	Ctime       int64    `json:"ctime,omitzero"`
-	Utime       int64    `json:"utime,omitzero"`
+	// CombiningAlgorithm ABAC 策略合并算法，为空表示使用业务配置
+	CombiningAlgorithm CombiningAlgorithm `json:"combiningAlgorithm,omitzero"`
+	Ctime              int64              `json:"ctime,omitzero"`
+	Utime              int64              `json:"utime,omitzero"`
 }
@@ -8,6 +8,17 @@ type Role struct {
 	Name        string `json:"name,omitzero"`
 	Description string `json:"description,omitzero"`
 	Metadata    string `json:"metadata,omitzero"`
-	Ctime       int64  `json:"ctime,omitzero"`
-	Utime       int64  `json:"utime,omitzero"`
+	// MaxMembers 最多授予多少个用户，0 表示不限制
+	MaxMembers int `json:"maxMembers,omitzero"`
+	// MaxRolesPerUser 拥有该角色的用户最多拥有多少个角色（包含该角色），0 表示不限制
+	MaxRolesPerUser int   `json:"maxRolesPerUser,omitzero"`
+	Ctime           int64 `json:"ctime,omitzero"`
+	Utime           int64 `json:"utime,omitzero"`
+}
+
+// RoleUtilization 角色的使用情况
+type RoleUtilization struct {
+	Role Role
+	// Members 尚未失效的授予人数，包含还未到生效时间的
+	Members int64
 }
@@ -6,6 +6,9 @@ type RolePermission struct {
 	BizID      int64      `json:"bizId,omitzero"`
 	Role       Role       `json:"role,omitzero"`
 	Permission Permission `json:"permission,omitzero"`
+	StartTime  int64      `json:"startTime,omitzero"` // 生效时间，0 表示不设限
+	EndTime    int64      `json:"endTime,omitzero"`   // 失效时间，0 表示不设限
+	Effect     Effect     `json:"effect,omitzero"`
 	Ctime      int64      `json:"ctime,omitzero"`
 	Utime      int64      `json:"utime,omitzero"`
 }
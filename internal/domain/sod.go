@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitee.com/flycash/permission-platform/internal/errs"
+)
+
+// SoDType 职责分离约束的类型
+type SoDType string
+
+const (
+	// SoDStatic 同一个用户不能同时拥有
+	SoDStatic SoDType = "static"
+	// SoDDynamic 可以同时拥有，但是不能在同一个会话里同时激活
+	SoDDynamic SoDType = "dynamic"
+)
+
+func (t SoDType) String() string {
+	return string(t)
+}
+
+func (t SoDType) IsValid() bool {
+	return t == SoDStatic || t == SoDDynamic
+}
+
+// SoDConstraint 职责分离约束，RoleIDs 里的角色两两互斥。
+// 直接拥有和通过角色包含关系间接拥有都算拥有
+type SoDConstraint struct {
+	ID          int64
+	BizID       int64
+	Name        string
+	Description string
+	Type        SoDType
+	RoleIDs     []int64
+	Ctime       int64
+	Utime       int64
+}
+
+// SoDOperation 被职责分离约束拒绝的操作
+type SoDOperation string
+
+const (
+	SoDOperationGrantUserRole       SoDOperation = "GRANT_USER_ROLE"
+	SoDOperationCreateRoleInclusion SoDOperation = "CREATE_ROLE_INCLUSION"
+	SoDOperationActivateRoles       SoDOperation = "ACTIVATE_ROLES"
+)
+
+// SoDViolation 一次操作违反了某一个约束
+type SoDViolation struct {
+	Constraint SoDConstraint
+	Operation  SoDOperation
+	// UserIDs 会违反约束的用户，角色本身就冲突的时候为空
+	UserIDs []int64
+	// RoleIDs 冲突的角色
+	RoleIDs []int64
+}
+
+// SoDViolationError 违反职责分离约束的错误，可以用 errors.Is(err, errs.ErrSoDViolation) 判断，
+// 用 errors.As 拿到具体违反了哪些约束
+type SoDViolationError struct {
+	Violations []SoDViolation
+}
+
+func (e *SoDViolationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msg := fmt.Sprintf("约束 %s(%d) 中的角色 %v 互斥", v.Constraint.Name, v.Constraint.ID, v.RoleIDs)
+		if len(v.UserIDs) > 0 {
+			msg += fmt.Sprintf("，涉及用户 %v", v.UserIDs)
+		}
+		msgs = append(msgs, msg)
+	}
+	return errs.ErrSoDViolation.Error() + ": " + strings.Join(msgs, "; ")
+}
+
+func (e *SoDViolationError) Unwrap() error {
+	return errs.ErrSoDViolation
+}
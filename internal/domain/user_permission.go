@@ -31,3 +31,14 @@ type UserPermission struct {
 	Ctime      int64      `json:"cTime,omitzero"`
 	Utime      int64      `json:"uTime,omitzero"`
 }
+
+// IsValidAt 判断权限在 t（毫秒时间戳）时刻是否处于生效期内，StartTime 或 EndTime 为 0 表示对应方向不设限
+func (u UserPermission) IsValidAt(t int64) bool {
+	if u.StartTime > 0 && t < u.StartTime {
+		return false
+	}
+	if u.EndTime > 0 && t > u.EndTime {
+		return false
+	}
+	return true
+}
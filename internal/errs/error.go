@@ -17,8 +17,28 @@ var (
 
 	ErrRolePermissionDuplicate = errors.New("角色权限关联记录唯一索引冲突")
 
+	ErrRoleInclusionCycle = errors.New("角色包含关系存在环")
+
+	ErrSoDConstraintDuplicate = errors.New("职责分离约束记录biz、name唯一索引冲突")
+	ErrSoDViolation           = errors.New("违反职责分离约束")
+
+	ErrRoleMemberLimitExceeded = errors.New("角色授予人数超过上限")
+	ErrUserRoleLimitExceeded   = errors.New("用户拥有的角色数超过上限")
+
+	ErrActionDefinitionDuplicate = errors.New("操作定义记录biz、name唯一索引冲突")
+
 	ErrAttributeNotFound error = errors.New("对应属性没找到")
 
+	ErrInvalidAttributeValue = errors.New("属性值不合法")
+
+	ErrPolicyNotFound         = errors.New("策略不存在")
+	ErrPolicyVersionNotFound  = errors.New("策略版本不存在")
+	ErrPolicyTestCaseNotFound = errors.New("策略测试用例不存在")
+	ErrPolicyTestFailed       = errors.New("策略测试没有通过")
+	ErrPolicyDraftChanged     = errors.New("策略在测试之后被修改或者发布过")
+
+	ErrBizModelImportIncomplete = errors.New("授权模型没有完整导入")
+
 	ErrUnknownOperator = errors.New("未知的比较符")
 
 	ErrUnknownDataType = errors.New("未知的数据类型")
@@ -30,6 +30,9 @@ type PermissionV1 struct {
 	Resource Resource `json:"resource"`
 	Action   string   `json:"action"`
 	Effect   string   `json:"effect"`
+	// 生效期，毫秒时间戳，0 表示不设限
+	StartTime int64 `json:"startTime,omitempty"`
+	EndTime   int64 `json:"endTime,omitempty"`
 }
 
 type Resource struct {
@@ -5,9 +5,9 @@ import (
 	"database/sql"
 	"time"
 
+	"gitee.com/flycash/permission-platform/internal/pkg/database/accessctrl"
 	"gitee.com/flycash/permission-platform/internal/pkg/database/log"
 	"gitee.com/flycash/permission-platform/internal/pkg/database/metrics"
-	"gitee.com/flycash/permission-platform/internal/pkg/database/trace"
 
 	"github.com/gotomicro/ego/core/econf"
 
@@ -2,10 +2,15 @@ package ioc
 
 import (
 	permissionv1 "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
+	grpcapi "gitee.com/flycash/permission-platform/internal/api/grpc"
+	"gitee.com/flycash/permission-platform/internal/api/grpc/abac"
 	"gitee.com/flycash/permission-platform/internal/api/grpc/interceptor/audit"
 	"gitee.com/flycash/permission-platform/internal/api/grpc/interceptor/auth"
+	"gitee.com/flycash/permission-platform/internal/api/grpc/interceptor/ratelimit"
 	"gitee.com/flycash/permission-platform/internal/api/grpc/rbac"
 	"gitee.com/flycash/permission-platform/internal/pkg/jwt"
+	pkgratelimit "gitee.com/flycash/permission-platform/internal/pkg/ratelimit"
+	"gitee.com/flycash/permission-platform/internal/repository"
 	auditdao "gitee.com/flycash/permission-platform/internal/repository/dao/audit"
 	"github.com/gotomicro/ego/server/egrpc"
 )
@@ -13,17 +18,30 @@ import (
 func InitGRPC(
 	crudServer *rbac.Server,
 	permServer *rbac.PermissionServiceServer,
+	batchPermServer *grpcapi.BatchPermissionServer,
+	policyServer *abac.ABACPolicyServer,
+	attrValServer *abac.ABACAttributeValServer,
+	attrDefServer *abac.ABACAttributeDefinitionServer,
+	bizModelServer *grpcapi.BizModelServer,
 	token *jwt.Token,
 	auditDAO auditdao.OperationLogDAO,
+	bizConfigRepo repository.BusinessConfigRepository,
+	limiter pkgratelimit.Limiter,
 ) []*egrpc.Component {
 	authInterceptor := auth.New(token).Build()
+	rateLimitInterceptor := ratelimit.New(bizConfigRepo, limiter).Build()
 	auditInterceptor := audit.New(auditDAO).Build()
 
 	rbacServer := egrpc.Load("server.grpc.rbac").Build(
-		egrpc.WithUnaryInterceptor(authInterceptor, auditInterceptor),
+		egrpc.WithUnaryInterceptor(authInterceptor, rateLimitInterceptor, auditInterceptor),
 	)
 	permissionv1.RegisterRBACServiceServer(rbacServer.Server, crudServer)
 	permissionv1.RegisterPermissionServiceServer(rbacServer.Server, permServer)
+	permissionv1.RegisterBatchPermissionServiceServer(rbacServer.Server, batchPermServer)
+	permissionv1.RegisterPolicyServiceServer(rbacServer.Server, policyServer)
+	permissionv1.RegisterAttributeValueServiceServer(rbacServer.Server, attrValServer)
+	permissionv1.RegisterAttributeDefinitionServiceServer(rbacServer.Server, attrDefServer)
+	permissionv1.RegisterBizModelServiceServer(rbacServer.Server, bizModelServer)
 
 	return []*egrpc.Component{rbacServer}
 }
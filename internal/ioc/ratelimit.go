@@ -0,0 +1,25 @@
+package ioc
+
+import (
+	"gitee.com/flycash/permission-platform/internal/pkg/ratelimit"
+	"github.com/gotomicro/ego/core/econf"
+	"github.com/redis/go-redis/v9"
+)
+
+const rateLimitTypeRedis = "redis"
+
+// InitRateLimiter 按照配置选择单机限流还是基于 Redis 的集群限流
+func InitRateLimiter(cmd redis.Cmdable) ratelimit.Limiter {
+	type Config struct {
+		Type string `yaml:"type"`
+	}
+	var cfg Config
+	err := econf.UnmarshalKey("rateLimit", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	if cfg.Type == rateLimitTypeRedis {
+		return ratelimit.NewRedisLimiter(cmd)
+	}
+	return ratelimit.NewLocalLimiter()
+}
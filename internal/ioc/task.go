@@ -2,11 +2,19 @@ package ioc
 
 import (
 	"gitee.com/flycash/permission-platform/internal/event/audit"
+	"gitee.com/flycash/permission-platform/internal/service/abac"
+	"gitee.com/flycash/permission-platform/internal/service/rbac"
 )
 
 func InitTasks(t1 *audit.UserRoleBinlogEventConsumer,
+	t2 *rbac.UserRoleExpirationTask,
+	t3 *abac.PolicyCron,
+	t4 *abac.AttributeValueTask,
 ) []Task {
 	return []Task{
 		t1,
+		t2,
+		t3,
+		t4,
 	}
 }
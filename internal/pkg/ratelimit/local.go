@@ -0,0 +1,45 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ Limiter = (*LocalLimiter)(nil)
+
+// LocalLimiter 单机固定窗口限流器，限流阈值只对当前实例生效
+type LocalLimiter struct {
+	mu      sync.Mutex
+	windows map[string]*window
+	now     func() time.Time
+}
+
+type window struct {
+	start int64
+	cnt   int
+}
+
+func NewLocalLimiter() *LocalLimiter {
+	return &LocalLimiter{
+		windows: make(map[string]*window),
+		now:     time.Now,
+	}
+}
+
+func (l *LocalLimiter) Limit(_ context.Context, key string, rate int) (bool, error) {
+	sec := l.now().Unix()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	w, ok := l.windows[key]
+	if !ok || w.start != sec {
+		// 新窗口，直接覆盖旧窗口，避免 map 无限增长
+		w = &window{start: sec}
+		l.windows[key] = w
+	}
+	if w.cnt >= rate {
+		return true, nil
+	}
+	w.cnt++
+	return false, nil
+}
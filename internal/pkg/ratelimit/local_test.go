@@ -0,0 +1,49 @@
+//go:build unit
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalLimiter_Limit(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1000, 0)
+	limiter := NewLocalLimiter()
+	limiter.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// 同一个窗口内最多通过 rate 个请求
+	for i := 0; i < 3; i++ {
+		limited, err := limiter.Limit(ctx, "biz:1", 3)
+		require.NoError(t, err)
+		assert.False(t, limited)
+	}
+	limited, err := limiter.Limit(ctx, "biz:1", 3)
+	require.NoError(t, err)
+	assert.True(t, limited)
+
+	// 不同 key 互不影响
+	limited, err = limiter.Limit(ctx, "biz:2", 3)
+	require.NoError(t, err)
+	assert.False(t, limited)
+
+	// 进入下一个窗口之后恢复
+	now = now.Add(time.Second)
+	limited, err = limiter.Limit(ctx, "biz:1", 3)
+	require.NoError(t, err)
+	assert.False(t, limited)
+
+	// 阈值调大之后立刻生效
+	now = now.Add(time.Second)
+	for i := 0; i < 5; i++ {
+		limited, err = limiter.Limit(ctx, "biz:1", 5)
+		require.NoError(t, err)
+		assert.False(t, limited)
+	}
+}
@@ -0,0 +1,15 @@
+-- 固定窗口限流
+local key = KEYS[1]
+local rate = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+
+local cnt = tonumber(redis.call('GET', key) or '0')
+if cnt >= rate then
+    return 1
+end
+
+cnt = redis.call('INCR', key)
+if cnt == 1 then
+    redis.call('PEXPIRE', key, window)
+end
+return 0
@@ -0,0 +1,32 @@
+package ratelimit
+
+import (
+	"context"
+	_ "embed"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+//go:embed lua/fixed_window.lua
+var luaFixedWindow string
+
+var _ Limiter = (*RedisLimiter)(nil)
+
+// RedisLimiter 基于 Redis 的固定窗口限流器，限流阈值在整个集群内生效
+type RedisLimiter struct {
+	cmd    redis.Cmdable
+	prefix string
+}
+
+func NewRedisLimiter(cmd redis.Cmdable) *RedisLimiter {
+	return &RedisLimiter{
+		cmd:    cmd,
+		prefix: "ratelimit",
+	}
+}
+
+func (r *RedisLimiter) Limit(ctx context.Context, key string, rate int) (bool, error) {
+	return r.cmd.Eval(ctx, luaFixedWindow, []string{r.prefix + ":" + key},
+		rate, time.Second.Milliseconds()).Bool()
+}
@@ -0,0 +1,9 @@
+package ratelimit
+
+import "context"
+
+// Limiter 限流器，窗口大小固定为一秒
+type Limiter interface {
+	// Limit 返回 true 表示 key 在当前窗口内已经超过 rate，需要被限流
+	Limit(ctx context.Context, key string, rate int) (bool, error)
+}
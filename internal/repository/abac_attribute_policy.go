@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"gitee.com/flycash/permission-platform/internal/repository/cache"
 
@@ -22,8 +24,26 @@ type PolicyRepo interface {
 	DeleteRule(ctx context.Context, bizID, ruleID int64) error
 	FindPoliciesByPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64) ([]domain.Policy, error)
 	SavePermissionPolicy(ctx context.Context, bizID, policyID, permissionID int64, effect domain.Effect) error
+	DeletePermissionPolicy(ctx context.Context, bizID, policyID, permissionID int64) error
 	FindPolicies(ctx context.Context, bizID int64, offset, limit int) (int64, []domain.Policy, error)
+	// FindBizPolicies 业务下所有已经发布的策略，返回的是当前生效的版本
 	FindBizPolicies(ctx context.Context, bizID int64) ([]domain.Policy, error)
+	// FindDraftPolicies 业务下所有策略的草稿，包含规则以及关联的权限
+	FindDraftPolicies(ctx context.Context, bizID int64) ([]domain.Policy, error)
+	// Publish 发布策略的草稿，返回新的版本号
+	Publish(ctx context.Context, bizID, policyID int64) (int64, error)
+	// FindDraft 策略当前的草稿，包含规则以及关联的权限
+	FindDraft(ctx context.Context, bizID, policyID int64) (domain.PolicyDraft, error)
+	// PublishDraft 发布 FindDraft 拿到的草稿，草稿在这之后被修改过或者策略被发布过的时候返回 errs.ErrPolicyDraftChanged
+	PublishDraft(ctx context.Context, draft domain.PolicyDraft) (int64, error)
+	// Rollback 回滚到指定版本，会用这个版本的内容发布一个新版本，返回新的版本号。草稿不受影响
+	Rollback(ctx context.Context, bizID, policyID, version int64) (int64, error)
+	// FindVersions 策略的所有版本，版本号从大到小
+	FindVersions(ctx context.Context, bizID, policyID int64) ([]domain.PolicyVersion, error)
+	SaveTestCase(ctx context.Context, testCase domain.PolicyTestCase) (int64, error)
+	DeleteTestCase(ctx context.Context, bizID, id int64) error
+	// FindTestCases policyID 为 0 的时候返回业务下全部的测试用例
+	FindTestCases(ctx context.Context, bizID, policyID int64) ([]domain.PolicyTestCase, error)
 }
 
 type policyRepo struct {
@@ -37,6 +57,36 @@ func (p *policyRepo) FindBizPolicies(ctx context.Context, bizID int64) ([]domain
 	return p.getPolicies(ctx, bizID)
 }
 
+func (p *policyRepo) FindDraftPolicies(ctx context.Context, bizID int64) ([]domain.Policy, error) {
+	var (
+		policies           []dao.Policy
+		rules              map[int64][]dao.PolicyRule
+		permissionPolicies map[int64][]dao.PermissionPolicy
+		eg                 errgroup.Group
+	)
+	eg.Go(func() error {
+		var eerr error
+		policies, eerr = p.policyDAO.FindPoliciesByBiz(ctx, bizID)
+		return eerr
+	})
+	eg.Go(func() error {
+		var eerr error
+		rules, eerr = p.policyDAO.FindPolicyRulesByBiz(ctx, bizID)
+		return eerr
+	})
+	eg.Go(func() error {
+		var eerr error
+		permissionPolicies, eerr = p.policyDAO.FindPermissionPolicy(ctx, bizID)
+		return eerr
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return slice.Map(policies, func(_ int, src dao.Policy) domain.Policy {
+		return p.toPolicyDomain(src, rules[src.ID], permissionPolicies)
+	}), nil
+}
+
 // FindPolicies 后台操作不加缓存了
 func (p *policyRepo) FindPolicies(ctx context.Context, bizID int64, offset, limit int) (int64, []domain.Policy, error) {
 	var (
@@ -83,7 +133,23 @@ func (p *policyRepo) SavePermissionPolicy(ctx context.Context, bizID, policyID,
 			elog.Any("policyID", policyID),
 			elog.Any("permissionID", permissionID),
 			elog.Any("effect", effect))
-		p.setPolicyToCacheByBizID(ctx, bizID)
+	}
+	return err
+}
+
+func (p *policyRepo) DeletePermissionPolicy(ctx context.Context, bizID, policyID, permissionID int64) error {
+	err := p.policyDAO.DeletePermissionPolicy(ctx, bizID, permissionID, policyID)
+	if err != nil {
+		p.logger.Error("删除权限策略关联失败",
+			elog.FieldErr(err),
+			elog.Int64("bizId", bizID),
+			elog.Any("policyID", policyID),
+			elog.Any("permissionID", permissionID))
+	} else {
+		p.logger.Info("删除权限策略关联",
+			elog.Int64("bizId", bizID),
+			elog.Any("policyID", policyID),
+			elog.Any("permissionID", permissionID))
 	}
 	return err
 }
@@ -104,6 +170,7 @@ func (p *policyRepo) Save(ctx context.Context, policy domain.Policy) (int64, err
 		BizID:       policy.BizID,
 		Name:        policy.Name,
 		ExecuteType: string(policy.ExecuteType),
+		Expression:  policy.Expression,
 		Description: policy.Description,
 		Status:      string(policy.Status),
 	}
@@ -118,7 +185,6 @@ func (p *policyRepo) Save(ctx context.Context, policy domain.Policy) (int64, err
 		p.logger.Info("添加策略",
 			elog.Int64("bizId", policy.BizID),
 			elog.Any("policy", policy))
-		p.setPolicyToCacheByBizID(ctx, policy.BizID)
 	}
 	return id, err
 }
@@ -144,6 +210,7 @@ func (p *policyRepo) First(ctx context.Context, bizID, id int64) (domain.Policy,
 	// 获取策略基本信息
 	var policy dao.Policy
 	var rules []dao.PolicyRule
+	var permissionPolicies []dao.PermissionPolicy
 	var eg errgroup.Group
 	eg.Go(func() error {
 		var eerr error
@@ -157,10 +224,16 @@ func (p *policyRepo) First(ctx context.Context, bizID, id int64) (domain.Policy,
 		return eerr
 	})
 
+	eg.Go(func() error {
+		var eerr error
+		permissionPolicies, eerr = p.policyDAO.FindPermissionPoliciesByPolicyID(ctx, bizID, id)
+		return eerr
+	})
+
 	if err := eg.Wait(); err != nil {
 		return domain.Policy{}, err
 	}
-	return p.toPolicyDomain(policy, rules, map[int64][]dao.PermissionPolicy{}), nil
+	return p.toPolicyDomain(policy, rules, map[int64][]dao.PermissionPolicy{id: permissionPolicies}), nil
 }
 
 func (p *policyRepo) SaveRule(ctx context.Context, bizID, policyID int64, rule domain.PolicyRule) (int64, error) {
@@ -173,6 +246,9 @@ func (p *policyRepo) SaveRule(ctx context.Context, bizID, policyID int64, rule d
 		Value:     rule.Value,
 		Operator:  string(rule.Operator),
 	}
+	if rule.ValueAttrDef != nil {
+		ruleDAO.ValueAttrDefID = rule.ValueAttrDef.ID
+	}
 	if rule.LeftRule != nil {
 		ruleDAO.Left = rule.LeftRule.ID
 	}
@@ -193,7 +269,6 @@ func (p *policyRepo) SaveRule(ctx context.Context, bizID, policyID int64, rule d
 			elog.Int64("policyId", policyID),
 			elog.Any("rule", rule),
 		)
-		p.setPolicyToCacheByBizID(ctx, bizID)
 	}
 	return id, err
 }
@@ -211,7 +286,6 @@ func (p *policyRepo) DeleteRule(ctx context.Context, bizID, ruleID int64) error
 			elog.Int64("bizId", bizID),
 			elog.Any("ruleID", ruleID),
 		)
-		p.setPolicyToCacheByBizID(ctx, bizID)
 	}
 	return err
 }
@@ -236,37 +310,157 @@ func (p *policyRepo) FindPoliciesByPermissionIDs(ctx context.Context, bizID int6
 	return p.getPolicyByPermissionID(policies, permissionID), nil
 }
 
+// getPolicies 只会返回已经发布的策略，草稿不会生效
 func (p *policyRepo) getPolicies(ctx context.Context, bizID int64) ([]domain.Policy, error) {
-	var (
-		eg                    errgroup.Group
-		daoPolicies           []dao.Policy
-		daoPolicyRules        map[int64][]dao.PolicyRule
-		daoPermissionPolicies map[int64][]dao.PermissionPolicy
-	)
-	eg.Go(func() error {
-		var eerr error
-		daoPermissionPolicies, eerr = p.policyDAO.FindPermissionPolicy(ctx, bizID)
-		return eerr
-	})
-	eg.Go(func() error {
-		var eerr error
-		daoPolicyRules, eerr = p.policyDAO.FindPolicyRulesByBiz(ctx, bizID)
-		return eerr
-	})
-	eg.Go(func() error {
-		var eerr error
-		daoPolicies, eerr = p.policyDAO.FindPoliciesByBiz(ctx, bizID)
-		return eerr
-	})
-	if err := eg.Wait(); err != nil {
+	versions, err := p.policyDAO.FindPublishedPolicyVersions(ctx, bizID)
+	if err != nil {
 		return nil, err
 	}
-	res := make([]domain.Policy, 0, len(daoPolicies))
+	res := make([]domain.Policy, 0, len(versions))
+	for idx := range versions {
+		version, err := p.toPolicyVersionDomain(versions[idx])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, version.Policy)
+	}
+	return res, nil
+}
+
+func (p *policyRepo) Publish(ctx context.Context, bizID, policyID int64) (int64, error) {
+	version, err := p.policyDAO.PublishPolicy(ctx, bizID, policyID)
+	if err != nil {
+		p.logger.Error("发布策略失败",
+			elog.FieldErr(err),
+			elog.Int64("bizId", bizID),
+			elog.Int64("policyId", policyID))
+		return 0, err
+	}
+	p.logger.Info("发布策略",
+		elog.Int64("bizId", bizID),
+		elog.Int64("policyId", policyID),
+		elog.Int64("version", version))
+	p.setPolicyToCacheByBizID(ctx, bizID)
+	return version, nil
+}
 
-	for idx := range daoPolicies {
-		daoPolicy := daoPolicies[idx]
-		rules := daoPolicyRules[daoPolicy.ID]
-		res = append(res, p.toPolicyDomain(daoPolicy, rules, daoPermissionPolicies))
+func (p *policyRepo) FindDraft(ctx context.Context, bizID, policyID int64) (domain.PolicyDraft, error) {
+	snapshot, err := p.policyDAO.FindPolicyDraft(ctx, bizID, policyID)
+	if err != nil {
+		return domain.PolicyDraft{}, err
+	}
+	policy, err := p.toPolicySnapshotDomain(snapshot)
+	if err != nil {
+		return domain.PolicyDraft{}, fmt.Errorf("策略 %d 的草稿解析失败: %w", policyID, err)
+	}
+	return domain.PolicyDraft{Policy: policy, Snapshot: snapshot}, nil
+}
+
+func (p *policyRepo) PublishDraft(ctx context.Context, draft domain.PolicyDraft) (int64, error) {
+	bizID, policyID := draft.Policy.BizID, draft.Policy.ID
+	version, err := p.policyDAO.PublishPolicyDraft(ctx, bizID, policyID, draft.Snapshot)
+	if err != nil {
+		p.logger.Error("发布策略失败",
+			elog.FieldErr(err),
+			elog.Int64("bizId", bizID),
+			elog.Int64("policyId", policyID))
+		return 0, err
+	}
+	p.logger.Info("发布策略",
+		elog.Int64("bizId", bizID),
+		elog.Int64("policyId", policyID),
+		elog.Int64("version", version))
+	p.setPolicyToCacheByBizID(ctx, bizID)
+	return version, nil
+}
+
+func (p *policyRepo) Rollback(ctx context.Context, bizID, policyID, version int64) (int64, error) {
+	newVersion, err := p.policyDAO.RollbackPolicy(ctx, bizID, policyID, version)
+	if err != nil {
+		p.logger.Error("回滚策略失败",
+			elog.FieldErr(err),
+			elog.Int64("bizId", bizID),
+			elog.Int64("policyId", policyID),
+			elog.Int64("version", version))
+		return 0, err
+	}
+	p.logger.Info("回滚策略",
+		elog.Int64("bizId", bizID),
+		elog.Int64("policyId", policyID),
+		elog.Int64("version", version),
+		elog.Int64("newVersion", newVersion))
+	p.setPolicyToCacheByBizID(ctx, bizID)
+	return newVersion, nil
+}
+
+func (p *policyRepo) FindVersions(ctx context.Context, bizID, policyID int64) ([]domain.PolicyVersion, error) {
+	versions, err := p.policyDAO.FindPolicyVersions(ctx, bizID, policyID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.PolicyVersion, 0, len(versions))
+	for idx := range versions {
+		version, err := p.toPolicyVersionDomain(versions[idx])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, version)
+	}
+	return res, nil
+}
+
+func (p *policyRepo) SaveTestCase(ctx context.Context, testCase domain.PolicyTestCase) (int64, error) {
+	attrs, err := json.Marshal(testCase.Attrs)
+	if err != nil {
+		return 0, err
+	}
+	return p.policyDAO.SavePolicyTestCase(ctx, dao.PolicyTestCase{
+		ID:           testCase.ID,
+		BizID:        testCase.BizID,
+		PolicyID:     testCase.PolicyID,
+		Name:         testCase.Name,
+		ResourceType: testCase.Resource.Type,
+		ResourceKey:  testCase.Resource.Key,
+		Action:       testCase.Action,
+		Attributes:   string(attrs),
+		Expected:     testCase.Expected.String(),
+	})
+}
+
+func (p *policyRepo) DeleteTestCase(ctx context.Context, bizID, id int64) error {
+	return p.policyDAO.DeletePolicyTestCase(ctx, bizID, id)
+}
+
+func (p *policyRepo) FindTestCases(ctx context.Context, bizID, policyID int64) ([]domain.PolicyTestCase, error) {
+	cases, err := p.policyDAO.FindPolicyTestCases(ctx, bizID, policyID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.PolicyTestCase, 0, len(cases))
+	for idx := range cases {
+		testCase := cases[idx]
+		var attrs domain.Attributes
+		if testCase.Attributes != "" {
+			if err := json.Unmarshal([]byte(testCase.Attributes), &attrs); err != nil {
+				return nil, fmt.Errorf("测试用例 %d 的属性解析失败: %w", testCase.ID, err)
+			}
+		}
+		res = append(res, domain.PolicyTestCase{
+			ID:       testCase.ID,
+			BizID:    testCase.BizID,
+			PolicyID: testCase.PolicyID,
+			Name:     testCase.Name,
+			Resource: domain.Resource{
+				BizID: testCase.BizID,
+				Type:  testCase.ResourceType,
+				Key:   testCase.ResourceKey,
+			},
+			Action:   testCase.Action,
+			Attrs:    attrs,
+			Expected: domain.Effect(testCase.Expected),
+			Ctime:    testCase.Ctime,
+			Utime:    testCase.Utime,
+		})
 	}
 	return res, nil
 }
@@ -307,9 +501,14 @@ func (p *policyRepo) toPolicyDomain(policy dao.Policy, rules []dao.PolicyRule, p
 		BizID:       policy.BizID,
 		Name:        policy.Name,
 		ExecuteType: domain.ExecuteType(policy.ExecuteType),
+		Expression:  policy.Expression,
 		Description: policy.Description,
 		Status:      domain.PolicyStatus(policy.Status),
 		Rules:       genDomainPolicyRules(rules),
+
+		PublishedVersion: policy.PublishedVersion,
+		Ctime:            policy.Ctime,
+		Utime:            policy.Utime,
 	}
 	if permissionPolicies, ok := permissionPolicyMap[policy.ID]; ok {
 		for idx := range permissionPolicies {
@@ -326,6 +525,30 @@ func (p *policyRepo) toPolicyDomain(policy dao.Policy, rules []dao.PolicyRule, p
 	return domainPolicy
 }
 
+func (p *policyRepo) toPolicyVersionDomain(version dao.PolicyVersion) (domain.PolicyVersion, error) {
+	policy, err := p.toPolicySnapshotDomain(version.Snapshot)
+	if err != nil {
+		return domain.PolicyVersion{}, fmt.Errorf("策略 %d 版本 %d 的快照解析失败: %w", version.PolicyID, version.Version, err)
+	}
+	policy.PublishedVersion = version.Version
+	return domain.PolicyVersion{
+		PolicyID: version.PolicyID,
+		Version:  version.Version,
+		Policy:   policy,
+		Ctime:    version.Ctime,
+	}, nil
+}
+
+func (p *policyRepo) toPolicySnapshotDomain(data string) (domain.Policy, error) {
+	var snapshot dao.PolicySnapshot
+	if err := json.Unmarshal([]byte(data), &snapshot); err != nil {
+		return domain.Policy{}, err
+	}
+	return p.toPolicyDomain(snapshot.Policy, snapshot.Rules, map[int64][]dao.PermissionPolicy{
+		snapshot.Policy.ID: snapshot.PermissionPolicies,
+	}), nil
+}
+
 func (p *policyRepo) getPolicyByPermissionID(policies []domain.Policy, permissionIDs []int64) []domain.Policy {
 	res := make([]domain.Policy, 0, len(policies))
 	for idx := range policies {
@@ -55,6 +55,9 @@ func genRule(rule domain.PolicyRule, ruleMap map[int64]dao.PolicyRule) domain.Po
 		Ctime:    ruleDao.Ctime,
 		Utime:    ruleDao.Utime,
 	}
+	if ruleDao.ValueAttrDefID > 0 {
+		rule.ValueAttrDef = &domain.AttributeDefinition{ID: ruleDao.ValueAttrDefID}
+	}
 	if ruleDao.Left > 0 {
 		left := genRule(domain.PolicyRule{ID: ruleDao.Left}, ruleMap)
 		rule.LeftRule = &left
@@ -2,8 +2,6 @@ package repository
 
 import (
 	"context"
-	"fmt"
-	"regexp"
 
 	"gitee.com/flycash/permission-platform/internal/repository/cache"
 	"github.com/gotomicro/ego/core/elog"
@@ -28,6 +26,11 @@ type AttributeValueRepository interface {
 	DeleteEnvironmentValue(ctx context.Context, bizID, id int64) error
 	FindEnvironmentValue(ctx context.Context, bizID int64) (domain.ABACObject, error)
 	FindEnvironmentValueWithDefinition(ctx context.Context, bizID int64) (domain.ABACObject, error)
+
+	// FindBizSubjectValues 业务下所有主体的属性值，不走缓存，属性定义只有 ID
+	FindBizSubjectValues(ctx context.Context, bizID int64) ([]domain.ABACObject, error)
+	// FindBizResourceValues 业务下所有资源的属性值，不走缓存，属性定义只有 ID
+	FindBizResourceValues(ctx context.Context, bizID int64) ([]domain.ABACObject, error)
 }
 
 type attributeValueRepository struct {
@@ -57,30 +60,7 @@ func NewAttributeValueRepository(envDao dao.EnvironmentAttributeDAO,
 	}
 }
 
-func (a *attributeValueRepository) checkVal(ctx context.Context, bizID int64, val string, definitionID int64) error {
-	definition, err := a.definitionDao.First(ctx, bizID, definitionID)
-	if err != nil {
-		return err
-	}
-	return a.matchRegex(definition.ValidationRule, val)
-}
-
-func (a *attributeValueRepository) matchRegex(pattern, input string) error {
-	matched, err := regexp.MatchString(pattern, input)
-	if err != nil {
-		return fmt.Errorf("正则表达式语法错误: %w", err)
-	}
-	if !matched {
-		return fmt.Errorf("填写的值不符合正则规范")
-	}
-	return nil
-}
-
 func (a *attributeValueRepository) SaveSubjectValue(ctx context.Context, bizID, subjectID int64, val domain.AttributeValue) (int64, error) {
-	err := a.checkVal(ctx, bizID, val.Value, val.Definition.ID)
-	if err != nil {
-		return 0, err
-	}
 	daoVal := dao.SubjectAttributeValue{
 		ID:        val.ID,
 		BizID:     bizID,
@@ -151,10 +131,6 @@ func (a *attributeValueRepository) FindSubjectValueWithDefinition(ctx context.Co
 }
 
 func (a *attributeValueRepository) SaveResourceValue(ctx context.Context, bizID, resourceID int64, val domain.AttributeValue) (int64, error) {
-	err := a.checkVal(ctx, bizID, val.Value, val.Definition.ID)
-	if err != nil {
-		return 0, err
-	}
 	daoVal := dao.ResourceAttributeValue{
 		ID:         val.ID,
 		BizID:      bizID,
@@ -225,10 +201,6 @@ func (a *attributeValueRepository) FindResourceValueWithDefinition(ctx context.C
 }
 
 func (a *attributeValueRepository) SaveEnvironmentValue(ctx context.Context, bizID int64, val domain.AttributeValue) (int64, error) {
-	err := a.checkVal(ctx, bizID, val.Value, val.Definition.ID)
-	if err != nil {
-		return 0, err
-	}
 	daoVal := dao.EnvironmentAttributeValue{
 		ID:        val.ID,
 		BizID:     bizID,
@@ -288,6 +260,43 @@ func (a *attributeValueRepository) FindEnvironmentValueWithDefinition(ctx contex
 	}, nil
 }
 
+func (a *attributeValueRepository) FindBizSubjectValues(ctx context.Context, bizID int64) ([]domain.ABACObject, error) {
+	values, err := a.subjectDao.FindByBiz(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	return groupAttributeValues(bizID, values, func(src dao.SubjectAttributeValue) (int64, domain.AttributeValue) {
+		return src.SubjectID, a.toDomainSubjectValue(src, dao.AttributeDefinition{ID: src.AttrDefID})
+	}), nil
+}
+
+func (a *attributeValueRepository) FindBizResourceValues(ctx context.Context, bizID int64) ([]domain.ABACObject, error) {
+	values, err := a.resourceDao.FindByBiz(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	return groupAttributeValues(bizID, values, func(src dao.ResourceAttributeValue) (int64, domain.AttributeValue) {
+		return src.ResourceID, a.toDomainResourceValue(src, dao.AttributeDefinition{ID: src.AttrDefID})
+	}), nil
+}
+
+// groupAttributeValues 按照主体或者资源分组，保持第一次出现的顺序
+func groupAttributeValues[T any](bizID int64, values []T, fn func(src T) (int64, domain.AttributeValue)) []domain.ABACObject {
+	res := make([]domain.ABACObject, 0, len(values))
+	index := make(map[int64]int, len(values))
+	for _, src := range values {
+		id, val := fn(src)
+		idx, ok := index[id]
+		if !ok {
+			idx = len(res)
+			index[id] = idx
+			res = append(res, domain.ABACObject{BizID: bizID, ID: id})
+		}
+		res[idx].AttributeValues = append(res[idx].AttributeValues, val)
+	}
+	return res
+}
+
 func (a *attributeValueRepository) toDomainSubjectValue(subjectVal dao.SubjectAttributeValue, definition dao.AttributeDefinition) domain.AttributeValue {
 	return domain.AttributeValue{
 		ID:         subjectVal.ID,
@@ -21,19 +21,19 @@ type BusinessConfigRepository interface {
 	Delete(ctx context.Context, id int64) error
 }
 
-// businessConfigRepository 业务配置仓储实现
-type businessConfigRepository struct {
+// BusinessConfigDefaultRepository 业务配置仓储实现
+type BusinessConfigDefaultRepository struct {
 	businessConfigDAO dao.BusinessConfigDAO
 }
 
-// NewBusinessConfigRepository 创建业务配置仓储实例
-func NewBusinessConfigRepository(businessConfigDAO dao.BusinessConfigDAO) BusinessConfigRepository {
-	return &businessConfigRepository{
+// NewBusinessConfigDefaultRepository 创建业务配置仓储实例
+func NewBusinessConfigDefaultRepository(businessConfigDAO dao.BusinessConfigDAO) *BusinessConfigDefaultRepository {
+	return &BusinessConfigDefaultRepository{
 		businessConfigDAO: businessConfigDAO,
 	}
 }
 
-func (r *businessConfigRepository) Create(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
+func (r *BusinessConfigDefaultRepository) Create(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
 	created, err := r.businessConfigDAO.Create(ctx, r.toEntity(config))
 	if err != nil {
 		return domain.BusinessConfig{}, err
@@ -41,7 +41,7 @@ func (r *businessConfigRepository) Create(ctx context.Context, config domain.Bus
 	return r.toDomain(created), nil
 }
 
-func (r *businessConfigRepository) Find(ctx context.Context, offset, limit int) ([]domain.BusinessConfig, error) {
+func (r *BusinessConfigDefaultRepository) Find(ctx context.Context, offset, limit int) ([]domain.BusinessConfig, error) {
 	list, err := r.businessConfigDAO.Find(ctx, offset, limit)
 	if err != nil {
 		return nil, err
@@ -52,7 +52,7 @@ func (r *businessConfigRepository) Find(ctx context.Context, offset, limit int)
 	}), nil
 }
 
-func (r *businessConfigRepository) FindByID(ctx context.Context, id int64) (domain.BusinessConfig, error) {
+func (r *BusinessConfigDefaultRepository) FindByID(ctx context.Context, id int64) (domain.BusinessConfig, error) {
 	config, err := r.businessConfigDAO.GetByID(ctx, id)
 	if err != nil {
 		return domain.BusinessConfig{}, err
@@ -60,7 +60,7 @@ func (r *businessConfigRepository) FindByID(ctx context.Context, id int64) (doma
 	return r.toDomain(config), nil
 }
 
-func (r *businessConfigRepository) Update(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
+func (r *BusinessConfigDefaultRepository) Update(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
 	err := r.businessConfigDAO.Update(ctx, r.toEntity(config))
 	if err != nil {
 		return domain.BusinessConfig{}, err
@@ -68,36 +68,44 @@ func (r *businessConfigRepository) Update(ctx context.Context, config domain.Bus
 	return config, nil
 }
 
-func (r *businessConfigRepository) UpdateToken(ctx context.Context, id int64, token string) error {
+func (r *BusinessConfigDefaultRepository) UpdateToken(ctx context.Context, id int64, token string) error {
 	return r.businessConfigDAO.UpdateToken(ctx, id, token)
 }
 
-func (r *businessConfigRepository) Delete(ctx context.Context, id int64) error {
+func (r *BusinessConfigDefaultRepository) Delete(ctx context.Context, id int64) error {
 	return r.businessConfigDAO.Delete(ctx, id)
 }
 
-func (r *businessConfigRepository) toEntity(bc domain.BusinessConfig) dao.BusinessConfig {
+func (r *BusinessConfigDefaultRepository) toEntity(bc domain.BusinessConfig) dao.BusinessConfig {
 	return dao.BusinessConfig{
-		ID:        bc.ID,
-		OwnerID:   bc.OwnerID,
-		OwnerType: bc.OwnerType,
-		Name:      bc.Name,
-		RateLimit: bc.RateLimit,
-		Token:     bc.Token,
-		Ctime:     bc.Ctime,
-		Utime:     bc.Utime,
+		ID:                     bc.ID,
+		OwnerID:                bc.OwnerID,
+		OwnerType:              bc.OwnerType,
+		Name:                   bc.Name,
+		RateLimit:              bc.RateLimit,
+		Engine:                 bc.Engine.OrDefault().String(),
+		CombiningAlgorithm:     bc.CombiningAlgorithm.OrDefault().String(),
+		NoPolicyEffect:         bc.NoPolicyEffectOrDefault().String(),
+		InvalidAttributeAction: bc.InvalidAttributeAction.OrDefault().String(),
+		Token:                  bc.Token,
+		Ctime:                  bc.Ctime,
+		Utime:                  bc.Utime,
 	}
 }
 
-func (r *businessConfigRepository) toDomain(bc dao.BusinessConfig) domain.BusinessConfig {
+func (r *BusinessConfigDefaultRepository) toDomain(bc dao.BusinessConfig) domain.BusinessConfig {
 	return domain.BusinessConfig{
-		ID:        bc.ID,
-		OwnerID:   bc.OwnerID,
-		OwnerType: bc.OwnerType,
-		Name:      bc.Name,
-		RateLimit: bc.RateLimit,
-		Token:     bc.Token,
-		Ctime:     bc.Ctime,
-		Utime:     bc.Utime,
+		ID:                     bc.ID,
+		OwnerID:                bc.OwnerID,
+		OwnerType:              bc.OwnerType,
+		Name:                   bc.Name,
+		RateLimit:              bc.RateLimit,
+		Engine:                 domain.Engine(bc.Engine).OrDefault(),
+		CombiningAlgorithm:     domain.CombiningAlgorithm(bc.CombiningAlgorithm).OrDefault(),
+		NoPolicyEffect:         domain.Effect(bc.NoPolicyEffect),
+		InvalidAttributeAction: domain.InvalidAttributeAction(bc.InvalidAttributeAction).OrDefault(),
+		Token:                  bc.Token,
+		Ctime:                  bc.Ctime,
+		Utime:                  bc.Utime,
 	}
 }
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/repository/cache"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+var _ BusinessConfigRepository = (*BusinessConfigCachedRepository)(nil)
+
+// BusinessConfigCachedRepository 权限判定、限流每次请求都要读取业务配置，所以缓存起来，修改和删除之后删除缓存
+type BusinessConfigCachedRepository struct {
+	repo   *BusinessConfigDefaultRepository
+	cache  cache.BusinessConfigCache
+	logger *elog.Component
+}
+
+// NewBusinessConfigCachedRepository 创建添加了缓存的业务配置仓储实例
+func NewBusinessConfigCachedRepository(
+	repo *BusinessConfigDefaultRepository,
+	cache cache.BusinessConfigCache,
+) *BusinessConfigCachedRepository {
+	return &BusinessConfigCachedRepository{
+		repo:   repo,
+		cache:  cache,
+		logger: elog.DefaultLogger.With(elog.FieldName("BusinessConfigCachedRepository")),
+	}
+}
+
+func (r *BusinessConfigCachedRepository) Create(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
+	return r.repo.Create(ctx, config)
+}
+
+func (r *BusinessConfigCachedRepository) Find(ctx context.Context, offset, limit int) ([]domain.BusinessConfig, error) {
+	return r.repo.Find(ctx, offset, limit)
+}
+
+func (r *BusinessConfigCachedRepository) FindByID(ctx context.Context, id int64) (domain.BusinessConfig, error) {
+	config, err := r.cache.Get(ctx, id)
+	if err == nil {
+		return config, nil
+	}
+	config, err = r.repo.FindByID(ctx, id)
+	if err != nil {
+		return domain.BusinessConfig{}, err
+	}
+	if err1 := r.cache.Set(ctx, config); err1 != nil {
+		r.logger.Warn("按ID查找业务配置成功后，设置缓存失败",
+			elog.FieldErr(err1),
+			elog.Any("id", id),
+		)
+	}
+	return config, nil
+}
+
+func (r *BusinessConfigCachedRepository) UpdateToken(ctx context.Context, id int64, token string) error {
+	if err := r.repo.UpdateToken(ctx, id, token); err != nil {
+		return err
+	}
+	r.invalidate(ctx, id)
+	return nil
+}
+
+func (r *BusinessConfigCachedRepository) Update(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
+	updated, err := r.repo.Update(ctx, config)
+	if err != nil {
+		return domain.BusinessConfig{}, err
+	}
+	r.invalidate(ctx, config.ID)
+	return updated, nil
+}
+
+func (r *BusinessConfigCachedRepository) Delete(ctx context.Context, id int64) error {
+	if err := r.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.invalidate(ctx, id)
+	return nil
+}
+
+func (r *BusinessConfigCachedRepository) invalidate(ctx context.Context, id int64) {
+	if err := r.cache.Del(ctx, id); err != nil {
+		r.logger.Warn("修改业务配置成功后，删除缓存失败",
+			elog.FieldErr(err),
+			elog.Any("id", id),
+		)
+	}
+}
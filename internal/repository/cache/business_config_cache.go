@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ecache"
+)
+
+// businessConfigExpiration 业务配置变更的时候会删除缓存，过期时间只是兜底
+const businessConfigExpiration = 10 * time.Minute
+
+type BusinessConfigCache interface {
+	// Get 获取业务配置
+	Get(ctx context.Context, id int64) (domain.BusinessConfig, error)
+	// Set 设置业务配置
+	Set(ctx context.Context, config domain.BusinessConfig) error
+	// Del 删除业务配置，下一次读取的时候重新加载
+	Del(ctx context.Context, id int64) error
+}
+
+type businessConfigCache struct {
+	c ecache.Cache
+}
+
+// NewBusinessConfigCache 所有实例需要共用同一个 c，否则删除缓存只在本实例上生效
+func NewBusinessConfigCache(c ecache.Cache) BusinessConfigCache {
+	return &businessConfigCache{c: c}
+}
+
+func (b *businessConfigCache) Get(ctx context.Context, id int64) (domain.BusinessConfig, error) {
+	val := b.c.Get(ctx, b.cacheKey(id))
+	if val.Err != nil {
+		if val.KeyNotFound() {
+			return domain.BusinessConfig{}, fmt.Errorf("%w", ErrKeyNotFound)
+		}
+		return domain.BusinessConfig{}, val.Err
+	}
+	var res domain.BusinessConfig
+	err := val.JSONScan(&res)
+	return res, err
+}
+
+func (b *businessConfigCache) Set(ctx context.Context, config domain.BusinessConfig) error {
+	value, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return b.c.Set(ctx, b.cacheKey(config.ID), value, businessConfigExpiration)
+}
+
+func (b *businessConfigCache) Del(ctx context.Context, id int64) error {
+	_, err := b.c.Delete(ctx, b.cacheKey(id))
+	return err
+}
+
+func (b *businessConfigCache) cacheKey(id int64) string {
+	return fmt.Sprintf("businessConfig:id:%d", id)
+}
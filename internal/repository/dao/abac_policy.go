@@ -2,9 +2,12 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
 
 	"github.com/pkg/errors"
 
@@ -23,8 +26,11 @@ type Policy struct {
 	Description string `gorm:"column:description;type:text;comment:策略描述" json:"description"`
 	Status      string `gorm:"column:status;type:enum('active','inactive');not null;default:active;index:idx_status;comment:策略状态" json:"status"`
 	ExecuteType string `gorm:"column:execute_type;type:varchar(255);default:logic"`
-	Ctime       int64  `gorm:"column:ctime;comment:创建时间"`
-	Utime       int64  `gorm:"column:utime;comment:更新时间"`
+	Expression  string `gorm:"column:expression;type:text;comment:策略表达式，execute_type 为 expression 时使用"`
+	// PublishedVersion 当前生效的版本，0 表示还没有发布过
+	PublishedVersion int64 `gorm:"column:published_version;not null;default:0;comment:当前生效的版本"`
+	Ctime            int64 `gorm:"column:ctime;comment:创建时间"`
+	Utime            int64 `gorm:"column:utime;comment:更新时间"`
 }
 
 // TableName 指定表名
@@ -39,11 +45,13 @@ type PolicyRule struct {
 	PolicyID  int64  `gorm:"column:policy_id;not null;index:idx_policy_id;comment:策略ID"`
 	AttrDefID int64  `gorm:"column:attr_def_id;not null;index:idx_attr_def_id;comment:属性定义ID"`
 	Value     string `gorm:"column:value;type:text;comment:比较值，取决于类型"`
-	Left      int64  `gorm:"column:left;comment:左规则ID"`
-	Right     int64  `gorm:"column:right;comment:右规则ID"`
-	Operator  string `gorm:"column:operator;type:varchar(255);not null;comment:操作符"`
-	Ctime     int64  `gorm:"column:ctime;comment:创建时间"`
-	Utime     int64  `gorm:"column:utime;comment:更新时间"`
+	// ValueAttrDefID 不为 0 的时候和这个属性的取值比较
+	ValueAttrDefID int64  `gorm:"column:value_attr_def_id;comment:比较的属性定义ID"`
+	Left           int64  `gorm:"column:left;comment:左规则ID"`
+	Right          int64  `gorm:"column:right;comment:右规则ID"`
+	Operator       string `gorm:"column:operator;type:varchar(255);not null;comment:操作符"`
+	Ctime          int64  `gorm:"column:ctime;comment:创建时间"`
+	Utime          int64  `gorm:"column:utime;comment:更新时间"`
 }
 
 // TableName 指定表名
@@ -67,6 +75,50 @@ func (p PermissionPolicy) TableName() string {
 	return "permission_policies"
 }
 
+// PolicyVersion 策略版本表模型，发布之后不会再修改
+type PolicyVersion struct {
+	ID       int64 `gorm:"column:id;primaryKey;autoIncrement;"`
+	BizID    int64 `gorm:"column:biz_id;index:idx_biz_id;comment:业务ID"`
+	PolicyID int64 `gorm:"column:policy_id;not null;uniqueIndex:idx_policy_version;comment:策略ID"`
+	Version  int64 `gorm:"column:version;not null;uniqueIndex:idx_policy_version;comment:版本号，同一个策略内递增"`
+	// Snapshot 发布时的策略、规则以及权限关联，JSON 格式的 PolicySnapshot
+	Snapshot string `gorm:"column:snapshot;type:longtext;comment:策略快照"`
+	Ctime    int64  `gorm:"column:ctime;comment:创建时间"`
+}
+
+// TableName 指定表名
+func (v PolicyVersion) TableName() string {
+	return "policy_versions"
+}
+
+// PolicySnapshot 发布时的策略快照
+type PolicySnapshot struct {
+	Policy             Policy             `json:"policy"`
+	Rules              []PolicyRule       `json:"rules"`
+	PermissionPolicies []PermissionPolicy `json:"permissionPolicies"`
+}
+
+// PolicyTestCase 策略测试用例表模型
+type PolicyTestCase struct {
+	ID           int64  `gorm:"column:id;primaryKey;autoIncrement;"`
+	BizID        int64  `gorm:"column:biz_id;index:idx_biz_policy;comment:业务ID"`
+	PolicyID     int64  `gorm:"column:policy_id;not null;index:idx_biz_policy;comment:策略ID"`
+	Name         string `gorm:"column:name;type:varchar(255);not null;comment:用例名称"`
+	ResourceType string `gorm:"column:resource_type;type:varchar(255);not null;comment:资源类型"`
+	ResourceKey  string `gorm:"column:resource_key;type:varchar(255);not null;comment:资源标识"`
+	Action       string `gorm:"column:action;type:varchar(255);not null;comment:操作"`
+	// Attributes JSON 格式的 domain.Attributes
+	Attributes string `gorm:"column:attributes;type:text;comment:测试使用的主体、资源、环境属性"`
+	Expected   string `gorm:"column:expected;type:enum('allow','deny');not null;comment:预期结果"`
+	Ctime      int64  `gorm:"column:ctime;comment:创建时间"`
+	Utime      int64  `gorm:"column:utime;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (c PolicyTestCase) TableName() string {
+	return "policy_test_cases"
+}
+
 // -------- Policy DAO Interface --------
 
 // PolicyDAO 综合策略数据访问接口
@@ -97,6 +149,27 @@ type PolicyDAO interface {
 	FindPoliciesByPermission(ctx context.Context, bizID int64, permissionIDs []int64) ([]PermissionPolicy, error)
 	FindPermissionPolicy(ctx context.Context, bizID int64) (map[int64][]PermissionPolicy, error)
 	FindPermissionPolicyByBizIDs(ctx context.Context, bizIDs []int64) (map[int64]map[int64][]PermissionPolicy, error)
+	FindPermissionPoliciesByPolicyID(ctx context.Context, bizID, policyID int64) ([]PermissionPolicy, error)
+
+	// PolicyVersion 相关方法
+	// PublishPolicy 把草稿，也就是 policies、policy_rules、permission_policies 里的数据发布成一个新版本，返回新版本号
+	PublishPolicy(ctx context.Context, bizID, policyID int64) (int64, error)
+	// FindPolicyDraft 策略当前草稿的快照，格式和发布之后的快照一样
+	FindPolicyDraft(ctx context.Context, bizID, policyID int64) (string, error)
+	// PublishPolicyDraft 发布读取草稿时拿到的快照，草稿在这之后被修改过或者策略被发布过的时候返回 errs.ErrPolicyDraftChanged
+	PublishPolicyDraft(ctx context.Context, bizID, policyID int64, draft string) (int64, error)
+	// RollbackPolicy 用指定版本的快照发布一个新版本，返回新版本号
+	RollbackPolicy(ctx context.Context, bizID, policyID, version int64) (int64, error)
+	FindPolicyVersions(ctx context.Context, bizID, policyID int64) ([]PolicyVersion, error)
+	// FindPublishedPolicyVersions 业务下所有策略当前生效的版本
+	FindPublishedPolicyVersions(ctx context.Context, bizID int64) ([]PolicyVersion, error)
+
+	// PolicyTestCase 相关方法
+	// SavePolicyTestCase ID 不为 0 的时候修改，业务下没有这个测试用例的时候返回 errs.ErrPolicyTestCaseNotFound
+	SavePolicyTestCase(ctx context.Context, testCase PolicyTestCase) (int64, error)
+	DeletePolicyTestCase(ctx context.Context, bizID, id int64) error
+	// FindPolicyTestCases policyID 为 0 的时候返回业务下全部的测试用例
+	FindPolicyTestCases(ctx context.Context, bizID, policyID int64) ([]PolicyTestCase, error)
 }
 
 type policyDAO struct {
@@ -200,7 +273,7 @@ func (p *policyDAO) SavePolicy(ctx context.Context, policy Policy) (int64, error
 
 	err := p.db.WithContext(ctx).
 		Clauses(clause.OnConflict{
-			DoUpdates: clause.AssignmentColumns([]string{"description", "utime"}),
+			DoUpdates: clause.AssignmentColumns([]string{"description", "execute_type", "expression", "utime"}),
 		}).Create(&policy).Error
 	return policy.ID, err
 }
@@ -228,7 +301,17 @@ func (p *policyDAO) DeletePolicy(ctx context.Context, bizID, id int64) error {
 			return err
 		}
 
-		// 3. 删除策略本身
+		// 3. 删除策略的所有版本
+		if err := tx.Where("biz_id = ? AND policy_id = ?", bizID, id).Delete(&PolicyVersion{}).Error; err != nil {
+			return err
+		}
+
+		// 4. 删除策略的测试用例
+		if err := tx.Where("biz_id = ? AND policy_id = ?", bizID, id).Delete(&PolicyTestCase{}).Error; err != nil {
+			return err
+		}
+
+		// 5. 删除策略本身
 		return tx.Where("id = ? AND biz_id = ?", id, bizID).Delete(&Policy{}).Error
 	})
 }
@@ -267,7 +350,7 @@ func (p *policyDAO) SavePolicyRule(ctx context.Context, rule PolicyRule) (int64,
 	err := p.db.WithContext(ctx).
 		Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"value", "left", "right", "operator", "utime"}),
+			DoUpdates: clause.AssignmentColumns([]string{"value", "value_attr_def_id", "left", "right", "operator", "utime"}),
 		}).Create(&rule).Error
 	return rule.ID, err
 }
@@ -348,3 +431,254 @@ func (p *policyDAO) FindPoliciesByPermission(ctx context.Context, bizID int64, p
 		Find(&relations).Error
 	return relations, err
 }
+
+func (p *policyDAO) PublishPolicy(ctx context.Context, bizID, policyID int64) (int64, error) {
+	var version int64
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		policy, err := p.lockPolicy(tx, bizID, policyID)
+		if err != nil {
+			return err
+		}
+		version, err = p.publish(tx, policy)
+		return err
+	})
+	return version, err
+}
+
+// publish 把策略当前的草稿做成快照，发布为新版本
+func (p *policyDAO) publish(tx *gorm.DB, policy Policy) (int64, error) {
+	snapshot, err := p.draftSnapshot(tx, policy)
+	if err != nil {
+		return 0, err
+	}
+	return p.createVersion(tx, policy, snapshot)
+}
+
+// draftSnapshot 策略当前草稿的 JSON 快照。规则和权限关联按照 ID 排序，草稿没有变化的时候快照也不变
+func (p *policyDAO) draftSnapshot(tx *gorm.DB, policy Policy) (string, error) {
+	snapshot := PolicySnapshot{Policy: policy}
+	if err := tx.Where("biz_id = ? AND policy_id = ?", policy.BizID, policy.ID).
+		Order("id").Find(&snapshot.Rules).Error; err != nil {
+		return "", err
+	}
+	if err := tx.Where("biz_id = ? AND policy_id = ?", policy.BizID, policy.ID).
+		Order("id").Find(&snapshot.PermissionPolicies).Error; err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (p *policyDAO) FindPolicyDraft(ctx context.Context, bizID, policyID int64) (string, error) {
+	var draft string
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var policy Policy
+		err := tx.Where("id = ? AND biz_id = ?", policyID, bizID).First(&policy).Error
+		if err != nil {
+			return err
+		}
+		draft, err = p.draftSnapshot(tx, policy)
+		return err
+	})
+	return draft, err
+}
+
+func (p *policyDAO) PublishPolicyDraft(ctx context.Context, bizID, policyID int64, draft string) (int64, error) {
+	var version int64
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		policy, err := p.lockPolicy(tx, bizID, policyID)
+		if err != nil {
+			return err
+		}
+		// 锁住策略之后再比较，比较和发布的是同一份快照
+		current, err := p.draftSnapshot(tx, policy)
+		if err != nil {
+			return err
+		}
+		if current != draft {
+			return fmt.Errorf("%w: 策略 %d", errs.ErrPolicyDraftChanged, policyID)
+		}
+		version, err = p.createVersion(tx, policy, current)
+		return err
+	})
+	return version, err
+}
+
+func (p *policyDAO) RollbackPolicy(ctx context.Context, bizID, policyID, version int64) (int64, error) {
+	var newVersion int64
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		policy, err := p.lockPolicy(tx, bizID, policyID)
+		if err != nil {
+			return err
+		}
+		var target PolicyVersion
+		err = tx.Where("biz_id = ? AND policy_id = ? AND version = ?", bizID, policyID, version).
+			First(&target).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: 策略 %d 没有版本 %d", errs.ErrPolicyVersionNotFound, policyID, version)
+		}
+		if err != nil {
+			return err
+		}
+		newVersion, err = p.createVersion(tx, policy, target.Snapshot)
+		return err
+	})
+	return newVersion, err
+}
+
+// backfillPolicyVersions 给策略版本表上线前已有的策略发布第一个版本，否则升级之后这些策略都不再生效。
+// 上线后新建、还没有发布过的草稿不能被发布，所以只处理迁移第一次开始之前创建的策略。
+// 每个策略单独一个事务，中途失败重启之后会从还没有版本的策略继续
+func backfillPolicyVersions(db *gorm.DB) error {
+	const name = "backfill_policy_versions"
+	migration, err := startDataMigration(db, name)
+	if err != nil || migration.Done {
+		return err
+	}
+	const batchSize = 100
+	p := &policyDAO{}
+	var lastID int64
+	for {
+		var ids []int64
+		err = db.Model(&Policy{}).
+			Where("id > ? AND published_version = 0 AND (ctime IS NULL OR ctime < ?)", lastID, migration.Ctime).
+			Where("NOT EXISTS (?)", db.Model(&PolicyVersion{}).Select("1").Where("policy_versions.policy_id = policies.id")).
+			Order("id").Limit(batchSize).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			err = db.Transaction(func(tx *gorm.DB) error {
+				var policy Policy
+				err1 := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&policy).Error
+				// 加锁之后再检查一次，别的实例可能已经发布过了
+				if err1 != nil || policy.PublishedVersion > 0 {
+					return err1
+				}
+				_, err1 = p.publish(tx, policy)
+				return err1
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(ids) < batchSize {
+			return finishDataMigration(db, name)
+		}
+		lastID = ids[len(ids)-1]
+	}
+}
+
+// lockPolicy 锁住策略，同一个策略的发布和回滚串行执行
+func (p *policyDAO) lockPolicy(tx *gorm.DB, bizID, policyID int64) (Policy, error) {
+	var policy Policy
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND biz_id = ?", policyID, bizID).
+		First(&policy).Error
+	return policy, err
+}
+
+// createVersion 创建一个新版本并且把它设置为生效的版本
+func (p *policyDAO) createVersion(tx *gorm.DB, policy Policy, snapshot string) (int64, error) {
+	var latest int64
+	err := tx.Model(&PolicyVersion{}).
+		Where("policy_id = ?", policy.ID).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&latest).Error
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now().UnixMilli()
+	version := PolicyVersion{
+		BizID:    policy.BizID,
+		PolicyID: policy.ID,
+		Version:  latest + 1,
+		Snapshot: snapshot,
+		Ctime:    now,
+	}
+	if err = tx.Create(&version).Error; err != nil {
+		return 0, err
+	}
+	err = tx.Model(&Policy{}).
+		Where("id = ?", policy.ID).
+		Updates(map[string]any{"published_version": version.Version, "utime": now}).Error
+	return version.Version, err
+}
+
+func (p *policyDAO) FindPolicyVersions(ctx context.Context, bizID, policyID int64) ([]PolicyVersion, error) {
+	var versions []PolicyVersion
+	err := p.db.WithContext(ctx).
+		Where("biz_id = ? AND policy_id = ?", bizID, policyID).
+		Order("version DESC").
+		Find(&versions).Error
+	return versions, err
+}
+
+func (p *policyDAO) FindPublishedPolicyVersions(ctx context.Context, bizID int64) ([]PolicyVersion, error) {
+	var versions []PolicyVersion
+	err := p.db.WithContext(ctx).
+		Table("policy_versions AS v").
+		Select("v.*").
+		Joins("JOIN policies AS p ON p.id = v.policy_id AND p.published_version = v.version").
+		Where("p.biz_id = ?", bizID).
+		Find(&versions).Error
+	return versions, err
+}
+
+func (p *policyDAO) FindPermissionPoliciesByPolicyID(ctx context.Context, bizID, policyID int64) ([]PermissionPolicy, error) {
+	var relations []PermissionPolicy
+	err := p.db.WithContext(ctx).
+		Where("biz_id = ? AND policy_id = ?", bizID, policyID).
+		Find(&relations).Error
+	return relations, err
+}
+
+func (p *policyDAO) SavePolicyTestCase(ctx context.Context, testCase PolicyTestCase) (int64, error) {
+	now := time.Now().UnixMilli()
+	testCase.Utime = now
+	if testCase.ID == 0 {
+		testCase.Ctime = now
+		err := p.db.WithContext(ctx).Create(&testCase).Error
+		return testCase.ID, err
+	}
+	// 只能修改本业务下的测试用例
+	res := p.db.WithContext(ctx).Model(&PolicyTestCase{}).
+		Where("id = ? AND biz_id = ?", testCase.ID, testCase.BizID).
+		Updates(map[string]any{
+			"policy_id":     testCase.PolicyID,
+			"name":          testCase.Name,
+			"resource_type": testCase.ResourceType,
+			"resource_key":  testCase.ResourceKey,
+			"action":        testCase.Action,
+			"attributes":    testCase.Attributes,
+			"expected":      testCase.Expected,
+			"utime":         testCase.Utime,
+		})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return 0, fmt.Errorf("%w: 业务 %d 下没有测试用例 %d", errs.ErrPolicyTestCaseNotFound, testCase.BizID, testCase.ID)
+	}
+	return testCase.ID, nil
+}
+
+func (p *policyDAO) DeletePolicyTestCase(ctx context.Context, bizID, id int64) error {
+	return p.db.WithContext(ctx).
+		Where("id = ? AND biz_id = ?", id, bizID).
+		Delete(&PolicyTestCase{}).Error
+}
+
+func (p *policyDAO) FindPolicyTestCases(ctx context.Context, bizID, policyID int64) ([]PolicyTestCase, error) {
+	var cases []PolicyTestCase
+	query := p.db.WithContext(ctx).Where("biz_id = ?", bizID)
+	if policyID > 0 {
+		query = query.Where("policy_id = ?", policyID)
+	}
+	err := query.Order("id").Find(&cases).Error
+	return cases, err
+}
@@ -38,6 +38,8 @@ type ResourceAttributeValueDAO interface {
 	FindByAttribute(ctx context.Context, bizID int64, attributeID int64) ([]ResourceAttributeValue, error)
 	// 根据属性ids查询所有资源属性值
 	FindByResourceIDs(ctx context.Context, resourceIDs []int64) (map[int64][]ResourceAttributeValue, error)
+	// 查询业务下所有资源的属性值
+	FindByBiz(ctx context.Context, bizID int64) ([]ResourceAttributeValue, error)
 }
 
 type resourceAttributeValueDAO struct {
@@ -106,3 +108,12 @@ func (r *resourceAttributeValueDAO) FindByAttribute(ctx context.Context, bizID,
 		Find(&values).Error
 	return values, err
 }
+
+func (r *resourceAttributeValueDAO) FindByBiz(ctx context.Context, bizID int64) ([]ResourceAttributeValue, error) {
+	var values []ResourceAttributeValue
+	err := r.db.WithContext(ctx).
+		Where("biz_id = ?", bizID).
+		Order("id").
+		Find(&values).Error
+	return values, err
+}
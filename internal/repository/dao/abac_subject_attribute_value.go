@@ -34,6 +34,8 @@ type SubjectAttributeValueDAO interface {
 	Del(ctx context.Context, id int64) error
 	// 查询主体的所有属性值
 	FindBySubject(ctx context.Context, bizID int64, subjectID int64) ([]SubjectAttributeValue, error)
+	// 查询业务下所有主体的属性值
+	FindByBiz(ctx context.Context, bizID int64) ([]SubjectAttributeValue, error)
 }
 
 type abacSubjectAttributeValueDAO struct {
@@ -78,3 +80,12 @@ func (s *abacSubjectAttributeValueDAO) FindBySubject(ctx context.Context, bizID,
 		Find(&values).Error
 	return values, err
 }
+
+func (s *abacSubjectAttributeValueDAO) FindByBiz(ctx context.Context, bizID int64) ([]SubjectAttributeValue, error) {
+	var values []SubjectAttributeValue
+	err := s.db.WithContext(ctx).
+		Where("biz_id = ?", bizID).
+		Order("id").
+		Find(&values).Error
+	return values, err
+}
@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/ego-component/egorm"
+)
+
+// SoDViolationLog 违反职责分离约束、被拒绝的操作
+type SoDViolationLog struct {
+	ID           int64  `gorm:"primaryKey;autoIncrement;comment:'职责分离违规日志表自增ID'"`
+	BizID        int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_constraint,priority:1;comment:'业务ID'"`
+	ConstraintID int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_constraint,priority:2;comment:'违反的约束ID'"`
+	Operation    string `gorm:"type:VARCHAR(255);NOT NULL;comment:'被拒绝的操作：GRANT_USER_ROLE/CREATE_ROLE_INCLUSION/ACTIVATE_ROLES'"`
+	UserIDs      string `gorm:"type:TEXT;comment:'会违反约束的用户ID，JSON 数组，角色本身冲突的时候为空'"`
+	RoleIDs      string `gorm:"type:TEXT;NOT NULL;comment:'冲突的角色ID，JSON 数组'"`
+	Request      string `gorm:"type:TEXT;NOT NULL;comment:'被拒绝的请求JSON序列化后的字符串'"`
+	Ctime        int64
+	Utime        int64
+}
+
+func (SoDViolationLog) TableName() string {
+	return "sod_violation_logs"
+}
+
+type SoDViolationLogDAO interface {
+	BatchCreate(ctx context.Context, logs []SoDViolationLog) error
+}
+
+type sodViolationLogDAO struct {
+	db *egorm.Component
+}
+
+func NewSoDViolationLogDAO(db *egorm.Component) SoDViolationLogDAO {
+	return &sodViolationLogDAO{db: db}
+}
+
+func (s *sodViolationLogDAO) BatchCreate(ctx context.Context, logs []SoDViolationLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	for i := range logs {
+		logs[i].Ctime, logs[i].Utime = now, now
+	}
+	return s.db.WithContext(ctx).Create(&logs).Error
+}
@@ -14,9 +14,14 @@ type BusinessConfig struct {
 	OwnerType string `gorm:"type:ENUM('person', 'organization');comment:'业务方类型：person-个人,organization-组织'"`
 	Name      string `gorm:"type:VARCHAR(255);NOT NULL;comment:'业务名称'"`
 	RateLimit int    `gorm:"type:INT;DEFAULT:1000;comment:'每秒最大请求数'"`
-	Token     string `gorm:"type:TEXT;NOT NULL;comment:'业务方Token，内部包含bizID'"`
-	Ctime     int64
-	Utime     int64
+	Engine    string `gorm:"type:ENUM('rbac', 'abac', 'rbac_abac', 'role_as_attribute');NOT NULL;DEFAULT:'rbac';comment:'权限判定引擎'"`
+	// ABAC 默认的策略合并算法
+	CombiningAlgorithm     string `gorm:"type:ENUM('deny_overrides', 'permit_overrides', 'first_applicable', 'only_one_applicable', 'deny_unless_permit', 'permit_unless_deny');NOT NULL;DEFAULT:'deny_overrides';comment:'ABAC 默认的策略合并算法'"`
+	NoPolicyEffect         string `gorm:"type:ENUM('allow', 'deny');NOT NULL;DEFAULT:'allow';comment:'ABAC 权限上没有绑定任何策略时的结果'"`
+	InvalidAttributeAction string `gorm:"type:ENUM('log', 'reject', 'ignore');NOT NULL;DEFAULT:'log';comment:'ABAC 实时传入的属性值不合法时的处理方式'"`
+	Token                  string `gorm:"type:TEXT;NOT NULL;comment:'业务方Token，内部包含bizID'"`
+	Ctime                  int64
+	Utime                  int64
 }
 
 // TableName 重命名表
@@ -99,11 +104,15 @@ func (b *businessConfigDAO) Update(ctx context.Context, config BusinessConfig) e
 		Model(&BusinessConfig{}).
 		Where("id = ?", config.ID).
 		Updates(map[string]any{
-			"owner_id":   config.OwnerID,
-			"owner_type": config.OwnerType,
-			"name":       config.Name,
-			"rate_limit": config.RateLimit,
-			"utime":      config.Utime,
+			"owner_id":                 config.OwnerID,
+			"owner_type":               config.OwnerType,
+			"name":                     config.Name,
+			"rate_limit":               config.RateLimit,
+			"engine":                   config.Engine,
+			"combining_algorithm":      config.CombiningAlgorithm,
+			"no_policy_effect":         config.NoPolicyEffect,
+			"invalid_attribute_action": config.InvalidAttributeAction,
+			"utime":                    config.Utime,
 		}).Error
 }
 
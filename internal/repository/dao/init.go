@@ -2,22 +2,30 @@ package dao
 
 import (
 	"errors"
+	"time"
 
 	auditdao "gitee.com/flycash/permission-platform/internal/repository/dao/audit"
 	"github.com/ego-component/egorm"
 	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func InitTables(db *egorm.Component) error {
-	return db.AutoMigrate(
+	err := db.AutoMigrate(
 		&BusinessConfig{},
 		&Resource{},
 		&Permission{},
+		&ActionDefinition{},
 		&Role{},
 		&RoleInclusion{},
+		&RoleInclusionClosure{},
 		&RolePermission{},
 		&UserRole{},
+		&UserRoleLock{},
 		&UserPermission{},
+		&SoDConstraint{},
+		&RoleActivation{},
 		&AttributeDefinition{},
 		&SubjectAttributeValue{},
 		&ResourceAttributeValue{},
@@ -25,9 +33,51 @@ func InitTables(db *egorm.Component) error {
 		&Policy{},
 		&PolicyRule{},
 		&PermissionPolicy{},
+		&PolicyVersion{},
+		&PolicyTestCase{},
 		&auditdao.OperationLog{},
 		&auditdao.UserRoleLog{},
+		&auditdao.SoDViolationLog{},
+		&DataMigration{},
 	)
+	if err != nil {
+		return err
+	}
+	if err = rebuildRoleInclusionClosures(db); err != nil {
+		return err
+	}
+	return backfillPolicyVersions(db)
+}
+
+// DataMigration 记录一次性的数据迁移，保证迁移只完整执行一次
+type DataMigration struct {
+	Name  string `gorm:"column:name;type:varchar(100);primaryKey;comment:迁移名称"`
+	Done  bool   `gorm:"column:done;not null;default:false;comment:是否已经完成"`
+	Ctime int64  `gorm:"column:ctime;comment:第一次开始执行的时间"`
+	Utime int64  `gorm:"column:utime;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (m DataMigration) TableName() string {
+	return "data_migrations"
+}
+
+// startDataMigration 登记迁移并返回迁移记录，已经登记过的迁移保留第一次开始执行的时间
+func startDataMigration(db *gorm.DB, name string) (DataMigration, error) {
+	now := time.Now().UnixMilli()
+	err := db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&DataMigration{Name: name, Ctime: now, Utime: now}).Error
+	if err != nil {
+		return DataMigration{}, err
+	}
+	var migration DataMigration
+	err = db.Where("name = ?", name).First(&migration).Error
+	return migration, err
+}
+
+func finishDataMigration(db *gorm.DB, name string) error {
+	return db.Model(&DataMigration{}).Where("name = ?", name).
+		Updates(map[string]any{"done": true, "utime": time.Now().UnixMilli()}).Error
 }
 
 // isUniqueConstraintError 检查是否是唯一索引冲突错误
@@ -20,8 +20,10 @@ type Permission struct {
 	ResourceKey  string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_biz_resource_key,priority:2;comment:'资源业务标识符 (如 用户ID, 文档路径)，冗余字段，加速查询'"`
 	Action       string `gorm:"type:VARCHAR(255);NOT NULL;NOT NULL;uniqueIndex:uk_biz_resource_action,priority:3;index:idx_biz_action,priority:2;comment:'操作类型'"`
 	Metadata     string `gorm:"type:TEXT;comment:'权限元数据，可扩展字段'"`
-	Ctime        int64
-	Utime        int64
+	// ABAC 策略合并算法
+	CombiningAlgorithm string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'ABAC 策略合并算法，为空表示使用业务配置'"`
+	Ctime              int64
+	Utime              int64
 }
 
 func (Permission) TableName() string {
@@ -40,6 +42,8 @@ type PermissionDAO interface {
 	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
 
 	FindPermissions(ctx context.Context, bizID int64, resourceType, resourceKey string, actions []string) ([]Permission, error)
+	FindByBizIDAndResourceType(ctx context.Context, bizID int64, resourceType string) ([]Permission, error)
+	FindByBizIDAndActions(ctx context.Context, bizID int64, actions []string) ([]Permission, error)
 }
 
 // permissionDAO 权限数据访问实现
@@ -61,6 +65,20 @@ func (p *permissionDAO) FindPermissions(ctx context.Context, bizID int64, resour
 	return permissions, err
 }
 
+func (p *permissionDAO) FindByBizIDAndResourceType(ctx context.Context, bizID int64, resourceType string) ([]Permission, error) {
+	var permissions []Permission
+	err := p.db.WithContext(ctx).
+		Where("biz_id = ? AND resource_type = ?", bizID, resourceType).Find(&permissions).Error
+	return permissions, err
+}
+
+func (p *permissionDAO) FindByBizIDAndActions(ctx context.Context, bizID int64, actions []string) ([]Permission, error) {
+	var permissions []Permission
+	err := p.db.WithContext(ctx).
+		Where("biz_id = ? AND action IN (?)", bizID, actions).Find(&permissions).Error
+	return permissions, err
+}
+
 func (p *permissionDAO) Create(ctx context.Context, permission Permission) (Permission, error) {
 	now := time.Now().UnixMilli()
 	permission.Ctime = now
@@ -93,11 +111,12 @@ func (p *permissionDAO) UpdateByBizIDAndID(ctx context.Context, permission Permi
 		Model(&Permission{}).
 		Where("biz_id = ? AND id = ?", permission.BizID, permission.ID).
 		Updates(map[string]any{
-			"name":        permission.Name,
-			"description": permission.Description,
-			"action":      permission.Action,
-			"metadata":    permission.Metadata,
-			"utime":       permission.Utime,
+			"name":                permission.Name,
+			"description":         permission.Description,
+			"action":              permission.Action,
+			"metadata":            permission.Metadata,
+			"combining_algorithm": permission.CombiningAlgorithm,
+			"utime":               permission.Utime,
 		}).Error
 }
 
@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"github.com/ego-component/egorm"
+)
+
+// ActionDefinition 操作定义表
+type ActionDefinition struct {
+	ID          int64  `gorm:"primaryKey;autoIncrement;comment:'操作定义ID'"`
+	BizID       int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_name,priority:1;comment:'业务ID'"`
+	Name        string `gorm:"type:VARCHAR(255);NOT NULL;uniqueIndex:uk_biz_name,priority:2;comment:'操作名称（创建后不可修改）'"`
+	Description string `gorm:"type:TEXT;comment:'操作描述'"`
+	Implies     string `gorm:"type:TEXT;comment:'直接蕴含的操作，JSON 数组'"`
+	Ctime       int64
+	Utime       int64
+}
+
+func (ActionDefinition) TableName() string {
+	return "action_definitions"
+}
+
+// ActionDefinitionDAO 操作定义数据访问接口
+type ActionDefinitionDAO interface {
+	Create(ctx context.Context, def ActionDefinition) (ActionDefinition, error)
+
+	FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]ActionDefinition, error)
+	// FindAllByBizID 查找业务下的所有操作定义，用于构建操作层级
+	FindAllByBizID(ctx context.Context, bizID int64) ([]ActionDefinition, error)
+	FindByBizIDAndID(ctx context.Context, bizID, id int64) (ActionDefinition, error)
+
+	UpdateByBizIDAndID(ctx context.Context, def ActionDefinition) error
+
+	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
+}
+
+// actionDefinitionDAO 操作定义数据访问实现
+type actionDefinitionDAO struct {
+	db *egorm.Component
+}
+
+// NewActionDefinitionDAO 创建操作定义数据访问对象
+func NewActionDefinitionDAO(db *egorm.Component) ActionDefinitionDAO {
+	return &actionDefinitionDAO{
+		db: db,
+	}
+}
+
+func (a *actionDefinitionDAO) Create(ctx context.Context, def ActionDefinition) (ActionDefinition, error) {
+	now := time.Now().UnixMilli()
+	def.Ctime = now
+	def.Utime = now
+	err := a.db.WithContext(ctx).Create(&def).Error
+	if isUniqueConstraintError(err) {
+		return ActionDefinition{}, fmt.Errorf("%w", errs.ErrActionDefinitionDuplicate)
+	}
+	return def, err
+}
+
+func (a *actionDefinitionDAO) FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]ActionDefinition, error) {
+	var defs []ActionDefinition
+	err := a.db.WithContext(ctx).Where("biz_id = ?", bizID).Offset(offset).Limit(limit).Find(&defs).Error
+	return defs, err
+}
+
+func (a *actionDefinitionDAO) FindAllByBizID(ctx context.Context, bizID int64) ([]ActionDefinition, error) {
+	var defs []ActionDefinition
+	err := a.db.WithContext(ctx).Where("biz_id = ?", bizID).Find(&defs).Error
+	return defs, err
+}
+
+func (a *actionDefinitionDAO) FindByBizIDAndID(ctx context.Context, bizID, id int64) (ActionDefinition, error) {
+	var def ActionDefinition
+	err := a.db.WithContext(ctx).Where("biz_id = ? AND id = ?", bizID, id).First(&def).Error
+	return def, err
+}
+
+func (a *actionDefinitionDAO) UpdateByBizIDAndID(ctx context.Context, def ActionDefinition) error {
+	def.Utime = time.Now().UnixMilli()
+	return a.db.WithContext(ctx).
+		Model(&ActionDefinition{}).
+		Where("biz_id = ? AND id = ?", def.BizID, def.ID).
+		Updates(map[string]interface{}{
+			"description": def.Description,
+			"implies":     def.Implies,
+			"utime":       def.Utime,
+		}).Error
+}
+
+func (a *actionDefinitionDAO) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
+	return a.db.WithContext(ctx).Where("biz_id = ? AND id = ?", bizID, id).Delete(&ActionDefinition{}).Error
+}
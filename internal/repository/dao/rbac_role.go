@@ -11,14 +11,16 @@ import (
 
 // Role 角色记录表
 type Role struct {
-	ID          int64  `gorm:"primaryKey;autoIncrement;comment:角色ID'"`
-	BizID       int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id;uniqueIndex:uk_biz_type_name,priority:1;comment:'业务ID'"`
-	Type        string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_role_type;uniqueIndex:uk_biz_type_name,priority:2;comment:'角色类（被冗余，创建后不可修改）'"`
-	Name        string `gorm:"type:VARCHAR(255);NOT NULL;uniqueIndex:uk_biz_type_name,priority:3;comment:'角色名称（被冗余，创建后不可修改）'"`
-	Description string `gorm:"type:TEXT;comment:'角色描述'"`
-	Metadata    string `gorm:"type:TEXT;comment:'角色元数据，可扩展字段'"`
-	Ctime       int64
-	Utime       int64
+	ID              int64  `gorm:"primaryKey;autoIncrement;comment:角色ID'"`
+	BizID           int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id;uniqueIndex:uk_biz_type_name,priority:1;comment:'业务ID'"`
+	Type            string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_role_type;uniqueIndex:uk_biz_type_name,priority:2;comment:'角色类（被冗余，创建后不可修改）'"`
+	Name            string `gorm:"type:VARCHAR(255);NOT NULL;uniqueIndex:uk_biz_type_name,priority:3;comment:'角色名称（被冗余，创建后不可修改）'"`
+	Description     string `gorm:"type:TEXT;comment:'角色描述'"`
+	Metadata        string `gorm:"type:TEXT;comment:'角色元数据，可扩展字段'"`
+	MaxMembers      int    `gorm:"NOT NULL;default:0;comment:'最多授予多少个用户，0 表示不限制'"`
+	MaxRolesPerUser int    `gorm:"NOT NULL;default:0;comment:'拥有该角色的用户最多拥有多少个角色，0 表示不限制'"`
+	Ctime           int64
+	Utime           int64
 }
 
 func (Role) TableName() string {
@@ -85,9 +87,11 @@ func (r *roleDAO) UpdateByBizIDAndID(ctx context.Context, role Role) error {
 		Model(&Role{}).
 		Where("biz_id = ? AND id = ?", role.BizID, role.ID).
 		Updates(map[string]interface{}{
-			"description": role.Description,
-			"metadata":    role.Metadata,
-			"utime":       role.Utime,
+			"description":        role.Description,
+			"metadata":           role.Metadata,
+			"max_members":        role.MaxMembers,
+			"max_roles_per_user": role.MaxRolesPerUser,
+			"utime":              role.Utime,
 		}).Error
 }
 
@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/ego-component/egorm"
+	"gorm.io/gorm/clause"
+)
+
+// RoleActivation 用户激活的角色，每个用户只保留最近一次激活
+type RoleActivation struct {
+	ID     int64 `gorm:"primaryKey;autoIncrement;comment:'激活记录主键'"`
+	BizID  int64 `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_user,priority:1;comment:'业务ID'"`
+	UserID int64 `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_user,priority:2;comment:'用户ID'"`
+	// RoleIDs 激活之后直接或者间接生效的角色ID
+	RoleIDs string `gorm:"type:TEXT;NOT NULL;comment:'激活之后生效的角色ID，JSON 数组'"`
+	Ctime   int64
+	Utime   int64
+}
+
+func (RoleActivation) TableName() string {
+	return "role_activations"
+}
+
+// RoleActivationDAO 用户激活角色的数据访问接口
+type RoleActivationDAO interface {
+	// Save 覆盖用户之前的激活
+	Save(ctx context.Context, activation RoleActivation) error
+	// FindByBizIDAndUserID 用户没有激活过的时候返回零值
+	FindByBizIDAndUserID(ctx context.Context, bizID, userID int64) (RoleActivation, error)
+}
+
+type roleActivationDAO struct {
+	db *egorm.Component
+}
+
+// NewRoleActivationDAO 创建用户激活角色的数据访问对象
+func NewRoleActivationDAO(db *egorm.Component) RoleActivationDAO {
+	return &roleActivationDAO{
+		db: db,
+	}
+}
+
+func (r *roleActivationDAO) Save(ctx context.Context, activation RoleActivation) error {
+	now := time.Now().UnixMilli()
+	activation.Ctime = now
+	activation.Utime = now
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "biz_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role_ids", "utime"}),
+		}).Create(&activation).Error
+}
+
+func (r *roleActivationDAO) FindByBizIDAndUserID(ctx context.Context, bizID, userID int64) (RoleActivation, error) {
+	var activation RoleActivation
+	err := r.db.WithContext(ctx).
+		Where("biz_id = ? AND user_id = ?", bizID, userID).
+		Limit(1).Find(&activation).Error
+	return activation, err
+}
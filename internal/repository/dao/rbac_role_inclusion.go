@@ -2,9 +2,15 @@ package dao
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"gitee.com/flycash/permission-platform/internal/errs"
 	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // RoleInclusion 角色包含关系表
@@ -25,15 +31,34 @@ func (RoleInclusion) TableName() string {
 	return "role_inclusions"
 }
 
+// RoleInclusionClosure 角色包含关系的传递闭包表，祖先角色直接或者间接包含后代角色，不记录角色自身
+type RoleInclusionClosure struct {
+	ID               int64 `gorm:"primaryKey;autoIncrement;comment:'闭包记录ID'"`
+	BizID            int64 `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_ancestor_descendant,priority:1;index:idx_biz_descendant,priority:1;comment:'业务ID'"`
+	AncestorRoleID   int64 `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_ancestor_descendant,priority:2;comment:'祖先角色ID（包含者）'"`
+	DescendantRoleID int64 `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_ancestor_descendant,priority:3;index:idx_biz_descendant,priority:2;comment:'后代角色ID（被包含者）'"`
+	Ctime            int64
+}
+
+func (RoleInclusionClosure) TableName() string {
+	return "role_inclusion_closures"
+}
+
 // RoleInclusionDAO 角色包含关系数据访问接口
 type RoleInclusionDAO interface {
-	Create(ctx context.Context, roleInclusion RoleInclusion) (RoleInclusion, error)
+	// Create check 不为 nil 的时候在锁住业务的授予之后、写入之前执行，返回错误的时候不会写入
+	Create(ctx context.Context, roleInclusion RoleInclusion, check GrantCheck) (RoleInclusion, error)
 
 	FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]RoleInclusion, error)
 	FindByBizIDAndID(ctx context.Context, bizID, id int64) (RoleInclusion, error)
 	FindByBizIDAndIncludingRoleIDs(ctx context.Context, bizID int64, includingRoleIDs []int64) ([]RoleInclusion, error)
 	FindByBizIDAndIncludedRoleIDs(ctx context.Context, bizID int64, includedRoleIDs []int64) ([]RoleInclusion, error)
 
+	// FindDescendantsByBizIDAndRoleIDs 查找这些角色直接或者间接包含的角色
+	FindDescendantsByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]RoleInclusionClosure, error)
+	// FindAncestorsByBizIDAndRoleIDs 查找直接或者间接包含这些角色的角色
+	FindAncestorsByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]RoleInclusionClosure, error)
+
 	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
 }
 
@@ -49,14 +74,72 @@ func NewRoleInclusionDAO(db *egorm.Component) RoleInclusionDAO {
 	}
 }
 
-func (r *roleInclusionDAO) Create(ctx context.Context, roleInclusion RoleInclusion) (RoleInclusion, error) {
+// Create 创建角色包含关系并维护闭包表，会形成环的时候返回 errs.ErrRoleInclusionCycle。
+// 包含关系会让已经拥有角色的用户获得新的角色，所以加业务的写锁，和授予用户角色串行执行
+func (r *roleInclusionDAO) Create(ctx context.Context, roleInclusion RoleInclusion, check GrantCheck) (RoleInclusion, error) {
 	now := time.Now().UnixMilli()
 	roleInclusion.Ctime = now
 	roleInclusion.Utime = now
-	err := r.db.WithContext(ctx).Create(&roleInclusion).Error
+	if err := ensureGrantLocks(r.db.WithContext(ctx), roleInclusion.BizID); err != nil {
+		return RoleInclusion{}, err
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockGrants(tx, roleInclusion.BizID, bizGrantLockUserID, "UPDATE"); err != nil {
+			return err
+		}
+		edges, err := r.lockEdges(tx, roleInclusion.BizID)
+		if err != nil {
+			return err
+		}
+		// 新增 A->B 之前 B 已经能到达 A，那么就会形成环
+		if path := inclusionPath(edges, roleInclusion.IncludedRoleID, roleInclusion.IncludingRoleID); path != nil {
+			edges = append(edges, roleInclusion)
+			return fmt.Errorf("%w: %s", errs.ErrRoleInclusionCycle,
+				formatInclusionPath(edges, append([]int64{roleInclusion.IncludingRoleID}, path...)))
+		}
+		if check != nil {
+			if err = check(ctx, newGrantTx(tx)); err != nil {
+				return err
+			}
+		}
+		if err = tx.Create(&roleInclusion).Error; err != nil {
+			return err
+		}
+		// 包含者及其祖先，都新增了被包含者及其后代
+		edges = append(edges, roleInclusion)
+		ancestors := append(reachableRoleIDs(reverseInclusions(edges), roleInclusion.IncludingRoleID), roleInclusion.IncludingRoleID)
+		return r.saveClosures(tx, roleInclusion.BizID, inclusionClosures(edges, ancestors), now)
+	})
 	return roleInclusion, err
 }
 
+// lockEdges 加锁读取业务下所有的包含关系，同一个业务的包含关系变更会串行执行，避免并发写入形成环
+func (r *roleInclusionDAO) lockEdges(tx *gorm.DB, bizID int64) ([]RoleInclusion, error) {
+	var edges []RoleInclusion
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("biz_id = ?", bizID).Find(&edges).Error
+	return edges, err
+}
+
+func (r *roleInclusionDAO) saveClosures(tx *gorm.DB, bizID int64, closures map[int64][]int64, now int64) error {
+	var rows []RoleInclusionClosure
+	for ancestor, descendants := range closures {
+		for _, descendant := range descendants {
+			rows = append(rows, RoleInclusionClosure{
+				BizID:            bizID,
+				AncestorRoleID:   ancestor,
+				DescendantRoleID: descendant,
+				Ctime:            now,
+			})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	const batchSize = 500
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, batchSize).Error
+}
+
 func (r *roleInclusionDAO) FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]RoleInclusion, error) {
 	var roleInclusions []RoleInclusion
 	err := r.db.WithContext(ctx).Where("biz_id = ?", bizID).Offset(offset).Limit(limit).Find(&roleInclusions).Error
@@ -81,8 +164,167 @@ func (r *roleInclusionDAO) FindByBizIDAndIncludedRoleIDs(ctx context.Context, bi
 	return roleInclusions, err
 }
 
+func (r *roleInclusionDAO) FindDescendantsByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]RoleInclusionClosure, error) {
+	var closures []RoleInclusionClosure
+	err := r.db.WithContext(ctx).Where("biz_id = ? AND ancestor_role_id IN (?)", bizID, roleIDs).Find(&closures).Error
+	return closures, err
+}
+
+func (r *roleInclusionDAO) FindAncestorsByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]RoleInclusionClosure, error) {
+	var closures []RoleInclusionClosure
+	err := r.db.WithContext(ctx).Where("biz_id = ? AND descendant_role_id IN (?)", bizID, roleIDs).Find(&closures).Error
+	return closures, err
+}
+
+// DeleteByBizIDAndID 删除角色包含关系，并重建包含者及其祖先的闭包记录
 func (r *roleInclusionDAO) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
-	return r.db.WithContext(ctx).
-		Where("biz_id = ? AND id = ?", bizID, id).
-		Delete(&RoleInclusion{}).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		edges, err := r.lockEdges(tx, bizID)
+		if err != nil {
+			return err
+		}
+		idx := slices.IndexFunc(edges, func(e RoleInclusion) bool { return e.ID == id })
+		if idx < 0 {
+			return nil
+		}
+		deleted := edges[idx]
+		if err = tx.Where("biz_id = ? AND id = ?", bizID, id).Delete(&RoleInclusion{}).Error; err != nil {
+			return err
+		}
+		// 存在多条路径的时候，不能直接删掉经过这条边的闭包记录，所以受影响的祖先全部重新计算
+		edges = slices.Delete(edges, idx, idx+1)
+		ancestors := append(reachableRoleIDs(reverseInclusions(edges), deleted.IncludingRoleID), deleted.IncludingRoleID)
+		err = tx.Where("biz_id = ? AND ancestor_role_id IN (?)", bizID, ancestors).Delete(&RoleInclusionClosure{}).Error
+		if err != nil {
+			return err
+		}
+		return r.saveClosures(tx, bizID, inclusionClosures(edges, ancestors), time.Now().UnixMilli())
+	})
+}
+
+// rebuildRoleInclusionClosures 根据包含关系重建所有业务的闭包表，用于闭包表上线前已有的数据
+func rebuildRoleInclusionClosures(db *gorm.DB) error {
+	var closureCnt int64
+	if err := db.Model(&RoleInclusionClosure{}).Count(&closureCnt).Error; err != nil || closureCnt > 0 {
+		return err
+	}
+	var edges []RoleInclusion
+	if err := db.Find(&edges).Error; err != nil {
+		return err
+	}
+	byBiz := make(map[int64][]RoleInclusion)
+	for i := range edges {
+		byBiz[edges[i].BizID] = append(byBiz[edges[i].BizID], edges[i])
+	}
+	d := &roleInclusionDAO{}
+	now := time.Now().UnixMilli()
+	return db.Transaction(func(tx *gorm.DB) error {
+		for bizID, bizEdges := range byBiz {
+			roots := make([]int64, 0, len(bizEdges))
+			for i := range bizEdges {
+				roots = append(roots, bizEdges[i].IncludingRoleID)
+			}
+			if err := d.saveClosures(tx, bizID, inclusionClosures(bizEdges, roots), now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// forwardInclusions 包含者到被包含者的邻接表
+func forwardInclusions(edges []RoleInclusion) map[int64][]int64 {
+	res := make(map[int64][]int64, len(edges))
+	for i := range edges {
+		res[edges[i].IncludingRoleID] = append(res[edges[i].IncludingRoleID], edges[i].IncludedRoleID)
+	}
+	return res
+}
+
+// reverseInclusions 被包含者到包含者的邻接表
+func reverseInclusions(edges []RoleInclusion) map[int64][]int64 {
+	res := make(map[int64][]int64, len(edges))
+	for i := range edges {
+		res[edges[i].IncludedRoleID] = append(res[edges[i].IncludedRoleID], edges[i].IncludingRoleID)
+	}
+	return res
+}
+
+// reachableRoleIDs 从 from 出发能到达的所有角色，不包括 from 自身
+func reachableRoleIDs(adj map[int64][]int64, from int64) []int64 {
+	visited := map[int64]struct{}{from: {}}
+	var res []int64
+	queue := []int64{from}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range adj[cur] {
+			if _, ok := visited[next]; ok {
+				continue
+			}
+			visited[next] = struct{}{}
+			res = append(res, next)
+			queue = append(queue, next)
+		}
+	}
+	return res
+}
+
+// inclusionClosures 计算 roots 中每个角色直接或者间接包含的角色
+func inclusionClosures(edges []RoleInclusion, roots []int64) map[int64][]int64 {
+	adj := forwardInclusions(edges)
+	res := make(map[int64][]int64, len(roots))
+	for _, root := range roots {
+		if _, ok := res[root]; ok {
+			continue
+		}
+		res[root] = reachableRoleIDs(adj, root)
+	}
+	return res
+}
+
+// inclusionPath 按照包含关系从 from 到 to 的最短路径，包括两端，到达不了的时候返回 nil
+func inclusionPath(edges []RoleInclusion, from, to int64) []int64 {
+	if from == to {
+		return []int64{from}
+	}
+	adj := forwardInclusions(edges)
+	prev := map[int64]int64{from: from}
+	queue := []int64{from}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, next := range adj[cur] {
+			if _, ok := prev[next]; ok {
+				continue
+			}
+			prev[next] = cur
+			if next != to {
+				queue = append(queue, next)
+				continue
+			}
+			path := []int64{to}
+			for node := to; node != from; {
+				node = prev[node]
+				path = append(path, node)
+			}
+			slices.Reverse(path)
+			return path
+		}
+	}
+	return nil
+}
+
+// formatInclusionPath 把路径格式化成 角色名(ID) -> 角色名(ID)
+func formatInclusionPath(edges []RoleInclusion, path []int64) string {
+	names := make(map[int64]string, len(edges)*2)
+	for i := range edges {
+		names[edges[i].IncludingRoleID] = edges[i].IncludingRoleName
+		names[edges[i].IncludedRoleID] = edges[i].IncludedRoleName
+	}
+	nodes := make([]string, 0, len(path))
+	for _, id := range path {
+		nodes = append(nodes, fmt.Sprintf("%s(%d)", names[id], id))
+	}
+	return strings.Join(nodes, " -> ")
 }
@@ -0,0 +1,62 @@
+//go:build unit
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInclusionPath(t *testing.T) {
+	t.Parallel()
+
+	// 1 -> 2 -> 3 -> 4，1 -> 4，5 -> 1
+	edges := []RoleInclusion{
+		{IncludingRoleID: 1, IncludingRoleName: "a", IncludedRoleID: 2, IncludedRoleName: "b"},
+		{IncludingRoleID: 2, IncludingRoleName: "b", IncludedRoleID: 3, IncludedRoleName: "c"},
+		{IncludingRoleID: 3, IncludingRoleName: "c", IncludedRoleID: 4, IncludedRoleName: "d"},
+		{IncludingRoleID: 1, IncludingRoleName: "a", IncludedRoleID: 4, IncludedRoleName: "d"},
+		{IncludingRoleID: 5, IncludingRoleName: "e", IncludedRoleID: 1, IncludedRoleName: "a"},
+	}
+	testCases := []struct {
+		name     string
+		from, to int64
+		want     []int64
+	}{
+		{name: "自身", from: 1, to: 1, want: []int64{1}},
+		{name: "最短路径", from: 1, to: 4, want: []int64{1, 4}},
+		{name: "多级", from: 5, to: 3, want: []int64{5, 1, 2, 3}},
+		{name: "逆向不可达", from: 4, to: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, inclusionPath(edges, tc.from, tc.to))
+		})
+	}
+
+	assert.Equal(t, "e(5) -> a(1) -> b(2)", formatInclusionPath(edges, []int64{5, 1, 2}))
+}
+
+func TestInclusionClosures(t *testing.T) {
+	t.Parallel()
+
+	edges := []RoleInclusion{
+		{IncludingRoleID: 1, IncludedRoleID: 2},
+		{IncludingRoleID: 2, IncludedRoleID: 3},
+		{IncludingRoleID: 1, IncludedRoleID: 3},
+		{IncludingRoleID: 5, IncludedRoleID: 1},
+		// 历史数据里面的环
+		{IncludingRoleID: 6, IncludedRoleID: 7},
+		{IncludingRoleID: 7, IncludedRoleID: 6},
+	}
+	closures := inclusionClosures(edges, []int64{1, 5, 3, 6, 1})
+	assert.Len(t, closures, 4)
+	assert.ElementsMatch(t, []int64{2, 3}, closures[1])
+	assert.ElementsMatch(t, []int64{1, 2, 3}, closures[5])
+	assert.Empty(t, closures[3])
+	assert.Equal(t, []int64{7}, closures[6])
+
+	assert.ElementsMatch(t, []int64{1, 2, 5}, reachableRoleIDs(reverseInclusions(edges), 3))
+}
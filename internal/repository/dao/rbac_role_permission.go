@@ -20,6 +20,9 @@ type RolePermission struct {
 	ResourceType     string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_biz_resource_type,priority:2;index:idx_biz_resource_key_action,priority:2;comment:'资源类型（冗余字段，加速查询）'"`
 	ResourceKey      string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_biz_resource_key_action,priority:3;comment:'资源标识符（冗余字段，加速查询）'"`
 	PermissionAction string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_biz_action,priority:2;index:idx_biz_resource_key_action,priority:4;comment:'操作类型（冗余字段，加速查询）'"`
+	StartTime        int64  `gorm:"NOT NULL;DEFAULT:0;comment:'权限生效时间，0表示不设限'"`
+	EndTime          int64  `gorm:"NOT NULL;DEFAULT:0;comment:'权限失效时间，0表示不设限'"`
+	Effect           string `gorm:"type:ENUM('allow', 'deny');NOT NULL;DEFAULT:'allow';comment:'deny 优先于 allow，可以用来覆盖从其他角色继承来的权限'"`
 	Ctime            int64
 	Utime            int64
 }
@@ -35,6 +38,7 @@ type RolePermissionDAO interface {
 	FindByBizID(ctx context.Context, bizID int64) ([]RolePermission, error)
 	FindByBizIDAndID(ctx context.Context, bizID, id int64) (RolePermission, error)
 	FindByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]RolePermission, error)
+	FindByBizIDAndPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64) ([]RolePermission, error)
 
 	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
 }
@@ -83,6 +87,12 @@ func (r *rolePermissionDAO) FindByBizIDAndRoleIDs(ctx context.Context, bizID int
 	return rolePermissions, err
 }
 
+func (r *rolePermissionDAO) FindByBizIDAndPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64) ([]RolePermission, error) {
+	var rolePermissions []RolePermission
+	err := r.db.WithContext(ctx).Where("biz_id = ? AND permission_id IN (?)", bizID, permissionIDs).Find(&rolePermissions).Error
+	return rolePermissions, err
+}
+
 func (r *rolePermissionDAO) FindByBizIDAndResourceType(ctx context.Context, bizID int64, resourceType string, offset, limit int) ([]RolePermission, error) {
 	var rolePermissions []RolePermission
 	err := r.db.WithContext(ctx).
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"github.com/ego-component/egorm"
+)
+
+// SoDConstraint 职责分离约束表
+type SoDConstraint struct {
+	ID          int64  `gorm:"primaryKey;autoIncrement;comment:'职责分离约束ID'"`
+	BizID       int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_name,priority:1;comment:'业务ID'"`
+	Name        string `gorm:"type:VARCHAR(255);NOT NULL;uniqueIndex:uk_biz_name,priority:2;comment:'约束名称'"`
+	Description string `gorm:"type:TEXT;comment:'约束描述'"`
+	Type        string `gorm:"type:ENUM('static', 'dynamic');NOT NULL;comment:'static 不能同时拥有，dynamic 不能同时激活'"`
+	RoleIDs     string `gorm:"type:TEXT;NOT NULL;comment:'互斥的角色ID，JSON 数组'"`
+	Ctime       int64
+	Utime       int64
+}
+
+func (SoDConstraint) TableName() string {
+	return "sod_constraints"
+}
+
+// SoDConstraintDAO 职责分离约束数据访问接口
+type SoDConstraintDAO interface {
+	Create(ctx context.Context, constraint SoDConstraint) (SoDConstraint, error)
+
+	// FindByBizID 业务下的所有约束，数量不多，校验的时候一次性加载
+	FindByBizID(ctx context.Context, bizID int64) ([]SoDConstraint, error)
+	FindByBizIDAndID(ctx context.Context, bizID, id int64) (SoDConstraint, error)
+
+	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
+}
+
+// sodConstraintDAO 职责分离约束数据访问实现
+type sodConstraintDAO struct {
+	db *egorm.Component
+}
+
+// NewSoDConstraintDAO 创建职责分离约束数据访问对象
+func NewSoDConstraintDAO(db *egorm.Component) SoDConstraintDAO {
+	return &sodConstraintDAO{
+		db: db,
+	}
+}
+
+func (s *sodConstraintDAO) Create(ctx context.Context, constraint SoDConstraint) (SoDConstraint, error) {
+	now := time.Now().UnixMilli()
+	constraint.Ctime = now
+	constraint.Utime = now
+	err := s.db.WithContext(ctx).Create(&constraint).Error
+	if isUniqueConstraintError(err) {
+		return SoDConstraint{}, fmt.Errorf("%w", errs.ErrSoDConstraintDuplicate)
+	}
+	return constraint, err
+}
+
+func (s *sodConstraintDAO) FindByBizID(ctx context.Context, bizID int64) ([]SoDConstraint, error) {
+	var constraints []SoDConstraint
+	err := s.db.WithContext(ctx).Where("biz_id = ?", bizID).Order("id").Find(&constraints).Error
+	return constraints, err
+}
+
+func (s *sodConstraintDAO) FindByBizIDAndID(ctx context.Context, bizID, id int64) (SoDConstraint, error) {
+	var constraint SoDConstraint
+	err := s.db.WithContext(ctx).Where("biz_id = ? AND id = ?", bizID, id).First(&constraint).Error
+	return constraint, err
+}
+
+func (s *sodConstraintDAO) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
+	return s.db.WithContext(ctx).Where("biz_id = ? AND id = ?", bizID, id).Delete(&SoDConstraint{}).Error
+}
@@ -31,10 +31,18 @@ func (UserPermission) TableName() string {
 // UserPermissionDAO 用户权限关联数据访问接口
 type UserPermissionDAO interface {
 	Create(ctx context.Context, userPermission UserPermission) (UserPermission, error)
+	// UpdateByBizIDAndID 修改授予的生效时间、失效时间以及效果
+	UpdateByBizIDAndID(ctx context.Context, userPermission UserPermission) error
 
 	FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]UserPermission, error)
 	FindByBizIDAndUserID(ctx context.Context, bizID, userID int64) ([]UserPermission, error)
+	// FindUnexpiredByBizIDAndUserID 查找尚未失效的权限，包含还未到生效时间的权限
+	FindUnexpiredByBizIDAndUserID(ctx context.Context, bizID, userID int64) ([]UserPermission, error)
 	FindByBizIDANDID(ctx context.Context, bizID, id int64) (UserPermission, error)
+	// FindUnexpiredUserIDsByBizIDAndPermissionIDs 按照用户ID升序分页查找被授予了这些权限的用户ID，已去重，只返回大于 afterUserID 的
+	FindUnexpiredUserIDsByBizIDAndPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64, effect string, afterUserID int64, limit int) ([]int64, error)
+	// FindUnexpiredByBizIDAndUserIDsAndPermissionIDs 查找这些用户在这些权限上尚未失效的授予记录
+	FindUnexpiredByBizIDAndUserIDsAndPermissionIDs(ctx context.Context, bizID int64, userIDs, permissionIDs []int64) ([]UserPermission, error)
 
 	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
 	DeleteByBizIDAndUserIDAndPermissionID(ctx context.Context, bizID, userID, permissionID int64) error
@@ -60,6 +68,18 @@ func (u *userPermissionDAO) Create(ctx context.Context, userPermission UserPermi
 	return userPermission, err
 }
 
+func (u *userPermissionDAO) UpdateByBizIDAndID(ctx context.Context, userPermission UserPermission) error {
+	return u.db.WithContext(ctx).Model(&UserPermission{}).
+		Where("biz_id = ? AND id = ? AND user_id = ? AND permission_id = ?",
+			userPermission.BizID, userPermission.ID, userPermission.UserID, userPermission.PermissionID).
+		Updates(map[string]any{
+			"start_time": userPermission.StartTime,
+			"end_time":   userPermission.EndTime,
+			"effect":     userPermission.Effect,
+			"utime":      time.Now().UnixMilli(),
+		}).Error
+}
+
 func (u *userPermissionDAO) FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]UserPermission, error) {
 	var userPermissions []UserPermission
 	err := u.db.WithContext(ctx).Where("biz_id = ?", bizID).Offset(offset).Limit(limit).Find(&userPermissions).Error
@@ -75,6 +95,31 @@ func (u *userPermissionDAO) FindByBizIDAndUserID(ctx context.Context, bizID, use
 	return userPermissions, err
 }
 
+func (u *userPermissionDAO) FindUnexpiredByBizIDAndUserID(ctx context.Context, bizID, userID int64) ([]UserPermission, error) {
+	var userPermissions []UserPermission
+	err := u.db.WithContext(ctx).
+		Where("biz_id = ? AND user_id = ? AND end_time >= ?", bizID, userID, time.Now().UnixMilli()).
+		Find(&userPermissions).Error
+	return userPermissions, err
+}
+
+func (u *userPermissionDAO) FindUnexpiredUserIDsByBizIDAndPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64, effect string, afterUserID int64, limit int) ([]int64, error) {
+	var userIDs []int64
+	err := u.db.WithContext(ctx).Model(&UserPermission{}).
+		Where("biz_id = ? AND permission_id IN (?) AND effect = ? AND end_time >= ? AND user_id > ?",
+			bizID, permissionIDs, effect, time.Now().UnixMilli(), afterUserID).
+		Distinct().Order("user_id ASC").Limit(limit).Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (u *userPermissionDAO) FindUnexpiredByBizIDAndUserIDsAndPermissionIDs(ctx context.Context, bizID int64, userIDs, permissionIDs []int64) ([]UserPermission, error) {
+	var userPermissions []UserPermission
+	err := u.db.WithContext(ctx).
+		Where("biz_id = ? AND user_id IN (?) AND permission_id IN (?) AND end_time >= ?", bizID, userIDs, permissionIDs, time.Now().UnixMilli()).
+		Find(&userPermissions).Error
+	return userPermissions, err
+}
+
 func (u *userPermissionDAO) FindByBizIDANDID(ctx context.Context, bizID, id int64) (UserPermission, error) {
 	var res UserPermission
 	err := u.db.WithContext(ctx).Where("biz_id = ? AND id = ?", bizID, id).First(&res).Error
@@ -2,9 +2,13 @@ package dao
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"gitee.com/flycash/permission-platform/internal/errs"
 	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // UserRole 用户角色关联关系表
@@ -18,7 +22,7 @@ type UserRole struct {
 	RoleName  string `json:"role_name" gorm:"type:VARCHAR(255);NOT NULL;comment:'角色名称（冗余字段，加速查询）'"`
 	RoleType  string `json:"role_type" gorm:"type:VARCHAR(255);NOT NULL;index:idx_biz_user_role_validity,priority:3;comment:'角色类型（冗余字段，加速查询）'"`
 	StartTime int64  `json:"start_time,string" gorm:"NOT NULL;index:idx_biz_user_role_validity,priority:4;comment:'授予角色生效时间'"`
-	EndTime   int64  `json:"end_time,string" gorm:"NOT NULL;index:idx_biz_user_role_validity,priority:5;comment:'授予角色失效时间'"`
+	EndTime   int64  `json:"end_time,string" gorm:"NOT NULL;index:idx_biz_user_role_validity,priority:5;index:idx_end_time;comment:'授予角色失效时间'"`
 	Ctime     int64  `json:"ctime,string"`
 	Utime     int64  `json:"utime,string"`
 }
@@ -27,16 +31,50 @@ func (UserRole) TableName() string {
 	return "user_roles"
 }
 
+// UserRoleLock 给用户授予角色时加锁用的记录，每个业务下的每个用户一条。
+// 用户可能还没有任何授予记录，所以不能靠锁 user_roles 里的记录来串行执行。
+// user_id 为 0 的记录是整个业务的锁，授予角色的时候加读锁，创建角色包含关系的时候加写锁
+type UserRoleLock struct {
+	ID     int64 `gorm:"primaryKey;autoIncrement;comment:'加锁记录主键'"`
+	BizID  int64 `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_user,priority:1;comment:'业务ID'"`
+	UserID int64 `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_user,priority:2;comment:'用户ID'"`
+	Ctime  int64
+}
+
+func (UserRoleLock) TableName() string {
+	return "user_role_locks"
+}
+
 // UserRoleDAO 用户角色关联数据访问接口
 type UserRoleDAO interface {
-	Create(ctx context.Context, userRole UserRole) (UserRole, error)
+	// Create 授予用户角色，锁住角色和用户之后，在同一个事务里校验角色的 MaxMembers 和用户拥有的角色上的 MaxRolesPerUser，
+	// 超过上限的时候返回 errs.ErrRoleMemberLimitExceeded 或者 errs.ErrUserRoleLimitExceeded。
+	// check 不为 nil 的时候在拿到锁之后、写入之前执行，返回错误的时候不会写入
+	Create(ctx context.Context, userRole UserRole, check GrantCheck) (UserRole, error)
+	// UpdateByBizIDAndID 修改授予的生效时间和失效时间，和 Create 一样加锁并且校验上限，check 的含义也一样
+	UpdateByBizIDAndID(ctx context.Context, userRole UserRole, check GrantCheck) error
 
 	FindByBizID(ctx context.Context, bizID int64) ([]UserRole, error)
 	FindByBizIDAndID(ctx context.Context, bizID, id int64) (UserRole, error)
 	FindByBizIDAndUserID(ctx context.Context, bizID int64, userID int64) ([]UserRole, error)
+	// FindUnexpiredByBizIDAndUserID 查找尚未失效的用户角色，包含还未到生效时间的
+	FindUnexpiredByBizIDAndUserID(ctx context.Context, bizID int64, userID int64) ([]UserRole, error)
 	FindByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]UserRole, error)
+	// FindUnexpiredUserIDsByBizIDAndRoleIDs 按照用户ID升序分页查找拥有这些角色的用户ID，已去重，只返回大于 afterUserID 的
+	FindUnexpiredUserIDsByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64, afterUserID int64, limit int) ([]int64, error)
+	// FindUnexpiredUserIDsByBizIDAndBothRoleIDs 查找同时拥有 roleIDs 中任意一个角色和 otherRoleIDs 中任意一个角色的用户ID，已去重
+	FindUnexpiredUserIDsByBizIDAndBothRoleIDs(ctx context.Context, bizID int64, roleIDs, otherRoleIDs []int64, limit int) ([]int64, error)
+	// FindUnexpiredByBizIDAndUserIDsAndRoleIDs 查找这些用户在这些角色上尚未失效的授予记录
+	FindUnexpiredByBizIDAndUserIDsAndRoleIDs(ctx context.Context, bizID int64, userIDs, roleIDs []int64) ([]UserRole, error)
+	// CountUnexpiredUsersByBizIDAndRoleIDs 每个角色尚未失效的授予人数，包含还未到生效时间的
+	CountUnexpiredUsersByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) (map[int64]int64, error)
+
+	// FindExpired 查找在 now 之前就已经失效的用户角色，按照 ID 升序
+	FindExpired(ctx context.Context, now int64, limit int) ([]UserRole, error)
 
 	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
+	// DeleteExpiredByIDs 删除 ids 中在 now 之前就已经失效的用户角色，返回实际删除的行数
+	DeleteExpiredByIDs(ctx context.Context, ids []int64, now int64) (int64, error)
 }
 
 // userRoleDAO 用户角色关联数据访问实现
@@ -51,14 +89,156 @@ func NewUserRoleDAO(db *egorm.Component) UserRoleDAO {
 	}
 }
 
-func (u *userRoleDAO) Create(ctx context.Context, userRole UserRole) (UserRole, error) {
+func (u *userRoleDAO) Create(ctx context.Context, userRole UserRole, check GrantCheck) (UserRole, error) {
 	now := time.Now().UnixMilli()
 	userRole.Ctime = now
 	userRole.Utime = now
-	err := u.db.WithContext(ctx).Create(&userRole).Error
+	if err := ensureGrantLocks(u.db.WithContext(ctx), userRole.BizID, userRole.UserID); err != nil {
+		return UserRole{}, err
+	}
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 业务的读锁让授予和创建角色包含关系串行执行，不同用户的授予之间互不影响
+		if err := lockGrants(tx, userRole.BizID, bizGrantLockUserID, "SHARE"); err != nil {
+			return err
+		}
+		if err := u.checkLimits(tx, userRole, now); err != nil {
+			return err
+		}
+		if check != nil {
+			if err := check(ctx, newGrantTx(tx)); err != nil {
+				return err
+			}
+		}
+		return tx.Create(&userRole).Error
+	})
 	return userRole, err
 }
 
+func (u *userRoleDAO) UpdateByBizIDAndID(ctx context.Context, userRole UserRole, check GrantCheck) error {
+	now := time.Now().UnixMilli()
+	if err := ensureGrantLocks(u.db.WithContext(ctx), userRole.BizID, userRole.UserID); err != nil {
+		return err
+	}
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockGrants(tx, userRole.BizID, bizGrantLockUserID, "SHARE"); err != nil {
+			return err
+		}
+		if err := u.checkLimits(tx, userRole, now); err != nil {
+			return err
+		}
+		if check != nil {
+			if err := check(ctx, newGrantTx(tx)); err != nil {
+				return err
+			}
+		}
+		return tx.Model(&UserRole{}).
+			Where("biz_id = ? AND id = ? AND user_id = ? AND role_id = ?", userRole.BizID, userRole.ID, userRole.UserID, userRole.RoleID).
+			Updates(map[string]any{
+				"start_time": userRole.StartTime,
+				"end_time":   userRole.EndTime,
+				"utime":      now,
+			}).Error
+	})
+}
+
+// GrantTx 授予事务里的数据访问对象，查询和授予用的是同一个连接，读到的也包括事务自己的修改
+type GrantTx struct {
+	SoDConstraintDAO SoDConstraintDAO
+	RoleInclusionDAO RoleInclusionDAO
+	UserRoleDAO      UserRoleDAO
+}
+
+// GrantCheck 在授予事务里、写入之前执行的校验，返回错误的时候不会写入。
+// 只能通过 tx 读取数据，不能写入，也不能使用其他连接，否则事务持有锁的时候还要等待另一个连接
+type GrantCheck func(ctx context.Context, tx GrantTx) error
+
+func newGrantTx(tx *gorm.DB) GrantTx {
+	return GrantTx{
+		SoDConstraintDAO: NewSoDConstraintDAO(tx),
+		RoleInclusionDAO: NewRoleInclusionDAO(tx),
+		UserRoleDAO:      NewUserRoleDAO(tx),
+	}
+}
+
+// bizGrantLockUserID 整个业务的加锁记录的用户ID
+const bizGrantLockUserID = 0
+
+// ensureGrantLocks 在事务外插入业务以及用户的加锁记录，已经存在的时候什么也不做，这样事务里总是有记录可以锁
+func ensureGrantLocks(db *gorm.DB, bizID int64, userIDs ...int64) error {
+	now := time.Now().UnixMilli()
+	locks := []UserRoleLock{{BizID: bizID, UserID: bizGrantLockUserID, Ctime: now}}
+	for _, userID := range userIDs {
+		locks = append(locks, UserRoleLock{BizID: bizID, UserID: userID, Ctime: now})
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&locks).Error
+}
+
+// lockGrants 锁住加锁记录，strength 为 UPDATE 或者 SHARE
+func lockGrants(tx *gorm.DB, bizID, userID int64, strength string) error {
+	var lock UserRoleLock
+	return tx.Clauses(clause.Locking{Strength: strength}).
+		Where("biz_id = ? AND user_id = ?", bizID, userID).
+		First(&lock).Error
+}
+
+// checkLimits 先锁住角色记录，再锁住用户的加锁记录，并发授予同一个角色、或者给同一个用户授予角色的时候只有一个可以继续。
+// 加锁的顺序是固定的，所以不会死锁；锁都拿到之后再读取，读到的是已经提交的最新数据。
+// 修改已有授予的时候不统计它自己
+func (u *userRoleDAO) checkLimits(tx *gorm.DB, userRole UserRole, now int64) error {
+	var role Role
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("biz_id = ? AND id = ?", userRole.BizID, userRole.RoleID).
+		Limit(1).Find(&role).Error
+	if err != nil {
+		return err
+	}
+	if err = lockGrants(tx, userRole.BizID, userRole.UserID, "UPDATE"); err != nil {
+		return err
+	}
+	// 已经失效的授予不占名额
+	if userRole.EndTime < now {
+		return nil
+	}
+
+	if role.MaxMembers > 0 {
+		var members int64
+		err = tx.Model(&UserRole{}).
+			Where("biz_id = ? AND role_id = ? AND end_time >= ? AND id <> ?", userRole.BizID, userRole.RoleID, now, userRole.ID).
+			Count(&members).Error
+		if err != nil {
+			return err
+		}
+		if members >= int64(role.MaxMembers) {
+			return fmt.Errorf("%w: 角色 %s 最多授予 %d 个用户", errs.ErrRoleMemberLimitExceeded, role.Name, role.MaxMembers)
+		}
+	}
+
+	var roleIDs []int64
+	err = tx.Model(&UserRole{}).
+		Where("biz_id = ? AND user_id = ? AND end_time >= ? AND id <> ?", userRole.BizID, userRole.UserID, now, userRole.ID).
+		Pluck("role_id", &roleIDs).Error
+	if err != nil {
+		return err
+	}
+	total := len(roleIDs) + 1
+	limits := []Role{role}
+	if len(roleIDs) > 0 {
+		var heldRoles []Role
+		err = tx.Where("biz_id = ? AND id IN (?) AND max_roles_per_user > 0", userRole.BizID, roleIDs).
+			Find(&heldRoles).Error
+		if err != nil {
+			return err
+		}
+		limits = append(limits, heldRoles...)
+	}
+	for _, r := range limits {
+		if r.MaxRolesPerUser > 0 && total > r.MaxRolesPerUser {
+			return fmt.Errorf("%w: 拥有角色 %s 的用户最多拥有 %d 个角色", errs.ErrUserRoleLimitExceeded, r.Name, r.MaxRolesPerUser)
+		}
+	}
+	return nil
+}
+
 func (u *userRoleDAO) FindByBizIDAndUserID(ctx context.Context, bizID, userID int64) ([]UserRole, error) {
 	var userRoles []UserRole
 	currentTime := time.Now().UnixMilli()
@@ -69,6 +249,14 @@ func (u *userRoleDAO) FindByBizIDAndUserID(ctx context.Context, bizID, userID in
 	return userRoles, err
 }
 
+func (u *userRoleDAO) FindUnexpiredByBizIDAndUserID(ctx context.Context, bizID, userID int64) ([]UserRole, error) {
+	var userRoles []UserRole
+	err := u.db.WithContext(ctx).
+		Where("biz_id = ? AND user_id = ? AND end_time >= ?", bizID, userID, time.Now().UnixMilli()).
+		Find(&userRoles).Error
+	return userRoles, err
+}
+
 func (u *userRoleDAO) FindByBizID(ctx context.Context, bizID int64) ([]UserRole, error) {
 	var userRoles []UserRole
 	err := u.db.WithContext(ctx).Where("biz_id = ?", bizID).Find(&userRoles).Error
@@ -85,6 +273,69 @@ func (u *userRoleDAO) FindByBizIDAndRoleIDs(ctx context.Context, bizID int64, ro
 	return userRoles, err
 }
 
+func (u *userRoleDAO) FindUnexpiredUserIDsByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64, afterUserID int64, limit int) ([]int64, error) {
+	var userIDs []int64
+	err := u.db.WithContext(ctx).Model(&UserRole{}).
+		Where("biz_id = ? AND role_id IN (?) AND end_time >= ? AND user_id > ?", bizID, roleIDs, time.Now().UnixMilli(), afterUserID).
+		Distinct().Order("user_id ASC").Limit(limit).Pluck("user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (u *userRoleDAO) FindUnexpiredUserIDsByBizIDAndBothRoleIDs(ctx context.Context, bizID int64, roleIDs, otherRoleIDs []int64, limit int) ([]int64, error) {
+	var userIDs []int64
+	now := time.Now().UnixMilli()
+	err := u.db.WithContext(ctx).Table("user_roles AS a").
+		Joins("JOIN user_roles AS b ON b.biz_id = a.biz_id AND b.user_id = a.user_id").
+		Where("a.biz_id = ? AND a.role_id IN (?) AND a.end_time >= ?", bizID, roleIDs, now).
+		Where("b.role_id IN (?) AND b.end_time >= ?", otherRoleIDs, now).
+		Distinct().Order("a.user_id ASC").Limit(limit).Pluck("a.user_id", &userIDs).Error
+	return userIDs, err
+}
+
+func (u *userRoleDAO) CountUnexpiredUsersByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) (map[int64]int64, error) {
+	var rows []struct {
+		RoleID  int64
+		Members int64
+	}
+	err := u.db.WithContext(ctx).Model(&UserRole{}).
+		Select("role_id, COUNT(*) AS members").
+		Where("biz_id = ? AND role_id IN (?) AND end_time >= ?", bizID, roleIDs, time.Now().UnixMilli()).
+		Group("role_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		res[row.RoleID] = row.Members
+	}
+	return res, nil
+}
+
+func (u *userRoleDAO) FindUnexpiredByBizIDAndUserIDsAndRoleIDs(ctx context.Context, bizID int64, userIDs, roleIDs []int64) ([]UserRole, error) {
+	var userRoles []UserRole
+	err := u.db.WithContext(ctx).
+		Where("biz_id = ? AND user_id IN (?) AND role_id IN (?) AND end_time >= ?", bizID, userIDs, roleIDs, time.Now().UnixMilli()).
+		Find(&userRoles).Error
+	return userRoles, err
+}
+
+func (u *userRoleDAO) FindExpired(ctx context.Context, now int64, limit int) ([]UserRole, error) {
+	var userRoles []UserRole
+	err := u.db.WithContext(ctx).
+		Where("end_time < ?", now).
+		Order("id ASC").
+		Limit(limit).
+		Find(&userRoles).Error
+	return userRoles, err
+}
+
+func (u *userRoleDAO) DeleteExpiredByIDs(ctx context.Context, ids []int64, now int64) (int64, error) {
+	res := u.db.WithContext(ctx).
+		Where("id IN (?) AND end_time < ?", ids, now).
+		Delete(&UserRole{})
+	return res.RowsAffected, res.Error
+}
+
 func (u *userRoleDAO) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
 	return u.db.WithContext(ctx).
 		Where("biz_id = ? AND id = ?", bizID, id).
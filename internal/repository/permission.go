@@ -14,6 +14,8 @@ type PermissionRepository interface {
 	FindPermissions(ctx context.Context, bizID int64, resourceType, resourceKey string, action []string) ([]domain.Permission, error)
 	FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]domain.Permission, error)
 	FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.Permission, error)
+	// FindByBizIDAndResourceType 某一类资源上的所有权限
+	FindByBizIDAndResourceType(ctx context.Context, bizID int64, resourceType string) ([]domain.Permission, error)
 
 	UpdateByBizIDAndID(ctx context.Context, permission domain.Permission) (domain.Permission, error)
 
@@ -36,6 +38,16 @@ func (r *permissionRepository) FindPermissions(ctx context.Context, bizID int64,
 	return list, nil
 }
 
+func (r *permissionRepository) FindByBizIDAndResourceType(ctx context.Context, bizID int64, resourceType string) ([]domain.Permission, error) {
+	permissions, err := r.permissionDAO.FindByBizIDAndResourceType(ctx, bizID, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(permissions, func(_ int, src dao.Permission) domain.Permission {
+		return r.toDomain(src)
+	}), nil
+}
+
 // NewPermissionRepository 创建权限仓储实例
 func NewPermissionRepository(permissionDAO dao.PermissionDAO) PermissionRepository {
 	return &permissionRepository{
@@ -84,17 +96,18 @@ func (r *permissionRepository) FindByBizID(ctx context.Context, bizID int64, off
 
 func (r *permissionRepository) toEntity(p domain.Permission) dao.Permission {
 	return dao.Permission{
-		ID:           p.ID,
-		BizID:        p.BizID,
-		Name:         p.Name,
-		Description:  p.Description,
-		ResourceID:   p.Resource.ID,
-		ResourceType: p.Resource.Type,
-		ResourceKey:  p.Resource.Key,
-		Action:       p.Action,
-		Metadata:     p.Metadata,
-		Ctime:        p.Ctime,
-		Utime:        p.Utime,
+		ID:                 p.ID,
+		BizID:              p.BizID,
+		Name:               p.Name,
+		Description:        p.Description,
+		ResourceID:         p.Resource.ID,
+		ResourceType:       p.Resource.Type,
+		ResourceKey:        p.Resource.Key,
+		Action:             p.Action,
+		Metadata:           p.Metadata,
+		CombiningAlgorithm: p.CombiningAlgorithm.String(),
+		Ctime:              p.Ctime,
+		Utime:              p.Utime,
 	}
 }
 
@@ -109,9 +122,10 @@ func (r *permissionRepository) toDomain(p dao.Permission) domain.Permission {
 			Type: p.ResourceType,
 			Key:  p.ResourceKey,
 		},
-		Action:   p.Action,
-		Metadata: p.Metadata,
-		Ctime:    p.Ctime,
-		Utime:    p.Utime,
+		Action:             p.Action,
+		Metadata:           p.Metadata,
+		CombiningAlgorithm: domain.CombiningAlgorithm(p.CombiningAlgorithm),
+		Ctime:              p.Ctime,
+		Utime:              p.Utime,
 	}
 }
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/repository/dao"
+)
+
+var _ ActionDefinitionRepository = (*ActionDefinitionDefaultRepository)(nil)
+
+// ActionDefinitionRepository 操作定义仓储接口
+type ActionDefinitionRepository interface {
+	Create(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error)
+
+	FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]domain.ActionDefinition, error)
+	FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.ActionDefinition, error)
+
+	UpdateByBizIDAndID(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error)
+
+	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
+}
+
+// ActionDefinitionDefaultRepository 操作定义仓储实现
+type ActionDefinitionDefaultRepository struct {
+	actionDefinitionDAO dao.ActionDefinitionDAO
+}
+
+// NewActionDefinitionDefaultRepository 创建操作定义仓储实例
+func NewActionDefinitionDefaultRepository(actionDefinitionDAO dao.ActionDefinitionDAO) *ActionDefinitionDefaultRepository {
+	return &ActionDefinitionDefaultRepository{
+		actionDefinitionDAO: actionDefinitionDAO,
+	}
+}
+
+func (r *ActionDefinitionDefaultRepository) Create(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error) {
+	created, err := r.actionDefinitionDAO.Create(ctx, toActionDefinitionEntity(def))
+	if err != nil {
+		return domain.ActionDefinition{}, err
+	}
+	return toActionDefinitionDomain(created)
+}
+
+func (r *ActionDefinitionDefaultRepository) FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]domain.ActionDefinition, error) {
+	defs, err := r.actionDefinitionDAO.FindByBizID(ctx, bizID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toActionDefinitionDomains(defs)
+}
+
+func (r *ActionDefinitionDefaultRepository) FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.ActionDefinition, error) {
+	def, err := r.actionDefinitionDAO.FindByBizIDAndID(ctx, bizID, id)
+	if err != nil {
+		return domain.ActionDefinition{}, err
+	}
+	return toActionDefinitionDomain(def)
+}
+
+func (r *ActionDefinitionDefaultRepository) UpdateByBizIDAndID(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error) {
+	err := r.actionDefinitionDAO.UpdateByBizIDAndID(ctx, toActionDefinitionEntity(def))
+	if err != nil {
+		return domain.ActionDefinition{}, err
+	}
+	return r.FindByBizIDAndID(ctx, def.BizID, def.ID)
+}
+
+func (r *ActionDefinitionDefaultRepository) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
+	return r.actionDefinitionDAO.DeleteByBizIDAndID(ctx, bizID, id)
+}
+
+func toActionDefinitionEntity(def domain.ActionDefinition) dao.ActionDefinition {
+	implies, _ := json.Marshal(def.Implies)
+	return dao.ActionDefinition{
+		ID:          def.ID,
+		BizID:       def.BizID,
+		Name:        def.Name,
+		Description: def.Description,
+		Implies:     string(implies),
+		Ctime:       def.Ctime,
+		Utime:       def.Utime,
+	}
+}
+
+func toActionDefinitionDomains(defs []dao.ActionDefinition) ([]domain.ActionDefinition, error) {
+	res := make([]domain.ActionDefinition, 0, len(defs))
+	for i := range defs {
+		def, err := toActionDefinitionDomain(defs[i])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, def)
+	}
+	return res, nil
+}
+
+func toActionDefinitionDomain(def dao.ActionDefinition) (domain.ActionDefinition, error) {
+	var implies []string
+	if def.Implies != "" {
+		if err := json.Unmarshal([]byte(def.Implies), &implies); err != nil {
+			return domain.ActionDefinition{}, fmt.Errorf("操作定义 %d 的蕴含操作解析失败: %w", def.ID, err)
+		}
+	}
+	return domain.ActionDefinition{
+		ID:          def.ID,
+		BizID:       def.BizID,
+		Name:        def.Name,
+		Description: def.Description,
+		Implies:     implies,
+		Ctime:       def.Ctime,
+		Utime:       def.Utime,
+	}, nil
+}
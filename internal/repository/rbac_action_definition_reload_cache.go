@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/mapx"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+var _ ActionDefinitionRepository = (*ActionDefinitionReloadCacheRepository)(nil)
+
+// ActionDefinitionReloadCacheRepository 操作定义变更之后，重新加载受影响用户的权限缓存。
+// 用户的权限缓存里已经按照操作层级展开了蕴含的操作，所以只有被允许了该操作、或者被允许了蕴含该操作的操作的用户受影响。
+// 受影响的用户可能很多，重新加载在后台按照用户ID分批执行，不阻塞操作定义的变更
+type ActionDefinitionReloadCacheRepository struct {
+	repo                *ActionDefinitionDefaultRepository
+	actionDefinitionDAO dao.ActionDefinitionDAO
+	permissionDAO       dao.PermissionDAO
+	rolePermissionDAO   dao.RolePermissionDAO
+	roleInclusionDAO    dao.RoleInclusionDAO
+	userRoleDAO         dao.UserRoleDAO
+	userPermissionDAO   dao.UserPermissionDAO
+	cacheReloader       UserPermissionCacheReloader
+	batchSize           int
+	logger              *elog.Component
+}
+
+// NewActionDefinitionReloadCacheRepository 创建可以重载缓存的操作定义仓储实例
+func NewActionDefinitionReloadCacheRepository(
+	repo *ActionDefinitionDefaultRepository,
+	actionDefinitionDAO dao.ActionDefinitionDAO,
+	permissionDAO dao.PermissionDAO,
+	rolePermissionDAO dao.RolePermissionDAO,
+	roleInclusionDAO dao.RoleInclusionDAO,
+	userRoleDAO dao.UserRoleDAO,
+	userPermissionDAO dao.UserPermissionDAO,
+	cacheReloader UserPermissionCacheReloader,
+) *ActionDefinitionReloadCacheRepository {
+	const defaultBatchSize = 100
+	return &ActionDefinitionReloadCacheRepository{
+		repo:                repo,
+		actionDefinitionDAO: actionDefinitionDAO,
+		permissionDAO:       permissionDAO,
+		rolePermissionDAO:   rolePermissionDAO,
+		roleInclusionDAO:    roleInclusionDAO,
+		userRoleDAO:         userRoleDAO,
+		userPermissionDAO:   userPermissionDAO,
+		cacheReloader:       cacheReloader,
+		batchSize:           defaultBatchSize,
+		logger:              elog.DefaultLogger.With(elog.FieldName("ActionDefinitionReloadCacheRepository")),
+	}
+}
+
+func (r *ActionDefinitionReloadCacheRepository) Create(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error) {
+	created, err := r.repo.Create(ctx, def)
+	if err != nil {
+		return domain.ActionDefinition{}, err
+	}
+	r.reload(ctx, created.BizID, created.Name, "创建操作定义成功后，重新加载受影响用户的缓存失败")
+	return created, nil
+}
+
+func (r *ActionDefinitionReloadCacheRepository) FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]domain.ActionDefinition, error) {
+	return r.repo.FindByBizID(ctx, bizID, offset, limit)
+}
+
+func (r *ActionDefinitionReloadCacheRepository) FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.ActionDefinition, error) {
+	return r.repo.FindByBizIDAndID(ctx, bizID, id)
+}
+
+func (r *ActionDefinitionReloadCacheRepository) UpdateByBizIDAndID(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error) {
+	updated, err := r.repo.UpdateByBizIDAndID(ctx, def)
+	if err != nil {
+		return domain.ActionDefinition{}, err
+	}
+	r.reload(ctx, updated.BizID, updated.Name, "更新操作定义成功后，重新加载受影响用户的缓存失败")
+	return updated, nil
+}
+
+func (r *ActionDefinitionReloadCacheRepository) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
+	deleted, err := r.repo.FindByBizIDAndID(ctx, bizID, id)
+	if err != nil {
+		return err
+	}
+	err = r.repo.DeleteByBizIDAndID(ctx, bizID, id)
+	if err != nil {
+		return err
+	}
+	r.reload(ctx, bizID, deleted.Name, "删除操作定义成功后，重新加载受影响用户的缓存失败")
+	return nil
+}
+
+// reload 在后台重新加载受影响用户的缓存，请求结束之后也要继续执行
+func (r *ActionDefinitionReloadCacheRepository) reload(ctx context.Context, bizID int64, action, msg string) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := r.reloadAffectedUsers(ctx, bizID, action); err != nil {
+			r.logger.Warn(msg, elog.FieldErr(err), elog.Any("bizID", bizID), elog.String("action", action))
+		}
+	}()
+}
+
+// reloadAffectedUsers 变更的只是 action 自身蕴含的操作，所以受影响的是被允许了 action 或者蕴含 action 的操作的用户，
+// 这个集合在变更前后是一样的。拒绝的权限不展开，不受影响
+func (r *ActionDefinitionReloadCacheRepository) reloadAffectedUsers(ctx context.Context, bizID int64, action string) error {
+	permIDs, roleIDs, err := r.getAffectedGrants(ctx, bizID, action)
+	if err != nil || len(permIDs) == 0 {
+		return err
+	}
+	var afterUserID int64
+	for {
+		direct, err := r.userPermissionDAO.FindUnexpiredUserIDsByBizIDAndPermissionIDs(ctx, bizID, permIDs,
+			domain.EffectAllow.String(), afterUserID, r.batchSize)
+		if err != nil {
+			return err
+		}
+		var viaRoles []int64
+		if len(roleIDs) > 0 {
+			viaRoles, err = r.userRoleDAO.FindUnexpiredUserIDsByBizIDAndRoleIDs(ctx, bizID, roleIDs, afterUserID, r.batchSize)
+			if err != nil {
+				return err
+			}
+		}
+		userIDs, next := mergeUserIDs(direct, viaRoles, r.batchSize)
+		users := slice.Map(userIDs, func(_ int, id int64) domain.User {
+			return domain.User{ID: id, BizID: bizID}
+		})
+		if err = r.cacheReloader.Reload(ctx, users); err != nil {
+			// 一批失败不影响后面的批次
+			r.logger.Warn("重新加载一批用户的缓存失败",
+				elog.FieldErr(err),
+				elog.Any("bizID", bizID),
+				elog.Any("userIDs", userIDs),
+			)
+		}
+		if next == 0 {
+			return nil
+		}
+		afterUserID = next
+	}
+}
+
+// getAffectedGrants 返回允许了受影响操作的权限，以及直接或者间接授予了这些权限的角色
+func (r *ActionDefinitionReloadCacheRepository) getAffectedGrants(ctx context.Context, bizID int64, action string) ([]int64, []int64, error) {
+	defs, err := r.actionDefinitionDAO.FindAllByBizID(ctx, bizID)
+	if err != nil {
+		return nil, nil, err
+	}
+	domainDefs, err := toActionDefinitionDomains(defs)
+	if err != nil {
+		return nil, nil, err
+	}
+	actions := append(domain.NewActionHierarchy(domainDefs).Implying(action), action)
+	perms, err := r.permissionDAO.FindByBizIDAndActions(ctx, bizID, actions)
+	if err != nil || len(perms) == 0 {
+		return nil, nil, err
+	}
+	permIDs := slice.Map(perms, func(_ int, src dao.Permission) int64 {
+		return src.ID
+	})
+
+	rolePerms, err := r.rolePermissionDAO.FindByBizIDAndPermissionIDs(ctx, bizID, permIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	roles := make(map[int64]struct{}, len(rolePerms))
+	for i := range rolePerms {
+		if !domain.Effect(rolePerms[i].Effect).IsDeny() {
+			roles[rolePerms[i].RoleID] = struct{}{}
+		}
+	}
+	if len(roles) == 0 {
+		return permIDs, nil, nil
+	}
+	// 直接或者间接包含这些角色的角色也受影响
+	closures, err := r.roleInclusionDAO.FindAncestorsByBizIDAndRoleIDs(ctx, bizID, mapx.Keys(roles))
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range closures {
+		roles[closures[i].AncestorRoleID] = struct{}{}
+	}
+	return permIDs, mapx.Keys(roles), nil
+}
@@ -85,26 +85,30 @@ func (r *roleRepository) FindByBizID(ctx context.Context, bizID int64, offset, l
 
 func (r *roleRepository) toEntity(role domain.Role) dao.Role {
 	return dao.Role{
-		ID:          role.ID,
-		BizID:       role.BizID,
-		Type:        role.Type,
-		Name:        role.Name,
-		Description: role.Description,
-		Metadata:    role.Metadata,
-		Ctime:       role.Ctime,
-		Utime:       role.Utime,
+		ID:              role.ID,
+		BizID:           role.BizID,
+		Type:            role.Type,
+		Name:            role.Name,
+		Description:     role.Description,
+		Metadata:        role.Metadata,
+		MaxMembers:      role.MaxMembers,
+		MaxRolesPerUser: role.MaxRolesPerUser,
+		Ctime:           role.Ctime,
+		Utime:           role.Utime,
 	}
 }
 
 func (r *roleRepository) toDomain(role dao.Role) domain.Role {
 	return domain.Role{
-		ID:          role.ID,
-		BizID:       role.BizID,
-		Type:        role.Type,
-		Name:        role.Name,
-		Description: role.Description,
-		Metadata:    role.Metadata,
-		Ctime:       role.Ctime,
-		Utime:       role.Utime,
+		ID:              role.ID,
+		BizID:           role.BizID,
+		Type:            role.Type,
+		Name:            role.Name,
+		Description:     role.Description,
+		Metadata:        role.Metadata,
+		MaxMembers:      role.MaxMembers,
+		MaxRolesPerUser: role.MaxRolesPerUser,
+		Ctime:           role.Ctime,
+		Utime:           role.Utime,
 	}
 }
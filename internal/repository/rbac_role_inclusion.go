@@ -12,7 +12,8 @@ var _ RoleInclusionRepository = (*RoleInclusionDefaultRepository)(nil)
 
 // RoleInclusionRepository 角色包含关系仓储接口
 type RoleInclusionRepository interface {
-	Create(ctx context.Context, roleInclusion domain.RoleInclusion) (domain.RoleInclusion, error)
+	// Create check 不为 nil 的时候在和授予用户角色串行执行的事务里、写入之前执行，返回错误的时候不会写入
+	Create(ctx context.Context, roleInclusion domain.RoleInclusion, check SoDCheck) (domain.RoleInclusion, error)
 
 	FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]domain.RoleInclusion, error)
 	FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.RoleInclusion, error)
@@ -34,8 +35,8 @@ func NewRoleInclusionDefaultRepository(roleInclusionDAO dao.RoleInclusionDAO) *R
 	}
 }
 
-func (r *RoleInclusionDefaultRepository) Create(ctx context.Context, roleInclusion domain.RoleInclusion) (domain.RoleInclusion, error) {
-	created, err := r.roleInclusionDAO.Create(ctx, r.toEntity(roleInclusion))
+func (r *RoleInclusionDefaultRepository) Create(ctx context.Context, roleInclusion domain.RoleInclusion, check SoDCheck) (domain.RoleInclusion, error) {
+	created, err := r.roleInclusionDAO.Create(ctx, r.toEntity(roleInclusion), toGrantCheck(check))
 	if err != nil {
 		return domain.RoleInclusion{}, err
 	}
@@ -72,6 +73,17 @@ func (r *RoleInclusionDefaultRepository) FindByBizIDAndIncludedRoleIDs(ctx conte
 	}), nil
 }
 
+// FindAncestorRoleIDs 查找直接或者间接包含该角色的所有角色ID
+func (r *RoleInclusionDefaultRepository) FindAncestorRoleIDs(ctx context.Context, bizID, roleID int64) ([]int64, error) {
+	closures, err := r.roleInclusionDAO.FindAncestorsByBizIDAndRoleIDs(ctx, bizID, []int64{roleID})
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(closures, func(_ int, src dao.RoleInclusionClosure) int64 {
+		return src.AncestorRoleID
+	}), nil
+}
+
 func (r *RoleInclusionDefaultRepository) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
 	return r.roleInclusionDAO.DeleteByBizIDAndID(ctx, bizID, id)
 }
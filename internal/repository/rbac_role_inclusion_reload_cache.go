@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
-	"github.com/ecodeclub/ekit/mapx"
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/gotomicro/ego/core/elog"
 )
@@ -33,8 +32,8 @@ func NewRoleInclusionReloadCacheRepository(
 	}
 }
 
-func (r *RoleInclusionReloadCacheRepository) Create(ctx context.Context, roleInclusion domain.RoleInclusion) (domain.RoleInclusion, error) {
-	created, err := r.repo.Create(ctx, roleInclusion)
+func (r *RoleInclusionReloadCacheRepository) Create(ctx context.Context, roleInclusion domain.RoleInclusion, check SoDCheck) (domain.RoleInclusion, error) {
+	created, err := r.repo.Create(ctx, roleInclusion, check)
 	if err != nil {
 		return domain.RoleInclusion{}, err
 	}
@@ -67,26 +66,12 @@ func (r *RoleInclusionReloadCacheRepository) getAffectedUsers(ctx context.Contex
 }
 
 func (r *RoleInclusionReloadCacheRepository) getAffectedRoleIDs(ctx context.Context, bizID, includedRoleID int64) ([]int64, error) {
-	allRoleIDs := make(map[int64]any)
-	allRoleIDs[includedRoleID] = struct{}{}
-
-	includedIDs := []int64{includedRoleID}
-	for {
-		inclusions, err := r.repo.FindByBizIDAndIncludedRoleIDs(ctx, bizID, includedIDs)
-		if err != nil {
-			return nil, err
-		}
-		if len(inclusions) == 0 {
-			break
-		}
-		// 沿着包含关系链，逆向查找
-		// A->B->C, 当C添加了权限，此时IncludedRoleID=C，然后要沿着与之关联的 IncludingRoleID 逆向查找 —— 找到B，再找到A
-		includedIDs = slice.Map(inclusions, func(_ int, src domain.RoleInclusion) int64 {
-			allRoleIDs[src.IncludingRole.ID] = struct{}{}
-			return src.IncludingRole.ID
-		})
+	// A->B->C, 当C添加了权限，此时IncludedRoleID=C，通过闭包表找到直接或者间接包含它的 B 和 A
+	ancestorIDs, err := r.repo.FindAncestorRoleIDs(ctx, bizID, includedRoleID)
+	if err != nil {
+		return nil, err
 	}
-	return mapx.Keys(allRoleIDs), nil
+	return append(ancestorIDs, includedRoleID), nil
 }
 
 func (r *RoleInclusionReloadCacheRepository) FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.RoleInclusion, error) {
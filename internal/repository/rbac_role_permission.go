@@ -114,6 +114,9 @@ func (r *RolePermissionDefaultRepository) toEntity(rp domain.RolePermission) dao
 		ResourceType:     rp.Permission.Resource.Type,
 		ResourceKey:      rp.Permission.Resource.Key,
 		PermissionAction: rp.Permission.Action,
+		StartTime:        rp.StartTime,
+		EndTime:          rp.EndTime,
+		Effect:           rp.Effect.String(),
 		Ctime:            rp.Ctime,
 		Utime:            rp.Utime,
 	}
@@ -136,7 +139,10 @@ func (r *RolePermissionDefaultRepository) toDomain(rp dao.RolePermission) domain
 			},
 			Action: rp.PermissionAction,
 		},
-		Ctime: rp.Ctime,
-		Utime: rp.Utime,
+		StartTime: rp.StartTime,
+		EndTime:   rp.EndTime,
+		Effect:    domain.Effect(rp.Effect),
+		Ctime:     rp.Ctime,
+		Utime:     rp.Utime,
 	}
 }
@@ -5,7 +5,6 @@ import (
 
 	"gitee.com/flycash/permission-platform/internal/domain"
 	"gitee.com/flycash/permission-platform/internal/repository/dao"
-	"github.com/ecodeclub/ekit/mapx"
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/gotomicro/ego/core/elog"
 )
@@ -71,26 +70,14 @@ func (r *RolePermissionReloadCacheRepository) getAffectedUsers(ctx context.Conte
 }
 
 func (r *RolePermissionReloadCacheRepository) getAffectedRoleIDs(ctx context.Context, bizID, includedRoleID int64) ([]int64, error) {
-	allRoleIDs := make(map[int64]any)
-	allRoleIDs[includedRoleID] = struct{}{}
-
-	includedIDs := []int64{includedRoleID}
-	for {
-		inclusions, err := r.roleInclusionDAO.FindByBizIDAndIncludedRoleIDs(ctx, bizID, includedIDs)
-		if err != nil {
-			return nil, err
-		}
-		if len(inclusions) == 0 {
-			break
-		}
-		// 沿着包含关系链，逆向查找
-		// A->B->C, 当C添加了权限，此时IncludedRoleID=C，然后要沿着与之关联的 IncludingRoleID 逆向查找 —— 找到B，再找到A
-		includedIDs = slice.Map(inclusions, func(_ int, src dao.RoleInclusion) int64 {
-			allRoleIDs[src.IncludingRoleID] = struct{}{}
-			return src.IncludingRoleID
-		})
+	// A->B->C, 当C添加了权限，此时IncludedRoleID=C，通过闭包表找到直接或者间接包含它的 B 和 A
+	closures, err := r.roleInclusionDAO.FindAncestorsByBizIDAndRoleIDs(ctx, bizID, []int64{includedRoleID})
+	if err != nil {
+		return nil, err
 	}
-	return mapx.Keys(allRoleIDs), nil
+	return append(slice.Map(closures, func(_ int, src dao.RoleInclusionClosure) int64 {
+		return src.AncestorRoleID
+	}), includedRoleID), nil
 }
 
 func (r *RolePermissionReloadCacheRepository) FindByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]domain.RolePermission, error) {
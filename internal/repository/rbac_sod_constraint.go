@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/repository/dao"
+	auditdao "gitee.com/flycash/permission-platform/internal/repository/dao/audit"
+	"github.com/ecodeclub/ekit/mapx"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+var _ SoDConstraintRepository = (*SoDConstraintDefaultRepository)(nil)
+
+// SoDConstraintRepository 职责分离约束仓储接口，同时提供校验约束需要的角色、用户查询
+type SoDConstraintRepository interface {
+	SoDReader
+
+	Create(ctx context.Context, constraint domain.SoDConstraint) (domain.SoDConstraint, error)
+	FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.SoDConstraint, error)
+
+	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
+
+	// RecordViolations 记录被拒绝的操作，request 是被拒绝的请求
+	RecordViolations(ctx context.Context, bizID int64, violations []domain.SoDViolation, request any) error
+	// SaveActivation 保存用户激活之后生效的角色，覆盖之前的激活
+	SaveActivation(ctx context.Context, bizID, userID int64, roleIDs []int64) error
+}
+
+// SoDReader 校验职责分离约束需要的查询
+type SoDReader interface {
+	FindByBizID(ctx context.Context, bizID int64) ([]domain.SoDConstraint, error)
+
+	// FindUnexpiredUserRoleIDs 用户尚未失效的角色ID，包含还未到生效时间的
+	FindUnexpiredUserRoleIDs(ctx context.Context, bizID, userID int64) ([]int64, error)
+	// FindEffectiveUserRoleIDs 用户当前生效的角色ID
+	FindEffectiveUserRoleIDs(ctx context.Context, bizID, userID int64) ([]int64, error)
+	// FindDescendantRoleIDs 每个角色直接或者间接包含的角色ID，包含角色自身
+	FindDescendantRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) (map[int64][]int64, error)
+	// FindAncestorRoleIDs 直接或者间接包含该角色的角色ID，不包含角色自身
+	FindAncestorRoleIDs(ctx context.Context, bizID, roleID int64) ([]int64, error)
+	// FindConflictingUserIDs 直接或者间接同时拥有 roleIDs 中任意一个角色和 otherRoleIDs 中任意一个角色的用户ID，最多 limit 个
+	FindConflictingUserIDs(ctx context.Context, bizID int64, roleIDs, otherRoleIDs []int64, limit int) ([]int64, error)
+}
+
+// SoDCheck 在授予事务里校验职责分离约束，reader 的查询和授予在同一个事务里。
+// 违反约束的时候返回 domain.SoDViolationError，由调用方在事务回滚之后记录
+type SoDCheck func(ctx context.Context, reader SoDReader) error
+
+// toGrantCheck 让 SoDCheck 通过事务里的数据访问对象查询
+func toGrantCheck(check SoDCheck) dao.GrantCheck {
+	if check == nil {
+		return nil
+	}
+	return func(ctx context.Context, tx dao.GrantTx) error {
+		return check(ctx, NewSoDConstraintDefaultRepository(tx.SoDConstraintDAO, tx.RoleInclusionDAO, tx.UserRoleDAO, nil, nil))
+	}
+}
+
+// SoDConstraintDefaultRepository 职责分离约束仓储实现
+type SoDConstraintDefaultRepository struct {
+	sodConstraintDAO   dao.SoDConstraintDAO
+	roleInclusionDAO   dao.RoleInclusionDAO
+	userRoleDAO        dao.UserRoleDAO
+	roleActivationDAO  dao.RoleActivationDAO
+	sodViolationLogDAO auditdao.SoDViolationLogDAO
+}
+
+// NewSoDConstraintDefaultRepository 创建职责分离约束仓储实例
+func NewSoDConstraintDefaultRepository(
+	sodConstraintDAO dao.SoDConstraintDAO,
+	roleInclusionDAO dao.RoleInclusionDAO,
+	userRoleDAO dao.UserRoleDAO,
+	roleActivationDAO dao.RoleActivationDAO,
+	sodViolationLogDAO auditdao.SoDViolationLogDAO,
+) *SoDConstraintDefaultRepository {
+	return &SoDConstraintDefaultRepository{
+		sodConstraintDAO:   sodConstraintDAO,
+		roleInclusionDAO:   roleInclusionDAO,
+		userRoleDAO:        userRoleDAO,
+		roleActivationDAO:  roleActivationDAO,
+		sodViolationLogDAO: sodViolationLogDAO,
+	}
+}
+
+func (r *SoDConstraintDefaultRepository) Create(ctx context.Context, constraint domain.SoDConstraint) (domain.SoDConstraint, error) {
+	created, err := r.sodConstraintDAO.Create(ctx, r.toEntity(constraint))
+	if err != nil {
+		return domain.SoDConstraint{}, err
+	}
+	return toSoDConstraintDomain(created), nil
+}
+
+func (r *SoDConstraintDefaultRepository) FindByBizID(ctx context.Context, bizID int64) ([]domain.SoDConstraint, error) {
+	constraints, err := r.sodConstraintDAO.FindByBizID(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(constraints, func(_ int, src dao.SoDConstraint) domain.SoDConstraint {
+		return toSoDConstraintDomain(src)
+	}), nil
+}
+
+func (r *SoDConstraintDefaultRepository) FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.SoDConstraint, error) {
+	constraint, err := r.sodConstraintDAO.FindByBizIDAndID(ctx, bizID, id)
+	if err != nil {
+		return domain.SoDConstraint{}, err
+	}
+	return toSoDConstraintDomain(constraint), nil
+}
+
+func (r *SoDConstraintDefaultRepository) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
+	return r.sodConstraintDAO.DeleteByBizIDAndID(ctx, bizID, id)
+}
+
+func (r *SoDConstraintDefaultRepository) FindUnexpiredUserRoleIDs(ctx context.Context, bizID, userID int64) ([]int64, error) {
+	userRoles, err := r.userRoleDAO.FindUnexpiredByBizIDAndUserID(ctx, bizID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(userRoles, func(_ int, src dao.UserRole) int64 {
+		return src.RoleID
+	}), nil
+}
+
+func (r *SoDConstraintDefaultRepository) FindEffectiveUserRoleIDs(ctx context.Context, bizID, userID int64) ([]int64, error) {
+	userRoles, err := r.userRoleDAO.FindUnexpiredByBizIDAndUserID(ctx, bizID, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	roleIDs := make([]int64, 0, len(userRoles))
+	for _, userRole := range userRoles {
+		if userRole.StartTime <= now {
+			roleIDs = append(roleIDs, userRole.RoleID)
+		}
+	}
+	return roleIDs, nil
+}
+
+func (r *SoDConstraintDefaultRepository) FindDescendantRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) (map[int64][]int64, error) {
+	res := make(map[int64][]int64, len(roleIDs))
+	if len(roleIDs) == 0 {
+		return res, nil
+	}
+	for _, roleID := range roleIDs {
+		res[roleID] = []int64{roleID}
+	}
+	closures, err := r.roleInclusionDAO.FindDescendantsByBizIDAndRoleIDs(ctx, bizID, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, closure := range closures {
+		res[closure.AncestorRoleID] = append(res[closure.AncestorRoleID], closure.DescendantRoleID)
+	}
+	return res, nil
+}
+
+func (r *SoDConstraintDefaultRepository) FindAncestorRoleIDs(ctx context.Context, bizID, roleID int64) ([]int64, error) {
+	closures, err := r.roleInclusionDAO.FindAncestorsByBizIDAndRoleIDs(ctx, bizID, []int64{roleID})
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(closures, func(_ int, src dao.RoleInclusionClosure) int64 {
+		return src.AncestorRoleID
+	}), nil
+}
+
+func (r *SoDConstraintDefaultRepository) FindConflictingUserIDs(ctx context.Context, bizID int64, roleIDs, otherRoleIDs []int64, limit int) ([]int64, error) {
+	// 拥有角色的祖先角色也就间接拥有了这个角色
+	holding, err := r.ancestorsOrSelf(ctx, bizID, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+	otherHolding, err := r.ancestorsOrSelf(ctx, bizID, otherRoleIDs)
+	if err != nil {
+		return nil, err
+	}
+	return r.userRoleDAO.FindUnexpiredUserIDsByBizIDAndBothRoleIDs(ctx, bizID, holding, otherHolding, limit)
+}
+
+func (r *SoDConstraintDefaultRepository) ancestorsOrSelf(ctx context.Context, bizID int64, roleIDs []int64) ([]int64, error) {
+	closures, err := r.roleInclusionDAO.FindAncestorsByBizIDAndRoleIDs(ctx, bizID, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]struct{}, len(roleIDs)+len(closures))
+	for _, roleID := range roleIDs {
+		res[roleID] = struct{}{}
+	}
+	for _, closure := range closures {
+		res[closure.AncestorRoleID] = struct{}{}
+	}
+	return mapx.Keys(res), nil
+}
+
+func (r *SoDConstraintDefaultRepository) RecordViolations(ctx context.Context, bizID int64, violations []domain.SoDViolation, request any) error {
+	req, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	logs := slice.Map(violations, func(_ int, src domain.SoDViolation) auditdao.SoDViolationLog {
+		userIDs := ""
+		if len(src.UserIDs) > 0 {
+			b, _ := json.Marshal(src.UserIDs)
+			userIDs = string(b)
+		}
+		roleIDs, _ := json.Marshal(src.RoleIDs)
+		return auditdao.SoDViolationLog{
+			BizID:        bizID,
+			ConstraintID: src.Constraint.ID,
+			Operation:    string(src.Operation),
+			UserIDs:      userIDs,
+			RoleIDs:      string(roleIDs),
+			Request:      string(req),
+		}
+	})
+	return r.sodViolationLogDAO.BatchCreate(ctx, logs)
+}
+
+func (r *SoDConstraintDefaultRepository) SaveActivation(ctx context.Context, bizID, userID int64, roleIDs []int64) error {
+	ids, err := json.Marshal(roleIDs)
+	if err != nil {
+		return err
+	}
+	return r.roleActivationDAO.Save(ctx, dao.RoleActivation{
+		BizID:   bizID,
+		UserID:  userID,
+		RoleIDs: string(ids),
+	})
+}
+
+func (r *SoDConstraintDefaultRepository) toEntity(constraint domain.SoDConstraint) dao.SoDConstraint {
+	roleIDs, _ := json.Marshal(constraint.RoleIDs)
+	return dao.SoDConstraint{
+		ID:          constraint.ID,
+		BizID:       constraint.BizID,
+		Name:        constraint.Name,
+		Description: constraint.Description,
+		Type:        constraint.Type.String(),
+		RoleIDs:     string(roleIDs),
+		Ctime:       constraint.Ctime,
+		Utime:       constraint.Utime,
+	}
+}
+
+func toSoDConstraintDomain(constraint dao.SoDConstraint) domain.SoDConstraint {
+	var roleIDs []int64
+	_ = json.Unmarshal([]byte(constraint.RoleIDs), &roleIDs)
+	return domain.SoDConstraint{
+		ID:          constraint.ID,
+		BizID:       constraint.BizID,
+		Name:        constraint.Name,
+		Description: constraint.Description,
+		Type:        domain.SoDType(constraint.Type),
+		RoleIDs:     roleIDs,
+		Ctime:       constraint.Ctime,
+		Utime:       constraint.Utime,
+	}
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+var _ SoDConstraintRepository = (*SoDConstraintReloadCacheRepository)(nil)
+
+// SoDConstraintReloadCacheRepository 动态约束和激活的角色决定了用户的哪些角色生效，变更之后重新加载受影响用户的缓存
+type SoDConstraintReloadCacheRepository struct {
+	repo          *SoDConstraintDefaultRepository
+	userRoleRepo  *UserRoleDefaultRepository
+	cacheReloader UserPermissionCacheReloader
+	logger        *elog.Component
+}
+
+// NewSoDConstraintReloadCacheRepository 创建可以重载缓存的职责分离约束仓储实例
+func NewSoDConstraintReloadCacheRepository(
+	repo *SoDConstraintDefaultRepository,
+	userRoleRepo *UserRoleDefaultRepository,
+	cacheReloader UserPermissionCacheReloader,
+) *SoDConstraintReloadCacheRepository {
+	return &SoDConstraintReloadCacheRepository{
+		repo:          repo,
+		userRoleRepo:  userRoleRepo,
+		cacheReloader: cacheReloader,
+		logger:        elog.DefaultLogger.With(elog.FieldName("SoDConstraintReloadCacheRepository")),
+	}
+}
+
+func (r *SoDConstraintReloadCacheRepository) Create(ctx context.Context, constraint domain.SoDConstraint) (domain.SoDConstraint, error) {
+	created, err := r.repo.Create(ctx, constraint)
+	if err != nil {
+		return domain.SoDConstraint{}, err
+	}
+	r.reloadHolders(ctx, created)
+	return created, nil
+}
+
+func (r *SoDConstraintReloadCacheRepository) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
+	deleted, err := r.repo.FindByBizIDAndID(ctx, bizID, id)
+	if err != nil {
+		return err
+	}
+	if err = r.repo.DeleteByBizIDAndID(ctx, bizID, id); err != nil {
+		return err
+	}
+	r.reloadHolders(ctx, deleted)
+	return nil
+}
+
+// reloadHolders 静态约束不影响已经拥有的角色，动态约束需要重新加载直接或者间接拥有约束里的角色的用户
+func (r *SoDConstraintReloadCacheRepository) reloadHolders(ctx context.Context, constraint domain.SoDConstraint) {
+	if constraint.Type != domain.SoDDynamic {
+		return
+	}
+	if err := r.cacheReloader.Reload(ctx, r.getHolders(ctx, constraint)); err != nil {
+		r.logger.Warn("动态职责分离约束变更之后，重新加载所有受影响用户的缓存失败",
+			elog.FieldErr(err),
+			elog.Any("bizID", constraint.BizID),
+			elog.Any("constraintID", constraint.ID),
+		)
+	}
+}
+
+func (r *SoDConstraintReloadCacheRepository) getHolders(ctx context.Context, constraint domain.SoDConstraint) []domain.User {
+	roleIDs, err := r.repo.ancestorsOrSelf(ctx, constraint.BizID, constraint.RoleIDs)
+	if err != nil {
+		return nil
+	}
+	userRoles, err := r.userRoleRepo.FindByBizIDAndRoleIDs(ctx, constraint.BizID, roleIDs)
+	if err != nil {
+		return nil
+	}
+	return slice.Map(userRoles, func(_ int, src domain.UserRole) domain.User {
+		return domain.User{
+			ID:    src.UserID,
+			BizID: src.BizID,
+		}
+	})
+}
+
+func (r *SoDConstraintReloadCacheRepository) SaveActivation(ctx context.Context, bizID, userID int64, roleIDs []int64) error {
+	if err := r.repo.SaveActivation(ctx, bizID, userID, roleIDs); err != nil {
+		return err
+	}
+	if err := r.cacheReloader.Reload(ctx, []domain.User{{ID: userID, BizID: bizID}}); err != nil {
+		r.logger.Warn("激活角色成功后，重新加载用户的缓存失败",
+			elog.FieldErr(err),
+			elog.Any("bizID", bizID),
+			elog.Any("userID", userID),
+		)
+	}
+	return nil
+}
+
+func (r *SoDConstraintReloadCacheRepository) FindByBizID(ctx context.Context, bizID int64) ([]domain.SoDConstraint, error) {
+	return r.repo.FindByBizID(ctx, bizID)
+}
+
+func (r *SoDConstraintReloadCacheRepository) FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.SoDConstraint, error) {
+	return r.repo.FindByBizIDAndID(ctx, bizID, id)
+}
+
+func (r *SoDConstraintReloadCacheRepository) FindUnexpiredUserRoleIDs(ctx context.Context, bizID, userID int64) ([]int64, error) {
+	return r.repo.FindUnexpiredUserRoleIDs(ctx, bizID, userID)
+}
+
+func (r *SoDConstraintReloadCacheRepository) FindEffectiveUserRoleIDs(ctx context.Context, bizID, userID int64) ([]int64, error) {
+	return r.repo.FindEffectiveUserRoleIDs(ctx, bizID, userID)
+}
+
+func (r *SoDConstraintReloadCacheRepository) FindDescendantRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) (map[int64][]int64, error) {
+	return r.repo.FindDescendantRoleIDs(ctx, bizID, roleIDs)
+}
+
+func (r *SoDConstraintReloadCacheRepository) FindAncestorRoleIDs(ctx context.Context, bizID, roleID int64) ([]int64, error) {
+	return r.repo.FindAncestorRoleIDs(ctx, bizID, roleID)
+}
+
+func (r *SoDConstraintReloadCacheRepository) FindConflictingUserIDs(ctx context.Context, bizID int64, roleIDs, otherRoleIDs []int64, limit int) ([]int64, error) {
+	return r.repo.FindConflictingUserIDs(ctx, bizID, roleIDs, otherRoleIDs, limit)
+}
+
+func (r *SoDConstraintReloadCacheRepository) RecordViolations(ctx context.Context, bizID int64, violations []domain.SoDViolation, request any) error {
+	return r.repo.RecordViolations(ctx, bizID, violations, request)
+}
@@ -2,7 +2,8 @@ package repository
 
 import (
 	"context"
-	"time"
+	"encoding/json"
+	"slices"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
 	"gitee.com/flycash/permission-platform/internal/repository/dao"
@@ -10,15 +11,13 @@ import (
 	"github.com/ecodeclub/ekit/slice"
 )
 
-const (
-	oneHundredYears = 100
-)
-
 var _ UserPermissionRepository = (*UserPermissionDefaultRepository)(nil)
 
 // UserPermissionRepository 用户权限关系仓储接口
 type UserPermissionRepository interface {
 	Create(ctx context.Context, userPermission domain.UserPermission) (domain.UserPermission, error)
+	// UpdateByBizIDAndID 修改授予的生效时间、失效时间以及效果
+	UpdateByBizIDAndID(ctx context.Context, userPermission domain.UserPermission) (domain.UserPermission, error)
 
 	FindByBizID(ctx context.Context, bizID int64, offset, limit int) ([]domain.UserPermission, error)
 	FindByBizIDAndUserID(ctx context.Context, bizID, userID int64) ([]domain.UserPermission, error)
@@ -27,28 +26,46 @@ type UserPermissionRepository interface {
 
 	// GetAll 获取用户的所有权限，包括个人权限、个人拥有的角色（及包含的角色）对应的权限
 	GetAll(ctx context.Context, bizID, userID int64) ([]domain.UserPermission, error)
+	// GetAllWithSources 和 GetAll 一样，但是不做去重，并且会带上每一条权限的来源，用于解释权限判定的过程
+	GetAllWithSources(ctx context.Context, bizID, userID int64) ([]domain.UserPermissionSource, error)
+	// FindSubjectSources 反向查找，按照用户ID升序分页找到在资源的操作上被允许过的用户，
+	// 并带上这些用户匹配上的、尚未失效的所有授权（包括拒绝），返回的下一页游标为 0 表示没有更多
+	// 这里不考虑动态职责分离约束的激活状态
+	FindSubjectSources(ctx context.Context, bizID int64, resource domain.Resource, action string, afterUserID int64, limit int) ([]domain.PermissionSubject, int64, error)
 }
 
 // UserPermissionDefaultRepository 用户权限关系仓储实现
 type UserPermissionDefaultRepository struct {
-	roleInclusionDAO  dao.RoleInclusionDAO
-	rolePermissionDAO dao.RolePermissionDAO
-	userRoleDAO       dao.UserRoleDAO
-	userPermissionDAO dao.UserPermissionDAO
+	actionDefinitionDAO dao.ActionDefinitionDAO
+	permissionDAO       dao.PermissionDAO
+	roleInclusionDAO    dao.RoleInclusionDAO
+	rolePermissionDAO   dao.RolePermissionDAO
+	userRoleDAO         dao.UserRoleDAO
+	userPermissionDAO   dao.UserPermissionDAO
+	sodConstraintDAO    dao.SoDConstraintDAO
+	roleActivationDAO   dao.RoleActivationDAO
 }
 
 // NewUserPermissionDefaultRepository 创建用户权限关系仓储实例
 func NewUserPermissionDefaultRepository(
+	actionDefinitionDAO dao.ActionDefinitionDAO,
+	permissionDAO dao.PermissionDAO,
 	roleInclusionDAO dao.RoleInclusionDAO,
 	rolePermissionDAO dao.RolePermissionDAO,
 	userRoleDAO dao.UserRoleDAO,
 	userPermissionDAO dao.UserPermissionDAO,
+	sodConstraintDAO dao.SoDConstraintDAO,
+	roleActivationDAO dao.RoleActivationDAO,
 ) *UserPermissionDefaultRepository {
 	return &UserPermissionDefaultRepository{
-		roleInclusionDAO:  roleInclusionDAO,
-		rolePermissionDAO: rolePermissionDAO,
-		userRoleDAO:       userRoleDAO,
-		userPermissionDAO: userPermissionDAO,
+		actionDefinitionDAO: actionDefinitionDAO,
+		permissionDAO:       permissionDAO,
+		roleInclusionDAO:    roleInclusionDAO,
+		rolePermissionDAO:   rolePermissionDAO,
+		userRoleDAO:         userRoleDAO,
+		userPermissionDAO:   userPermissionDAO,
+		sodConstraintDAO:    sodConstraintDAO,
+		roleActivationDAO:   roleActivationDAO,
 	}
 }
 
@@ -60,6 +77,13 @@ func (r *UserPermissionDefaultRepository) Create(ctx context.Context, userPermis
 	return r.toDomain(created), nil
 }
 
+func (r *UserPermissionDefaultRepository) UpdateByBizIDAndID(ctx context.Context, userPermission domain.UserPermission) (domain.UserPermission, error) {
+	if err := r.userPermissionDAO.UpdateByBizIDAndID(ctx, r.toEntity(userPermission)); err != nil {
+		return domain.UserPermission{}, err
+	}
+	return r.FindByBizIDAndID(ctx, userPermission.BizID, userPermission.ID)
+}
+
 func (r *UserPermissionDefaultRepository) FindByBizIDAndUserID(ctx context.Context, bizID, userID int64) ([]domain.UserPermission, error) {
 	userPermissions, err := r.userPermissionDAO.FindByBizIDAndUserID(ctx, bizID, userID)
 	if err != nil {
@@ -134,19 +158,28 @@ func (r *UserPermissionDefaultRepository) toDomain(up dao.UserPermission) domain
 	}
 }
 
+// GetAll 返回的结果包含还未到生效时间的权限，调用方需要通过 domain.UserPermission.IsValidAt 自行过滤
+// 这样缓存下来的权限集合在生效时间到达或者失效时间过去之后，依旧能得到正确的结果。
+// 允许的权限会按照业务方的操作层级展开，例如授予了 write 就会额外带上一条 read。
+// 同时拥有动态职责分离约束里的多个角色的时候，只有激活了的那一个角色的权限生效
 func (r *UserPermissionDefaultRepository) GetAll(ctx context.Context, bizID, userID int64) ([]domain.UserPermission, error) {
-	permissions, err := r.userPermissionDAO.FindByBizIDAndUserID(ctx, bizID, userID)
+	permissions, err := r.userPermissionDAO.FindUnexpiredByBizIDAndUserID(ctx, bizID, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	roleIDs, err := r.getAllRoleIDs(ctx, bizID, userID)
+	roleValidities, err := r.getAllRoleValidities(ctx, bizID, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	// 包含了所需的冗余字段，所以我们不再需要查 Permission 表和 Resource
-	rolePerms, err := r.getRolePermissions(ctx, bizID, userID, roleIDs)
+	rolePerms, err := r.getRolePermissions(ctx, bizID, userID, roleValidities)
+	if err != nil {
+		return nil, err
+	}
+
+	hierarchy, err := r.getActionHierarchy(ctx, bizID)
 	if err != nil {
 		return nil, err
 	}
@@ -154,75 +187,398 @@ func (r *UserPermissionDefaultRepository) GetAll(ctx context.Context, bizID, use
 	perms := slice.Map(permissions, func(_ int, src dao.UserPermission) domain.UserPermission {
 		return r.toDomain(src)
 	})
-	return append(perms, rolePerms...), nil
+	return r.expandImpliedActions(append(perms, rolePerms...), hierarchy), nil
+}
+
+func (r *UserPermissionDefaultRepository) getActionHierarchy(ctx context.Context, bizID int64) (domain.ActionHierarchy, error) {
+	defs, err := r.actionDefinitionDAO.FindAllByBizID(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	domainDefs, err := toActionDefinitionDomains(defs)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewActionHierarchy(domainDefs), nil
+}
+
+// expandImpliedActions 把允许的权限展开到它蕴含的操作上，展开出来的权限和原权限的资源、生效期一致。
+// 拒绝的权限不展开，只作用在授权时的操作上
+func (r *UserPermissionDefaultRepository) expandImpliedActions(perms []domain.UserPermission, hierarchy domain.ActionHierarchy) []domain.UserPermission {
+	if len(hierarchy) == 0 {
+		return perms
+	}
+	type uniqueKey struct {
+		resource  domain.Resource
+		action    string
+		effect    domain.Effect
+		startTime int64
+		endTime   int64
+	}
+	keyOf := func(p domain.UserPermission) uniqueKey {
+		return uniqueKey{
+			resource:  domain.Resource{Type: p.Permission.Resource.Type, Key: p.Permission.Resource.Key},
+			action:    p.Permission.Action,
+			effect:    p.Effect,
+			startTime: p.StartTime,
+			endTime:   p.EndTime,
+		}
+	}
+	seen := make(map[uniqueKey]struct{}, len(perms))
+	for i := range perms {
+		seen[keyOf(perms[i])] = struct{}{}
+	}
+	res := slices.Clone(perms)
+	for i := range perms {
+		if perms[i].Effect.IsDeny() {
+			continue
+		}
+		for _, action := range hierarchy.Implied(perms[i].Permission.Action) {
+			implied := perms[i]
+			implied.Permission.Action = action
+			key := keyOf(implied)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			res = append(res, implied)
+		}
+	}
+	return res
 }
 
-// getAllRoleIDs 获取用户所有角色ID，包括继承的角色
-func (r *UserPermissionDefaultRepository) getAllRoleIDs(ctx context.Context, bizID, userID int64) ([]int64, error) {
+// validity 生效期
+type validity struct {
+	startTime int64
+	endTime   int64
+}
+
+// getAllRoleValidities 获取用户所有角色ID，包括继承的角色，以及这些角色对用户的生效期
+// 被包含的角色沿用包含它的角色的生效期，同一个角色可能通过不同的授予记录拿到多个生效期
+func (r *UserPermissionDefaultRepository) getAllRoleValidities(ctx context.Context, bizID, userID int64) (map[int64][]validity, error) {
 	// 1. 先找到直接关联的角色
-	directRoles, err := r.userRoleDAO.FindByBizIDAndUserID(ctx, bizID, userID)
+	directRoles, err := r.userRoleDAO.FindUnexpiredByBizIDAndUserID(ctx, bizID, userID)
 	if err != nil {
 		return nil, err
 	}
+	if len(directRoles) == 0 {
+		return map[int64][]validity{}, nil
+	}
+
+	direct := make(map[int64][]validity, len(directRoles))
+	for i := range directRoles {
+		ur := directRoles[i]
+		addValidity(direct, ur.RoleID, validity{startTime: ur.StartTime, endTime: ur.EndTime})
+	}
 
-	allRoleIDs := make(map[int64]any, len(directRoles))
+	// 2. 通过闭包表一次查出所有被包含的角色
+	closures, err := r.roleInclusionDAO.FindDescendantsByBizIDAndRoleIDs(ctx, bizID, mapx.Keys(direct))
+	if err != nil {
+		return nil, err
+	}
+	inactive, err := r.getInactiveRoleIDs(ctx, bizID, userID, mapx.Keys(direct), closures)
+	if err != nil {
+		return nil, err
+	}
+	if len(inactive) > 0 {
+		// 有角色没有激活的时候要沿着包含关系绕开这些角色，闭包表做不到，改为逐层查找
+		paths, err := r.getAllRolePaths(ctx, bizID, directRoles, inactive)
+		if err != nil {
+			return nil, err
+		}
+		res := make(map[int64][]validity, len(paths))
+		for roleID, rps := range paths {
+			for _, rp := range rps {
+				addValidity(res, roleID, rp.validity)
+			}
+		}
+		return res, nil
+	}
+	res := make(map[int64][]validity, len(direct)+len(closures))
+	for roleID, validities := range direct {
+		for _, v := range validities {
+			addValidity(res, roleID, v)
+		}
+	}
+	for i := range closures {
+		for _, v := range direct[closures[i].AncestorRoleID] {
+			addValidity(res, closures[i].DescendantRoleID, v)
+		}
+	}
+	return res, nil
+}
 
-	directRoleIDs := slice.Map(directRoles, func(_ int, src dao.UserRole) int64 {
-		allRoleIDs[src.RoleID] = struct{}{}
-		return src.RoleID
+// getInactiveRoleIDs 用户拥有但是因为动态职责分离约束没有生效的角色，closures 是用户直接拥有的角色包含的角色
+func (r *UserPermissionDefaultRepository) getInactiveRoleIDs(ctx context.Context, bizID, userID int64,
+	directRoleIDs []int64, closures []dao.RoleInclusionClosure,
+) (map[int64]struct{}, error) {
+	constraints, err := r.sodConstraintDAO.FindByBizID(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	dynamic := slice.FilterMap(constraints, func(_ int, src dao.SoDConstraint) (domain.SoDConstraint, bool) {
+		return toSoDConstraintDomain(src), src.Type == domain.SoDDynamic.String()
 	})
+	if len(dynamic) == 0 {
+		return nil, nil
+	}
+	held := make(map[int64]struct{}, len(directRoleIDs)+len(closures))
+	for _, roleID := range directRoleIDs {
+		held[roleID] = struct{}{}
+	}
+	for i := range closures {
+		held[closures[i].DescendantRoleID] = struct{}{}
+	}
+	activation, err := r.roleActivationDAO.FindByBizIDAndUserID(ctx, bizID, userID)
+	if err != nil {
+		return nil, err
+	}
+	var activatedIDs []int64
+	if activation.RoleIDs != "" {
+		if err = json.Unmarshal([]byte(activation.RoleIDs), &activatedIDs); err != nil {
+			return nil, err
+		}
+	}
+	activated := make(map[int64]struct{}, len(activatedIDs))
+	for _, roleID := range activatedIDs {
+		activated[roleID] = struct{}{}
+	}
+	return inactiveRoleIDs(dynamic, held, activated), nil
+}
+
+// inactiveRoleIDs 用户同时拥有动态约束里的多个角色的时候，只有激活了的那一个生效。
+// 没有激活，或者激活的角色里就有多个互斥的时候，约束里用户拥有的角色都不生效
+func inactiveRoleIDs(constraints []domain.SoDConstraint, held, activated map[int64]struct{}) map[int64]struct{} {
+	res := make(map[int64]struct{})
+	for _, c := range constraints {
+		var owned, active []int64
+		for _, roleID := range c.RoleIDs {
+			if _, ok := held[roleID]; !ok {
+				continue
+			}
+			owned = append(owned, roleID)
+			if _, ok := activated[roleID]; ok {
+				active = append(active, roleID)
+			}
+		}
+		if len(owned) < 2 {
+			continue
+		}
+		for _, roleID := range owned {
+			if len(active) > 1 || !slices.Contains(active, roleID) {
+				res[roleID] = struct{}{}
+			}
+		}
+	}
+	return res
+}
+
+// intersect 求两个生效期的交集，0 表示对应方向不设限，交集为空的时候返回 false
+func (v validity) intersect(other validity) (validity, bool) {
+	res := v
+	if other.startTime > res.startTime {
+		res.startTime = other.startTime
+	}
+	if other.endTime > 0 && (res.endTime == 0 || other.endTime < res.endTime) {
+		res.endTime = other.endTime
+	}
+	if res.endTime > 0 && res.startTime > res.endTime {
+		return validity{}, false
+	}
+	return res, true
+}
+
+func addValidity(m map[int64][]validity, roleID int64, v validity) bool {
+	if slices.Contains(m[roleID], v) {
+		return false
+	}
+	m[roleID] = append(m[roleID], v)
+	return true
+}
 
-	includedIDs := directRoleIDs
+// GetAllWithSources 直接查询数据库，不走缓存
+func (r *UserPermissionDefaultRepository) GetAllWithSources(ctx context.Context, bizID, userID int64) ([]domain.UserPermissionSource, error) {
+	permissions, err := r.userPermissionDAO.FindUnexpiredByBizIDAndUserID(ctx, bizID, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := slice.Map(permissions, func(_ int, src dao.UserPermission) domain.UserPermissionSource {
+		return domain.UserPermissionSource{UserPermission: r.toDomain(src)}
+	})
 
-	for {
-		// 找到 directRoles 包含的角色
-		inclusions, err := r.roleInclusionDAO.FindByBizIDAndIncludingRoleIDs(ctx, bizID, includedIDs)
+	directRoles, err := r.userRoleDAO.FindUnexpiredByBizIDAndUserID(ctx, bizID, userID)
+	if err != nil {
+		return nil, err
+	}
+	var inactive map[int64]struct{}
+	if len(directRoles) > 0 {
+		directRoleIDs := slice.Map(directRoles, func(_ int, src dao.UserRole) int64 { return src.RoleID })
+		closures, err := r.roleInclusionDAO.FindDescendantsByBizIDAndRoleIDs(ctx, bizID, directRoleIDs)
+		if err != nil {
+			return nil, err
+		}
+		inactive, err = r.getInactiveRoleIDs(ctx, bizID, userID, directRoleIDs, closures)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rolePaths, err := r.getAllRolePaths(ctx, bizID, directRoles, inactive)
+	if err != nil {
+		return nil, err
+	}
+	var rolePerms []dao.RolePermission
+	if len(rolePaths) > 0 {
+		rolePerms, err = r.rolePermissionDAO.FindByBizIDAndRoleIDs(ctx, bizID, mapx.Keys(rolePaths))
 		if err != nil {
 			return nil, err
 		}
-		if len(inclusions) == 0 {
-			break
+	}
+	for i := range rolePerms {
+		src := rolePerms[i]
+		for _, rp := range rolePaths[src.RoleID] {
+			v, ok := rp.validity.intersect(validity{startTime: src.StartTime, endTime: src.EndTime})
+			if !ok {
+				continue
+			}
+			res = append(res, domain.UserPermissionSource{
+				UserPermission: r.rolePermissionToUserPermission(bizID, userID, src, v),
+				RolePath:       rp.path,
+			})
+		}
+	}
+
+	hierarchy, err := r.getActionHierarchy(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	// 不做去重，每一条展开出来的权限都记录是从哪个操作蕴含来的
+	for i, n := 0, len(res); i < n; i++ {
+		if res[i].UserPermission.Effect.IsDeny() {
+			continue
+		}
+		for _, action := range hierarchy.Implied(res[i].UserPermission.Permission.Action) {
+			implied := res[i]
+			implied.UserPermission.Permission.Action = action
+			implied.ImpliedBy = res[i].UserPermission.Permission.Action
+			res = append(res, implied)
+		}
+	}
+	return res, nil
+}
+
+// rolePath 用户拿到某个角色的路径，以及沿着这条路径得到的生效期
+type rolePath struct {
+	path     []int64
+	validity validity
+}
+
+// getAllRolePaths 从用户直接拥有的角色出发，沿着包含关系逐层找到所有角色，
+// 同一个角色的同一个生效期只记录最先找到的路径，也就是最短的那条。inactive 里的角色以及只能经过它们拿到的角色都不算拥有
+func (r *UserPermissionDefaultRepository) getAllRolePaths(ctx context.Context, bizID int64, directRoles []dao.UserRole, inactive map[int64]struct{}) (map[int64][]rolePath, error) {
+	res := make(map[int64][]rolePath, len(directRoles))
+	validities := make(map[int64][]validity, len(directRoles))
+	frontier := make(map[int64][]rolePath, len(directRoles))
+	for i := range directRoles {
+		ur := directRoles[i]
+		if _, ok := inactive[ur.RoleID]; ok {
+			continue
+		}
+		v := validity{startTime: ur.StartTime, endTime: ur.EndTime}
+		if addValidity(validities, ur.RoleID, v) {
+			rp := rolePath{path: []int64{ur.RoleID}, validity: v}
+			res[ur.RoleID] = append(res[ur.RoleID], rp)
+			frontier[ur.RoleID] = append(frontier[ur.RoleID], rp)
 		}
-		includedIDs = slice.Map(inclusions, func(_ int, src dao.RoleInclusion) int64 {
-			allRoleIDs[src.IncludedRoleID] = struct{}{}
-			return src.IncludedRoleID
-		})
 	}
 
-	// 你要根据 inclusions 里面的 IncludedRoleID 进步沿着包含链找下去
-	return mapx.Keys(allRoleIDs), nil
+	for len(frontier) > 0 {
+		inclusions, err := r.roleInclusionDAO.FindByBizIDAndIncludingRoleIDs(ctx, bizID, mapx.Keys(frontier))
+		if err != nil {
+			return nil, err
+		}
+		next := make(map[int64][]rolePath)
+		for i := range inclusions {
+			inc := inclusions[i]
+			if _, ok := inactive[inc.IncludedRoleID]; ok {
+				continue
+			}
+			for _, rp := range frontier[inc.IncludingRoleID] {
+				if !addValidity(validities, inc.IncludedRoleID, rp.validity) {
+					continue
+				}
+				nrp := rolePath{path: append(slices.Clone(rp.path), inc.IncludedRoleID), validity: rp.validity}
+				res[inc.IncludedRoleID] = append(res[inc.IncludedRoleID], nrp)
+				next[inc.IncludedRoleID] = append(next[inc.IncludedRoleID], nrp)
+			}
+		}
+		frontier = next
+	}
+	return res, nil
 }
 
-// getRolePermissions 获取指定角色ID列表对应的所有权限
-func (r *UserPermissionDefaultRepository) getRolePermissions(ctx context.Context, bizID, userID int64, roleIDs []int64) ([]domain.UserPermission, error) {
-	if len(roleIDs) == 0 {
+// getRolePermissions 获取指定角色对应的所有权限，权限的生效期就是角色对用户的生效期
+func (r *UserPermissionDefaultRepository) getRolePermissions(ctx context.Context, bizID, userID int64, roleValidities map[int64][]validity) ([]domain.UserPermission, error) {
+	if len(roleValidities) == 0 {
 		return []domain.UserPermission{}, nil
 	}
-	permissions, err := r.rolePermissionDAO.FindByBizIDAndRoleIDs(ctx, bizID, roleIDs)
+	permissions, err := r.rolePermissionDAO.FindByBizIDAndRoleIDs(ctx, bizID, mapx.Keys(roleValidities))
 	if err != nil {
 		return nil, err
 	}
-	// 将RolePermission转换为UserPermission格式
-	return slice.Map(permissions, func(_ int, src dao.RolePermission) domain.UserPermission {
-		return domain.UserPermission{
-			ID:     0,
-			BizID:  bizID,
-			UserID: userID,
-			Permission: domain.Permission{
-				ID:    src.PermissionID,
+	type uniqueKey struct {
+		permissionID int64
+		effect       domain.Effect
+		validity     validity
+	}
+	seen := make(map[uniqueKey]struct{}, len(permissions))
+	res := make([]domain.UserPermission, 0, len(permissions))
+	for i := range permissions {
+		src := permissions[i]
+		effect := domain.Effect(src.Effect)
+		if effect != domain.EffectDeny {
+			effect = domain.EffectAllow
+		}
+		for _, roleValidity := range roleValidities[src.RoleID] {
+			// 角色权限本身的生效期和角色对用户的生效期取交集
+			v, ok := roleValidity.intersect(validity{startTime: src.StartTime, endTime: src.EndTime})
+			if !ok {
+				continue
+			}
+			key := uniqueKey{permissionID: src.PermissionID, effect: effect, validity: v}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			// 将RolePermission转换为UserPermission格式
+			res = append(res, r.rolePermissionToUserPermission(bizID, userID, src, v))
+		}
+	}
+	return res, nil
+}
+
+func (r *UserPermissionDefaultRepository) rolePermissionToUserPermission(bizID, userID int64, src dao.RolePermission, v validity) domain.UserPermission {
+	effect := domain.Effect(src.Effect)
+	if effect != domain.EffectDeny {
+		effect = domain.EffectAllow
+	}
+	return domain.UserPermission{
+		ID:     0,
+		BizID:  bizID,
+		UserID: userID,
+		Permission: domain.Permission{
+			ID:    src.PermissionID,
+			BizID: src.BizID,
+			Resource: domain.Resource{
 				BizID: src.BizID,
-				Resource: domain.Resource{
-					BizID: src.BizID,
-					Type:  src.ResourceType,
-					Key:   src.ResourceKey,
-				},
-				Action: src.PermissionAction,
+				Type:  src.ResourceType,
+				Key:   src.ResourceKey,
 			},
-			StartTime: time.Now().UnixMilli(),
-			EndTime:   time.Now().AddDate(oneHundredYears, 0, 0).UnixMilli(),
-			Effect:    domain.EffectAllow,
-			Ctime:     src.Ctime,
-			Utime:     src.Utime,
-		}
-	}), nil
+			Action: src.PermissionAction,
+		},
+		StartTime: v.startTime,
+		EndTime:   v.endTime,
+		Effect:    effect,
+		Ctime:     src.Ctime,
+		Utime:     src.Utime,
+	}
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
 	permissionevt "gitee.com/flycash/permission-platform/internal/event/permission"
@@ -55,6 +56,21 @@ func (r *UserPermissionCachedRepository) Create(ctx context.Context, userPermiss
 	return created, err
 }
 
+func (r *UserPermissionCachedRepository) UpdateByBizIDAndID(ctx context.Context, userPermission domain.UserPermission) (domain.UserPermission, error) {
+	updated, err := r.repo.UpdateByBizIDAndID(ctx, userPermission)
+	if err != nil {
+		return domain.UserPermission{}, err
+	}
+	if err1 := r.Reload(ctx, []domain.User{{ID: updated.UserID, BizID: updated.BizID}}); err1 != nil {
+		r.logger.Warn("修改用户权限成功后，重新加载缓存失败",
+			elog.FieldErr(err1),
+			elog.Any("bizID", updated.BizID),
+			elog.Any("userID", updated.UserID),
+		)
+	}
+	return updated, nil
+}
+
 func (r *UserPermissionCachedRepository) Reload(ctx context.Context, users []domain.User) error {
 	var evt permissionevt.UserPermissionEvent
 	evt.Permissions = make(map[int64]permissionevt.UserPermission)
@@ -82,8 +98,10 @@ func (r *UserPermissionCachedRepository) Reload(ctx context.Context, users []dom
 							Key:  src.Permission.Resource.Key,
 							Type: src.Permission.Resource.Type,
 						},
-						Action: src.Permission.Action,
-						Effect: src.Effect.String(),
+						Action:    src.Permission.Action,
+						Effect:    src.Effect.String(),
+						StartTime: src.StartTime,
+						EndTime:   src.EndTime,
 					}
 				}),
 			}
@@ -142,10 +160,12 @@ func (r *UserPermissionCachedRepository) DeleteByBizIDAndID(ctx context.Context,
 	return nil
 }
 
+// GetAll 缓存中保存的是全部未失效的权限，返回前按照当前时间过滤，
+// 因此即便缓存是在权限失效之前写入的，过了失效时间也不会再返回该权限
 func (r *UserPermissionCachedRepository) GetAll(ctx context.Context, bizID, userID int64) ([]domain.UserPermission, error) {
 	perms, err := r.cache.Get(ctx, bizID, userID)
 	if err == nil {
-		return perms, nil
+		return r.filterValid(perms), nil
 	}
 
 	perms, err = r.repo.GetAll(ctx, bizID, userID)
@@ -165,5 +185,22 @@ func (r *UserPermissionCachedRepository) GetAll(ctx context.Context, bizID, user
 			elog.Any("userID", userID),
 		)
 	}
-	return perms, nil
+	return r.filterValid(perms), nil
+}
+
+func (r *UserPermissionCachedRepository) filterValid(perms []domain.UserPermission) []domain.UserPermission {
+	now := time.Now().UnixMilli()
+	return slice.FilterMap(perms, func(_ int, src domain.UserPermission) (domain.UserPermission, bool) {
+		return src, src.IsValidAt(now)
+	})
+}
+
+// FindSubjectSources 反向查找不走缓存
+func (r *UserPermissionCachedRepository) FindSubjectSources(ctx context.Context, bizID int64, resource domain.Resource, action string, afterUserID int64, limit int) ([]domain.PermissionSubject, int64, error) {
+	return r.repo.FindSubjectSources(ctx, bizID, resource, action, afterUserID, limit)
+}
+
+// GetAllWithSources 只用于排查问题，不走缓存
+func (r *UserPermissionCachedRepository) GetAllWithSources(ctx context.Context, bizID, userID int64) ([]domain.UserPermissionSource, error) {
+	return r.repo.GetAllWithSources(ctx, bizID, userID)
 }
@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"slices"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/repository/dao"
+	"gitee.com/flycash/permission-platform/pkg/reskey"
+	"github.com/ecodeclub/ekit/mapx"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// FindSubjectSources 反向查找拥有权限的用户。
+// 先找到覆盖了该资源和操作的权限、授予了这些权限的角色，再通过闭包表找到直接或者间接包含这些角色的角色，
+// 这些数据量都不大；用户量大的部分按照用户ID翻页，每一页只加载这一页用户的授予记录
+func (r *UserPermissionDefaultRepository) FindSubjectSources(ctx context.Context, bizID int64, resource domain.Resource,
+	action string, afterUserID int64, limit int,
+) ([]domain.PermissionSubject, int64, error) {
+	// 1. 覆盖了该资源和操作的权限
+	perms, err := r.permissionDAO.FindByBizIDAndResourceType(ctx, bizID, resource.Type)
+	if err != nil {
+		return nil, 0, err
+	}
+	hierarchy, err := r.getActionHierarchy(ctx, bizID)
+	if err != nil {
+		return nil, 0, err
+	}
+	permIDs := make([]int64, 0, len(perms))
+	for i := range perms {
+		p := perms[i]
+		if reskey.Match(p.ResourceKey, resource.Key) &&
+			(reskey.MatchAction(p.Action, action) || slices.Contains(hierarchy.Implied(p.Action), action)) {
+			permIDs = append(permIDs, p.ID)
+		}
+	}
+	if len(permIDs) == 0 {
+		return []domain.PermissionSubject{}, 0, nil
+	}
+
+	// 2. 授予了这些权限的角色，以及直接或者间接包含这些角色的角色
+	rolePerms, err := r.rolePermissionDAO.FindByBizIDAndPermissionIDs(ctx, bizID, permIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	rolePerms = slice.FilterMap(rolePerms, func(_ int, src dao.RolePermission) (dao.RolePermission, bool) {
+		return src, coversAction(src.PermissionAction, domain.Effect(src.Effect), action)
+	})
+	grants := make(map[int64][]dao.RolePermission, len(rolePerms))
+	allowRoles := make(map[int64]struct{}, len(rolePerms))
+	for i := range rolePerms {
+		grants[rolePerms[i].RoleID] = append(grants[rolePerms[i].RoleID], rolePerms[i])
+		if !domain.Effect(rolePerms[i].Effect).IsDeny() {
+			allowRoles[rolePerms[i].RoleID] = struct{}{}
+		}
+	}
+	var closures []dao.RoleInclusionClosure
+	if len(grants) > 0 {
+		closures, err = r.roleInclusionDAO.FindAncestorsByBizIDAndRoleIDs(ctx, bizID, mapx.Keys(grants))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	holderRoles := make(map[int64]struct{}, len(grants)+len(closures))
+	allowHolderRoles := make(map[int64]struct{}, len(allowRoles)+len(closures))
+	for roleID := range grants {
+		holderRoles[roleID] = struct{}{}
+	}
+	for roleID := range allowRoles {
+		allowHolderRoles[roleID] = struct{}{}
+	}
+	for i := range closures {
+		holderRoles[closures[i].AncestorRoleID] = struct{}{}
+		if _, ok := allowRoles[closures[i].DescendantRoleID]; ok {
+			allowHolderRoles[closures[i].AncestorRoleID] = struct{}{}
+		}
+	}
+
+	// 3. 只拿到过拒绝的用户不可能被允许，所以只按照允许的授予记录翻页
+	userIDs, next, err := r.pageSubjectIDs(ctx, bizID, permIDs, mapx.Keys(allowHolderRoles), afterUserID, limit)
+	if err != nil || len(userIDs) == 0 {
+		return []domain.PermissionSubject{}, 0, err
+	}
+
+	// 4. 这一页用户的所有相关授予记录
+	userPerms, err := r.userPermissionDAO.FindUnexpiredByBizIDAndUserIDsAndPermissionIDs(ctx, bizID, userIDs, permIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	var userRoles []dao.UserRole
+	if len(holderRoles) > 0 {
+		userRoles, err = r.userRoleDAO.FindUnexpiredByBizIDAndUserIDsAndRoleIDs(ctx, bizID, userIDs, mapx.Keys(holderRoles))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	var inclusions []dao.RoleInclusion
+	if len(closures) > 0 {
+		inclusions, err = r.roleInclusionDAO.FindByBizIDAndIncludedRoleIDs(ctx, bizID, mapx.Keys(holderRoles))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	sources := make(map[int64][]domain.UserPermissionSource, len(userIDs))
+	for i := range userPerms {
+		src := userPerms[i]
+		if !coversAction(src.PermissionAction, domain.Effect(src.Effect), action) {
+			continue
+		}
+		sources[src.UserID] = append(sources[src.UserID], withImpliedAction(domain.UserPermissionSource{
+			UserPermission: r.toDomain(src),
+		}, action))
+	}
+	paths := newRolePathFinder(inclusions)
+	for i := range userRoles {
+		ur := userRoles[i]
+		roleValidity := validity{startTime: ur.StartTime, endTime: ur.EndTime}
+		for grantRoleID, rps := range grants {
+			path := paths.find(ur.RoleID, grantRoleID)
+			if path == nil {
+				continue
+			}
+			for j := range rps {
+				v, ok := roleValidity.intersect(validity{startTime: rps[j].StartTime, endTime: rps[j].EndTime})
+				if !ok {
+					continue
+				}
+				sources[ur.UserID] = append(sources[ur.UserID], withImpliedAction(domain.UserPermissionSource{
+					UserPermission: r.rolePermissionToUserPermission(bizID, ur.UserID, rps[j], v),
+					RolePath:       path,
+				}, action))
+			}
+		}
+	}
+	return slice.Map(userIDs, func(_ int, userID int64) domain.PermissionSubject {
+		return domain.PermissionSubject{UserID: userID, Sources: sources[userID]}
+	}), next, nil
+}
+
+// pageSubjectIDs 合并直接授予和角色授予两路有序的用户ID，取前 limit 个
+func (r *UserPermissionDefaultRepository) pageSubjectIDs(ctx context.Context, bizID int64, permIDs, roleIDs []int64,
+	afterUserID int64, limit int,
+) ([]int64, int64, error) {
+	direct, err := r.userPermissionDAO.FindUnexpiredUserIDsByBizIDAndPermissionIDs(ctx, bizID, permIDs,
+		domain.EffectAllow.String(), afterUserID, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	var viaRoles []int64
+	if len(roleIDs) > 0 {
+		viaRoles, err = r.userRoleDAO.FindUnexpiredUserIDsByBizIDAndRoleIDs(ctx, bizID, roleIDs, afterUserID, limit)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	userIDs, next := mergeUserIDs(direct, viaRoles, limit)
+	return userIDs, next, nil
+}
+
+// mergeUserIDs 合并两个升序、各自去重的用户ID列表，取前 limit 个。
+// 取满了 limit 个的时候，最后一个用户ID就是下一页的游标，否则没有下一页
+func mergeUserIDs(a, b []int64, limit int) ([]int64, int64) {
+	res := make([]int64, 0, min(len(a)+len(b), limit))
+	for len(res) < limit && (len(a) > 0 || len(b) > 0) {
+		var id int64
+		switch {
+		case len(b) == 0 || (len(a) > 0 && a[0] < b[0]):
+			id, a = a[0], a[1:]
+		case len(a) == 0 || b[0] < a[0]:
+			id, b = b[0], b[1:]
+		default:
+			id, a, b = a[0], a[1:], b[1:]
+		}
+		res = append(res, id)
+	}
+	if len(res) < limit {
+		return res, 0
+	}
+	return res, res[len(res)-1]
+}
+
+// coversAction 授权时的操作是否覆盖了 action，允许的授权还要考虑操作层级，这一步在调用方用权限过滤时已经完成
+func coversAction(grantedAction string, effect domain.Effect, action string) bool {
+	return !effect.IsDeny() || reskey.MatchAction(grantedAction, action)
+}
+
+// withImpliedAction 授权时的操作蕴含了 action 的时候，和 GetAllWithSources 一样记录成从哪个操作展开出来的
+func withImpliedAction(src domain.UserPermissionSource, action string) domain.UserPermissionSource {
+	granted := src.UserPermission.Permission.Action
+	if reskey.MatchAction(granted, action) {
+		return src
+	}
+	src.UserPermission.Permission.Action = action
+	src.ImpliedBy = granted
+	return src
+}
+
+// rolePathFinder 在角色包含关系上查找最短路径，结果按照起点缓存
+type rolePathFinder struct {
+	adj  map[int64][]int64
+	prev map[int64]map[int64]int64
+}
+
+func newRolePathFinder(inclusions []dao.RoleInclusion) *rolePathFinder {
+	adj := make(map[int64][]int64, len(inclusions))
+	for i := range inclusions {
+		adj[inclusions[i].IncludingRoleID] = append(adj[inclusions[i].IncludingRoleID], inclusions[i].IncludedRoleID)
+	}
+	return &rolePathFinder{adj: adj, prev: make(map[int64]map[int64]int64)}
+}
+
+// find 返回从 from 到 to 的最短路径，包括两端，到达不了的时候返回 nil
+func (f *rolePathFinder) find(from, to int64) []int64 {
+	prev, ok := f.prev[from]
+	if !ok {
+		prev = map[int64]int64{from: from}
+		queue := []int64{from}
+		for len(queue) > 0 {
+			cur := queue[0]
+			queue = queue[1:]
+			for _, next := range f.adj[cur] {
+				if _, visited := prev[next]; !visited {
+					prev[next] = cur
+					queue = append(queue, next)
+				}
+			}
+		}
+		f.prev[from] = prev
+	}
+	if _, reachable := prev[to]; !reachable {
+		return nil
+	}
+	path := []int64{to}
+	for node := to; node != from; {
+		node = prev[node]
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+	return path
+}
@@ -0,0 +1,50 @@
+//go:build unit
+
+package repository
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/repository/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeUserIDs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		a, b     []int64
+		limit    int
+		want     []int64
+		wantNext int64
+	}{
+		{name: "都为空", limit: 3, want: []int64{}},
+		{name: "去重并且有序", a: []int64{1, 3, 5}, b: []int64{2, 3, 6}, limit: 10, want: []int64{1, 2, 3, 5, 6}},
+		{name: "取满了还有下一页", a: []int64{1, 3, 5}, b: []int64{2, 3, 6}, limit: 3, want: []int64{1, 2, 3}, wantNext: 3},
+		{name: "只有一路", b: []int64{4, 7}, limit: 2, want: []int64{4, 7}, wantNext: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, next := mergeUserIDs(tt.a, tt.b, tt.limit)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantNext, next)
+		})
+	}
+}
+
+func TestRolePathFinder(t *testing.T) {
+	t.Parallel()
+	// 1 -> 2 -> 3，1 -> 3，4 -> 2
+	finder := newRolePathFinder([]dao.RoleInclusion{
+		{IncludingRoleID: 1, IncludedRoleID: 2},
+		{IncludingRoleID: 2, IncludedRoleID: 3},
+		{IncludingRoleID: 1, IncludedRoleID: 3},
+		{IncludingRoleID: 4, IncludedRoleID: 2},
+	})
+	assert.Equal(t, []int64{1}, finder.find(1, 1))
+	assert.Equal(t, []int64{1, 3}, finder.find(1, 3))
+	assert.Equal(t, []int64{4, 2, 3}, finder.find(4, 3))
+	assert.Nil(t, finder.find(3, 1))
+	assert.Nil(t, finder.find(1, 4))
+}
@@ -0,0 +1,191 @@
+//go:build unit
+
+package repository
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidity_Intersect(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		a, b   validity
+		want   validity
+		wantOk bool
+	}{
+		{
+			name:   "both unbounded",
+			a:      validity{},
+			b:      validity{},
+			want:   validity{},
+			wantOk: true,
+		},
+		{
+			name:   "one unbounded",
+			a:      validity{startTime: 100, endTime: 200},
+			b:      validity{},
+			want:   validity{startTime: 100, endTime: 200},
+			wantOk: true,
+		},
+		{
+			name:   "overlap",
+			a:      validity{startTime: 100, endTime: 300},
+			b:      validity{startTime: 200, endTime: 400},
+			want:   validity{startTime: 200, endTime: 300},
+			wantOk: true,
+		},
+		{
+			name:   "only end bounded",
+			a:      validity{startTime: 100},
+			b:      validity{endTime: 150},
+			want:   validity{startTime: 100, endTime: 150},
+			wantOk: true,
+		},
+		{
+			name:   "disjoint",
+			a:      validity{startTime: 100, endTime: 200},
+			b:      validity{startTime: 300, endTime: 400},
+			wantOk: false,
+		},
+	}
+	for idx := range tests {
+		tt := tests[idx]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := tt.a.intersect(tt.b)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, got)
+			}
+			// 交集满足交换律
+			got2, ok2 := tt.b.intersect(tt.a)
+			assert.Equal(t, ok, ok2)
+			assert.Equal(t, got, got2)
+		})
+	}
+}
+
+func TestUserPermissionDefaultRepository_expandImpliedActions(t *testing.T) {
+	t.Parallel()
+
+	hierarchy := domain.NewActionHierarchy([]domain.ActionDefinition{
+		{Name: "admin", Implies: []string{"write"}},
+		{Name: "write", Implies: []string{"read"}},
+	})
+	newPermission := func(action string, effect domain.Effect) domain.UserPermission {
+		return domain.UserPermission{
+			Permission: domain.Permission{
+				Resource: domain.Resource{Type: "doc", Key: "/docs/1"},
+				Action:   action,
+			},
+			EndTime: 1000,
+			Effect:  effect,
+		}
+	}
+	actionsOf := func(perms []domain.UserPermission) []string {
+		return slice.Map(perms, func(_ int, src domain.UserPermission) string {
+			return src.Permission.Action + ":" + src.Effect.String()
+		})
+	}
+
+	tests := []struct {
+		name      string
+		hierarchy domain.ActionHierarchy
+		perms     []domain.UserPermission
+		want      []string
+	}{
+		{
+			name:      "没有操作定义",
+			hierarchy: nil,
+			perms:     []domain.UserPermission{newPermission("admin", domain.EffectAllow)},
+			want:      []string{"admin:allow"},
+		},
+		{
+			name:      "逐级展开",
+			hierarchy: hierarchy,
+			perms:     []domain.UserPermission{newPermission("admin", domain.EffectAllow)},
+			want:      []string{"admin:allow", "write:allow", "read:allow"},
+		},
+		{
+			name:      "拒绝不展开",
+			hierarchy: hierarchy,
+			perms:     []domain.UserPermission{newPermission("write", domain.EffectDeny)},
+			want:      []string{"write:deny"},
+		},
+		{
+			name:      "已有的权限不重复展开",
+			hierarchy: hierarchy,
+			perms: []domain.UserPermission{
+				newPermission("read", domain.EffectAllow),
+				newPermission("write", domain.EffectAllow),
+			},
+			want: []string{"read:allow", "write:allow"},
+		},
+	}
+	for idx := range tests {
+		tt := tests[idx]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := &UserPermissionDefaultRepository{}
+			got := r.expandImpliedActions(tt.perms, tt.hierarchy)
+			assert.Equal(t, tt.want, actionsOf(got))
+			for i := range got {
+				assert.Equal(t, tt.perms[0].Permission.Resource, got[i].Permission.Resource)
+				assert.Equal(t, tt.perms[0].EndTime, got[i].EndTime)
+			}
+		})
+	}
+}
+
+func TestInactiveRoleIDs(t *testing.T) {
+	t.Parallel()
+	set := func(ids ...int64) map[int64]struct{} {
+		res := make(map[int64]struct{}, len(ids))
+		for _, id := range ids {
+			res[id] = struct{}{}
+		}
+		return res
+	}
+	constraints := []domain.SoDConstraint{{ID: 1, Type: domain.SoDDynamic, RoleIDs: []int64{1, 2, 3}}}
+	tests := []struct {
+		name      string
+		held      map[int64]struct{}
+		activated map[int64]struct{}
+		want      map[int64]struct{}
+	}{
+		{
+			name: "只拥有一个角色的时候不需要激活",
+			held: set(1, 4),
+			want: set(),
+		},
+		{
+			name: "拥有多个角色但是没有激活",
+			held: set(1, 2, 4),
+			want: set(1, 2),
+		},
+		{
+			name:      "只有激活的角色生效",
+			held:      set(1, 2, 3),
+			activated: set(2, 4),
+			want:      set(1, 3),
+		},
+		{
+			name:      "激活了多个互斥的角色",
+			held:      set(1, 2),
+			activated: set(1, 2),
+			want:      set(1, 2),
+		},
+	}
+	for idx := range tests {
+		tt := tests[idx]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, inactiveRoleIDs(constraints, tt.held, tt.activated))
+		})
+	}
+}
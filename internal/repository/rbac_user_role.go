@@ -13,10 +13,15 @@ var _ UserRoleRepository = (*UserRoleDefaultRepository)(nil)
 
 // UserRoleRepository 用户角色关系仓储接口
 type UserRoleRepository interface {
-	Create(ctx context.Context, userRole domain.UserRole) (domain.UserRole, error)
+	// Create check 不为 nil 的时候在锁住用户的事务里、写入之前执行，返回错误的时候不会写入
+	Create(ctx context.Context, userRole domain.UserRole, check SoDCheck) (domain.UserRole, error)
+	// UpdateByBizIDAndID 修改授予的生效时间和失效时间，check 的含义和 Create 一样
+	UpdateByBizIDAndID(ctx context.Context, userRole domain.UserRole, check SoDCheck) (domain.UserRole, error)
 
 	FindByBizID(ctx context.Context, bizID int64) ([]domain.UserRole, error)
 	FindByBizIDAndUserID(ctx context.Context, bizID, userID int64) ([]domain.UserRole, error)
+	// CountMembersByRoleIDs 每个角色尚未失效的授予人数，没有授予的角色不在结果里
+	CountMembersByRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) (map[int64]int64, error)
 
 	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
 }
@@ -35,8 +40,8 @@ func NewUserRoleDefaultRepository(userRoleDAO dao.UserRoleDAO) *UserRoleDefaultR
 	}
 }
 
-func (r *UserRoleDefaultRepository) Create(ctx context.Context, userRole domain.UserRole) (domain.UserRole, error) {
-	created, err := r.userRoleDAO.Create(ctx, r.toEntity(userRole))
+func (r *UserRoleDefaultRepository) Create(ctx context.Context, userRole domain.UserRole, check SoDCheck) (domain.UserRole, error) {
+	created, err := r.userRoleDAO.Create(ctx, r.toEntity(userRole), toGrantCheck(check))
 	if err != nil {
 		r.logger.Error("授予角色权限失败",
 			elog.Int64("bizId", userRole.BizID),
@@ -58,6 +63,27 @@ func (r *UserRoleDefaultRepository) Create(ctx context.Context, userRole domain.
 	return r.toDomain(created), nil
 }
 
+func (r *UserRoleDefaultRepository) UpdateByBizIDAndID(ctx context.Context, userRole domain.UserRole, check SoDCheck) (domain.UserRole, error) {
+	err := r.userRoleDAO.UpdateByBizIDAndID(ctx, r.toEntity(userRole), toGrantCheck(check))
+	if err != nil {
+		r.logger.Error("修改用户角色的有效期失败",
+			elog.Int64("bizId", userRole.BizID),
+			elog.Int64("userRoleId", userRole.ID),
+			elog.Int64("userId", userRole.UserID),
+			elog.Int64("roleId", userRole.Role.ID),
+			elog.FieldErr(err),
+		)
+		return domain.UserRole{}, err
+	}
+	r.logger.Info("修改用户角色的有效期",
+		elog.Int64("bizId", userRole.BizID),
+		elog.Int64("userRoleId", userRole.ID),
+		elog.Int64("startTime", userRole.StartTime),
+		elog.Int64("endTime", userRole.EndTime),
+	)
+	return r.FindByBizIDAndID(ctx, userRole.BizID, userRole.ID)
+}
+
 func (r *UserRoleDefaultRepository) FindByBizID(ctx context.Context, bizID int64) ([]domain.UserRole, error) {
 	userRoles, err := r.userRoleDAO.FindByBizID(ctx, bizID)
 	if err != nil {
@@ -80,6 +106,13 @@ func (r *UserRoleDefaultRepository) FindByBizIDAndUserID(ctx context.Context, bi
 	}), nil
 }
 
+func (r *UserRoleDefaultRepository) CountMembersByRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) (map[int64]int64, error) {
+	if len(roleIDs) == 0 {
+		return map[int64]int64{}, nil
+	}
+	return r.userRoleDAO.CountUnexpiredUsersByBizIDAndRoleIDs(ctx, bizID, roleIDs)
+}
+
 func (r *UserRoleDefaultRepository) FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.UserRole, error) {
 	ur, err := r.userRoleDAO.FindByBizIDAndID(ctx, bizID, id)
 	if err != nil {
@@ -115,6 +148,40 @@ func (r *UserRoleDefaultRepository) DeleteByBizIDAndID(ctx context.Context, bizI
 	return err
 }
 
+// FindExpired 查找在 now 之前就已经失效的用户角色
+func (r *UserRoleDefaultRepository) FindExpired(ctx context.Context, now int64, limit int) ([]domain.UserRole, error) {
+	userRoles, err := r.userRoleDAO.FindExpired(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(userRoles, func(_ int, src dao.UserRole) domain.UserRole {
+		return r.toDomain(src)
+	}), nil
+}
+
+// DeleteExpired 撤销已经失效的用户角色
+func (r *UserRoleDefaultRepository) DeleteExpired(ctx context.Context, userRoles []domain.UserRole, now int64) error {
+	if len(userRoles) == 0 {
+		return nil
+	}
+	ids := slice.Map(userRoles, func(_ int, src domain.UserRole) int64 {
+		return src.ID
+	})
+	cnt, err := r.userRoleDAO.DeleteExpiredByIDs(ctx, ids, now)
+	if err != nil {
+		r.logger.Error("撤销已失效的角色权限失败",
+			elog.FieldErr(err),
+			elog.Any("userRoleIds", ids),
+		)
+		return err
+	}
+	r.logger.Info("撤销已失效的角色权限",
+		elog.Any("userRoleIds", ids),
+		elog.Int64("deleted", cnt),
+	)
+	return nil
+}
+
 func (r *UserRoleDefaultRepository) toEntity(ur domain.UserRole) dao.UserRole {
 	return dao.UserRole{
 		ID:        ur.ID,
@@ -28,8 +28,8 @@ func NewUserRoleReloadCacheRepository(
 	}
 }
 
-func (r *UserRoleReloadCacheRepository) Create(ctx context.Context, userRole domain.UserRole) (domain.UserRole, error) {
-	created, err := r.repo.Create(ctx, userRole)
+func (r *UserRoleReloadCacheRepository) Create(ctx context.Context, userRole domain.UserRole, check SoDCheck) (domain.UserRole, error) {
+	created, err := r.repo.Create(ctx, userRole, check)
 	if err != nil {
 		return domain.UserRole{}, err
 	}
@@ -43,10 +43,29 @@ func (r *UserRoleReloadCacheRepository) Create(ctx context.Context, userRole dom
 	return created, nil
 }
 
+func (r *UserRoleReloadCacheRepository) UpdateByBizIDAndID(ctx context.Context, userRole domain.UserRole, check SoDCheck) (domain.UserRole, error) {
+	updated, err := r.repo.UpdateByBizIDAndID(ctx, userRole, check)
+	if err != nil {
+		return domain.UserRole{}, err
+	}
+	if err1 := r.cacheReloader.Reload(ctx, []domain.User{{ID: updated.UserID, BizID: updated.BizID}}); err1 != nil {
+		r.logger.Warn("修改用户角色成功后，重新加载受影响用户的缓存失败",
+			elog.FieldErr(err1),
+			elog.Any("bizID", updated.BizID),
+			elog.Any("userID", updated.UserID),
+		)
+	}
+	return updated, nil
+}
+
 func (r *UserRoleReloadCacheRepository) FindByBizIDAndUserID(ctx context.Context, bizID, userID int64) ([]domain.UserRole, error) {
 	return r.repo.FindByBizIDAndUserID(ctx, bizID, userID)
 }
 
+func (r *UserRoleReloadCacheRepository) CountMembersByRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) (map[int64]int64, error) {
+	return r.repo.CountMembersByRoleIDs(ctx, bizID, roleIDs)
+}
+
 func (r *UserRoleReloadCacheRepository) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
 	deleted, err := r.repo.FindByBizIDAndID(ctx, bizID, id)
 	if err != nil {
@@ -0,0 +1,254 @@
+// Package analyzer 静态分析 ABAC 策略，找出永远不会满足或者总是满足的规则、多余的规则、
+// 运算符和属性类型不匹配、引用了已经删除的属性，以及同一个权限上允许和拒绝的策略条件重叠。
+//
+// 分析和 logicOperatorExecutor 的判定逻辑保持一致：不合法的规则判定结果总是 false，
+// 属性没有取值的时候比较的结果也是 false，所以 NOT 一个比较在属性没有取值的时候是 true
+package analyzer
+
+import (
+	"fmt"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/service/abac/evaluator"
+	"gitee.com/flycash/permission-platform/internal/service/abac/expr"
+)
+
+// Analyze 分析业务下的策略，策略需要包含规则以及关联的权限。
+// 表达式策略只检查引用的属性和类型，不参与可满足性和冲突的分析
+func Analyze(policies []domain.Policy, defs domain.BizAttrDefinition) []domain.PolicyIssue {
+	a := &analyzer{defs: defs, selector: evaluator.NewSelector()}
+	conds := make([]*node, len(policies))
+	analyzable := make([]bool, len(policies))
+	for idx := range policies {
+		conds[idx], analyzable[idx] = a.analyzePolicy(policies[idx])
+	}
+	for idx := range policies {
+		for jdx := idx + 1; jdx < len(policies); jdx++ {
+			if analyzable[idx] && analyzable[jdx] {
+				a.checkConflict(policies[idx], policies[jdx], conds[idx], conds[jdx])
+			}
+		}
+	}
+	return a.issues
+}
+
+type analyzer struct {
+	defs     domain.BizAttrDefinition
+	selector evaluator.Selector
+	issues   []domain.PolicyIssue
+	// policyID 正在分析的策略
+	policyID int64
+}
+
+func (a *analyzer) report(level domain.PolicyIssueLevel, kind domain.PolicyIssueKind, ruleID int64, format string, args ...any) {
+	a.issues = append(a.issues, domain.PolicyIssue{
+		Level:    level,
+		Kind:     kind,
+		PolicyID: a.policyID,
+		RuleID:   ruleID,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// analyzePolicy 返回策略的条件，也就是所有根规则的且，没有办法分析的时候返回 false
+func (a *analyzer) analyzePolicy(policy domain.Policy) (*node, bool) {
+	a.policyID = policy.ID
+	if policy.ExecuteType == domain.ExpressionType {
+		a.analyzeExpression(policy)
+		return nil, false
+	}
+	roots := make([]*node, 0, len(policy.Rules))
+	for idx := range policy.Rules {
+		roots = append(roots, a.compile(policy.Rules[idx]))
+	}
+	a.checkUnsatisfiable(roots)
+	a.checkAlwaysTrue(roots)
+	a.checkRedundant(roots)
+	return all(roots), true
+}
+
+func (a *analyzer) analyzeExpression(policy domain.Policy) {
+	prog, err := expr.Compile(policy.Expression)
+	if err != nil {
+		a.report(domain.PolicyIssueError, domain.PolicyIssueInvalidRule, 0, "策略表达式错误: %s", err)
+		return
+	}
+	for _, ref := range prog.Refs() {
+		if _, ok := a.defs.DefsOf(ref.EntityType).GetByName(ref.Name); !ok {
+			a.report(domain.PolicyIssueError, domain.PolicyIssueUndefinedAttribute, 0, "属性 %s 没有定义或者已经被删除", ref)
+		}
+	}
+	for _, cmp := range prog.Comparisons() {
+		def, ok := a.defs.DefsOf(cmp.Attr.EntityType).GetByName(cmp.Attr.Name)
+		if !ok {
+			continue
+		}
+		if !cmp.Operator.SupportedBy(def.DataType) {
+			a.report(domain.PolicyIssueError, domain.PolicyIssueTypeMismatch, 0,
+				"%s 不能用于 %s 类型的属性 %s", cmp.Operator, def.DataType, cmp.Attr)
+		}
+		if cmp.Value == nil {
+			continue
+		}
+		valueDef, ok := a.defs.DefsOf(cmp.Value.EntityType).GetByName(cmp.Value.Name)
+		if ok && valueDef.DataType != def.DataType {
+			a.report(domain.PolicyIssueError, domain.PolicyIssueTypeMismatch, 0,
+				"属性 %s 和 %s 的类型不一致", cmp.Attr, cmp.Value)
+		}
+	}
+}
+
+// checkUnsatisfiable 找出永远不会满足的规则，只报告最小的那个
+func (a *analyzer) checkUnsatisfiable(roots []*node) {
+	var visit func(n *node) bool
+	visit = func(n *node) bool {
+		if n == nil {
+			return false
+		}
+		// 不合法的规则已经报告过了
+		if n.kind == kindFalse {
+			return true
+		}
+		childUnsat := false
+		for _, child := range n.children() {
+			childUnsat = visit(child) || childUnsat
+		}
+		d, ok := toDNF(n, false)
+		if !ok || d.satisfiable(false) {
+			return false
+		}
+		if !childUnsat {
+			a.report(domain.PolicyIssueError, domain.PolicyIssueUnsatisfiable, n.rule.ID, "规则永远不会满足")
+		}
+		return true
+	}
+	anyUnsat := false
+	for _, root := range roots {
+		anyUnsat = visit(root) || anyUnsat
+	}
+	if anyUnsat || len(roots) < 2 {
+		return
+	}
+	if d, ok := toDNF(all(roots), false); ok && !d.satisfiable(false) {
+		a.report(domain.PolicyIssueError, domain.PolicyIssueUnsatisfiable, 0, "规则之间互相矛盾，策略永远不会满足")
+	}
+}
+
+// checkAlwaysTrue 找出只要属性有取值就总是满足的规则，只报告最小的那个
+func (a *analyzer) checkAlwaysTrue(roots []*node) {
+	var visit func(n *node) bool
+	visit = func(n *node) bool {
+		if n == nil || n.kind == kindFalse {
+			return false
+		}
+		childTrue := false
+		for _, child := range n.children() {
+			childTrue = visit(child) || childTrue
+		}
+		d, ok := toDNF(n, true)
+		if !ok || d.satisfiable(true) {
+			return false
+		}
+		if !childTrue {
+			a.report(domain.PolicyIssueWarning, domain.PolicyIssueAlwaysTrue, n.rule.ID, "只要属性有取值，规则就总是满足")
+		}
+		return true
+	}
+	for _, root := range roots {
+		visit(root)
+	}
+}
+
+// checkRedundant 找出连续的 AND 或者 OR 里面多余的规则，根规则之间是且的关系
+func (a *analyzer) checkRedundant(roots []*node) {
+	a.checkChain(flatten(roots, kindAnd), kindAnd)
+	var visit func(n *node, parent nodeKind)
+	visit = func(n *node, parent nodeKind) {
+		if n == nil {
+			return
+		}
+		if (n.kind == kindAnd || n.kind == kindOr) && n.kind != parent {
+			a.checkChain(flatten(n.children(), n.kind), n.kind)
+		}
+		for _, child := range n.children() {
+			visit(child, n.kind)
+		}
+	}
+	for _, root := range roots {
+		visit(root, kindAnd)
+	}
+}
+
+// checkChain AND 里面其它规则满足的时候一定满足的规则是多余的，
+// OR 里面满足的时候其它规则一定有一个满足的规则是多余的
+func (a *analyzer) checkChain(members []*node, kind nodeKind) {
+	if len(members) < 2 {
+		return
+	}
+	// 整体永远不会满足或者总是满足的时候已经报告过了
+	if kind == kindAnd {
+		if d, ok := toDNF(all(members), false); !ok || !d.satisfiable(false) {
+			return
+		}
+	} else if d, ok := toDNF(anyOf(members), true); !ok || !d.satisfiable(true) {
+		return
+	}
+	remaining := members
+	for idx := 0; idx < len(remaining) && len(remaining) > 1; {
+		member := remaining[idx]
+		if member.kind == kindFalse {
+			idx++
+			continue
+		}
+		others := make([]*node, 0, len(remaining)-1)
+		others = append(others, remaining[:idx]...)
+		others = append(others, remaining[idx+1:]...)
+		var check *node
+		if kind == kindAnd {
+			check = all([]*node{all(others), not(member)})
+		} else {
+			check = all([]*node{member, not(anyOf(others))})
+		}
+		if d, ok := toDNF(check, false); ok && !d.satisfiable(false) {
+			a.report(domain.PolicyIssueWarning, domain.PolicyIssueRedundant, member.rule.ID, "规则是多余的，去掉之后不影响判定结果")
+			remaining = others
+			continue
+		}
+		idx++
+	}
+}
+
+// checkConflict 两个策略在同一个权限上一个允许一个拒绝，并且条件可以同时满足
+func (a *analyzer) checkConflict(p1, p2 domain.Policy, c1, c2 *node) {
+	for idx := range p1.Permissions {
+		permissionID := p1.Permissions[idx].Permission.ID
+		effect, ok := p2.EffectOf(permissionID)
+		if !ok || effect == p1.Permissions[idx].Effect {
+			continue
+		}
+		d, ok := toDNF(all([]*node{c1, c2}), false)
+		if !ok || !d.satisfiable(false) {
+			continue
+		}
+		issue := domain.PolicyIssue{
+			Level:           domain.PolicyIssueWarning,
+			Kind:            domain.PolicyIssueConflict,
+			PolicyID:        p1.ID,
+			RelatedPolicyID: p2.ID,
+			PermissionID:    permissionID,
+			Message: fmt.Sprintf("策略 %d 和策略 %d 在权限 %d 上一个允许一个拒绝，条件有重叠，同时满足的时候由合并算法决定结果",
+				p1.ID, p2.ID, permissionID),
+		}
+		if implies(c1, c2) && implies(c2, c1) {
+			issue.Level = domain.PolicyIssueError
+			issue.Message = fmt.Sprintf("策略 %d 和策略 %d 在权限 %d 上一个允许一个拒绝，条件完全相同", p1.ID, p2.ID, permissionID)
+		}
+		a.issues = append(a.issues, issue)
+	}
+}
+
+// implies c1 满足的时候 c2 一定满足
+func implies(c1, c2 *node) bool {
+	d, ok := toDNF(all([]*node{c1, not(c2)}), false)
+	return ok && !d.satisfiable(false)
+}
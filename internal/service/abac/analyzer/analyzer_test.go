@@ -0,0 +1,250 @@
+//go:build unit
+
+package analyzer
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+)
+
+var testDefs = func() domain.BizAttrDefinition {
+	defs := domain.AttrDefs{
+		{ID: 1, Name: "age", DataType: domain.DataTypeNumber, EntityType: domain.EntityTypeSubject},
+		{ID: 2, Name: "dept", DataType: domain.DataTypeString, EntityType: domain.EntityTypeSubject},
+		{ID: 3, Name: "admin", DataType: domain.DataTypeBoolean, EntityType: domain.EntityTypeSubject},
+		{ID: 4, Name: "score", DataType: domain.DataTypeFloat, EntityType: domain.EntityTypeSubject},
+		{ID: 5, Name: "owner_dept", DataType: domain.DataTypeString, EntityType: domain.EntityTypeResource},
+	}
+	return domain.BizAttrDefinition{
+		SubjectAttrDefs:  defs[:4],
+		ResourceAttrDefs: defs[4:],
+		AllDefs:          defs.Map(),
+	}
+}()
+
+func leaf(id, attrID int64, op domain.RuleOperator, value string) domain.PolicyRule {
+	return domain.PolicyRule{ID: id, AttrDef: domain.AttributeDefinition{ID: attrID}, Operator: op, Value: value}
+}
+
+func logic(id int64, op domain.RuleOperator, left, right domain.PolicyRule) domain.PolicyRule {
+	return domain.PolicyRule{ID: id, Operator: op, LeftRule: &left, RightRule: &right}
+}
+
+func negate(id int64, right domain.PolicyRule) domain.PolicyRule {
+	return domain.PolicyRule{ID: id, Operator: domain.NOT, RightRule: &right}
+}
+
+func policy(id int64, effect domain.Effect, rules ...domain.PolicyRule) domain.Policy {
+	return domain.Policy{
+		ID:    id,
+		Rules: rules,
+		Permissions: []domain.UserPermission{
+			{Permission: domain.Permission{ID: 1}, Effect: effect},
+		},
+	}
+}
+
+// issue 只比较问题的位置和类型，不比较描述
+type issue struct {
+	kind     domain.PolicyIssueKind
+	level    domain.PolicyIssueLevel
+	policyID int64
+	ruleID   int64
+}
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		policies []domain.Policy
+		want     []issue
+	}{
+		{
+			name: "没有问题",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, logic(10, domain.AND,
+					leaf(11, 1, domain.Greater, "18"),
+					leaf(12, 2, domain.Equals, "dev"))),
+			},
+		},
+		{
+			name: "范围矛盾",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, logic(10, domain.AND,
+					leaf(11, 1, domain.Greater, "10"),
+					leaf(12, 1, domain.Less, "5"))),
+			},
+			want: []issue{{domain.PolicyIssueUnsatisfiable, domain.PolicyIssueError, 1, 10}},
+		},
+		{
+			name: "整数之间没有取值",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, logic(10, domain.AND,
+					leaf(11, 1, domain.Greater, "10"),
+					leaf(12, 1, domain.Less, "11"))),
+			},
+			want: []issue{{domain.PolicyIssueUnsatisfiable, domain.PolicyIssueError, 1, 10}},
+		},
+		{
+			name: "浮点数之间有取值",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, logic(10, domain.AND,
+					leaf(11, 4, domain.Greater, "10"),
+					leaf(12, 4, domain.Less, "11"))),
+			},
+		},
+		{
+			name: "根规则之间矛盾",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow,
+					leaf(11, 2, domain.IN, `["dev","ops"]`),
+					leaf(12, 2, domain.Equals, "qa")),
+			},
+			want: []issue{{domain.PolicyIssueUnsatisfiable, domain.PolicyIssueError, 1, 0}},
+		},
+		{
+			name: "布尔值两个取值都排除了",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, logic(10, domain.AND,
+					leaf(11, 3, domain.NotEquals, "true"),
+					leaf(12, 3, domain.NotEquals, "false"))),
+			},
+			want: []issue{{domain.PolicyIssueUnsatisfiable, domain.PolicyIssueError, 1, 10}},
+		},
+		{
+			name: "属性没有取值的时候 NOT 成立",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, logic(10, domain.AND,
+					negate(11, leaf(12, 2, domain.Equals, "dev")),
+					negate(13, leaf(14, 2, domain.NotEquals, "dev")))),
+			},
+		},
+		{
+			name: "NOT 总是满足的规则",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, negate(10, logic(11, domain.AND,
+					leaf(12, 1, domain.Greater, "10"),
+					leaf(13, 1, domain.Less, "5")))),
+			},
+			want: []issue{
+				{domain.PolicyIssueUnsatisfiable, domain.PolicyIssueError, 1, 11},
+				{domain.PolicyIssueAlwaysTrue, domain.PolicyIssueWarning, 1, 10},
+			},
+		},
+		{
+			name: "多余的规则",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, logic(10, domain.AND,
+					leaf(11, 1, domain.Greater, "10"),
+					leaf(12, 1, domain.Greater, "5"))),
+			},
+			want: []issue{{domain.PolicyIssueRedundant, domain.PolicyIssueWarning, 1, 12}},
+		},
+		{
+			name: "OR 里面多余的规则",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, logic(10, domain.OR,
+					leaf(11, 2, domain.Equals, "dev"),
+					leaf(12, 2, domain.IN, `["dev","ops"]`))),
+			},
+			want: []issue{{domain.PolicyIssueRedundant, domain.PolicyIssueWarning, 1, 11}},
+		},
+		{
+			name: "总是满足",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, logic(10, domain.OR,
+					leaf(11, 1, domain.GreaterOrEqual, "18"),
+					leaf(12, 1, domain.Less, "18"))),
+			},
+			want: []issue{{domain.PolicyIssueAlwaysTrue, domain.PolicyIssueWarning, 1, 10}},
+		},
+		{
+			name: "属性已经被删除",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, leaf(11, 100, domain.Equals, "dev")),
+			},
+			want: []issue{{domain.PolicyIssueUndefinedAttribute, domain.PolicyIssueError, 1, 11}},
+		},
+		{
+			name: "运算符和类型不匹配",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, leaf(11, 2, domain.Greater, "dev")),
+			},
+			want: []issue{{domain.PolicyIssueTypeMismatch, domain.PolicyIssueError, 1, 11}},
+		},
+		{
+			name: "两个属性类型不一致",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, domain.PolicyRule{
+					ID: 11, AttrDef: domain.AttributeDefinition{ID: 1}, Operator: domain.Equals,
+					ValueAttrDef: &domain.AttributeDefinition{ID: 5},
+				}),
+			},
+			want: []issue{{domain.PolicyIssueTypeMismatch, domain.PolicyIssueError, 1, 11}},
+		},
+		{
+			name: "比较值不合法",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, leaf(11, 1, domain.Equals, "abc")),
+			},
+			want: []issue{{domain.PolicyIssueInvalidValue, domain.PolicyIssueError, 1, 11}},
+		},
+		{
+			name: "逻辑运算符缺少子规则",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, domain.PolicyRule{ID: 11, Operator: domain.AND}),
+			},
+			want: []issue{{domain.PolicyIssueInvalidRule, domain.PolicyIssueError, 1, 11}},
+		},
+		{
+			name: "允许和拒绝的条件完全相同",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, leaf(11, 2, domain.Equals, "dev")),
+				policy(2, domain.EffectDeny, leaf(21, 2, domain.IN, `["dev"]`)),
+			},
+			want: []issue{{domain.PolicyIssueConflict, domain.PolicyIssueError, 1, 0}},
+		},
+		{
+			name: "允许和拒绝的条件有重叠",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, leaf(11, 1, domain.Greater, "10")),
+				policy(2, domain.EffectDeny, leaf(21, 1, domain.Less, "20")),
+			},
+			want: []issue{{domain.PolicyIssueConflict, domain.PolicyIssueWarning, 1, 0}},
+		},
+		{
+			name: "允许和拒绝的条件没有重叠",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, leaf(11, 1, domain.Greater, "10")),
+				policy(2, domain.EffectDeny, leaf(21, 1, domain.Less, "5")),
+			},
+		},
+		{
+			name: "效果相同不算冲突",
+			policies: []domain.Policy{
+				policy(1, domain.EffectAllow, leaf(11, 2, domain.Equals, "dev")),
+				policy(2, domain.EffectAllow, leaf(21, 2, domain.Equals, "dev")),
+			},
+		},
+		{
+			name: "表达式引用了没有定义的属性",
+			policies: []domain.Policy{
+				{ID: 1, ExecuteType: domain.ExpressionType, Expression: `subject.dept == resource.owner_dept && subject.level > 3`},
+			},
+			want: []issue{{domain.PolicyIssueUndefinedAttribute, domain.PolicyIssueError, 1, 0}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := slice.Map(Analyze(tc.policies, testDefs), func(_ int, src domain.PolicyIssue) issue {
+				return issue{kind: src.Kind, level: src.Level, policyID: src.PolicyID, ruleID: src.RuleID}
+			})
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package analyzer
+
+import (
+	"math"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+)
+
+// maxTerms 规则展开成析取范式之后最多的项数，超过之后放弃分析
+const maxTerms = 256
+
+// literal 一个比较，或者它的否定
+type literal struct {
+	atom *atom
+	neg  bool
+}
+
+// term 若干比较的且
+type term []literal
+
+// dnf 析取范式，若干 term 的或。没有 term 是 false，有一个空的 term 是 true
+type dnf []term
+
+func constDNF(val bool) dnf {
+	if val {
+		return dnf{term{}}
+	}
+	return nil
+}
+
+// toDNF 把规则或者它的否定展开成析取范式，太大的时候返回 false
+func toDNF(n *node, neg bool) (dnf, bool) {
+	if n == nil {
+		return constDNF(!neg), true
+	}
+	switch n.kind {
+	case kindLeaf:
+		return dnf{term{{atom: n.atom, neg: neg}}}, true
+	case kindNot:
+		return toDNF(n.right, !neg)
+	case kindAnd, kindOr:
+		left, ok := toDNF(n.left, neg)
+		if !ok {
+			return nil, false
+		}
+		right, ok := toDNF(n.right, neg)
+		if !ok {
+			return nil, false
+		}
+		// 德摩根定律，取反之后 AND 变成 OR
+		if (n.kind == kindAnd) != neg {
+			return product(left, right)
+		}
+		if len(left)+len(right) > maxTerms {
+			return nil, false
+		}
+		return append(append(dnf{}, left...), right...), true
+	default:
+		return constDNF(neg), true
+	}
+}
+
+func product(left, right dnf) (dnf, bool) {
+	if len(left)*len(right) > maxTerms {
+		return nil, false
+	}
+	res := make(dnf, 0, len(left)*len(right))
+	for _, l := range left {
+		for _, r := range right {
+			t := make(term, 0, len(l)+len(r))
+			res = append(res, append(append(t, l...), r...))
+		}
+	}
+	return res, true
+}
+
+// satisfiable 是否存在一组属性取值满足条件。
+// assumePresent 为 false 的时候属性可以没有取值，此时属性上的比较都是 false，比较的否定都是 true
+func (d dnf) satisfiable(assumePresent bool) bool {
+	for _, t := range d {
+		if t.satisfiable(assumePresent) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t term) satisfiable(assumePresent bool) bool {
+	present := make(map[int64]bool, len(t))
+	opaque := make(map[string]bool, len(t))
+	groups := make(map[int64][]literal, len(t))
+	for _, lit := range t {
+		if !lit.neg {
+			// 比较为 true 的时候两边的属性都有取值
+			present[lit.atom.def.ID] = true
+			present[lit.atom.valueDefID] = true
+		}
+		if lit.atom.reasoned {
+			groups[lit.atom.def.ID] = append(groups[lit.atom.def.ID], lit)
+			continue
+		}
+		// 无法推理的比较，同一个比较同时要求满足和不满足就矛盾
+		key := lit.atom.key()
+		if neg, ok := opaque[key]; ok && neg != lit.neg {
+			return false
+		}
+		opaque[key] = lit.neg
+	}
+	for attrID, lits := range groups {
+		if !assumePresent && !present[attrID] {
+			continue
+		}
+		if !valuesSatisfiable(lits[0].atom.def.DataType, lits) {
+			return false
+		}
+	}
+	return true
+}
+
+// negations 比较的否定，属性有取值的时候成立
+var negations = map[domain.RuleOperator]domain.RuleOperator{
+	domain.Equals:         domain.NotEquals,
+	domain.NotEquals:      domain.Equals,
+	domain.Greater:        domain.LessOrEqual,
+	domain.LessOrEqual:    domain.Greater,
+	domain.Less:           domain.GreaterOrEqual,
+	domain.GreaterOrEqual: domain.Less,
+	domain.IN:             domain.NotIn,
+	domain.NotIn:          domain.IN,
+}
+
+func (lit literal) op() domain.RuleOperator {
+	if lit.neg {
+		return negations[lit.atom.op]
+	}
+	return lit.atom.op
+}
+
+// valuesSatisfiable 同一个属性上的比较能否同时满足
+func valuesSatisfiable(dataType domain.DataType, lits []literal) bool {
+	if dataType == domain.DataTypeNumber || dataType == domain.DataTypeFloat {
+		return numsSatisfiable(dataType == domain.DataTypeNumber, lits)
+	}
+	return strsSatisfiable(dataType == domain.DataTypeBoolean, lits)
+}
+
+// valueSet 相等比较的约束，allowed 为 nil 的时候没有限制
+type valueSet[T comparable] struct {
+	allowed  map[T]struct{}
+	excluded map[T]struct{}
+}
+
+func newValueSet[T comparable]() *valueSet[T] {
+	return &valueSet[T]{excluded: make(map[T]struct{})}
+}
+
+func (s *valueSet[T]) allow(vals []T) {
+	next := make(map[T]struct{}, len(vals))
+	for _, v := range vals {
+		if _, ok := s.allowed[v]; s.allowed == nil || ok {
+			next[v] = struct{}{}
+		}
+	}
+	s.allowed = next
+}
+
+func (s *valueSet[T]) exclude(vals []T) {
+	for _, v := range vals {
+		s.excluded[v] = struct{}{}
+	}
+}
+
+func (s *valueSet[T]) apply(op domain.RuleOperator, vals []T) {
+	switch op {
+	case domain.Equals, domain.IN:
+		s.allow(vals)
+	case domain.NotEquals, domain.NotIn:
+		s.exclude(vals)
+	}
+}
+
+// any allowed 里面是否有没有被排除的值
+func (s *valueSet[T]) any(ok func(v T) bool) bool {
+	for v := range s.allowed {
+		if _, excluded := s.excluded[v]; !excluded && ok(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func strsSatisfiable(isBool bool, lits []literal) bool {
+	set := newValueSet[string]()
+	if isBool {
+		set.allow([]string{"true", "false"})
+	}
+	for _, lit := range lits {
+		set.apply(lit.op(), lit.atom.strs)
+	}
+	if set.allowed == nil {
+		// 字符串和 IP 的取值有无限多个，排除有限个之后总能找到一个
+		return true
+	}
+	return set.any(func(string) bool { return true })
+}
+
+// numsSatisfiable 数字的比较能否同时满足，integer 为 true 的时候只考虑整数
+func numsSatisfiable(integer bool, lits []literal) bool {
+	lo, hi := math.Inf(-1), math.Inf(1)
+	loOpen, hiOpen := false, false
+	set := newValueSet[float64]()
+	for _, lit := range lits {
+		op := lit.op()
+		set.apply(op, lit.atom.nums)
+		if op == domain.IN || op == domain.NotIn {
+			continue
+		}
+		v := lit.atom.nums[0]
+		switch op {
+		case domain.Greater:
+			if v > lo || (v == lo && !loOpen) {
+				lo, loOpen = v, true
+			}
+		case domain.GreaterOrEqual:
+			if v > lo {
+				lo, loOpen = v, false
+			}
+		case domain.Less:
+			if v < hi || (v == hi && !hiOpen) {
+				hi, hiOpen = v, true
+			}
+		case domain.LessOrEqual:
+			if v < hi {
+				hi, hiOpen = v, false
+			}
+		}
+	}
+	inRange := func(v float64) bool {
+		return (v > lo || (v == lo && !loOpen)) && (v < hi || (v == hi && !hiOpen))
+	}
+	if set.allowed != nil {
+		return set.any(inRange)
+	}
+	if integer {
+		// 转换成闭区间 [lo, hi] 里面的整数
+		if loOpen {
+			lo = math.Floor(lo) + 1
+		} else {
+			lo = math.Ceil(lo)
+		}
+		if hiOpen {
+			hi = math.Ceil(hi) - 1
+		} else {
+			hi = math.Floor(hi)
+		}
+		if lo > hi {
+			return false
+		}
+		if math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+			return true
+		}
+		excluded := 0
+		for v := range set.excluded {
+			if v >= lo && v <= hi && v == math.Trunc(v) {
+				excluded++
+			}
+		}
+		return hi-lo+1 > float64(excluded)
+	}
+	if lo < hi {
+		return true
+	}
+	_, excluded := set.excluded[lo]
+	return lo == hi && !loOpen && !hiOpen && !excluded
+}
@@ -0,0 +1,253 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/service/abac/converter"
+)
+
+type nodeKind int
+
+const (
+	// kindFalse 判定结果总是 false 的规则，例如引用了没有定义的属性
+	kindFalse nodeKind = iota
+	kindLeaf
+	kindAnd
+	kindOr
+	kindNot
+)
+
+// node 编译之后的规则，缺少的子规则为 nil，视为 true
+type node struct {
+	rule  domain.PolicyRule
+	kind  nodeKind
+	left  *node
+	right *node
+	atom  *atom
+}
+
+func (n *node) children() []*node {
+	switch n.kind {
+	case kindAnd, kindOr:
+		return []*node{n.left, n.right}
+	case kindNot:
+		return []*node{n.right}
+	default:
+		return nil
+	}
+}
+
+// all 所有规则的且，没有规则的时候为 nil，也就是 true
+func all(nodes []*node) *node {
+	var res *node
+	for idx, n := range nodes {
+		if idx == 0 {
+			res = n
+			continue
+		}
+		res = &node{kind: kindAnd, left: res, right: n}
+	}
+	return res
+}
+
+// anyOf 所有规则的或，没有规则的时候为 false
+func anyOf(nodes []*node) *node {
+	if len(nodes) == 0 {
+		return &node{kind: kindFalse}
+	}
+	res := nodes[0]
+	for _, n := range nodes[1:] {
+		res = &node{kind: kindOr, left: res, right: n}
+	}
+	return res
+}
+
+func not(n *node) *node {
+	return &node{kind: kindNot, right: n}
+}
+
+// flatten 展开连续的同一种逻辑运算
+func flatten(nodes []*node, kind nodeKind) []*node {
+	res := make([]*node, 0, len(nodes))
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		if n.kind == kind {
+			res = append(res, flatten(n.children(), kind)...)
+			continue
+		}
+		res = append(res, n)
+	}
+	return res
+}
+
+// compile 编译规则，同时报告规则本身的问题
+func (a *analyzer) compile(rule domain.PolicyRule) *node {
+	n := &node{rule: rule, kind: kindFalse}
+	if rule.LeftRule == nil && rule.RightRule == nil {
+		if rule.Operator.IsLogical() {
+			a.report(domain.PolicyIssueError, domain.PolicyIssueInvalidRule, rule.ID, "逻辑运算符 %s 缺少子规则", rule.Operator)
+			return n
+		}
+		if at, ok := a.compileAtom(rule); ok {
+			n.kind, n.atom = kindLeaf, at
+		}
+		return n
+	}
+	switch rule.Operator {
+	case domain.AND, domain.OR:
+		n.kind = kindAnd
+		if rule.Operator == domain.OR {
+			n.kind = kindOr
+		}
+		if rule.LeftRule != nil {
+			n.left = a.compile(*rule.LeftRule)
+		}
+		if rule.RightRule != nil {
+			n.right = a.compile(*rule.RightRule)
+		}
+	case domain.NOT:
+		// NOT 只对右边的子规则取反
+		if rule.LeftRule != nil {
+			a.report(domain.PolicyIssueWarning, domain.PolicyIssueInvalidRule, rule.ID, "NOT 会忽略左边的子规则")
+		}
+		if rule.RightRule == nil {
+			a.report(domain.PolicyIssueError, domain.PolicyIssueInvalidRule, rule.ID, "NOT 缺少右边的子规则，判定结果总是 false")
+			return n
+		}
+		n.kind = kindNot
+		n.right = a.compile(*rule.RightRule)
+	default:
+		a.report(domain.PolicyIssueError, domain.PolicyIssueInvalidRule, rule.ID, "%s 不是逻辑运算符，不能连接子规则", rule.Operator)
+	}
+	return n
+}
+
+// compileAtom 校验叶子规则，不合法的时候返回 false，此时判定结果总是 false
+func (a *analyzer) compileAtom(rule domain.PolicyRule) (*atom, bool) {
+	if !rule.Operator.IsValid() {
+		a.report(domain.PolicyIssueError, domain.PolicyIssueInvalidRule, rule.ID, "未知的运算符 %s", rule.Operator)
+		return nil, false
+	}
+	def, ok := a.defs.GetByDefID(rule.AttrDef.ID)
+	if !ok {
+		a.report(domain.PolicyIssueError, domain.PolicyIssueUndefinedAttribute, rule.ID, "属性 %d 没有定义或者已经被删除", rule.AttrDef.ID)
+		return nil, false
+	}
+	if !rule.Operator.SupportedBy(def.DataType) {
+		a.report(domain.PolicyIssueError, domain.PolicyIssueTypeMismatch, rule.ID,
+			"%s 不能用于 %s 类型的属性 %s", rule.Operator, def.DataType, def.Name)
+		return nil, false
+	}
+	at := &atom{def: def, op: rule.Operator, value: rule.Value}
+	if rule.ValueAttrDef != nil {
+		valueDef, ok := a.defs.GetByDefID(rule.ValueAttrDef.ID)
+		if !ok {
+			a.report(domain.PolicyIssueError, domain.PolicyIssueUndefinedAttribute, rule.ID, "属性 %d 没有定义或者已经被删除", rule.ValueAttrDef.ID)
+			return nil, false
+		}
+		if valueDef.DataType != def.DataType {
+			a.report(domain.PolicyIssueError, domain.PolicyIssueTypeMismatch, rule.ID, "属性 %s 和 %s 的类型不一致", def.Name, valueDef.Name)
+			return nil, false
+		}
+		at.valueDefID = valueDef.ID
+		return at, true
+	}
+	if err := a.validateValue(def.DataType, rule.Operator, rule.Value); err != nil {
+		a.report(domain.PolicyIssueError, domain.PolicyIssueInvalidValue, rule.ID, "%s 的比较值 %s 不合法: %s", def.Name, rule.Value, err)
+		return nil, false
+	}
+	at.parse()
+	return at, true
+}
+
+// sampleValues 各个类型的一个合法的取值，用来借助 evaluator 校验比较值
+var sampleValues = map[domain.DataType]string{
+	domain.DataTypeString:   "",
+	domain.DataTypeNumber:   "0",
+	domain.DataTypeFloat:    "0",
+	domain.DataTypeBoolean:  "false",
+	domain.DataTypeDatetime: "0",
+	domain.DataTypeArray:    `[""]`,
+	domain.DataTypeIP:       "127.0.0.1",
+}
+
+// validateValue 用 evaluator 判定一次，运算符已经校验过了，出错只可能是比较值不合法
+func (a *analyzer) validateValue(dataType domain.DataType, op domain.RuleOperator, value string) error {
+	checker, err := a.selector.Select(dataType)
+	if err != nil {
+		return err
+	}
+	_, err = checker.Evaluate(value, sampleValues[dataType], op)
+	return err
+}
+
+// atom 叶子规则上的一个比较
+type atom struct {
+	def   domain.AttributeDefinition
+	op    domain.RuleOperator
+	value string
+	// valueDefID 大于 0 的时候和这个属性的取值比较
+	valueDefID int64
+	// reasoned 能否推理，能推理的比较解析之后的值放在 nums 或者 strs 里面
+	reasoned bool
+	nums     []float64
+	strs     []string
+}
+
+// parse 解析能够推理的比较，也就是数字的大小比较，以及字符串、布尔值、IP 的相等比较
+func (at *atom) parse() {
+	switch at.def.DataType {
+	case domain.DataTypeNumber, domain.DataTypeFloat:
+		at.nums, at.reasoned = parseNums(at.def.DataType, at.op, at.value)
+	case domain.DataTypeString:
+		switch at.op {
+		case domain.Equals, domain.NotEquals:
+			at.strs, at.reasoned = []string{at.value}, true
+		case domain.IN, domain.NotIn:
+			at.reasoned = json.Unmarshal([]byte(at.value), &at.strs) == nil
+		}
+	case domain.DataTypeBoolean:
+		if val, err := converter.NewBoolConverter().Decode(at.value); err == nil {
+			at.strs, at.reasoned = []string{strconv.FormatBool(val)}, true
+		}
+	case domain.DataTypeIP:
+		if at.op != domain.Equals && at.op != domain.NotEquals {
+			return
+		}
+		if addr, err := converter.NewIPConverter().Decode(at.value); err == nil {
+			at.strs, at.reasoned = []string{addr.String()}, true
+		}
+	}
+}
+
+func parseNums(dataType domain.DataType, op domain.RuleOperator, value string) ([]float64, bool) {
+	if op == domain.IN || op == domain.NotIn {
+		var res []float64
+		if dataType == domain.DataTypeNumber {
+			var list []int64
+			if err := json.Unmarshal([]byte(value), &list); err != nil {
+				return nil, false
+			}
+			for _, v := range list {
+				res = append(res, float64(v))
+			}
+			return res, true
+		}
+		return res, json.Unmarshal([]byte(value), &res) == nil
+	}
+	if dataType == domain.DataTypeNumber {
+		v, err := converter.NewNumberConverter().Decode(value)
+		return []float64{float64(v)}, err == nil
+	}
+	v, err := converter.NewFloatConverter().Decode(value)
+	return []float64{v}, err == nil
+}
+
+// key 无法推理的比较按照写法区分
+func (at *atom) key() string {
+	return strconv.FormatInt(at.def.ID, 10) + " " + at.op.String() + " " + at.value + " " + strconv.FormatInt(at.valueDefID, 10)
+}
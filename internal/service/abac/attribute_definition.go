@@ -27,6 +27,9 @@ func NewAttributeDefinitionSvc(repo repository.AttributeDefinitionRepository) At
 }
 
 func (a *attributeDefinitionSvc) Save(ctx context.Context, bizID int64, definition domain.AttributeDefinition) (int64, error) {
+	if err := CheckValidationRule(definition.ValidationRule); err != nil {
+		return 0, err
+	}
 	return a.repo.Save(ctx, bizID, definition)
 }
 
@@ -0,0 +1,139 @@
+package abac
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+)
+
+const defaultValidationCacheCapacity = 1024
+
+// attributeValidation 解析好的校验规则
+type attributeValidation struct {
+	domain.AttributeValidation
+	regex *regexp.Regexp
+}
+
+// AttributeValidator 按照属性定义的 ValidationRule 校验属性值。
+// 实时属性每次判定都要校验，所以缓存解析好的校验规则，避免每次都重新解析 JSON、编译正则表达式，
+// key 是 ValidationRule
+type AttributeValidator struct {
+	mu       sync.RWMutex
+	rules    map[string]attributeValidation
+	capacity int
+}
+
+func NewAttributeValidator() *AttributeValidator {
+	return newAttributeValidator(defaultValidationCacheCapacity)
+}
+
+func newAttributeValidator(capacity int) *AttributeValidator {
+	return &AttributeValidator{
+		rules:    make(map[string]attributeValidation),
+		capacity: capacity,
+	}
+}
+
+// CheckValidationRule 保存属性定义之前校验 ValidationRule，
+// 既不是合法的 JSON 规则，也不是合法的正则表达式的时候返回 errs.ErrInvalidParameter
+func CheckValidationRule(rule string) error {
+	_, err := parseAttributeValidation(rule)
+	return err
+}
+
+// parseAttributeValidation 先按照 JSON 规则解析，不是 JSON 的时候按照之前的写法，整个视为正则表达式。
+// JSON 规则里面不认识的字段同样视为错误，避免写错字段名之后规则悄悄不生效
+func parseAttributeValidation(rule string) (attributeValidation, error) {
+	var res attributeValidation
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return res, nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(rule))
+	decoder.DisallowUnknownFields()
+	if jsonErr := decoder.Decode(&res.AttributeValidation); jsonErr != nil {
+		if strings.HasPrefix(rule, "{") {
+			return res, fmt.Errorf("%w: 校验规则不是合法的 JSON: %w", errs.ErrInvalidParameter, jsonErr)
+		}
+		if _, err := regexp.Compile(rule); err != nil {
+			return res, fmt.Errorf("%w: 校验规则既不是合法的 JSON，也不是合法的正则表达式: %w", errs.ErrInvalidParameter, err)
+		}
+		res.AttributeValidation = domain.AttributeValidation{Regex: rule}
+	} else if decoder.More() {
+		return res, fmt.Errorf("%w: 校验规则不是合法的 JSON: 末尾有多余的内容", errs.ErrInvalidParameter)
+	}
+	if res.Regex != "" {
+		re, err := regexp.Compile(res.Regex)
+		if err != nil {
+			return res, fmt.Errorf("%w: 正则表达式语法错误: %w", errs.ErrInvalidParameter, err)
+		}
+		res.regex = re
+	}
+	return res, res.Check()
+}
+
+// Validate 校验属性值能否按照属性类型解析，以及是否满足校验规则。
+// 返回规范化之后的值，目前只有按照 TimeLayouts 解析的时间会被转换成毫秒时间戳
+func (v *AttributeValidator) Validate(def domain.AttributeDefinition, val string) (string, error) {
+	rule, err := v.get(def.ValidationRule)
+	if err != nil {
+		return "", err
+	}
+	if rule.regex != nil && !rule.regex.MatchString(val) {
+		return "", fmt.Errorf("%w: 属性 %s 的值 %q 不符合正则规范", errs.ErrInvalidAttributeValue, def.Name, val)
+	}
+	if def.DataType != domain.DataTypeArray {
+		res, err := rule.ValidateScalar(def.DataType, val)
+		if err != nil {
+			return "", fmt.Errorf("%w: 属性 %s 的值 %q %s", errs.ErrInvalidAttributeValue, def.Name, val, err.Error())
+		}
+		return res, nil
+	}
+	var elems []string
+	if err := json.Unmarshal([]byte(val), &elems); err != nil {
+		return "", fmt.Errorf("%w: 属性 %s 的值 %q 不是字符串数组", errs.ErrInvalidAttributeValue, def.Name, val)
+	}
+	changed := false
+	for idx := range elems {
+		elem, err := rule.ValidateScalar(rule.ElementType, elems[idx])
+		if err != nil {
+			return "", fmt.Errorf("%w: 属性 %s 的第 %d 个元素 %q %s", errs.ErrInvalidAttributeValue, def.Name, idx, elems[idx], err.Error())
+		}
+		changed = changed || elem != elems[idx]
+		elems[idx] = elem
+	}
+	if !changed {
+		return val, nil
+	}
+	data, err := json.Marshal(elems)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// get 解析失败的规则不缓存，属性定义在保存的时候已经校验过，正常情况下不会出现
+func (v *AttributeValidator) get(rule string) (attributeValidation, error) {
+	v.mu.RLock()
+	res, ok := v.rules[rule]
+	v.mu.RUnlock()
+	if ok {
+		return res, nil
+	}
+	res, err := parseAttributeValidation(rule)
+	if err != nil {
+		return res, err
+	}
+	v.mu.Lock()
+	if len(v.rules) >= v.capacity {
+		v.rules = make(map[string]attributeValidation)
+	}
+	v.rules[rule] = res
+	v.mu.Unlock()
+	return res, nil
+}
@@ -0,0 +1,122 @@
+//go:build unit
+
+package abac
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckValidationRule(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{name: "没有规则", rule: ""},
+		{name: "旧的正则写法", rule: "^[a-z]+$"},
+		{name: "JSON 规则", rule: `{"regex":"^\\d+$","min":1,"max":10}`},
+		{name: "正则错误", rule: "(", wantErr: true},
+		{name: "JSON 错误", rule: `{"min":}`, wantErr: true},
+		{name: "JSON 里面有不认识的字段", rule: `{"mim":1}`, wantErr: true},
+		{name: "JSON 末尾有多余的内容", rule: `{"min":1} {"max":2}`, wantErr: true},
+		{name: "不是 JSON 也不是正则", rule: "[a-", wantErr: true},
+		{name: "不以 { 开头的 JSON 也不是正则", rule: `"(`, wantErr: true},
+		{name: "min 大于 max", rule: `{"min":10,"max":1}`, wantErr: true},
+		{name: "数组套数组", rule: `{"elementType":"array"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := CheckValidationRule(tt.rule)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAttributeValidator_Validate(t *testing.T) {
+	t.Parallel()
+	validator := NewAttributeValidator()
+	at := time.Date(2025, 5, 6, 9, 30, 0, 0, time.Local).UnixMilli()
+	tests := []struct {
+		name     string
+		dataType domain.DataType
+		rule     string
+		val      string
+		want     string
+		wantErr  bool
+	}{
+		{name: "正则", dataType: domain.DataTypeString, rule: "^[a-z]+$", val: "dev", want: "dev"},
+		{name: "不符合正则", dataType: domain.DataTypeString, rule: "^[a-z]+$", val: "Dev", wantErr: true},
+		{name: "整数", dataType: domain.DataTypeNumber, val: "18", want: "18"},
+		{name: "不是整数", dataType: domain.DataTypeNumber, val: "18.5", wantErr: true},
+		{name: "整数范围", dataType: domain.DataTypeNumber, rule: `{"min":0,"max":150}`, val: "150", want: "150"},
+		{name: "超出范围", dataType: domain.DataTypeNumber, rule: `{"min":0,"max":150}`, val: "151", wantErr: true},
+		{name: "浮点数", dataType: domain.DataTypeFloat, rule: `{"min":0}`, val: "-0.5", wantErr: true},
+		{name: "布尔值", dataType: domain.DataTypeBoolean, val: "yes", wantErr: true},
+		{name: "枚举", dataType: domain.DataTypeString, rule: `{"enum":["dev","ops"]}`, val: "ops", want: "ops"},
+		{name: "不在枚举里", dataType: domain.DataTypeString, rule: `{"enum":["dev","ops"]}`, val: "qa", wantErr: true},
+		{name: "毫秒时间戳", dataType: domain.DataTypeDatetime, val: "1746495000000", want: "1746495000000"},
+		{name: "不是时间戳", dataType: domain.DataTypeDatetime, val: "2025-05-06", wantErr: true},
+		{
+			name: "按照格式解析时间", dataType: domain.DataTypeDatetime, rule: `{"timeLayouts":["2006-01-02 15:04"]}`,
+			val: "2025-05-06 09:30", want: strconv.FormatInt(at, 10),
+		},
+		{name: "IP", dataType: domain.DataTypeIP, val: "10.0.0.1", want: "10.0.0.1"},
+		{name: "不是 IP", dataType: domain.DataTypeIP, val: "10.0.0.256", wantErr: true},
+		{name: "IP 去掉首尾空白", dataType: domain.DataTypeIP, val: " 10.0.0.1\n", want: "10.0.0.1"},
+		{name: "IPv4-mapped IPv6", dataType: domain.DataTypeIP, val: "::ffff:10.0.0.1", want: "10.0.0.1"},
+		{name: "数组元素类型", dataType: domain.DataTypeArray, rule: `{"elementType":"number"}`, val: `["1","2"]`, want: `["1","2"]`},
+		{name: "数组元素类型错误", dataType: domain.DataTypeArray, rule: `{"elementType":"number"}`, val: `["1","a"]`, wantErr: true},
+		{name: "不是数组", dataType: domain.DataTypeArray, val: "dev", wantErr: true},
+		{
+			name: "数组元素按照格式解析时间", dataType: domain.DataTypeArray,
+			rule: `{"elementType":"datetime","timeLayouts":["2006-01-02 15:04"]}`,
+			val:  `["2025-05-06 09:30"]`, want: `["` + strconv.FormatInt(at, 10) + `"]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			def := domain.AttributeDefinition{Name: "attr", DataType: tt.dataType, ValidationRule: tt.rule}
+			got, err := validator.Validate(def, tt.val)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errs.ErrInvalidAttributeValue)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAttributeValidator_Cache(t *testing.T) {
+	t.Parallel()
+	validator := newAttributeValidator(2)
+	first, err := validator.get(`{"regex": "^a+$"}`)
+	require.NoError(t, err)
+	second, err := validator.get(`{"regex": "^a+$"}`)
+	require.NoError(t, err)
+	assert.Same(t, first.regex, second.regex, "同一个规则应该复用解析结果")
+
+	_, err = validator.get(`{"regex": "("}`)
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+	assert.Len(t, validator.rules, 1, "解析失败的规则不缓存")
+
+	_, err = validator.get("^b+$")
+	require.NoError(t, err)
+	_, err = validator.get("^c+$")
+	require.NoError(t, err)
+	assert.Len(t, validator.rules, 1, "超过容量之后清空")
+}
@@ -3,14 +3,22 @@ package abac
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
 	"gitee.com/flycash/permission-platform/internal/repository"
 	"gitee.com/flycash/permission-platform/internal/repository/cache"
 	"github.com/ego-component/eetcd"
+	"github.com/gotomicro/ego/core/elog"
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
+const (
+	defaultMinRestartBackoff = time.Second
+	defaultMaxRestartBackoff = time.Minute
+)
+
 type AttributeValueTask struct {
 	repo        repository.AttributeValueRepository
 	localCache  cache.ABACAttributeValCache
@@ -18,6 +26,11 @@ type AttributeValueTask struct {
 	resourceKey string
 	subjectKey  string
 	etcdClient  *eetcd.Component
+
+	// 监听中断之后重新启动的退避时间，从 minBackoff 开始翻倍，最多 maxBackoff
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	logger     *elog.Component
 }
 
 func NewAttributeValueTask(repo repository.AttributeValueRepository, localCache, redisCache cache.ABACAttributeValCache, resourceKey, subjectKey string, etcdClient *eetcd.Component) *AttributeValueTask {
@@ -28,6 +41,41 @@ func NewAttributeValueTask(repo repository.AttributeValueRepository, localCache,
 		resourceKey: resourceKey,
 		subjectKey:  subjectKey,
 		etcdClient:  etcdClient,
+		minBackoff:  defaultMinRestartBackoff,
+		maxBackoff:  defaultMaxRestartBackoff,
+		logger:      elog.DefaultLogger.With(elog.FieldName("AttributeValueTask")),
+	}
+}
+
+// Start 在后台监听资源和主体的热点属性变更，监听中断之后退避重启，直到 ctx 被取消
+func (t *AttributeValueTask) Start(ctx context.Context) {
+	go t.keepRunning(ctx, "resource", t.StartResLoop)
+	go t.keepRunning(ctx, "subject", t.StartSubjectLoop)
+}
+
+func (t *AttributeValueTask) keepRunning(ctx context.Context, name string, loop func(ctx context.Context) error) {
+	backoff := t.minBackoff
+	for {
+		start := time.Now()
+		err := loop(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		// 正常运行过一段时间之后再中断，从头开始退避
+		if time.Since(start) > t.maxBackoff {
+			backoff = t.minBackoff
+		}
+		t.logger.Error("监听热点属性变更中断，稍后重新启动",
+			elog.FieldErr(err),
+			elog.String("loop", name),
+			elog.Duration("backoff", backoff),
+		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, t.maxBackoff)
 	}
 }
 
@@ -44,14 +92,22 @@ func (t *AttributeValueTask) startLoop(ctx context.Context, key string,
 ) error {
 	watchChan := t.etcdClient.Watch(ctx, key)
 	for watchResp := range watchChan {
+		if err := watchResp.Err(); err != nil {
+			return fmt.Errorf("监听 %s 失败: %w", key, err)
+		}
 		for _, event := range watchResp.Events {
 			if event.Type == clientv3.EventTypePut {
 				// 更新热点数据
-				_ = updateDataFunc(ctx, event.Kv.Value)
+				if err := updateDataFunc(ctx, event.Kv.Value); err != nil {
+					t.logger.Warn("更新热点属性失败", elog.FieldErr(err), elog.String("key", key))
+				}
 			}
 		}
 	}
-	return nil
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return fmt.Errorf("监听 %s 的通道被关闭", key)
 }
 
 func (t *AttributeValueTask) updateSubject(ctx context.Context, value []byte) error {
@@ -0,0 +1,50 @@
+//go:build unit
+
+package abac
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttributeValueTask_KeepRunning(t *testing.T) {
+	t.Parallel()
+
+	task := &AttributeValueTask{
+		minBackoff: time.Millisecond,
+		maxBackoff: 10 * time.Millisecond,
+		logger:     elog.DefaultLogger,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		task.keepRunning(ctx, "resource", func(ctx context.Context) error {
+			// 前两次监听中断，第三次一直监听到 ctx 被取消
+			if calls.Add(1) < 3 {
+				return errors.New("mock watch error")
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	}()
+
+	assert.Eventually(t, func() bool {
+		return calls.Load() == 3
+	}, time.Second, time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("ctx 取消之后没有退出")
+	}
+	assert.Equal(t, int32(3), calls.Load())
+}
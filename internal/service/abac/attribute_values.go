@@ -23,8 +23,50 @@ type AttributeValueSvc interface {
 
 type attributeValueSvc struct {
 	repository.AttributeValueRepository
+	definitionRepo repository.AttributeDefinitionRepository
+	validator      *AttributeValidator
 }
 
-func NewAttributeValueSvc(repository repository.AttributeValueRepository) AttributeValueSvc {
-	return &attributeValueSvc{repository}
+func NewAttributeValueSvc(repository repository.AttributeValueRepository, definitionRepo repository.AttributeDefinitionRepository) AttributeValueSvc {
+	return &attributeValueSvc{
+		AttributeValueRepository: repository,
+		definitionRepo:           definitionRepo,
+		validator:                NewAttributeValidator(),
+	}
+}
+
+func (a *attributeValueSvc) SaveSubjectValue(ctx context.Context, bizID, subjectID int64, val domain.AttributeValue) (int64, error) {
+	var err error
+	val.Value, err = a.checkVal(ctx, bizID, val)
+	if err != nil {
+		return 0, err
+	}
+	return a.AttributeValueRepository.SaveSubjectValue(ctx, bizID, subjectID, val)
+}
+
+func (a *attributeValueSvc) SaveResourceValue(ctx context.Context, bizID, resourceID int64, val domain.AttributeValue) (int64, error) {
+	var err error
+	val.Value, err = a.checkVal(ctx, bizID, val)
+	if err != nil {
+		return 0, err
+	}
+	return a.AttributeValueRepository.SaveResourceValue(ctx, bizID, resourceID, val)
+}
+
+func (a *attributeValueSvc) SaveEnvironmentValue(ctx context.Context, bizID int64, val domain.AttributeValue) (int64, error) {
+	var err error
+	val.Value, err = a.checkVal(ctx, bizID, val)
+	if err != nil {
+		return 0, err
+	}
+	return a.AttributeValueRepository.SaveEnvironmentValue(ctx, bizID, val)
+}
+
+// checkVal 按照属性定义校验属性值，返回规范化之后的值
+func (a *attributeValueSvc) checkVal(ctx context.Context, bizID int64, val domain.AttributeValue) (string, error) {
+	definition, err := a.definitionRepo.First(ctx, bizID, val.Definition.ID)
+	if err != nil {
+		return "", err
+	}
+	return a.validator.Validate(definition, val.Value)
 }
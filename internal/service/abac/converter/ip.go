@@ -0,0 +1,86 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+)
+
+type IPConverter struct{}
+
+func NewIPConverter() IPConverter {
+	return IPConverter{}
+}
+
+func (i IPConverter) Decode(str string) (netip.Addr, error) {
+	return domain.ParseIP(str)
+}
+
+func (i IPConverter) Encode(t netip.Addr) (string, error) {
+	return t.String(), nil
+}
+
+// CIDRConverter 网段列表，可以是单个网段 10.0.0.0/8，也可以是 JSON 数组 ["10.0.0.0/8", "192.168.1.0/24"]。
+// 不带掩码的 IP 视为只包含它自己的网段
+type CIDRConverter struct{}
+
+func NewCIDRConverter() CIDRConverter {
+	return CIDRConverter{}
+}
+
+func (c CIDRConverter) Decode(str string) ([]netip.Prefix, error) {
+	str = strings.TrimSpace(str)
+	items := []string{str}
+	if strings.HasPrefix(str, "[") {
+		items = nil
+		if err := json.Unmarshal([]byte(str), &items); err != nil {
+			return nil, err
+		}
+	}
+	res := make([]netip.Prefix, 0, len(items))
+	for _, item := range items {
+		prefix, err := c.parsePrefix(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, prefix)
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("网段列表不能为空")
+	}
+	return res, nil
+}
+
+func (c CIDRConverter) parsePrefix(str string) (netip.Prefix, error) {
+	if !strings.Contains(str, "/") {
+		addr, err := netip.ParseAddr(str)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(str)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if prefix.Addr().Is4In6() {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+	}
+	return prefix.Masked(), nil
+}
+
+func (c CIDRConverter) Encode(t []netip.Prefix) (string, error) {
+	items := make([]string, 0, len(t))
+	for _, prefix := range t {
+		items = append(items, prefix.String())
+	}
+	val, err := json.Marshal(items)
+	if err != nil {
+		return "", err
+	}
+	return string(val), nil
+}
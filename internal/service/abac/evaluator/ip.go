@@ -0,0 +1,53 @@
+package evaluator
+
+import (
+	"net/netip"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"gitee.com/flycash/permission-platform/internal/service/abac/converter"
+)
+
+type IPEvaluator struct {
+	ipConverter   converter.Converter[netip.Addr]
+	cidrConverter converter.Converter[[]netip.Prefix]
+}
+
+func NewIPEvaluator() IPEvaluator {
+	return IPEvaluator{
+		ipConverter:   converter.NewIPConverter(),
+		cidrConverter: converter.NewCIDRConverter(),
+	}
+}
+
+func (i IPEvaluator) Evaluate(wantVal, actualVal string, op domain.RuleOperator) (bool, error) {
+	actual, err := i.ipConverter.Decode(actualVal)
+	if err != nil {
+		return false, err
+	}
+	switch op {
+	case domain.Equals, domain.NotEquals:
+		want, err := i.ipConverter.Decode(wantVal)
+		if err != nil {
+			return false, err
+		}
+		return (want == actual) == (op == domain.Equals), nil
+	case domain.InCIDR, domain.NotInCIDR:
+		prefixes, err := i.cidrConverter.Decode(wantVal)
+		if err != nil {
+			return false, err
+		}
+		return i.contains(prefixes, actual) == (op == domain.InCIDR), nil
+	default:
+		return false, errs.ErrUnknownOperator
+	}
+}
+
+func (i IPEvaluator) contains(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for idx := range prefixes {
+		if prefixes[idx].Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
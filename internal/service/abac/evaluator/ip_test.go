@@ -0,0 +1,107 @@
+package evaluator
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+)
+
+func TestIPEvaluator_Evaluate(t *testing.T) {
+	t.Parallel()
+	evaluator := NewIPEvaluator()
+
+	tests := []struct {
+		name      string
+		wantVal   string
+		actualVal string
+		op        domain.RuleOperator
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "in single cidr",
+			wantVal:   "10.0.0.0/8",
+			actualVal: "10.1.2.3",
+			op:        domain.InCIDR,
+			want:      true,
+		},
+		{
+			name:      "in cidr list",
+			wantVal:   `["192.168.1.0/24", "172.16.0.0/12"]`,
+			actualVal: "172.20.0.1",
+			op:        domain.InCIDR,
+			want:      true,
+		},
+		{
+			name:      "not in cidr list",
+			wantVal:   `["192.168.1.0/24", "172.16.0.0/12"]`,
+			actualVal: "8.8.8.8",
+			op:        domain.NotInCIDR,
+			want:      true,
+		},
+		{
+			name:      "ip without mask",
+			wantVal:   `["192.168.1.10"]`,
+			actualVal: "192.168.1.11",
+			op:        domain.InCIDR,
+			want:      false,
+		},
+		{
+			name:      "ipv4 mapped ipv6",
+			wantVal:   "10.0.0.0/8",
+			actualVal: "::ffff:10.0.0.1",
+			op:        domain.InCIDR,
+			want:      true,
+		},
+		{
+			name:      "ipv6",
+			wantVal:   "2001:db8::/32",
+			actualVal: "2001:db8::1",
+			op:        domain.InCIDR,
+			want:      true,
+		},
+		{
+			name:      "equals",
+			wantVal:   "10.0.0.1",
+			actualVal: "10.0.0.1",
+			op:        domain.Equals,
+			want:      true,
+		},
+		{
+			name:      "invalid ip",
+			wantVal:   "10.0.0.0/8",
+			actualVal: "abc",
+			op:        domain.InCIDR,
+			wantErr:   true,
+		},
+		{
+			name:      "invalid cidr",
+			wantVal:   "10.0.0.0/33",
+			actualVal: "10.0.0.1",
+			op:        domain.InCIDR,
+			wantErr:   true,
+		},
+		{
+			name:      "unsupported operator",
+			wantVal:   "10.0.0.1",
+			actualVal: "10.0.0.1",
+			op:        domain.Greater,
+			wantErr:   true,
+		},
+	}
+
+	for idx := range tests {
+		tt := tests[idx]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := evaluator.Evaluate(tt.wantVal, tt.actualVal, tt.op)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IPEvaluator.Evaluate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("IPEvaluator.Evaluate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,15 +2,25 @@ package evaluator
 
 import (
 	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
 	"gitee.com/flycash/permission-platform/internal/errs"
 )
 
-type StringEvaluator struct{}
+// 正则表达式缓存的最大条数，超过之后整体清空
+const defaultRegexpCacheSize = 1024
+
+type StringEvaluator struct {
+	regexps *regexpCache
+}
 
 func NewStringEvaluator() *StringEvaluator {
-	return &StringEvaluator{}
+	return &StringEvaluator{
+		regexps: newRegexpCache(defaultRegexpCacheSize),
+	}
 }
 
 func (s StringEvaluator) Evaluate(wantVal, actualVal string, op domain.RuleOperator) (bool, error) {
@@ -26,6 +36,20 @@ func (s StringEvaluator) Evaluate(wantVal, actualVal string, op domain.RuleOpera
 		return wantVal == actualVal, nil
 	case domain.NotEquals:
 		return wantVal != actualVal, nil
+	case domain.EqualsIgnoreCase:
+		return strings.EqualFold(wantVal, actualVal), nil
+	case domain.StartsWith:
+		return strings.HasPrefix(actualVal, wantVal), nil
+	case domain.EndsWith:
+		return strings.HasSuffix(actualVal, wantVal), nil
+	case domain.Contains:
+		return strings.Contains(actualVal, wantVal), nil
+	case domain.Matches:
+		re, err := s.regexps.get(wantVal)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(actualVal), nil
 	default:
 		return false, errs.ErrUnknownOperator
 	}
@@ -38,3 +62,37 @@ func (StringEvaluator) getSliceData(wantVal string) (res []string, err error) {
 	}
 	return res, err
 }
+
+// regexpCache 缓存编译好的正则表达式，避免每次校验都重新编译
+type regexpCache struct {
+	mu       sync.RWMutex
+	regexps  map[string]*regexp.Regexp
+	capacity int
+}
+
+func newRegexpCache(capacity int) *regexpCache {
+	return &regexpCache{
+		regexps:  make(map[string]*regexp.Regexp),
+		capacity: capacity,
+	}
+}
+
+func (c *regexpCache) get(pattern string) (*regexp.Regexp, error) {
+	c.mu.RLock()
+	re, ok := c.regexps[pattern]
+	c.mu.RUnlock()
+	if ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	if len(c.regexps) >= c.capacity {
+		c.regexps = make(map[string]*regexp.Regexp)
+	}
+	c.regexps[pattern] = re
+	c.mu.Unlock()
+	return re, nil
+}
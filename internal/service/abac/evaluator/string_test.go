@@ -0,0 +1,128 @@
+package evaluator
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+)
+
+func TestStringEvaluator_Evaluate(t *testing.T) {
+	t.Parallel()
+	evaluator := NewStringEvaluator()
+
+	tests := []struct {
+		name      string
+		wantVal   string
+		actualVal string
+		op        domain.RuleOperator
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "starts with",
+			wantVal:   "/home/alice/",
+			actualVal: "/home/alice/docs/a.txt",
+			op:        domain.StartsWith,
+			want:      true,
+		},
+		{
+			name:      "starts with failure",
+			wantVal:   "/home/alice/",
+			actualVal: "/home/bob/a.txt",
+			op:        domain.StartsWith,
+			want:      false,
+		},
+		{
+			name:      "ends with",
+			wantVal:   "@example.com",
+			actualVal: "alice@example.com",
+			op:        domain.EndsWith,
+			want:      true,
+		},
+		{
+			name:      "contains",
+			wantVal:   "admin",
+			actualVal: "sys-admin-group",
+			op:        domain.Contains,
+			want:      true,
+		},
+		{
+			name:      "matches",
+			wantVal:   `@(example|test)\.com$`,
+			actualVal: "alice@test.com",
+			op:        domain.Matches,
+			want:      true,
+		},
+		{
+			name:      "matches failure",
+			wantVal:   `@(example|test)\.com$`,
+			actualVal: "alice@test.com.cn",
+			op:        domain.Matches,
+			want:      false,
+		},
+		{
+			name:      "invalid regexp",
+			wantVal:   `(`,
+			actualVal: "a",
+			op:        domain.Matches,
+			wantErr:   true,
+		},
+		{
+			name:      "equals ignore case",
+			wantVal:   "Alice@Example.com",
+			actualVal: "alice@example.COM",
+			op:        domain.EqualsIgnoreCase,
+			want:      true,
+		},
+		{
+			name:      "equals is case sensitive",
+			wantVal:   "Alice",
+			actualVal: "alice",
+			op:        domain.Equals,
+			want:      false,
+		},
+		{
+			name:      "unsupported operator",
+			wantVal:   "a",
+			actualVal: "b",
+			op:        domain.Greater,
+			wantErr:   true,
+		},
+	}
+
+	for idx := range tests {
+		tt := tests[idx]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := evaluator.Evaluate(tt.wantVal, tt.actualVal, tt.op)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringEvaluator.Evaluate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("StringEvaluator.Evaluate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegexpCache(t *testing.T) {
+	t.Parallel()
+	cache := newRegexpCache(2)
+	first, err := cache.get("a+")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := cache.get("a+")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Errorf("regexpCache.get() 没有复用编译结果")
+	}
+	_, _ = cache.get("b+")
+	_, _ = cache.get("c+")
+	if len(cache.regexps) != 1 {
+		t.Errorf("regexpCache 超过容量之后应该清空, len = %d", len(cache.regexps))
+	}
+}
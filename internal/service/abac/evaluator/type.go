@@ -26,6 +26,7 @@ func NewSelector() Selector {
 			domain.DataTypeNumber:   NewNumberEvaluator(),
 			domain.DataTypeDatetime: NewTimeEvaluator(),
 			domain.DataTypeArray:    NewArrayEvaluator(),
+			domain.DataTypeIP:       NewIPEvaluator(),
 		},
 	}
 }
@@ -0,0 +1,276 @@
+// Package expr 文本形式的策略表达式，例如
+// subject.dept == resource.owner_dept && env.time in @time(09:00-18:00)
+package expr
+
+import (
+	"fmt"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/service/abac/evaluator"
+)
+
+// AttrRef 表达式里引用的属性
+type AttrRef struct {
+	EntityType domain.EntityType
+	Name       string
+}
+
+func (r AttrRef) String() string {
+	return string(r.EntityType) + "." + r.Name
+}
+
+// Lookup 根据属性引用查找属性的取值，找不到返回 false
+type Lookup func(ref AttrRef) (domain.AttributeValue, bool)
+
+// Unknown 部分求值的时候取值未知、要保留在条件里的属性，返回它的定义
+type Unknown func(ref AttrRef) (domain.AttributeDefinition, bool)
+
+// Comparison 表达式里的一个比较，Attr 是运算符左边的属性
+type Comparison struct {
+	Attr     AttrRef
+	Operator domain.RuleOperator
+	// Value 右边是属性的时候不为空
+	Value *AttrRef
+}
+
+// Program 编译后的表达式，可以并发使用
+type Program struct {
+	src         string
+	root        node
+	refs        []AttrRef
+	comparisons []Comparison
+}
+
+// Compile 解析表达式
+func Compile(src string) (*Program, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	if tokens[0].kind == tokenEOF {
+		return nil, fmt.Errorf("表达式不能为空")
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.errorf(t, "多余的 %q", t.text)
+	}
+	prog := &Program{src: src, root: root}
+	seen := make(map[AttrRef]struct{})
+	root.walkComparisons(func(n *compareNode) {
+		prog.comparisons = append(prog.comparisons, Comparison{Attr: *n.left.attr, Operator: n.op, Value: n.right.attr})
+	})
+	root.walk(func(o operand) {
+		if o.attr == nil {
+			return
+		}
+		if _, ok := seen[*o.attr]; ok {
+			return
+		}
+		seen[*o.attr] = struct{}{}
+		prog.refs = append(prog.refs, *o.attr)
+	})
+	return prog, nil
+}
+
+// Source 表达式原文
+func (p *Program) Source() string {
+	return p.src
+}
+
+// Refs 表达式引用的所有属性，按照出现的顺序去重
+func (p *Program) Refs() []AttrRef {
+	return p.refs
+}
+
+// Comparisons 表达式里所有的比较，in @time(...) 是展开之后的比较
+func (p *Program) Comparisons() []Comparison {
+	return p.comparisons
+}
+
+// Eval 对表达式求值，返回整棵表达式树的判定过程，根节点的 Result 就是最终结果
+func (p *Program) Eval(selector evaluator.Selector, lookup Lookup) domain.RuleTrace {
+	return p.root.eval(selector, lookup)
+}
+
+// Residual 部分求值，只引用了已知属性的比较直接求值，引用了 unknown 属性的比较保留下来
+func (p *Program) Residual(selector evaluator.Selector, lookup Lookup, unknown Unknown) domain.Residual {
+	return p.root.residual(selector, lookup, unknown)
+}
+
+type node interface {
+	eval(selector evaluator.Selector, lookup Lookup) domain.RuleTrace
+	residual(selector evaluator.Selector, lookup Lookup, unknown Unknown) domain.Residual
+	walk(fn func(o operand))
+	walkComparisons(fn func(n *compareNode))
+}
+
+// operand 比较的一边，要么是属性，要么是字面量
+type operand struct {
+	attr    *AttrRef
+	literal string
+	// text 在表达式里的写法，用于展示
+	text   string
+	isFunc bool
+	isList bool
+}
+
+type logicalNode struct {
+	op    domain.RuleOperator
+	left  node // NOT 的时候为 nil
+	right node
+	// text 展开之前的写法，例如 in @time(...)，普通的逻辑运算为空
+	text string
+}
+
+func (n *logicalNode) eval(selector evaluator.Selector, lookup Lookup) domain.RuleTrace {
+	res := domain.RuleTrace{Rule: domain.PolicyRule{Operator: n.op, Value: n.text}}
+	left := true
+	if n.left != nil {
+		trace := n.left.eval(selector, lookup)
+		left = trace.Result
+		res.Left = &trace
+	}
+	trace := n.right.eval(selector, lookup)
+	res.Right = &trace
+	switch n.op {
+	case domain.AND:
+		res.Result = left && trace.Result
+	case domain.OR:
+		res.Result = left || trace.Result
+	case domain.NOT:
+		res.Result = !trace.Result
+	}
+	return res
+}
+
+func (n *logicalNode) residual(selector evaluator.Selector, lookup Lookup, unknown Unknown) domain.Residual {
+	left := domain.ResidualOf(true)
+	if n.left != nil {
+		left = n.left.residual(selector, lookup, unknown)
+	}
+	right := n.right.residual(selector, lookup, unknown)
+	switch n.op {
+	case domain.AND:
+		return left.And(right)
+	case domain.OR:
+		return left.Or(right)
+	case domain.NOT:
+		return right.Not()
+	default:
+		return domain.ResidualOf(false)
+	}
+}
+
+func (n *logicalNode) walk(fn func(o operand)) {
+	if n.left != nil {
+		n.left.walk(fn)
+	}
+	n.right.walk(fn)
+}
+
+func (n *logicalNode) walkComparisons(fn func(n *compareNode)) {
+	if n.left != nil {
+		n.left.walkComparisons(fn)
+	}
+	n.right.walkComparisons(fn)
+}
+
+// compareNode 左边一定是属性，右边可能是属性或者字面量
+type compareNode struct {
+	op    domain.RuleOperator
+	left  operand
+	right operand
+	text  string
+}
+
+func (n *compareNode) eval(selector evaluator.Selector, lookup Lookup) domain.RuleTrace {
+	res := domain.RuleTrace{Rule: domain.PolicyRule{Operator: n.op, Value: n.text}}
+	actual, ok := lookup(*n.left.attr)
+	if !ok {
+		res.Err = fmt.Sprintf("属性 %s 没有取值", n.left.attr)
+		return res
+	}
+	res.Rule.AttrDef = actual.Definition
+	res.ActualValue = actual.Value
+	want := n.right.literal
+	if n.right.attr != nil {
+		val, ok := lookup(*n.right.attr)
+		if !ok {
+			res.Err = fmt.Sprintf("属性 %s 没有取值", n.right.attr)
+			return res
+		}
+		res.Rule.ValueAttrDef = &val.Definition
+		if val.Definition.DataType != actual.Definition.DataType {
+			res.Err = fmt.Sprintf("属性 %s 和 %s 的类型不一致", n.left.attr, n.right.attr)
+			return res
+		}
+		want = val.Value
+	}
+	checker, err := selector.Select(actual.Definition.DataType)
+	if err != nil {
+		res.Err = err.Error()
+		return res
+	}
+	res.Result, err = checker.Evaluate(want, actual.Value, n.op)
+	if err != nil {
+		res.Result = false
+		res.Err = err.Error()
+	}
+	return res
+}
+
+// residual 和 eval 一样，取值缺失或者两边类型不一致的比较结果为 false
+func (n *compareNode) residual(selector evaluator.Selector, lookup Lookup, unknown Unknown) domain.Residual {
+	leftDef, leftUnknown := unknown(*n.left.attr)
+	var (
+		rightDef     domain.AttributeDefinition
+		rightUnknown bool
+	)
+	if n.right.attr != nil {
+		rightDef, rightUnknown = unknown(*n.right.attr)
+	}
+	switch {
+	case leftUnknown && rightUnknown:
+		if leftDef.DataType != rightDef.DataType {
+			return domain.ResidualOf(false)
+		}
+		return domain.Residual{Condition: &domain.ResidualCondition{
+			Operator: n.op, Attr: leftDef.Name, DataType: leftDef.DataType, ValueAttr: rightDef.Name,
+		}}
+	case leftUnknown:
+		want := n.right.literal
+		if n.right.attr != nil {
+			val, ok := lookup(*n.right.attr)
+			if !ok || val.Definition.DataType != leftDef.DataType {
+				return domain.ResidualOf(false)
+			}
+			want = val.Value
+		}
+		return domain.Residual{Condition: &domain.ResidualCondition{
+			Operator: n.op, Attr: leftDef.Name, DataType: leftDef.DataType, Value: want,
+		}}
+	case rightUnknown:
+		actual, ok := lookup(*n.left.attr)
+		if !ok || actual.Definition.DataType != rightDef.DataType {
+			return domain.ResidualOf(false)
+		}
+		return domain.Residual{Condition: &domain.ResidualCondition{
+			Operator: n.op, Attr: rightDef.Name, DataType: rightDef.DataType, Value: actual.Value, Reversed: true,
+		}}
+	default:
+		return domain.ResidualOf(n.eval(selector, lookup).Result)
+	}
+}
+
+func (n *compareNode) walk(fn func(o operand)) {
+	fn(n.left)
+	fn(n.right)
+}
+
+func (n *compareNode) walkComparisons(fn func(n *compareNode)) {
+	fn(n)
+}
@@ -0,0 +1,203 @@
+//go:build unit
+
+package expr
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/service/abac/evaluator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		src      string
+		wantRefs []AttrRef
+		wantErr  bool
+	}{
+		{
+			name: "属性和属性比较",
+			src:  "subject.dept == resource.owner_dept && env.time in @time(09:00-18:00)",
+			wantRefs: []AttrRef{
+				{EntityType: domain.EntityTypeSubject, Name: "dept"},
+				{EntityType: domain.EntityTypeResource, Name: "owner_dept"},
+				{EntityType: domain.EntityTypeEnvironment, Name: "time"},
+			},
+		},
+		{
+			name: "括号和取反，重复引用去重",
+			src:  `!(subject.age < 18 || subject.age > 60) && subject.role not in ["guest", "intern"]`,
+			wantRefs: []AttrRef{
+				{EntityType: domain.EntityTypeSubject, Name: "age"},
+				{EntityType: domain.EntityTypeSubject, Name: "role"},
+			},
+		},
+		{
+			name:     "字面量在左边",
+			src:      "18 <= subject.age",
+			wantRefs: []AttrRef{{EntityType: domain.EntityTypeSubject, Name: "age"}},
+		},
+		{
+			name: "字符串运算符",
+			src:  `resource.path starts with subject.home && subject.email matches "@example\\.com$"`,
+			wantRefs: []AttrRef{
+				{EntityType: domain.EntityTypeResource, Name: "path"},
+				{EntityType: domain.EntityTypeSubject, Name: "home"},
+				{EntityType: domain.EntityTypeSubject, Name: "email"},
+			},
+		},
+		{
+			name:     "网段",
+			src:      `env.client_ip not in cidr ["10.0.0.0/8", "192.168.0.0/16"]`,
+			wantRefs: []AttrRef{{EntityType: domain.EntityTypeEnvironment, Name: "client_ip"}},
+		},
+		{name: "空表达式", src: "  ", wantErr: true},
+		{name: "网段错误", src: `env.client_ip in cidr "10.0.0.0/33"`, wantErr: true},
+		{name: "正则表达式错误", src: `subject.email matches "("`, wantErr: true},
+		{name: "字符串运算符的左边是字面量", src: `"abc" contains subject.name`, wantErr: true},
+		{name: "未知的前缀", src: "user.age > 18", wantErr: true},
+		{name: "两边都是字面量", src: "1 == 1", wantErr: true},
+		{name: "缺少运算符", src: "subject.age 18", wantErr: true},
+		{name: "缺少右括号", src: "(subject.age > 18", wantErr: true},
+		{name: "多余的内容", src: "subject.age > 18 subject.age", wantErr: true},
+		{name: "字符串没有结束", src: "subject.dept == 'dev", wantErr: true},
+		{name: "列表只能用于 in", src: "subject.age > [1, 2]", wantErr: true},
+		{name: "时间段只能用于 in", src: "env.time > @time(09:00-18:00)", wantErr: true},
+		{name: "in 的左边是字面量", src: `"dev" in subject.depts`, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			prog, err := Compile(tc.src)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRefs, prog.Refs())
+		})
+	}
+}
+
+func TestProgram_Eval(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour, minute int) string {
+		return strconv.FormatInt(time.Date(2025, 5, 6, hour, minute, 0, 0, time.Local).UnixMilli(), 10)
+	}
+	values := func(time string) map[AttrRef]domain.AttributeValue {
+		return map[AttrRef]domain.AttributeValue{
+			{EntityType: domain.EntityTypeSubject, Name: "dept"}: {
+				Definition: domain.AttributeDefinition{ID: 1, Name: "dept", DataType: domain.DataTypeString}, Value: "dev",
+			},
+			{EntityType: domain.EntityTypeSubject, Name: "age"}: {
+				Definition: domain.AttributeDefinition{ID: 2, Name: "age", DataType: domain.DataTypeNumber}, Value: "20",
+			},
+			{EntityType: domain.EntityTypeResource, Name: "owner_dept"}: {
+				Definition: domain.AttributeDefinition{ID: 3, Name: "owner_dept", DataType: domain.DataTypeString}, Value: "dev",
+			},
+			{EntityType: domain.EntityTypeResource, Name: "level"}: {
+				Definition: domain.AttributeDefinition{ID: 4, Name: "level", DataType: domain.DataTypeNumber}, Value: "3",
+			},
+			{EntityType: domain.EntityTypeEnvironment, Name: "time"}: {
+				Definition: domain.AttributeDefinition{ID: 5, Name: "time", DataType: domain.DataTypeDatetime}, Value: time,
+			},
+			{EntityType: domain.EntityTypeEnvironment, Name: "client_ip"}: {
+				Definition: domain.AttributeDefinition{ID: 6, Name: "client_ip", DataType: domain.DataTypeIP}, Value: "10.1.2.3",
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		src     string
+		time    string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "上班时间同部门",
+			src:  "subject.dept == resource.owner_dept && env.time in @time(09:00-18:00)",
+			time: at(10, 0),
+			want: true,
+		},
+		{
+			name: "下班时间",
+			src:  "subject.dept == resource.owner_dept && env.time in @time(09:00-18:00)",
+			time: at(20, 0),
+		},
+		{
+			name: "不在时间段内",
+			src:  "env.time not in @time(09:00-18:00)",
+			time: at(20, 0),
+			want: true,
+		},
+		{
+			name: "字面量在左边",
+			src:  "18 < subject.age",
+			time: at(10, 0),
+			want: true,
+		},
+		{
+			name: "列表",
+			src:  `subject.dept in ["dev", "ops"] && !(subject.age >= 60)`,
+			time: at(10, 0),
+			want: true,
+		},
+		{
+			name: "或",
+			src:  "subject.age > 30 || subject.dept == 'dev'",
+			time: at(10, 0),
+			want: true,
+		},
+		{
+			name: "忽略大小写",
+			src:  `subject.dept equals ignore case "DEV" && resource.owner_dept ends with "v"`,
+			time: at(10, 0),
+			want: true,
+		},
+		{
+			name: "办公网络",
+			src:  `env.client_ip in cidr ["10.0.0.0/8", "192.168.0.0/16"]`,
+			time: at(10, 0),
+			want: true,
+		},
+		{
+			name:    "属性类型不一致",
+			src:     "subject.age == resource.owner_dept",
+			time:    at(10, 0),
+			wantErr: true,
+		},
+		{
+			name:    "属性没有取值",
+			src:     "subject.title == 'boss'",
+			time:    at(10, 0),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			prog, err := Compile(tc.src)
+			require.NoError(t, err)
+			vals := values(tc.time)
+			trace := prog.Eval(evaluator.NewSelector(), func(ref AttrRef) (domain.AttributeValue, bool) {
+				val, ok := vals[ref]
+				return val, ok
+			})
+			assert.Equal(t, tc.want, trace.Result)
+			if tc.wantErr {
+				assert.NotEmpty(t, trace.Err)
+			}
+		})
+	}
+}
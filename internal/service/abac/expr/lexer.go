@@ -0,0 +1,141 @@
+package expr
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenFunc // @time(09:00-18:00) 这种时间规则
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenComma
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenOperator // == != > >= < <=
+)
+
+type token struct {
+	kind tokenKind
+	text string // 字符串字面量是去掉引号、处理过转义之后的内容
+	pos  int
+}
+
+// lex 把表达式切分成 token，最后一个 token 一定是 tokenEOF
+func lex(src string) ([]token, error) {
+	var res []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			res = append(res, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			res = append(res, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case c == '[':
+			res = append(res, token{kind: tokenLBracket, text: "[", pos: i})
+			i++
+		case c == ']':
+			res = append(res, token{kind: tokenRBracket, text: "]", pos: i})
+			i++
+		case c == ',':
+			res = append(res, token{kind: tokenComma, text: ",", pos: i})
+			i++
+		case strings.HasPrefix(src[i:], "&&"):
+			res = append(res, token{kind: tokenAnd, text: "&&", pos: i})
+			i += 2
+		case strings.HasPrefix(src[i:], "||"):
+			res = append(res, token{kind: tokenOr, text: "||", pos: i})
+			i += 2
+		case strings.HasPrefix(src[i:], "=="), strings.HasPrefix(src[i:], "!="),
+			strings.HasPrefix(src[i:], ">="), strings.HasPrefix(src[i:], "<="):
+			res = append(res, token{kind: tokenOperator, text: src[i : i+2], pos: i})
+			i += 2
+		case c == '>' || c == '<':
+			res = append(res, token{kind: tokenOperator, text: src[i : i+1], pos: i})
+			i++
+		case c == '!':
+			res = append(res, token{kind: tokenNot, text: "!", pos: i})
+			i++
+		case c == '"' || c == '\'':
+			text, n, err := lexString(src[i:])
+			if err != nil {
+				return nil, fmt.Errorf("位置 %d: %w", i, err)
+			}
+			res = append(res, token{kind: tokenString, text: text, pos: i})
+			i += n
+		case c == '@':
+			end := strings.IndexByte(src[i:], ')')
+			if end < 0 || !strings.Contains(src[i:i+end], "(") {
+				return nil, fmt.Errorf("位置 %d: 时间规则需要形如 @time(...)", i)
+			}
+			res = append(res, token{kind: tokenFunc, text: src[i : i+end+1], pos: i})
+			i += end + 1
+		case c == '-' || isDigit(c):
+			j := i + 1
+			for j < len(src) && (isDigit(src[j]) || src[j] == '.') {
+				j++
+			}
+			if src[i:j] == "-" {
+				return nil, fmt.Errorf("位置 %d: 非法的字符 -", i)
+			}
+			res = append(res, token{kind: tokenNumber, text: src[i:j], pos: i})
+			i = j
+		case isIdentStart(c):
+			j := i + 1
+			for j < len(src) && (isIdentPart(src[j]) || src[j] == '.') {
+				j++
+			}
+			res = append(res, token{kind: tokenIdent, text: src[i:j], pos: i})
+			i = j
+		default:
+			return nil, fmt.Errorf("位置 %d: 非法的字符 %q", i, c)
+		}
+	}
+	return append(res, token{kind: tokenEOF, pos: len(src)}), nil
+}
+
+// lexString 解析引号包起来的字符串，返回内容以及消耗的字节数
+func lexString(src string) (string, int, error) {
+	quote := src[0]
+	var sb strings.Builder
+	for i := 1; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			if i+1 >= len(src) {
+				return "", 0, fmt.Errorf("字符串没有结束")
+			}
+			i++
+			sb.WriteByte(src[i])
+		case quote:
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteByte(src[i])
+		}
+	}
+	return "", 0, fmt.Errorf("字符串没有结束")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
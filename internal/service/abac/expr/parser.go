@@ -0,0 +1,333 @@
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/service/abac/converter"
+)
+
+// 符号形式的比较运算符
+var symbolOperators = map[string]domain.RuleOperator{
+	"==": domain.Equals,
+	"!=": domain.NotEquals,
+	">":  domain.Greater,
+	">=": domain.GreaterOrEqual,
+	"<":  domain.Less,
+	"<=": domain.LessOrEqual,
+}
+
+// 关键字形式的比较运算符，key 是组成运算符的关键字，不区分大小写
+var keywordOperators = []struct {
+	words []string
+	op    domain.RuleOperator
+}{
+	// 需要放在 in、not in 的前面
+	{words: []string{"not", "in", "cidr"}, op: domain.NotInCIDR},
+	{words: []string{"in", "cidr"}, op: domain.InCIDR},
+	{words: []string{"not", "in"}, op: domain.NotIn},
+	{words: []string{"in"}, op: domain.IN},
+	{words: []string{"any", "match"}, op: domain.AnyMatch},
+	{words: []string{"all", "match"}, op: domain.AllMatch},
+	{words: []string{"starts", "with"}, op: domain.StartsWith},
+	{words: []string{"ends", "with"}, op: domain.EndsWith},
+	{words: []string{"contains"}, op: domain.Contains},
+	{words: []string{"matches"}, op: domain.Matches},
+	{words: []string{"equals", "ignore", "case"}, op: domain.EqualsIgnoreCase},
+}
+
+// 属性引用的前缀
+var entityPrefixes = map[string]domain.EntityType{
+	"subject":     domain.EntityTypeSubject,
+	"resource":    domain.EntityTypeResource,
+	"env":         domain.EntityTypeEnvironment,
+	"environment": domain.EntityTypeEnvironment,
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return fmt.Errorf("位置 %d: %s", t.pos, fmt.Sprintf(format, args...))
+}
+
+// parseOr or := and ( "||" and )*
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{op: domain.OR, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseAnd and := unary ( "&&" unary )*
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{op: domain.AND, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseUnary unary := "!" unary | "(" or ")" | comparison
+func (p *parser) parseUnary() (node, error) {
+	switch p.peek().kind {
+	case tokenNot:
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &logicalNode{op: domain.NOT, right: operand}, nil
+	case tokenLParen:
+		p.next()
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokenRParen {
+			return nil, p.errorf(t, "缺少 )")
+		}
+		return n, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+// parseComparison comparison := operand operator operand
+func (p *parser) parseComparison() (node, error) {
+	start := p.peek()
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	opToken := p.peek()
+	op, err := p.parseOperator()
+	if err != nil {
+		return nil, err
+	}
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return newComparison(start, opToken, left, op, right)
+}
+
+func (p *parser) parseOperator() (domain.RuleOperator, error) {
+	t := p.peek()
+	if t.kind == tokenOperator {
+		p.next()
+		return symbolOperators[t.text], nil
+	}
+	for _, kw := range keywordOperators {
+		if p.matchWords(kw.words) {
+			p.pos += len(kw.words)
+			return kw.op, nil
+		}
+	}
+	return "", p.errorf(t, "需要比较运算符，实际是 %q", t.text)
+}
+
+func (p *parser) matchWords(words []string) bool {
+	for i, w := range words {
+		t := p.tokens[min(p.pos+i, len(p.tokens)-1)]
+		if t.kind != tokenIdent || !strings.EqualFold(t.text, w) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *parser) parseOperand() (operand, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenIdent:
+		return p.parseIdent(t)
+	case tokenString, tokenNumber:
+		return operand{literal: t.text, text: t.text}, nil
+	case tokenFunc:
+		return operand{literal: t.text, text: t.text, isFunc: true}, nil
+	case tokenLBracket:
+		return p.parseList(t)
+	default:
+		return operand{}, p.errorf(t, "需要属性或者字面量，实际是 %q", t.text)
+	}
+}
+
+func (p *parser) parseIdent(t token) (operand, error) {
+	switch strings.ToLower(t.text) {
+	case "true", "false":
+		return operand{literal: strings.ToLower(t.text), text: t.text}, nil
+	}
+	prefix, name, ok := strings.Cut(t.text, ".")
+	entity, known := entityPrefixes[prefix]
+	if !ok || !known || name == "" || strings.Contains(name, ".") {
+		return operand{}, p.errorf(t, "属性需要形如 subject.xxx、resource.xxx 或者 env.xxx，实际是 %q", t.text)
+	}
+	return operand{attr: &AttrRef{EntityType: entity, Name: name}, text: t.text}, nil
+}
+
+// parseList 列表字面量会被转换成 JSON 数组，和 PolicyRule 里 IN 的取值格式保持一致
+func (p *parser) parseList(start token) (operand, error) {
+	var items []any
+	for {
+		t := p.next()
+		switch t.kind {
+		case tokenRBracket:
+			if len(items) == 0 {
+				return p.listOperand(items)
+			}
+			return operand{}, p.errorf(t, "列表里多余的 ,")
+		case tokenString:
+			items = append(items, t.text)
+		case tokenNumber:
+			items = append(items, json.Number(t.text))
+		default:
+			return operand{}, p.errorf(t, "列表里只能是字符串或者数字，实际是 %q", t.text)
+		}
+		switch t = p.next(); t.kind {
+		case tokenComma:
+			continue
+		case tokenRBracket:
+			return p.listOperand(items)
+		default:
+			return operand{}, p.errorf(start, "列表没有结束")
+		}
+	}
+}
+
+func (p *parser) listOperand(items []any) (operand, error) {
+	if items == nil {
+		items = []any{}
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return operand{}, err
+	}
+	return operand{literal: string(data), text: string(data), isList: true}, nil
+}
+
+// orderingOperators 交换左右两边的时候需要翻转的运算符
+var orderingOperators = map[domain.RuleOperator]domain.RuleOperator{
+	domain.Equals:         domain.Equals,
+	domain.NotEquals:      domain.NotEquals,
+	domain.Greater:        domain.Less,
+	domain.Less:           domain.Greater,
+	domain.GreaterOrEqual: domain.LessOrEqual,
+	domain.LessOrEqual:    domain.GreaterOrEqual,
+}
+
+// listOperators 右边可以是列表的运算符
+var listOperators = map[domain.RuleOperator]bool{
+	domain.IN:        true,
+	domain.NotIn:     true,
+	domain.AnyMatch:  true,
+	domain.AllMatch:  true,
+	domain.InCIDR:    true,
+	domain.NotInCIDR: true,
+}
+
+func newComparison(start, opToken token, left operand, op domain.RuleOperator, right operand) (node, error) {
+	text := left.text + " " + opToken.text + " " + right.text
+	if opToken.kind == tokenIdent {
+		text = left.text + " " + strings.ToLower(op.String()) + " " + right.text
+	}
+	switch {
+	case left.attr == nil && right.attr == nil:
+		return nil, fmt.Errorf("位置 %d: 比较的两边至少有一个是属性", start.pos)
+	case left.attr == nil:
+		// 字面量在左边的时候交换两边，只有比较大小和相等的运算符可以交换
+		flipped, ok := orderingOperators[op]
+		if !ok {
+			return nil, fmt.Errorf("位置 %d: %s 的左边必须是属性", opToken.pos, op)
+		}
+		left, right, op = right, left, flipped
+	}
+	if right.isList && !listOperators[op] {
+		return nil, fmt.Errorf("位置 %d: 列表只能和 in、not in、any match、all match、in cidr、not in cidr 一起使用", opToken.pos)
+	}
+	if op == domain.Matches && right.attr == nil {
+		if _, err := regexp.Compile(right.literal); err != nil {
+			return nil, fmt.Errorf("位置 %d: 正则表达式错误: %w", opToken.pos, err)
+		}
+	}
+	if op.IsCIDR() && right.attr == nil {
+		if _, err := converter.NewCIDRConverter().Decode(right.literal); err != nil {
+			return nil, fmt.Errorf("位置 %d: 网段错误: %w", opToken.pos, err)
+		}
+	}
+	if rng, ok := timeRange(right); ok {
+		return newTimeRange(opToken, left, op, rng, text)
+	}
+	return &compareNode{op: op, left: left, right: right, text: text}, nil
+}
+
+// timeRange 解析 @time(09:00-18:00) 这种每天的时间段
+func timeRange(o operand) ([2]string, bool) {
+	if !o.isFunc {
+		return [2]string{}, false
+	}
+	name, arg, ok := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(o.literal, "@"), ")"), "(")
+	if !ok || name != "time" {
+		return [2]string{}, false
+	}
+	from, to, ok := strings.Cut(arg, "-")
+	if !ok || !strings.Contains(from, ":") || !strings.Contains(to, ":") {
+		return [2]string{}, false
+	}
+	return [2]string{strings.TrimSpace(from), strings.TrimSpace(to)}, true
+}
+
+// newTimeRange in @time(09:00-18:00) 会被展开成 >= @day(09:00) && <= @day(18:00)
+func newTimeRange(opToken token, attr operand, op domain.RuleOperator, rng [2]string, text string) (node, error) {
+	if op != domain.IN && op != domain.NotIn {
+		return nil, fmt.Errorf("位置 %d: 时间段只能和 in、not in 一起使用", opToken.pos)
+	}
+	from := operand{literal: "@day(" + rng[0] + ")", text: "@day(" + rng[0] + ")", isFunc: true}
+	to := operand{literal: "@day(" + rng[1] + ")", text: "@day(" + rng[1] + ")", isFunc: true}
+	var res node = &logicalNode{
+		op:   domain.AND,
+		left: &compareNode{op: domain.GreaterOrEqual, left: attr, right: from, text: attr.text + " >= " + from.text},
+		right: &compareNode{
+			op: domain.LessOrEqual, left: attr, right: to, text: attr.text + " <= " + to.text,
+		},
+		text: text,
+	}
+	if op == domain.NotIn {
+		res = &logicalNode{op: domain.NOT, right: res, text: text}
+	}
+	return res, nil
+}
@@ -1,127 +1,414 @@
 package abac
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"slices"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
 	"gitee.com/flycash/permission-platform/internal/repository"
 	"github.com/ecodeclub/ekit/slice"
+	"github.com/gotomicro/ego/core/elog"
 	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
 )
 
 type PermissionSvc interface {
 	Check(ctx context.Context, bizID, uid int64, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error)
+	// Explain 和 Check 的判定逻辑一致，但是会返回判定过程
+	Explain(ctx context.Context, bizID, uid int64, resource domain.Resource, actions []string, attrs domain.Attributes) (domain.ABACTrace, error)
+	// Simulate 用候选策略替换业务当前生效的全部策略重放请求，返回判定结果发生变化的请求以及相关的策略。
+	// 不会修改任何数据
+	Simulate(ctx context.Context, bizID int64, candidates []domain.Policy, requests []domain.CheckRequest) (domain.PolicySimulation, error)
+	// RecentRequests 本实例最近处理过的校验请求，最新的在前面。只有开启了记录的业务才有
+	RecentRequests(bizID int64, limit int) []domain.CheckRequest
+	// CheckWith 只使用给定的策略和属性判定，不读取预存的属性值，资源也不需要存在。用于执行策略的测试用例
+	CheckWith(ctx context.Context, bizID int64, policies []domain.Policy, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error)
+	// ListAccessible 某一类资源的某个操作上，每个绑定了策略的资源剩下的、只和资源属性有关的条件。
+	// 主体和环境属性已经代入，资源属性（包括平台上预存的）交给调用方代入
+	ListAccessible(ctx context.Context, bizID, uid int64, resourceType, action string, attrs domain.Attributes) (domain.ABACAccess, error)
 }
 
+// maxResidualPolicies 一个资源上最多化简多少个结果不确定的策略，化简的时候要枚举它们所有的组合
+const maxResidualPolicies = 6
+
 type permissionSvc struct {
+	bizConfigRepo  repository.BusinessConfigRepository
 	permissionRepo repository.PermissionRepository
 	resourceRepo   repository.ResourceRepository
 	policyRepo     repository.PolicyRepo
 	valRepo        repository.AttributeValueRepository
 	definitionRepo repository.AttributeDefinitionRepository
 	parser         PolicyExecutor
+	recent         *RecentRequests
+	validator      *AttributeValidator
+	logger         *elog.Component
 }
 
-func NewPermissionSvc(permissionRepo repository.PermissionRepository,
+func NewPermissionSvc(
+	bizConfigRepo repository.BusinessConfigRepository,
+	permissionRepo repository.PermissionRepository,
 	resourceRepo repository.ResourceRepository,
 	policyRepo repository.PolicyRepo,
 	valRepo repository.AttributeValueRepository,
 	definitionRepo repository.AttributeDefinitionRepository,
 	parser PolicyExecutor,
+	recent *RecentRequests,
 ) PermissionSvc {
 	return &permissionSvc{
+		bizConfigRepo:  bizConfigRepo,
 		permissionRepo: permissionRepo,
 		resourceRepo:   resourceRepo,
 		policyRepo:     policyRepo,
 		valRepo:        valRepo,
 		definitionRepo: definitionRepo,
 		parser:         parser,
+		recent:         recent,
+		validator:      NewAttributeValidator(),
+		logger:         elog.DefaultLogger.With(elog.FieldName("PermissionSvc")),
 	}
 }
 
 func (p *permissionSvc) Check(ctx context.Context, bizID, uid int64, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error) {
-	permissions, res, bizDefinition, err := p.getPermissionAndRes(ctx, bizID, resource, actions)
+	p.recent.add(bizID, domain.CheckRequest{UID: uid, Resource: resource, Actions: actions, Attrs: attrs})
+	in, err := p.prepare(ctx, bizID, uid, resource, actions, attrs)
 	if err != nil {
 		return false, err
 	}
+	matched := make([]bool, len(in.policies))
+	for idx := range in.policies {
+		matched[idx] = p.parser.Check(in.policies[idx], in.subObj, in.resObj, in.envObj)
+	}
+	return p.decide(in, matched), nil
+}
+
+func (p *permissionSvc) CheckWith(ctx context.Context, bizID int64, policies []domain.Policy, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error) {
+	var (
+		in            checkInput
+		bizDefinition domain.BizAttrDefinition
+		eg            errgroup.Group
+	)
+	eg.Go(func() error {
+		var eerr error
+		in.permissions, eerr = p.permissionRepo.FindPermissions(ctx, bizID, resource.Type, resource.Key, actions)
+		return eerr
+	})
+	eg.Go(func() error {
+		var eerr error
+		bizDefinition, eerr = p.definitionRepo.Find(ctx, bizID)
+		return eerr
+	})
+	eg.Go(func() error {
+		var eerr error
+		in.bizConfig, eerr = p.findBizConfig(ctx, bizID)
+		return eerr
+	})
+	if err := eg.Wait(); err != nil {
+		return false, err
+	}
+	if err := p.mergeRealTimeAttrs(&in, bizDefinition, attrs); err != nil {
+		return false, err
+	}
+	in.policies = candidatesOf(policies, in.permissions)
+	matched := make([]bool, len(in.policies))
+	for idx := range in.policies {
+		matched[idx] = p.parser.Check(in.policies[idx], in.subObj, in.resObj, in.envObj)
+	}
+	return p.decide(in, matched), nil
+}
+
+func (p *permissionSvc) Explain(ctx context.Context, bizID, uid int64, resource domain.Resource, actions []string, attrs domain.Attributes) (domain.ABACTrace, error) {
+	in, err := p.prepare(ctx, bizID, uid, resource, actions, attrs)
+	if err != nil {
+		return domain.ABACTrace{}, err
+	}
+	return p.explain(in), nil
+}
+
+func (p *permissionSvc) explain(in checkInput) domain.ABACTrace {
+	res := domain.ABACTrace{
+		Attributes: slices.Concat(in.subObj.AttributeValues, in.resObj.AttributeValues, in.envObj.AttributeValues),
+		Policies:   make([]domain.PolicyTrace, 0, len(in.policies)),
+	}
+	matched := make([]bool, len(in.policies))
+	for idx := range in.policies {
+		trace := p.parser.Explain(in.policies[idx], in.subObj, in.resObj, in.envObj)
+		res.Policies = append(res.Policies, trace)
+		matched[idx] = trace.Matched
+	}
+	res.Allowed = p.decide(in, matched)
+	return res
+}
+
+// decide 按照权限上的合并算法合并各个策略的判定结果，权限上没有配置的使用业务配置的算法。
+// 任意一个权限的结果是拒绝或者无法判定就拒绝，否则只要有一个权限的结果是允许就允许；
+// 所有权限上都没有绑定策略的时候，由业务配置的 NoPolicyEffect 决定
+func (p *permissionSvc) decide(in checkInput, matched []bool) bool {
+	if len(in.policies) == 0 {
+		return in.bizConfig.NoPolicyEffectOrDefault().IsAllow()
+	}
+	var hasPermit bool
+	for idx := range in.permissions {
+		perm := in.permissions[idx]
+		decisions := make([]domain.Decision, 0, len(in.policies))
+		for jdx := range in.policies {
+			effect, ok := in.policies[jdx].EffectOf(perm.ID)
+			if !ok {
+				continue
+			}
+			decisions = append(decisions, domain.DecisionOf(matched[jdx], effect))
+		}
+		if len(decisions) == 0 {
+			continue
+		}
+		algorithm := perm.CombiningAlgorithm
+		if algorithm == "" {
+			algorithm = in.bizConfig.CombiningAlgorithm
+		}
+		switch algorithm.Combine(decisions) {
+		case domain.DecisionDeny, domain.DecisionIndeterminate:
+			return false
+		case domain.DecisionPermit:
+			hasPermit = true
+		case domain.DecisionNotApplicable:
+		}
+	}
+	// 一条都没符合就返回没通过校验
+	return hasPermit
+}
+
+func (p *permissionSvc) ListAccessible(ctx context.Context, bizID, uid int64, resourceType, action string, attrs domain.Attributes) (domain.ABACAccess, error) {
+	var (
+		in            checkInput
+		permissions   []domain.Permission
+		bizDefinition domain.BizAttrDefinition
+		eg            errgroup.Group
+	)
+	eg.Go(func() error {
+		var eerr error
+		permissions, eerr = p.permissionRepo.FindByBizIDAndResourceType(ctx, bizID, resourceType)
+		return eerr
+	})
+	eg.Go(func() error {
+		var eerr error
+		bizDefinition, eerr = p.definitionRepo.Find(ctx, bizID)
+		return eerr
+	})
+	eg.Go(func() error {
+		var eerr error
+		in.bizConfig, eerr = p.findBizConfig(ctx, bizID)
+		return eerr
+	})
+	if err := eg.Wait(); err != nil {
+		return domain.ABACAccess{}, err
+	}
+	permissions = slice.FilterMap(permissions, func(_ int, src domain.Permission) (domain.Permission, bool) {
+		return src, src.Action == action
+	})
+	permissionIDs := slice.Map(permissions, func(_ int, src domain.Permission) int64 {
+		return src.ID
+	})
+
+	eg.Go(func() error {
+		var eerr error
+		in.subObj, eerr = p.valRepo.FindSubjectValue(ctx, bizID, uid)
+		in.subObj.FillDefinitions(bizDefinition.SubjectAttrDefs)
+		return eerr
+	})
+	eg.Go(func() error {
+		var eerr error
+		in.envObj, eerr = p.valRepo.FindEnvironmentValue(ctx, bizID)
+		in.envObj.FillDefinitions(bizDefinition.EnvironmentAttrDefs)
+		return eerr
+	})
+	eg.Go(func() error {
+		if len(permissionIDs) == 0 {
+			return nil
+		}
+		var eerr error
+		in.policies, eerr = p.policyRepo.FindPoliciesByPermissionIDs(ctx, bizID, permissionIDs)
+		return eerr
+	})
+	if err := eg.Wait(); err != nil {
+		return domain.ABACAccess{}, err
+	}
+	if err := p.mergeRealTimeAttrs(&in, bizDefinition, attrs); err != nil {
+		return domain.ABACAccess{}, err
+	}
+
+	res := domain.ABACAccess{DefaultAllowed: in.bizConfig.NoPolicyEffectOrDefault().IsAllow()}
+	residuals := make(map[int64]domain.Residual, len(in.policies))
+	for idx := range in.policies {
+		policy := in.policies[idx]
+		residuals[policy.ID] = p.parser.Residual(policy, in.subObj, in.envObj, bizDefinition.ResourceAttrDefs)
+	}
+	// 校验的时候按照资源标识精确查找权限，所以同一个资源标识上的权限一起判定
+	keys := make([]string, 0, len(permissions))
+	byKey := make(map[string][]domain.Permission, len(permissions))
+	for idx := range permissions {
+		key := permissions[idx].Resource.Key
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], permissions[idx])
+	}
+	for _, key := range keys {
+		keyIn := checkInput{bizConfig: in.bizConfig, permissions: byKey[key]}
+		keyIn.policies = candidatesOf(in.policies, keyIn.permissions)
+		if len(keyIn.policies) == 0 {
+			continue
+		}
+		residual, ok := p.residualOf(keyIn, slice.Map(keyIn.policies, func(_ int, src domain.Policy) domain.Residual {
+			return residuals[src.ID]
+		}))
+		if !ok {
+			res.Unresolved = append(res.Unresolved, key)
+			continue
+		}
+		res.Residuals = append(res.Residuals, domain.ResourceResidual{Key: key, Residual: residual})
+	}
+	return res, nil
+}
+
+// residualOf 按照 decide 合并各个策略部分求值的结果。
+// 枚举结果不确定的策略所有满足和不满足的组合，允许的组合用或连起来；不确定的策略太多的时候返回 false
+func (p *permissionSvc) residualOf(in checkInput, residuals []domain.Residual) (domain.Residual, bool) {
+	var undetermined []int
+	matched := make([]bool, len(residuals))
+	for idx := range residuals {
+		if residuals[idx].IsDetermined() {
+			matched[idx] = residuals[idx].Value
+			continue
+		}
+		undetermined = append(undetermined, idx)
+	}
+	if len(undetermined) > maxResidualPolicies {
+		return domain.Residual{}, false
+	}
+	res, allowed, total := domain.ResidualOf(false), 0, 1<<len(undetermined)
+	for mask := 0; mask < total; mask++ {
+		term := domain.ResidualOf(true)
+		for bit, idx := range undetermined {
+			matched[idx] = mask&(1<<bit) != 0
+			if matched[idx] {
+				term = term.And(residuals[idx])
+			} else {
+				term = term.And(residuals[idx].Not())
+			}
+		}
+		if p.decide(in, matched) {
+			allowed++
+			res = res.Or(term)
+		}
+	}
+	if allowed == total {
+		return domain.ResidualOf(true), true
+	}
+	return res, true
+}
+
+// checkInput 判定需要的全部数据
+type checkInput struct {
+	bizConfig   domain.BusinessConfig
+	permissions []domain.Permission
+	// policies 按照策略ID升序排列
+	policies []domain.Policy
+	subObj   domain.ABACObject
+	resObj   domain.ABACObject
+	envObj   domain.ABACObject
+}
+
+// mergeRealTimeAttrs 合并实时属性，不合法的属性值按照业务配置处理：
+// reject 返回错误，log 记录日志之后继续使用原始值，ignore 直接忽略
+func (p *permissionSvc) mergeRealTimeAttrs(in *checkInput, bizDefinition domain.BizAttrDefinition, attrs domain.Attributes) error {
+	action := in.bizConfig.InvalidAttributeAction.OrDefault()
+	err := errors.Join(
+		in.subObj.MergeRealTimeAttrs(bizDefinition.SubjectAttrDefs, attrs.Subject, action, p.validator.Validate),
+		in.resObj.MergeRealTimeAttrs(bizDefinition.ResourceAttrDefs, attrs.Resource, action, p.validator.Validate),
+		in.envObj.MergeRealTimeAttrs(bizDefinition.EnvironmentAttrDefs, attrs.Environment, action, p.validator.Validate),
+	)
+	if err == nil {
+		return nil
+	}
+	switch action {
+	case domain.InvalidAttributeReject:
+		return err
+	case domain.InvalidAttributeLog:
+		p.logger.Warn("实时传入的属性值不合法", elog.FieldErr(err), elog.Int64("bizID", in.bizConfig.ID))
+	}
+	return nil
+}
+
+// prepare 准备判定需要的属性和策略，预存属性和实时属性合并在一起，实时属性的优先级更加高
+func (p *permissionSvc) prepare(ctx context.Context, bizID, uid int64, resource domain.Resource, actions []string, attrs domain.Attributes) (checkInput, error) {
+	var in checkInput
+	permissions, res, bizDefinition, err := p.getPermissionAndRes(ctx, bizID, resource, actions)
+	if err != nil {
+		return in, err
+	}
+	in.permissions = permissions
 	permissionIds := slice.Map(permissions, func(_ int, src domain.Permission) int64 {
 		return src.ID
 	})
 	resource.ID = res.ID
 
 	var eg errgroup.Group
-	var (
-		subObj   domain.ABACObject
-		resObj   domain.ABACObject
-		envObj   domain.ABACObject
-		policies []domain.Policy
-	)
-
 	eg.Go(func() error {
 		var eerr error
-		subObj, eerr = p.valRepo.FindSubjectValue(ctx, bizID, uid)
+		in.subObj, eerr = p.valRepo.FindSubjectValue(ctx, bizID, uid)
 		// 填充对应的 attribute_definition 定义
 		// 理论上来说，使用 JOIN 之类的查询，或者缓存做得好，可以直接在上面调用里面搞好的
-		subObj.FillDefinitions(bizDefinition.SubjectAttrDefs)
+		in.subObj.FillDefinitions(bizDefinition.SubjectAttrDefs)
 		return eerr
 	})
 	eg.Go(func() error {
 		var eerr error
-		resObj, eerr = p.valRepo.FindResourceValue(ctx, bizID, resource.ID)
-		resObj.FillDefinitions(bizDefinition.ResourceAttrDefs)
+		in.resObj, eerr = p.valRepo.FindResourceValue(ctx, bizID, resource.ID)
+		in.resObj.FillDefinitions(bizDefinition.ResourceAttrDefs)
 		return eerr
 	})
 	eg.Go(func() error {
 		var eerr error
-		envObj, eerr = p.valRepo.FindEnvironmentValue(ctx, bizID)
-		envObj.FillDefinitions(bizDefinition.EnvironmentAttrDefs)
+		in.envObj, eerr = p.valRepo.FindEnvironmentValue(ctx, bizID)
+		in.envObj.FillDefinitions(bizDefinition.EnvironmentAttrDefs)
 		return eerr
 	})
 
 	eg.Go(func() error {
 		var eerr error
-		policies, eerr = p.policyRepo.FindPoliciesByPermissionIDs(ctx, bizID, permissionIds)
+		in.policies, eerr = p.policyRepo.FindPoliciesByPermissionIDs(ctx, bizID, permissionIds)
+		return eerr
+	})
+	eg.Go(func() error {
+		var eerr error
+		in.bizConfig, eerr = p.findBizConfig(ctx, bizID)
 		return eerr
 	})
 
 	err = eg.Wait()
 	if err != nil {
-		return false, err
+		return in, err
 	}
 
-	// 将预存属性和实时属性合并在一起，实时属性的优先级更加高
-	subObj.MergeRealTimeAttrs(bizDefinition.SubjectAttrDefs, attrs.Subject)
-	resObj.MergeRealTimeAttrs(bizDefinition.ResourceAttrDefs, attrs.Resource)
-	envObj.MergeRealTimeAttrs(bizDefinition.EnvironmentAttrDefs, attrs.Environment)
-	var hasPermit bool
-	var hasDeny bool
-	if len(policies) == 0 {
-		// 你也可以采用保守措施，返回  false
-		return true, nil
-	}
-	for idx := range policies {
-		policy := policies[idx]
-		if p.parser.Check(policy, subObj, resObj, envObj) {
-			for jdx := range policy.Permissions {
-				perm := policy.Permissions[jdx]
-				if perm.Effect == domain.EffectAllow {
-					hasPermit = true
-				}
-				if perm.Effect == domain.EffectDeny {
-					hasDeny = true
-				}
-			}
-		}
-	}
-	// 拒绝是高优先级
-	if hasDeny {
-		return false, nil
+	err = p.mergeRealTimeAttrs(&in, bizDefinition, attrs)
+	if err != nil {
+		return in, err
 	}
-	if hasPermit {
-		return true, nil
+	// first_applicable 依赖策略的顺序
+	slices.SortFunc(in.policies, func(a, b domain.Policy) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return in, nil
+}
+
+// findBizConfig 没有业务配置的使用默认配置
+func (p *permissionSvc) findBizConfig(ctx context.Context, bizID int64) (domain.BusinessConfig, error) {
+	cfg, err := p.bizConfigRepo.FindByID(ctx, bizID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return domain.BusinessConfig{}, nil
 	}
-	// 一条都没符合就返回没通过校验
-	return false, nil
+	return cfg, err
 }
 
 func (p *permissionSvc) getPermissionAndRes(ctx context.Context, bizID int64, resource domain.Resource, actions []string) ([]domain.Permission, domain.Resource, domain.BizAttrDefinition, error) {
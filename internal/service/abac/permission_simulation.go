@@ -0,0 +1,104 @@
+package abac
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+func (p *permissionSvc) Simulate(ctx context.Context, bizID int64, candidates []domain.Policy, requests []domain.CheckRequest) (domain.PolicySimulation, error) {
+	res := domain.PolicySimulation{Total: len(requests)}
+	for idx := range requests {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		req := requests[idx]
+		in, err := p.prepare(ctx, bizID, req.UID, req.Resource, req.Actions, req.Attrs)
+		if err != nil {
+			res.Failures = append(res.Failures, domain.SimulationFailure{Request: req, Err: err.Error()})
+			continue
+		}
+		before := p.explain(in)
+		in.policies = candidatesOf(candidates, in.permissions)
+		after := p.explain(in)
+		if before.Allowed == after.Allowed {
+			continue
+		}
+		res.Flips = append(res.Flips, domain.DecisionFlip{
+			Request: req,
+			Before:  before.Allowed,
+			After:   after.Allowed,
+			Changes: policyChanges(before.Policies, after.Policies),
+		})
+	}
+	return res, nil
+}
+
+func (p *permissionSvc) RecentRequests(bizID int64, limit int) []domain.CheckRequest {
+	return p.recent.latest(bizID, limit)
+}
+
+// candidatesOf 和 prepare 一样，只保留关联了这些权限的候选策略，并且按照策略ID升序排列
+func candidatesOf(candidates []domain.Policy, permissions []domain.Permission) []domain.Policy {
+	permissionIDs := slice.Map(permissions, func(_ int, src domain.Permission) int64 {
+		return src.ID
+	})
+	res := slice.FilterMap(candidates, func(_ int, src domain.Policy) (domain.Policy, bool) {
+		return src, src.ContainsAnyPermissions(permissionIDs)
+	})
+	slices.SortStableFunc(res, func(a, b domain.Policy) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return res
+}
+
+// policyChanges 找出命中情况或者关联的权限发生了变化的策略，以及新增和删除的策略。
+// 新增的策略 ID 可能为 0，这种策略没有办法和当前的策略对应，一律视为新增
+func policyChanges(before, after []domain.PolicyTrace) []domain.PolicyChange {
+	var res []domain.PolicyChange
+	afterByID := make(map[int64]int, len(after))
+	for idx := range after {
+		if after[idx].Policy.ID > 0 {
+			afterByID[after[idx].Policy.ID] = idx
+		}
+	}
+	paired := make(map[int]struct{}, len(after))
+	for idx := range before {
+		b := &before[idx]
+		jdx, ok := afterByID[b.Policy.ID]
+		if !ok {
+			res = append(res, domain.PolicyChange{Before: b})
+			continue
+		}
+		paired[jdx] = struct{}{}
+		a := &after[jdx]
+		if a.Matched != b.Matched || !samePermissions(a.Policy, b.Policy) {
+			res = append(res, domain.PolicyChange{Before: b, After: a})
+		}
+	}
+	for idx := range after {
+		if _, ok := paired[idx]; !ok {
+			res = append(res, domain.PolicyChange{After: &after[idx]})
+		}
+	}
+	return res
+}
+
+func samePermissions(a, b domain.Policy) bool {
+	if len(a.Permissions) != len(b.Permissions) {
+		return false
+	}
+	effects := make(map[int64]domain.Effect, len(a.Permissions))
+	for _, perm := range a.Permissions {
+		effects[perm.Permission.ID] = perm.Effect
+	}
+	for _, perm := range b.Permissions {
+		if effect, ok := effects[perm.Permission.ID]; !ok || effect != perm.Effect {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,256 @@
+//go:build unit
+
+package abac
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionSvc_decide(t *testing.T) {
+	t.Parallel()
+
+	newPolicy := func(id int64, bindings map[int64]domain.Effect) domain.Policy {
+		p := domain.Policy{ID: id}
+		for permissionID, effect := range bindings {
+			p.Permissions = append(p.Permissions, domain.UserPermission{
+				Permission: domain.Permission{ID: permissionID},
+				Effect:     effect,
+			})
+		}
+		return p
+	}
+
+	testCases := []struct {
+		name    string
+		in      checkInput
+		matched []bool
+		want    bool
+	}{
+		{
+			name: "没有策略默认允许",
+			in:   checkInput{permissions: []domain.Permission{{ID: 1}}},
+			want: true,
+		},
+		{
+			name: "没有策略按照业务配置拒绝",
+			in: checkInput{
+				bizConfig:   domain.BusinessConfig{NoPolicyEffect: domain.EffectDeny},
+				permissions: []domain.Permission{{ID: 1}},
+			},
+			want: false,
+		},
+		{
+			name: "策略都不满足",
+			in: checkInput{
+				permissions: []domain.Permission{{ID: 1}},
+				policies:    []domain.Policy{newPolicy(1, map[int64]domain.Effect{1: domain.EffectAllow})},
+			},
+			matched: []bool{false},
+			want:    false,
+		},
+		{
+			name: "业务配置允许优先",
+			in: checkInput{
+				bizConfig:   domain.BusinessConfig{CombiningAlgorithm: domain.PermitOverrides},
+				permissions: []domain.Permission{{ID: 1}},
+				policies: []domain.Policy{
+					newPolicy(1, map[int64]domain.Effect{1: domain.EffectDeny}),
+					newPolicy(2, map[int64]domain.Effect{1: domain.EffectAllow}),
+				},
+			},
+			matched: []bool{true, true},
+			want:    true,
+		},
+		{
+			name: "权限上的算法覆盖业务配置",
+			in: checkInput{
+				bizConfig:   domain.BusinessConfig{CombiningAlgorithm: domain.PermitOverrides},
+				permissions: []domain.Permission{{ID: 1, CombiningAlgorithm: domain.FirstApplicable}},
+				policies: []domain.Policy{
+					newPolicy(1, map[int64]domain.Effect{1: domain.EffectDeny}),
+					newPolicy(2, map[int64]domain.Effect{1: domain.EffectAllow}),
+				},
+			},
+			matched: []bool{true, true},
+			want:    false,
+		},
+		{
+			name: "只作用在绑定的权限上",
+			in: checkInput{
+				permissions: []domain.Permission{{ID: 1}, {ID: 2}},
+				policies: []domain.Policy{
+					newPolicy(1, map[int64]domain.Effect{1: domain.EffectAllow}),
+					newPolicy(2, map[int64]domain.Effect{2: domain.EffectAllow}),
+				},
+			},
+			matched: []bool{true, false},
+			want:    true,
+		},
+		{
+			name: "任意一个权限被拒绝就拒绝",
+			in: checkInput{
+				permissions: []domain.Permission{{ID: 1}, {ID: 2, CombiningAlgorithm: domain.OnlyOneApplicable}},
+				policies: []domain.Policy{
+					newPolicy(1, map[int64]domain.Effect{1: domain.EffectAllow, 2: domain.EffectAllow}),
+					newPolicy(2, map[int64]domain.Effect{2: domain.EffectAllow}),
+				},
+			},
+			matched: []bool{true, true},
+			want:    false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			svc := &permissionSvc{}
+			assert.Equal(t, tc.want, svc.decide(tc.in, tc.matched))
+		})
+	}
+}
+
+func TestPermissionSvc_residualOf(t *testing.T) {
+	t.Parallel()
+
+	newPolicy := func(id int64, effect domain.Effect) domain.Policy {
+		return domain.Policy{ID: id, Permissions: []domain.UserPermission{
+			{Permission: domain.Permission{ID: 1}, Effect: effect},
+		}}
+	}
+	cond := func(attr string) domain.Residual {
+		return domain.Residual{Condition: &domain.ResidualCondition{Operator: domain.Equals, Attr: attr, Value: "1"}}
+	}
+
+	testCases := []struct {
+		name      string
+		algorithm domain.CombiningAlgorithm
+		policies  []domain.Policy
+		residuals []domain.Residual
+		want      domain.Residual
+		wantOK    bool
+	}{
+		{
+			name:      "一个允许的策略",
+			policies:  []domain.Policy{newPolicy(1, domain.EffectAllow)},
+			residuals: []domain.Residual{cond("a")},
+			want:      cond("a"),
+			wantOK:    true,
+		},
+		{
+			name:      "拒绝优先",
+			algorithm: domain.DenyOverrides,
+			policies:  []domain.Policy{newPolicy(1, domain.EffectAllow), newPolicy(2, domain.EffectDeny)},
+			residuals: []domain.Residual{cond("a"), cond("b")},
+			want:      cond("a").And(cond("b").Not()),
+			wantOK:    true,
+		},
+		{
+			name:      "已经确定允许",
+			algorithm: domain.PermitOverrides,
+			policies:  []domain.Policy{newPolicy(1, domain.EffectAllow), newPolicy(2, domain.EffectDeny)},
+			residuals: []domain.Residual{domain.ResidualOf(true), cond("b")},
+			want:      domain.ResidualOf(true),
+			wantOK:    true,
+		},
+		{
+			name:      "已经确定拒绝",
+			policies:  []domain.Policy{newPolicy(1, domain.EffectAllow), newPolicy(2, domain.EffectDeny)},
+			residuals: []domain.Residual{cond("a"), domain.ResidualOf(true)},
+			want:      domain.ResidualOf(false),
+			wantOK:    true,
+		},
+		{
+			name: "不确定的策略太多",
+			policies: []domain.Policy{
+				newPolicy(1, domain.EffectAllow), newPolicy(2, domain.EffectAllow), newPolicy(3, domain.EffectAllow),
+				newPolicy(4, domain.EffectAllow), newPolicy(5, domain.EffectAllow), newPolicy(6, domain.EffectAllow),
+				newPolicy(7, domain.EffectAllow),
+			},
+			residuals: []domain.Residual{cond("a"), cond("b"), cond("c"), cond("d"), cond("e"), cond("f"), cond("g")},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			svc := &permissionSvc{}
+			in := checkInput{
+				bizConfig:   domain.BusinessConfig{CombiningAlgorithm: tc.algorithm},
+				permissions: []domain.Permission{{ID: 1}},
+				policies:    tc.policies,
+			}
+			got, ok := svc.residualOf(in, tc.residuals)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPolicyChanges(t *testing.T) {
+	t.Parallel()
+
+	trace := func(id int64, matched bool, effect domain.Effect) domain.PolicyTrace {
+		return domain.PolicyTrace{
+			Policy: domain.Policy{
+				ID: id,
+				Permissions: []domain.UserPermission{
+					{Permission: domain.Permission{ID: 1}, Effect: effect},
+				},
+			},
+			Matched: matched,
+		}
+	}
+	type change struct {
+		before int64
+		after  int64
+	}
+
+	testCases := []struct {
+		name   string
+		before []domain.PolicyTrace
+		after  []domain.PolicyTrace
+		want   []change
+	}{
+		{
+			name:   "没有变化",
+			before: []domain.PolicyTrace{trace(1, true, domain.EffectAllow)},
+			after:  []domain.PolicyTrace{trace(1, true, domain.EffectAllow)},
+		},
+		{
+			name:   "命中情况变化",
+			before: []domain.PolicyTrace{trace(1, true, domain.EffectAllow), trace(2, true, domain.EffectAllow)},
+			after:  []domain.PolicyTrace{trace(1, false, domain.EffectAllow), trace(2, true, domain.EffectAllow)},
+			want:   []change{{before: 1, after: 1}},
+		},
+		{
+			name:   "效果变化",
+			before: []domain.PolicyTrace{trace(1, true, domain.EffectAllow)},
+			after:  []domain.PolicyTrace{trace(1, true, domain.EffectDeny)},
+			want:   []change{{before: 1, after: 1}},
+		},
+		{
+			name:   "删除和新增",
+			before: []domain.PolicyTrace{trace(1, true, domain.EffectAllow)},
+			after:  []domain.PolicyTrace{trace(0, true, domain.EffectDeny), trace(2, false, domain.EffectAllow)},
+			want:   []change{{before: 1, after: -1}, {before: -1, after: 0}, {before: -1, after: 2}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var got []change
+			for _, c := range policyChanges(tc.before, tc.after) {
+				res := change{before: -1, after: -1}
+				if c.Before != nil {
+					res.before = c.Before.Policy.ID
+				}
+				if c.After != nil {
+					res.after = c.After.Policy.ID
+				}
+				got = append(got, res)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -2,26 +2,177 @@ package abac
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
 	"gitee.com/flycash/permission-platform/internal/repository"
+	"gitee.com/flycash/permission-platform/internal/service/abac/converter"
+	"gitee.com/flycash/permission-platform/internal/service/abac/expr"
+	"github.com/gotomicro/ego/core/elog"
 )
 
 type PolicySvc interface {
-	Save(ctx context.Context, policy domain.Policy) (int64, error)
+	// Save 保存之后会分析策略，返回发现的问题，有问题也不影响保存
+	Save(ctx context.Context, policy domain.Policy) (int64, []domain.PolicyIssue, error)
 	Delete(ctx context.Context, bizID, id int64) error
 	First(ctx context.Context, bizID, id int64) (domain.Policy, error) // 包含规则
 	SaveRule(ctx context.Context, bizID, policyID int64, rule domain.PolicyRule) (int64, error)
 	DeleteRule(ctx context.Context, bizID, ruleID int64) error
 	FindPoliciesByPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64) ([]domain.Policy, error)
 	SavePermissionPolicy(ctx context.Context, bizID, policyID, permissionID int64, effect domain.Effect) error
+	DeletePermissionPolicy(ctx context.Context, bizID, policyID, permissionID int64) error
 	FindPolicies(ctx context.Context, bizID int64, offset, limit int) (int64, []domain.Policy, error)
+	// FindDraftPolicies 业务下所有策略的草稿，包含规则以及关联的权限
+	FindDraftPolicies(ctx context.Context, bizID int64) ([]domain.Policy, error)
+	// Publish 发布策略的草稿，Save、SaveRule 等修改的都是草稿，发布之后才会生效
+	Publish(ctx context.Context, bizID, policyID int64) (int64, error)
+	// Rollback 回滚到指定版本，和发布一样要先通过测试用例
+	Rollback(ctx context.Context, bizID, policyID, version int64) (int64, error)
+	FindVersions(ctx context.Context, bizID, policyID int64) ([]domain.PolicyVersion, error)
+	// SaveTestCase 保存策略的测试用例，策略或者要修改的测试用例不属于这个业务的时候返回 errs.ErrPolicyNotFound 或者 errs.ErrPolicyTestCaseNotFound
+	SaveTestCase(ctx context.Context, testCase domain.PolicyTestCase) (int64, error)
+	DeleteTestCase(ctx context.Context, bizID, id int64) error
+	// FindTestCases policyID 为 0 的时候返回业务下全部的测试用例
+	FindTestCases(ctx context.Context, bizID, policyID int64) ([]domain.PolicyTestCase, error)
+	// RunTests 用当前生效的策略执行测试用例，policyID 为 0 的时候执行业务下全部的测试用例
+	RunTests(ctx context.Context, bizID, policyID int64) ([]domain.PolicyTestResult, error)
+	// Analyze 静态分析策略，policyID 为 0 的时候分析业务下的全部策略，
+	// 否则只返回这个策略的问题，包括和它冲突的策略
+	Analyze(ctx context.Context, bizID, policyID int64) ([]domain.PolicyIssue, error)
 }
 
 type policySvc struct {
 	repository.PolicyRepo
+	definitionRepo repository.AttributeDefinitionRepository
+	permissionSvc  PermissionSvc
+	logger         *elog.Component
 }
 
-func NewPolicySvc(repo repository.PolicyRepo) PolicySvc {
-	return &policySvc{repo}
+func NewPolicySvc(repo repository.PolicyRepo, definitionRepo repository.AttributeDefinitionRepository, permissionSvc PermissionSvc) PolicySvc {
+	return &policySvc{
+		PolicyRepo:     repo,
+		definitionRepo: definitionRepo,
+		permissionSvc:  permissionSvc,
+		logger:         elog.DefaultLogger,
+	}
+}
+
+func (p *policySvc) Save(ctx context.Context, policy domain.Policy) (int64, []domain.PolicyIssue, error) {
+	if !policy.ExecuteType.IsValid() {
+		return 0, nil, fmt.Errorf("%w: 未知的执行方式 %s", errs.ErrInvalidParameter, policy.ExecuteType)
+	}
+	if policy.ExecuteType == domain.ExpressionType {
+		if err := p.validateExpression(ctx, policy); err != nil {
+			return 0, nil, err
+		}
+	}
+	id, err := p.PolicyRepo.Save(ctx, policy)
+	if err != nil {
+		return 0, nil, err
+	}
+	// 已经保存成功了，分析失败只记录日志
+	issues, err := p.Analyze(ctx, policy.BizID, id)
+	if err != nil {
+		p.logger.Error("分析策略失败", elog.FieldErr(err), elog.Int64("bizID", policy.BizID), elog.Int64("policyID", id))
+	}
+	return id, issues, nil
+}
+
+func (p *policySvc) SaveRule(ctx context.Context, bizID, policyID int64, rule domain.PolicyRule) (int64, error) {
+	if err := p.validateRule(ctx, bizID, rule); err != nil {
+		return 0, err
+	}
+	return p.PolicyRepo.SaveRule(ctx, bizID, policyID, rule)
+}
+
+// validateRule 校验运算符，以及运算符能否用于属性的类型
+func (p *policySvc) validateRule(ctx context.Context, bizID int64, rule domain.PolicyRule) error {
+	if !rule.Operator.IsValid() {
+		return fmt.Errorf("%w: 未知的运算符 %s", errs.ErrInvalidParameter, rule.Operator)
+	}
+	if !rule.Operator.IsStringPattern() && !rule.Operator.IsCIDR() && rule.ValueAttrDef == nil {
+		return nil
+	}
+	if rule.Operator.IsLogical() {
+		return fmt.Errorf("%w: 逻辑运算符 %s 不能和属性比较", errs.ErrInvalidParameter, rule.Operator)
+	}
+	bizDefinition, err := p.definitionRepo.Find(ctx, bizID)
+	if err != nil {
+		return err
+	}
+	def, ok := bizDefinition.GetByDefID(rule.AttrDef.ID)
+	if !ok {
+		return fmt.Errorf("%w: 属性 %d 没有定义", errs.ErrInvalidParameter, rule.AttrDef.ID)
+	}
+	if rule.ValueAttrDef != nil {
+		// 和另一个属性比较，两个属性的类型必须一致
+		valueDef, ok := bizDefinition.GetByDefID(rule.ValueAttrDef.ID)
+		if !ok {
+			return fmt.Errorf("%w: 属性 %d 没有定义", errs.ErrInvalidParameter, rule.ValueAttrDef.ID)
+		}
+		if valueDef.DataType != def.DataType {
+			return fmt.Errorf("%w: 属性 %s 和 %s 的类型不一致", errs.ErrInvalidParameter, def.Name, valueDef.Name)
+		}
+	}
+	if err := validateOperatorDataType(rule.Operator, def); err != nil {
+		return err
+	}
+	if rule.ValueAttrDef != nil {
+		return nil
+	}
+	switch {
+	case rule.Operator == domain.Matches:
+		if _, err := regexp.Compile(rule.Value); err != nil {
+			return fmt.Errorf("%w: 正则表达式错误: %w", errs.ErrInvalidParameter, err)
+		}
+	case rule.Operator.IsCIDR():
+		if _, err := converter.NewCIDRConverter().Decode(rule.Value); err != nil {
+			return fmt.Errorf("%w: 网段错误: %w", errs.ErrInvalidParameter, err)
+		}
+	}
+	return nil
+}
+
+// validateOperatorDataType 字符串运算符只能用于字符串属性，网段运算符只能用于 IP 属性
+func validateOperatorDataType(op domain.RuleOperator, def domain.AttributeDefinition) error {
+	if op.IsStringPattern() && def.DataType != domain.DataTypeString {
+		return fmt.Errorf("%w: %s 只能用于字符串属性，%s 不是字符串", errs.ErrInvalidParameter, op, def.Name)
+	}
+	if op.IsCIDR() && def.DataType != domain.DataTypeIP {
+		return fmt.Errorf("%w: %s 只能用于 IP 属性，%s 不是 IP", errs.ErrInvalidParameter, op, def.Name)
+	}
+	return nil
+}
+
+// validateExpression 校验表达式的语法，以及引用的属性都已经定义
+func (p *policySvc) validateExpression(ctx context.Context, policy domain.Policy) error {
+	prog, err := expr.Compile(policy.Expression)
+	if err != nil {
+		return fmt.Errorf("%w: 策略表达式错误: %w", errs.ErrInvalidParameter, err)
+	}
+	bizDefinition, err := p.definitionRepo.Find(ctx, policy.BizID)
+	if err != nil {
+		return err
+	}
+	for _, ref := range prog.Refs() {
+		if _, ok := bizDefinition.DefsOf(ref.EntityType).GetByName(ref.Name); !ok {
+			return fmt.Errorf("%w: 属性 %s 没有定义", errs.ErrInvalidParameter, ref)
+		}
+	}
+	for _, cmp := range prog.Comparisons() {
+		def, _ := bizDefinition.DefsOf(cmp.Attr.EntityType).GetByName(cmp.Attr.Name)
+		if err := validateOperatorDataType(cmp.Operator, def); err != nil {
+			return err
+		}
+		if cmp.Value == nil {
+			continue
+		}
+		valueDef, _ := bizDefinition.DefsOf(cmp.Value.EntityType).GetByName(cmp.Value.Name)
+		if valueDef.DataType != def.DataType {
+			return fmt.Errorf("%w: 属性 %s 和 %s 的类型不一致", errs.ErrInvalidParameter, cmp.Attr, cmp.Value)
+		}
+	}
+	return nil
 }
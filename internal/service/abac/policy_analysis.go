@@ -0,0 +1,28 @@
+package abac
+
+import (
+	"context"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/service/abac/analyzer"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// Analyze 分析的是草稿，也就是正在编辑的策略
+func (p *policySvc) Analyze(ctx context.Context, bizID, policyID int64) ([]domain.PolicyIssue, error) {
+	policies, err := p.PolicyRepo.FindDraftPolicies(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	bizDefinition, err := p.definitionRepo.Find(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	issues := analyzer.Analyze(policies, bizDefinition)
+	if policyID == 0 {
+		return issues, nil
+	}
+	return slice.FilterMap(issues, func(_ int, src domain.PolicyIssue) (domain.PolicyIssue, bool) {
+		return src, src.PolicyID == policyID || src.RelatedPolicyID == policyID
+	}), nil
+}
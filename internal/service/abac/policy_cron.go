@@ -9,27 +9,59 @@ import (
 	"gitee.com/flycash/permission-platform/internal/repository"
 	"gitee.com/flycash/permission-platform/internal/repository/cache"
 	"github.com/ego-component/eetcd"
+	"github.com/gotomicro/ego/core/elog"
 )
 
 type PolicyCron struct {
-	client *eetcd.Component
-	repo   repository.PolicyRepo
-	cache  cache.ABACPolicyCache
+	client   *eetcd.Component
+	repo     repository.PolicyRepo
+	cache    cache.ABACPolicyCache
+	interval time.Duration
+	logger   *elog.Component
 }
 
 const (
-	hotPolicyName  = "hotPolicy"
-	defaultTimeout = 5 * time.Second
+	hotPolicyName             = "hotPolicy"
+	defaultTimeout            = 5 * time.Second
+	defaultPolicyCronInterval = time.Minute
 )
 
 func NewPolicyCron(client *eetcd.Component, repo repository.PolicyRepo, ca cache.ABACPolicyCache) *PolicyCron {
 	return &PolicyCron{
-		client: client,
-		repo:   repo,
-		cache:  ca,
+		client:   client,
+		repo:     repo,
+		cache:    ca,
+		interval: defaultPolicyCronInterval,
+		logger:   elog.DefaultLogger.With(elog.FieldName("PolicyCron")),
 	}
 }
 
+// WithInterval 设置刷新热点业务策略缓存的间隔
+func (p *PolicyCron) WithInterval(interval time.Duration) *PolicyCron {
+	if interval > 0 {
+		p.interval = interval
+	}
+	return p
+}
+
+// Start 立刻刷新一次，之后按照 interval 定时刷新，直到 ctx 被取消
+func (p *PolicyCron) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			if err := p.Run(ctx); err != nil {
+				p.logger.Error("刷新热点业务的策略缓存失败", elog.FieldErr(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
 // 定时任务
 func (p *PolicyCron) Run(ctx context.Context) error {
 	nctx, cancel := context.WithTimeout(ctx, defaultTimeout)
@@ -0,0 +1,132 @@
+package abac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"github.com/ecodeclub/ekit/slice"
+	"gorm.io/gorm"
+)
+
+func (p *policySvc) SaveTestCase(ctx context.Context, testCase domain.PolicyTestCase) (int64, error) {
+	if testCase.Name == "" {
+		return 0, fmt.Errorf("%w: 测试用例名称不能为空", errs.ErrInvalidParameter)
+	}
+	if testCase.Resource.Type == "" || testCase.Resource.Key == "" || testCase.Action == "" {
+		return 0, fmt.Errorf("%w: 测试用例的资源和操作不能为空", errs.ErrInvalidParameter)
+	}
+	if testCase.Expected != domain.EffectAllow && testCase.Expected != domain.EffectDeny {
+		return 0, fmt.Errorf("%w: 未知的预期结果 %s", errs.ErrInvalidParameter, testCase.Expected)
+	}
+	// 测试用例只能关联本业务下的策略
+	if _, err := p.PolicyRepo.First(ctx, testCase.BizID, testCase.PolicyID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, fmt.Errorf("%w: 业务 %d 下没有策略 %d", errs.ErrPolicyNotFound, testCase.BizID, testCase.PolicyID)
+		}
+		return 0, err
+	}
+	return p.PolicyRepo.SaveTestCase(ctx, testCase)
+}
+
+func (p *policySvc) RunTests(ctx context.Context, bizID, policyID int64) ([]domain.PolicyTestResult, error) {
+	cases, err := p.PolicyRepo.FindTestCases(ctx, bizID, policyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cases) == 0 {
+		return nil, nil
+	}
+	policies, err := p.PolicyRepo.FindBizPolicies(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	return p.runTests(ctx, bizID, policies, cases)
+}
+
+// Publish 发布之前，用草稿替换当前生效的版本执行这个策略的测试用例，全部通过才会发布。
+// 测试的是读取草稿时的快照，发布的时候如果草稿已经被修改过就不发布，避免发布没有测试过的内容
+func (p *policySvc) Publish(ctx context.Context, bizID, policyID int64) (int64, error) {
+	draft, err := p.PolicyRepo.FindDraft(ctx, bizID, policyID)
+	if err != nil {
+		return 0, err
+	}
+	if err = p.checkBeforePublish(ctx, bizID, draft.Policy); err != nil {
+		return 0, err
+	}
+	return p.PolicyRepo.PublishDraft(ctx, draft)
+}
+
+// Rollback 回滚同样会改变生效的策略，所以也要先用目标版本执行测试用例。版本发布之后不会再修改，不需要检查是否变化
+func (p *policySvc) Rollback(ctx context.Context, bizID, policyID, version int64) (int64, error) {
+	versions, err := p.PolicyRepo.FindVersions(ctx, bizID, policyID)
+	if err != nil {
+		return 0, err
+	}
+	target, ok := slice.Find(versions, func(src domain.PolicyVersion) bool {
+		return src.Version == version
+	})
+	if !ok {
+		return 0, fmt.Errorf("%w: 策略 %d 没有版本 %d", errs.ErrPolicyVersionNotFound, policyID, version)
+	}
+	if err = p.checkBeforePublish(ctx, bizID, target.Policy); err != nil {
+		return 0, err
+	}
+	return p.PolicyRepo.Rollback(ctx, bizID, policyID, version)
+}
+
+// checkBeforePublish 用 policy 替换当前生效的版本执行它的测试用例，有没通过的用例返回 errs.ErrPolicyTestFailed
+func (p *policySvc) checkBeforePublish(ctx context.Context, bizID int64, policy domain.Policy) error {
+	cases, err := p.PolicyRepo.FindTestCases(ctx, bizID, policy.ID)
+	if err != nil || len(cases) == 0 {
+		return err
+	}
+	published, err := p.PolicyRepo.FindBizPolicies(ctx, bizID)
+	if err != nil {
+		return err
+	}
+	results, err := p.runTests(ctx, bizID, withDraft(published, policy), cases)
+	if err != nil {
+		return err
+	}
+	failed := slice.FilterMap(results, func(_ int, src domain.PolicyTestResult) (string, bool) {
+		return src.Case.Name, !src.Passed
+	})
+	if len(failed) > 0 {
+		return fmt.Errorf("%w: %s", errs.ErrPolicyTestFailed, strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func (p *policySvc) runTests(ctx context.Context, bizID int64, policies []domain.Policy, cases []domain.PolicyTestCase) ([]domain.PolicyTestResult, error) {
+	res := make([]domain.PolicyTestResult, 0, len(cases))
+	for idx := range cases {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		testCase := cases[idx]
+		allowed, err := p.permissionSvc.CheckWith(ctx, bizID, policies, testCase.Resource, []string{testCase.Action}, testCase.Attrs)
+		res = append(res, testResultOf(testCase, allowed, err))
+	}
+	return res, nil
+}
+
+// testResultOf 执行出错的用例视为没有通过
+func testResultOf(testCase domain.PolicyTestCase, allowed bool, err error) domain.PolicyTestResult {
+	if err != nil {
+		return domain.PolicyTestResult{Case: testCase, Err: err.Error()}
+	}
+	actual := domain.EffectOf(allowed)
+	return domain.PolicyTestResult{Case: testCase, Passed: actual == testCase.Expected, Actual: actual}
+}
+
+// withDraft 用草稿替换当前生效的版本，没有发布过的策略直接加进去
+func withDraft(published []domain.Policy, draft domain.Policy) []domain.Policy {
+	res := slice.FilterMap(published, func(_ int, src domain.Policy) (domain.Policy, bool) {
+		return src, src.ID != draft.ID
+	})
+	return append(res, draft)
+}
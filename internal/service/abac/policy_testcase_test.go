@@ -0,0 +1,84 @@
+//go:build unit
+
+package abac
+
+import (
+	"errors"
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestResultOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		expected domain.Effect
+		allowed  bool
+		err      error
+		want     domain.PolicyTestResult
+	}{
+		{
+			name:     "预期允许",
+			expected: domain.EffectAllow,
+			allowed:  true,
+			want:     domain.PolicyTestResult{Passed: true, Actual: domain.EffectAllow},
+		},
+		{
+			name:     "预期拒绝",
+			expected: domain.EffectDeny,
+			want:     domain.PolicyTestResult{Passed: true, Actual: domain.EffectDeny},
+		},
+		{
+			name:     "结果不符合预期",
+			expected: domain.EffectDeny,
+			allowed:  true,
+			want:     domain.PolicyTestResult{Actual: domain.EffectAllow},
+		},
+		{
+			name:     "执行出错",
+			expected: domain.EffectDeny,
+			err:      errors.New("mock error"),
+			want:     domain.PolicyTestResult{Err: "mock error"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testCase := domain.PolicyTestCase{Name: tc.name, Expected: tc.expected}
+			tc.want.Case = testCase
+			assert.Equal(t, tc.want, testResultOf(testCase, tc.allowed, tc.err))
+		})
+	}
+}
+
+func TestWithDraft(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		published []int64
+		draft     int64
+		want      []int64
+	}{
+		{name: "替换已经发布的版本", published: []int64{1, 2, 3}, draft: 2, want: []int64{1, 3, 2}},
+		{name: "没有发布过", published: []int64{1, 3}, draft: 2, want: []int64{1, 3, 2}},
+		{name: "业务下没有策略", draft: 2, want: []int64{2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			published := slice.Map(tc.published, func(_ int, id int64) domain.Policy {
+				return domain.Policy{ID: id, PublishedVersion: 1}
+			})
+			got := withDraft(published, domain.Policy{ID: tc.draft})
+			assert.Equal(t, tc.want, slice.Map(got, func(_ int, src domain.Policy) int64 {
+				return src.ID
+			}))
+			assert.Zero(t, got[len(got)-1].PublishedVersion)
+		})
+	}
+}
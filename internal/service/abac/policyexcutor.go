@@ -1,6 +1,8 @@
 package abac
 
 import (
+	"fmt"
+
 	"gitee.com/flycash/permission-platform/internal/domain"
 	"gitee.com/flycash/permission-platform/internal/service/abac/evaluator"
 	"github.com/ecodeclub/ekit/mapx"
@@ -11,6 +13,46 @@ import (
 type PolicyExecutor interface {
 	// Check values 是 attr_id 到值的映射
 	Check(policy domain.Policy, subject domain.ABACObject, resource domain.ABACObject, environment domain.ABACObject) bool
+	// Explain 和 Check 的判定逻辑一致，但是会返回每一个规则节点的判定结果
+	Explain(policy domain.Policy, subject domain.ABACObject, resource domain.ABACObject, environment domain.ABACObject) domain.PolicyTrace
+	// Residual 资源未知的时候部分求值，和资源属性有关的比较保留下来，resourceDefs 是业务的资源属性定义
+	Residual(policy domain.Policy, subject domain.ABACObject, environment domain.ABACObject, resourceDefs domain.AttrDefs) domain.Residual
+}
+
+// NewPolicyExecutor 按照策略的 ExecuteType 选择执行方法
+func NewPolicyExecutor(checkBuilder evaluator.Selector) PolicyExecutor {
+	return &executeTypeExecutor{
+		logic: &logicOperatorExecutor{
+			selector: checkBuilder,
+			logger:   elog.DefaultLogger,
+		},
+		expression: newExpressionExecutor(checkBuilder, defaultProgramCacheSize),
+	}
+}
+
+// executeTypeExecutor 根据策略的 ExecuteType 分发到具体的执行方法
+type executeTypeExecutor struct {
+	logic      PolicyExecutor
+	expression PolicyExecutor
+}
+
+func (e *executeTypeExecutor) Check(policy domain.Policy, subject, resource, environment domain.ABACObject) bool {
+	return e.executorOf(policy).Check(policy, subject, resource, environment)
+}
+
+func (e *executeTypeExecutor) Explain(policy domain.Policy, subject, resource, environment domain.ABACObject) domain.PolicyTrace {
+	return e.executorOf(policy).Explain(policy, subject, resource, environment)
+}
+
+func (e *executeTypeExecutor) Residual(policy domain.Policy, subject, environment domain.ABACObject, resourceDefs domain.AttrDefs) domain.Residual {
+	return e.executorOf(policy).Residual(policy, subject, environment, resourceDefs)
+}
+
+func (e *executeTypeExecutor) executorOf(policy domain.Policy) PolicyExecutor {
+	if policy.ExecuteType == domain.ExpressionType {
+		return e.expression
+	}
+	return e.logic
 }
 
 // 基于逻辑运算符的方法
@@ -19,13 +61,6 @@ type logicOperatorExecutor struct {
 	logger   *elog.Component
 }
 
-func NewPolicyExecutor(checkBuilder evaluator.Selector) PolicyExecutor {
-	return &logicOperatorExecutor{
-		selector: checkBuilder,
-		logger:   elog.DefaultLogger,
-	}
-}
-
 func (r *logicOperatorExecutor) Check(policy domain.Policy, subject, resource, environment domain.ABACObject) bool {
 	// 因为 Rule 是按照 attr_id 来设计的，所以我们可以合并一下所有的对象的属性取值
 	// 它们的 attr_id 都是不同的，所以不会有问题
@@ -46,12 +81,15 @@ func (r *logicOperatorExecutor) Check(policy domain.Policy, subject, resource, e
 func (r *logicOperatorExecutor) checkOneRule(rule domain.PolicyRule, values map[int64]domain.AttributeValue) bool {
 	if rule.LeftRule == nil && rule.RightRule == nil {
 		val := values[rule.AttrDef.ID]
-		actualVal := val.Value
+		wantVal, err := r.wantValue(rule, val, values)
+		if err != nil {
+			return false
+		}
 		checker, err := r.selector.Select(val.Definition.DataType)
 		if err != nil {
 			return false
 		}
-		ok, err := checker.Evaluate(rule.Value, actualVal, rule.Operator)
+		ok, err := checker.Evaluate(wantVal.Value, val.Value, rule.Operator)
 		if err != nil {
 			return false
 		}
@@ -75,3 +113,172 @@ func (r *logicOperatorExecutor) checkOneRule(rule domain.PolicyRule, values map[
 		return false
 	}
 }
+
+func (r *logicOperatorExecutor) Explain(policy domain.Policy, subject, resource, environment domain.ABACObject) domain.PolicyTrace {
+	smap := subject.ValuesMap()
+	rmap := resource.ValuesMap()
+	env := environment.ValuesMap()
+	values := mapx.Merge(smap, rmap, env)
+
+	res := domain.PolicyTrace{
+		Policy:  policy,
+		Matched: true,
+		Rules:   make([]domain.RuleTrace, 0, len(policy.Rules)),
+	}
+	for idx := range policy.Rules {
+		trace := r.explainOneRule(policy.Rules[idx], values)
+		res.Matched = res.Matched && trace.Result
+		res.Rules = append(res.Rules, trace)
+	}
+	return res
+}
+
+func (r *logicOperatorExecutor) explainOneRule(rule domain.PolicyRule, values map[int64]domain.AttributeValue) domain.RuleTrace {
+	res := domain.RuleTrace{Rule: rule}
+	if rule.LeftRule == nil && rule.RightRule == nil {
+		val := values[rule.AttrDef.ID]
+		res.ActualValue = val.Value
+		wantVal, err := r.wantValue(rule, val, values)
+		if rule.ValueAttrDef != nil {
+			// 和另一个属性比较的时候，记录另一个属性实际使用的值
+			def := wantVal.Definition
+			res.Rule.ValueAttrDef = &def
+			res.Rule.Value = wantVal.Value
+		}
+		if err != nil {
+			res.Err = err.Error()
+			return res
+		}
+		checker, err := r.selector.Select(val.Definition.DataType)
+		if err != nil {
+			res.Err = err.Error()
+			return res
+		}
+		res.Result, err = checker.Evaluate(wantVal.Value, val.Value, rule.Operator)
+		if err != nil {
+			res.Result = false
+			res.Err = err.Error()
+		}
+		return res
+	}
+	left, right := true, true
+	if rule.LeftRule != nil {
+		trace := r.explainOneRule(*rule.LeftRule, values)
+		left = trace.Result
+		res.Left = &trace
+	}
+	if rule.RightRule != nil {
+		trace := r.explainOneRule(*rule.RightRule, values)
+		right = trace.Result
+		res.Right = &trace
+	}
+	switch rule.Operator {
+	case domain.AND:
+		res.Result = left && right
+	case domain.OR:
+		res.Result = left || right
+	case domain.NOT:
+		res.Result = !right
+	default:
+		res.Err = "未知的逻辑运算符 " + rule.Operator.String()
+	}
+	return res
+}
+
+func (r *logicOperatorExecutor) Residual(policy domain.Policy, subject, environment domain.ABACObject, resourceDefs domain.AttrDefs) domain.Residual {
+	values := mapx.Merge(subject.ValuesMap(), environment.ValuesMap())
+	defs := resourceDefs.Map()
+	res := domain.ResidualOf(true)
+	for idx := range policy.Rules {
+		res = res.And(r.residualOfRule(policy.Rules[idx], values, defs))
+	}
+	return res
+}
+
+func (r *logicOperatorExecutor) residualOfRule(rule domain.PolicyRule, values map[int64]domain.AttributeValue,
+	resourceDefs map[int64]domain.AttributeDefinition,
+) domain.Residual {
+	if rule.LeftRule == nil && rule.RightRule == nil {
+		return r.residualOfLeaf(rule, values, resourceDefs)
+	}
+	left, right := domain.ResidualOf(true), domain.ResidualOf(true)
+	if rule.LeftRule != nil {
+		left = r.residualOfRule(*rule.LeftRule, values, resourceDefs)
+	}
+	if rule.RightRule != nil {
+		right = r.residualOfRule(*rule.RightRule, values, resourceDefs)
+	}
+	switch rule.Operator {
+	case domain.AND:
+		return left.And(right)
+	case domain.OR:
+		return left.Or(right)
+	case domain.NOT:
+		return right.Not()
+	default:
+		return domain.ResidualOf(false)
+	}
+}
+
+// residualOfLeaf 和资源属性有关的比较保留下来，另一边是主体或者环境属性的时候换成它的取值；
+// 和 checkOneRule 一样，取值缺失或者两边类型不一致的比较结果为 false
+func (r *logicOperatorExecutor) residualOfLeaf(rule domain.PolicyRule, values map[int64]domain.AttributeValue,
+	resourceDefs map[int64]domain.AttributeDefinition,
+) domain.Residual {
+	attr, attrUnknown := resourceDefs[rule.AttrDef.ID]
+	var (
+		valueAttr        domain.AttributeDefinition
+		valueAttrUnknown bool
+	)
+	if rule.ValueAttrDef != nil {
+		valueAttr, valueAttrUnknown = resourceDefs[rule.ValueAttrDef.ID]
+	}
+	switch {
+	case attrUnknown && valueAttrUnknown:
+		if attr.DataType != valueAttr.DataType {
+			return domain.ResidualOf(false)
+		}
+		return domain.Residual{Condition: &domain.ResidualCondition{
+			Operator: rule.Operator, Attr: attr.Name, DataType: attr.DataType, ValueAttr: valueAttr.Name,
+		}}
+	case attrUnknown:
+		want := rule.Value
+		if rule.ValueAttrDef != nil {
+			val, ok := values[rule.ValueAttrDef.ID]
+			if !ok || val.Definition.DataType != attr.DataType {
+				return domain.ResidualOf(false)
+			}
+			want = val.Value
+		}
+		return domain.Residual{Condition: &domain.ResidualCondition{
+			Operator: rule.Operator, Attr: attr.Name, DataType: attr.DataType, Value: want,
+		}}
+	case valueAttrUnknown:
+		actual, ok := values[rule.AttrDef.ID]
+		if !ok || actual.Definition.DataType != valueAttr.DataType {
+			return domain.ResidualOf(false)
+		}
+		return domain.Residual{Condition: &domain.ResidualCondition{
+			Operator: rule.Operator, Attr: valueAttr.Name, DataType: valueAttr.DataType, Value: actual.Value, Reversed: true,
+		}}
+	default:
+		return domain.ResidualOf(r.checkOneRule(rule, values))
+	}
+}
+
+// wantValue 规则期望的值，ValueAttrDef 不为空的时候是另一个属性的取值，两个属性的类型必须一致
+func (r *logicOperatorExecutor) wantValue(rule domain.PolicyRule, actual domain.AttributeValue,
+	values map[int64]domain.AttributeValue,
+) (domain.AttributeValue, error) {
+	if rule.ValueAttrDef == nil {
+		return domain.AttributeValue{Definition: actual.Definition, Value: rule.Value}, nil
+	}
+	val, ok := values[rule.ValueAttrDef.ID]
+	if !ok {
+		return domain.AttributeValue{Definition: *rule.ValueAttrDef}, fmt.Errorf("属性 %d 没有取值", rule.ValueAttrDef.ID)
+	}
+	if val.Definition.DataType != actual.Definition.DataType {
+		return val, fmt.Errorf("属性 %s 和 %s 的类型不一致", actual.Definition.Name, val.Definition.Name)
+	}
+	return val, nil
+}
@@ -0,0 +1,119 @@
+package abac
+
+import (
+	"sync"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/service/abac/evaluator"
+	"gitee.com/flycash/permission-platform/internal/service/abac/expr"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// 编译结果缓存的最大条数，超过之后整体清空
+const defaultProgramCacheSize = 4096
+
+// expressionExecutor 基于文本表达式的方法，表达式编译之后会缓存起来
+type expressionExecutor struct {
+	selector evaluator.Selector
+	logger   *elog.Component
+
+	mu       sync.RWMutex
+	programs map[string]*expr.Program
+	capacity int
+}
+
+func newExpressionExecutor(selector evaluator.Selector, capacity int) *expressionExecutor {
+	return &expressionExecutor{
+		selector: selector,
+		logger:   elog.DefaultLogger,
+		programs: make(map[string]*expr.Program),
+		capacity: capacity,
+	}
+}
+
+func (e *expressionExecutor) Check(policy domain.Policy, subject, resource, environment domain.ABACObject) bool {
+	return e.Explain(policy, subject, resource, environment).Matched
+}
+
+func (e *expressionExecutor) Explain(policy domain.Policy, subject, resource, environment domain.ABACObject) domain.PolicyTrace {
+	res := domain.PolicyTrace{Policy: policy}
+	prog, err := e.compile(policy.Expression)
+	if err != nil {
+		// 保存的时候已经校验过，走到这里说明数据被改坏了
+		e.logger.Error("策略表达式解析失败",
+			elog.FieldErr(err),
+			elog.Int64("bizId", policy.BizID),
+			elog.Int64("policyId", policy.ID))
+		res.Rules = []domain.RuleTrace{{
+			Rule: domain.PolicyRule{Value: policy.Expression},
+			Err:  err.Error(),
+		}}
+		return res
+	}
+	values := map[domain.EntityType]map[string]domain.AttributeValue{
+		domain.EntityTypeSubject:     namedValues(subject),
+		domain.EntityTypeResource:    namedValues(resource),
+		domain.EntityTypeEnvironment: namedValues(environment),
+	}
+	trace := prog.Eval(e.selector, func(ref expr.AttrRef) (domain.AttributeValue, bool) {
+		val, ok := values[ref.EntityType][ref.Name]
+		return val, ok
+	})
+	res.Matched = trace.Result
+	res.Rules = []domain.RuleTrace{trace}
+	return res
+}
+
+func (e *expressionExecutor) Residual(policy domain.Policy, subject, environment domain.ABACObject, resourceDefs domain.AttrDefs) domain.Residual {
+	prog, err := e.compile(policy.Expression)
+	if err != nil {
+		e.logger.Error("策略表达式解析失败",
+			elog.FieldErr(err),
+			elog.Int64("bizId", policy.BizID),
+			elog.Int64("policyId", policy.ID))
+		return domain.ResidualOf(false)
+	}
+	values := map[domain.EntityType]map[string]domain.AttributeValue{
+		domain.EntityTypeSubject:     namedValues(subject),
+		domain.EntityTypeEnvironment: namedValues(environment),
+	}
+	return prog.Residual(e.selector, func(ref expr.AttrRef) (domain.AttributeValue, bool) {
+		val, ok := values[ref.EntityType][ref.Name]
+		return val, ok
+	}, func(ref expr.AttrRef) (domain.AttributeDefinition, bool) {
+		if ref.EntityType != domain.EntityTypeResource {
+			return domain.AttributeDefinition{}, false
+		}
+		return resourceDefs.GetByName(ref.Name)
+	})
+}
+
+func (e *expressionExecutor) compile(src string) (*expr.Program, error) {
+	e.mu.RLock()
+	prog, ok := e.programs[src]
+	e.mu.RUnlock()
+	if ok {
+		return prog, nil
+	}
+	prog, err := expr.Compile(src)
+	if err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	if len(e.programs) >= e.capacity {
+		e.programs = make(map[string]*expr.Program)
+	}
+	e.programs[src] = prog
+	e.mu.Unlock()
+	return prog, nil
+}
+
+// namedValues 属性名到取值的映射
+func namedValues(obj domain.ABACObject) map[string]domain.AttributeValue {
+	res := make(map[string]domain.AttributeValue, len(obj.AttributeValues))
+	for idx := range obj.AttributeValues {
+		val := obj.AttributeValues[idx]
+		res[val.Definition.Name] = val
+	}
+	return res
+}
@@ -0,0 +1,282 @@
+//go:build unit
+
+package abac
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/service/abac/evaluator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogicOperatorExecutor_Explain(t *testing.T) {
+	t.Parallel()
+
+	ageDef := domain.AttributeDefinition{ID: 1, Name: "age", DataType: domain.DataTypeNumber}
+	deptDef := domain.AttributeDefinition{ID: 2, Name: "dept", DataType: domain.DataTypeString}
+	levelDef := domain.AttributeDefinition{ID: 3, Name: "level", DataType: domain.DataTypeNumber}
+	ownerDeptDef := domain.AttributeDefinition{ID: 4, Name: "owner_dept", DataType: domain.DataTypeString}
+	subject := domain.ABACObject{AttributeValues: []domain.AttributeValue{
+		{Definition: ageDef, Value: "20"},
+		{Definition: deptDef, Value: "dev"},
+	}}
+	resource := domain.ABACObject{AttributeValues: []domain.AttributeValue{
+		{Definition: levelDef, Value: "abc"},
+		{Definition: ownerDeptDef, Value: "dev"},
+	}}
+
+	testCases := []struct {
+		name      string
+		rules     []domain.PolicyRule
+		wantMatch bool
+		assert    func(t *testing.T, rules []domain.RuleTrace)
+	}{
+		{
+			name: "叶子节点记录期望值和实际值",
+			rules: []domain.PolicyRule{
+				{ID: 1, AttrDef: ageDef, Operator: domain.GreaterOrEqual, Value: "18"},
+			},
+			wantMatch: true,
+			assert: func(t *testing.T, rules []domain.RuleTrace) {
+				assert.Len(t, rules, 1)
+				assert.Equal(t, "20", rules[0].ActualValue)
+				assert.True(t, rules[0].Result)
+			},
+		},
+		{
+			name: "逻辑节点记录左右子树",
+			rules: []domain.PolicyRule{
+				{
+					ID:       3,
+					Operator: domain.OR,
+					LeftRule: &domain.PolicyRule{
+						ID: 1, AttrDef: ageDef, Operator: domain.Less, Value: "18",
+					},
+					RightRule: &domain.PolicyRule{
+						ID: 2, AttrDef: deptDef, Operator: domain.Equals, Value: "dev",
+					},
+				},
+			},
+			wantMatch: true,
+			assert: func(t *testing.T, rules []domain.RuleTrace) {
+				assert.True(t, rules[0].Result)
+				assert.False(t, rules[0].Left.Result)
+				assert.True(t, rules[0].Right.Result)
+				assert.Equal(t, "dev", rules[0].Right.ActualValue)
+			},
+		},
+		{
+			name: "和另一个属性比较",
+			rules: []domain.PolicyRule{
+				{ID: 1, AttrDef: deptDef, Operator: domain.Equals, ValueAttrDef: &domain.AttributeDefinition{ID: 4}},
+			},
+			wantMatch: true,
+			assert: func(t *testing.T, rules []domain.RuleTrace) {
+				assert.True(t, rules[0].Result)
+				assert.Equal(t, "dev", rules[0].ActualValue)
+				assert.Equal(t, "dev", rules[0].Rule.Value)
+				assert.Equal(t, "owner_dept", rules[0].Rule.ValueAttrDef.Name)
+			},
+		},
+		{
+			name: "和另一个属性比较，类型不一致",
+			rules: []domain.PolicyRule{
+				{ID: 1, AttrDef: ageDef, Operator: domain.Equals, ValueAttrDef: &domain.AttributeDefinition{ID: 4}},
+			},
+			wantMatch: false,
+			assert: func(t *testing.T, rules []domain.RuleTrace) {
+				assert.False(t, rules[0].Result)
+				assert.NotEmpty(t, rules[0].Err)
+			},
+		},
+		{
+			name: "和另一个属性比较，属性没有取值",
+			rules: []domain.PolicyRule{
+				{ID: 1, AttrDef: deptDef, Operator: domain.Equals, ValueAttrDef: &domain.AttributeDefinition{ID: 5}},
+			},
+			wantMatch: false,
+			assert: func(t *testing.T, rules []domain.RuleTrace) {
+				assert.False(t, rules[0].Result)
+				assert.NotEmpty(t, rules[0].Err)
+			},
+		},
+		{
+			name: "求值失败的节点记录原因",
+			rules: []domain.PolicyRule{
+				{ID: 1, AttrDef: ageDef, Operator: domain.GreaterOrEqual, Value: "18"},
+				{ID: 2, AttrDef: levelDef, Operator: domain.Greater, Value: "1"},
+			},
+			wantMatch: false,
+			assert: func(t *testing.T, rules []domain.RuleTrace) {
+				assert.Len(t, rules, 2)
+				assert.True(t, rules[0].Result)
+				assert.False(t, rules[1].Result)
+				assert.NotEmpty(t, rules[1].Err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			executor := NewPolicyExecutor(evaluator.NewSelector())
+			policy := domain.Policy{ID: 1, Rules: tc.rules}
+			trace := executor.Explain(policy, subject, resource, domain.ABACObject{})
+			assert.Equal(t, tc.wantMatch, trace.Matched)
+			assert.Equal(t, executor.Check(policy, subject, resource, domain.ABACObject{}), trace.Matched)
+			tc.assert(t, trace.Rules)
+		})
+	}
+}
+
+func TestExpressionExecutor_Explain(t *testing.T) {
+	t.Parallel()
+
+	subject := domain.ABACObject{AttributeValues: []domain.AttributeValue{
+		{Definition: domain.AttributeDefinition{ID: 1, Name: "dept", DataType: domain.DataTypeString}, Value: "dev"},
+	}}
+	resource := domain.ABACObject{AttributeValues: []domain.AttributeValue{
+		{Definition: domain.AttributeDefinition{ID: 2, Name: "owner_dept", DataType: domain.DataTypeString}, Value: "dev"},
+	}}
+
+	testCases := []struct {
+		name       string
+		expression string
+		wantMatch  bool
+		wantErr    bool
+	}{
+		{
+			name:       "属性和属性比较",
+			expression: "subject.dept == resource.owner_dept",
+			wantMatch:  true,
+		},
+		{
+			name:       "不满足",
+			expression: "subject.dept != resource.owner_dept",
+		},
+		{
+			name:       "表达式错误",
+			expression: "subject.dept ==",
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			executor := NewPolicyExecutor(evaluator.NewSelector())
+			policy := domain.Policy{ID: 1, ExecuteType: domain.ExpressionType, Expression: tc.expression}
+			trace := executor.Explain(policy, subject, resource, domain.ABACObject{})
+			assert.Equal(t, tc.wantMatch, trace.Matched)
+			assert.Equal(t, executor.Check(policy, subject, resource, domain.ABACObject{}), trace.Matched)
+			assert.Len(t, trace.Rules, 1)
+			assert.Equal(t, tc.wantErr, trace.Rules[0].Err != "")
+		})
+	}
+}
+
+func TestPolicyExecutor_Residual(t *testing.T) {
+	t.Parallel()
+
+	deptDef := domain.AttributeDefinition{ID: 1, Name: "dept", DataType: domain.DataTypeString}
+	ageDef := domain.AttributeDefinition{ID: 2, Name: "age", DataType: domain.DataTypeNumber}
+	ownerDeptDef := domain.AttributeDefinition{ID: 3, Name: "owner_dept", DataType: domain.DataTypeString}
+	levelDef := domain.AttributeDefinition{ID: 4, Name: "level", DataType: domain.DataTypeNumber}
+	subject := domain.ABACObject{AttributeValues: []domain.AttributeValue{
+		{Definition: deptDef, Value: "dev"},
+		{Definition: ageDef, Value: "20"},
+	}}
+	resourceDefs := domain.AttrDefs{ownerDeptDef, levelDef}
+
+	testCases := []struct {
+		name   string
+		policy domain.Policy
+		want   domain.Residual
+	}{
+		{
+			name: "只有主体属性的直接求值",
+			policy: domain.Policy{Rules: []domain.PolicyRule{
+				{AttrDef: ageDef, Operator: domain.GreaterOrEqual, Value: "18"},
+			}},
+			want: domain.ResidualOf(true),
+		},
+		{
+			name: "和资源属性比较的时候代入主体属性的取值",
+			policy: domain.Policy{Rules: []domain.PolicyRule{
+				{AttrDef: ageDef, Operator: domain.GreaterOrEqual, Value: "18"},
+				{AttrDef: ownerDeptDef, Operator: domain.Equals, ValueAttrDef: &deptDef},
+			}},
+			want: domain.Residual{Condition: &domain.ResidualCondition{
+				Operator: domain.Equals, Attr: "owner_dept", DataType: domain.DataTypeString, Value: "dev",
+			}},
+		},
+		{
+			name: "主体属性在左边",
+			policy: domain.Policy{Rules: []domain.PolicyRule{
+				{AttrDef: ageDef, Operator: domain.GreaterOrEqual, ValueAttrDef: &levelDef},
+			}},
+			want: domain.Residual{Condition: &domain.ResidualCondition{
+				Operator: domain.GreaterOrEqual, Attr: "level", DataType: domain.DataTypeNumber, Value: "20", Reversed: true,
+			}},
+		},
+		{
+			name: "已经确定的分支被化简掉",
+			policy: domain.Policy{Rules: []domain.PolicyRule{
+				{
+					Operator: domain.OR,
+					LeftRule: &domain.PolicyRule{AttrDef: ageDef, Operator: domain.Less, Value: "18"},
+					RightRule: &domain.PolicyRule{
+						Operator:  domain.NOT,
+						RightRule: &domain.PolicyRule{AttrDef: levelDef, Operator: domain.Greater, Value: "3"},
+					},
+				},
+			}},
+			want: domain.Residual{Condition: &domain.ResidualCondition{
+				Operator: domain.NOT,
+				Right: &domain.ResidualCondition{
+					Operator: domain.Greater, Attr: "level", DataType: domain.DataTypeNumber, Value: "3",
+				},
+			}},
+		},
+		{
+			name: "类型不一致的比较不满足",
+			policy: domain.Policy{Rules: []domain.PolicyRule{
+				{AttrDef: levelDef, Operator: domain.Equals, ValueAttrDef: &deptDef},
+			}},
+			want: domain.ResidualOf(false),
+		},
+		{
+			name: "表达式",
+			policy: domain.Policy{
+				ExecuteType: domain.ExpressionType,
+				Expression:  "subject.dept == resource.owner_dept && (subject.age < 18 || resource.level <= 3)",
+			},
+			want: domain.Residual{Condition: &domain.ResidualCondition{
+				Operator: domain.AND,
+				Left: &domain.ResidualCondition{
+					Operator: domain.Equals, Attr: "owner_dept", DataType: domain.DataTypeString, Value: "dev", Reversed: true,
+				},
+				Right: &domain.ResidualCondition{
+					Operator: domain.LessOrEqual, Attr: "level", DataType: domain.DataTypeNumber, Value: "3",
+				},
+			}},
+		},
+		{
+			name: "表达式只有主体属性",
+			policy: domain.Policy{
+				ExecuteType: domain.ExpressionType,
+				Expression:  "subject.dept == \"ops\"",
+			},
+			want: domain.ResidualOf(false),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			executor := NewPolicyExecutor(evaluator.NewSelector())
+			assert.Equal(t, tc.want, executor.Residual(tc.policy, subject, domain.ABACObject{}, resourceDefs))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package abac
+
+import (
+	"sync"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+)
+
+// 每个业务保留的最近校验请求的数量
+const defaultRecentRequestSize = 1000
+
+// RecentRequestsConfig 记录最近校验请求的配置
+type RecentRequestsConfig struct {
+	// BizIDs 记录哪些业务的请求，为空表示都不记录
+	BizIDs []int64 `yaml:"bizIDs"`
+	// Capacity 每个业务保留的请求数量，为 0 的时候使用默认值
+	Capacity int `yaml:"capacity"`
+	// WithAttributes 是否保留请求里实时传入的属性。属性里面可能有敏感信息，默认不保留，
+	// 这时候重放只使用平台上预存的属性
+	WithAttributes bool `yaml:"withAttributes"`
+}
+
+// RecentRequests 记录开启了记录的业务最近的校验请求，策略模拟的时候可以直接拿来重放。
+// 只保存在本实例的内存里面，所以拿到的是本实例处理过的请求。
+// 业务在创建的时候就确定了，之后只读，每个业务各自加锁，不同业务的校验互不影响
+type RecentRequests struct {
+	withAttributes bool
+	rings          map[int64]*requestRing
+}
+
+// requestRing 环形缓冲，写满之后覆盖最老的请求
+type requestRing struct {
+	mu   sync.Mutex
+	buf  []domain.CheckRequest
+	next int
+}
+
+func NewRecentRequests(cfg RecentRequestsConfig) *RecentRequests {
+	capacity := cfg.Capacity
+	if capacity <= 0 {
+		capacity = defaultRecentRequestSize
+	}
+	rings := make(map[int64]*requestRing, len(cfg.BizIDs))
+	for _, bizID := range cfg.BizIDs {
+		rings[bizID] = &requestRing{buf: make([]domain.CheckRequest, 0, capacity)}
+	}
+	return &RecentRequests{
+		withAttributes: cfg.WithAttributes,
+		rings:          rings,
+	}
+}
+
+// enabled 没有开启记录的业务，校验的时候不需要构造请求
+func (r *RecentRequests) enabled(bizID int64) bool {
+	_, ok := r.rings[bizID]
+	return ok
+}
+
+func (r *RecentRequests) add(bizID int64, req domain.CheckRequest) {
+	ring, ok := r.rings[bizID]
+	if !ok {
+		return
+	}
+	if !r.withAttributes {
+		req.Attrs = domain.Attributes{}
+	}
+	ring.mu.Lock()
+	defer ring.mu.Unlock()
+	if len(ring.buf) < cap(ring.buf) {
+		ring.buf = append(ring.buf, req)
+		return
+	}
+	ring.buf[ring.next] = req
+	ring.next = (ring.next + 1) % len(ring.buf)
+}
+
+// latest 最近的 limit 个请求，最新的在前面
+func (r *RecentRequests) latest(bizID int64, limit int) []domain.CheckRequest {
+	ring, ok := r.rings[bizID]
+	if !ok || limit <= 0 {
+		return nil
+	}
+	ring.mu.Lock()
+	defer ring.mu.Unlock()
+	size := len(ring.buf)
+	limit = min(limit, size)
+	res := make([]domain.CheckRequest, 0, limit)
+	// 写满之前 next 一直是 0，最新的就是最后一个
+	newest := ring.next - 1
+	if size < cap(ring.buf) {
+		newest = size - 1
+	}
+	for i := 0; i < limit; i++ {
+		res = append(res, ring.buf[(newest-i+size)%size])
+	}
+	return res
+}
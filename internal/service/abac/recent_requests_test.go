@@ -0,0 +1,64 @@
+//go:build unit
+
+package abac
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecentRequests(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		added []int64
+		limit int
+		want  []int64
+	}{
+		{name: "没有请求", limit: 3},
+		{name: "没有写满", added: []int64{1, 2}, limit: 3, want: []int64{2, 1}},
+		{name: "只取一部分", added: []int64{1, 2, 3}, limit: 2, want: []int64{3, 2}},
+		{name: "覆盖最老的请求", added: []int64{1, 2, 3, 4, 5}, limit: 3, want: []int64{5, 4, 3}},
+		{name: "刚好写满两圈", added: []int64{1, 2, 3, 4, 5, 6}, limit: 5, want: []int64{6, 5, 4}},
+		{name: "limit 不合法", added: []int64{1}, limit: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			recent := NewRecentRequests(RecentRequestsConfig{BizIDs: []int64{1, 2}, Capacity: 3})
+			for _, uid := range tc.added {
+				recent.add(1, domain.CheckRequest{UID: uid})
+			}
+			// 其它业务的请求互不影响
+			recent.add(2, domain.CheckRequest{UID: 100})
+			got := slice.Map(recent.latest(1, tc.limit), func(_ int, src domain.CheckRequest) int64 {
+				return src.UID
+			})
+			if len(tc.want) == 0 {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRecentRequests_Recording(t *testing.T) {
+	t.Parallel()
+	req := domain.CheckRequest{UID: 1, Attrs: domain.Attributes{Subject: map[string]string{"phone": "123"}}}
+
+	recent := NewRecentRequests(RecentRequestsConfig{BizIDs: []int64{1}})
+	recent.add(1, req)
+	recent.add(2, req)
+	got := recent.latest(1, 10)
+	assert.Equal(t, []domain.CheckRequest{{UID: 1}}, got, "默认不保留属性")
+	assert.Empty(t, recent.latest(2, 10), "没有开启记录的业务不记录")
+
+	recent = NewRecentRequests(RecentRequestsConfig{BizIDs: []int64{1}, WithAttributes: true})
+	recent.add(1, req)
+	assert.Equal(t, []domain.CheckRequest{req}, recent.latest(1, 10))
+}
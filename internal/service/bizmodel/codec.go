@@ -0,0 +1,50 @@
+package bizmodel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"gopkg.in/yaml.v3"
+)
+
+// Format 授权模型文档的格式
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// Encode 把授权模型编码成文档，format 为空的时候使用 JSON
+func Encode(model domain.BizModel, format Format) ([]byte, error) {
+	switch format {
+	case "", FormatJSON:
+		return json.MarshalIndent(model, "", "  ")
+	case FormatYAML:
+		return yaml.Marshal(model)
+	default:
+		return nil, fmt.Errorf("%w: 未知的文档格式 %s", errs.ErrInvalidParameter, format)
+	}
+}
+
+// Decode 解析文档，format 为空的时候使用 JSON
+func Decode(data []byte, format Format) (domain.BizModel, error) {
+	var (
+		model domain.BizModel
+		err   error
+	)
+	switch format {
+	case "", FormatJSON:
+		err = json.Unmarshal(data, &model)
+	case FormatYAML:
+		err = yaml.Unmarshal(data, &model)
+	default:
+		return model, fmt.Errorf("%w: 未知的文档格式 %s", errs.ErrInvalidParameter, format)
+	}
+	if err != nil {
+		return model, fmt.Errorf("%w: 文档解析失败 %s", errs.ErrInvalidParameter, err.Error())
+	}
+	return model, nil
+}
@@ -0,0 +1,717 @@
+package bizmodel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+func (s *service) Import(ctx context.Context, bizID int64, model domain.BizModel, dryRun bool) (domain.BizModelImportResult, error) {
+	if err := validate(model, s.validator); err != nil {
+		return domain.BizModelImportResult{}, err
+	}
+	snap, err := s.load(ctx, bizID)
+	if err != nil {
+		return domain.BizModelImportResult{}, err
+	}
+	im := &importer{
+		svc:           s,
+		bizID:         bizID,
+		dryRun:        dryRun,
+		snap:          snap,
+		resourceIDs:   make(map[int64]int64, len(model.Resources)),
+		permissionIDs: make(map[int64]int64, len(model.Permissions)),
+		roleIDs:       make(map[int64]int64, len(model.Roles)),
+		attrDefIDs:    make(map[int64]int64, len(model.AttributeDefinitions)),
+		resources:     make(map[int64]domain.Resource, len(model.Resources)),
+		permissions:   make(map[int64]domain.Permission, len(model.Permissions)),
+		roles:         make(map[int64]domain.Role, len(model.Roles)),
+	}
+	// 被引用的数据先导入，后面的步骤才能替换 ID
+	steps := []func(ctx context.Context, model domain.BizModel) error{
+		im.importResources,
+		im.importActionDefinitions,
+		im.importPermissions,
+		im.importRoles,
+		im.importRoleInclusions,
+		im.importRolePermissions,
+		im.importUserRoles,
+		im.importUserPermissions,
+		im.importAttributeDefinitions,
+		im.importAttributeValues,
+		im.importPolicies,
+	}
+	for _, step := range steps {
+		if err = step(ctx, model); err != nil {
+			return im.result(), im.incomplete(err)
+		}
+	}
+	// 所有数据都写入之后再发布，一个策略没有通过测试用例不影响其它策略发布
+	return im.result(), im.publish(ctx)
+}
+
+// importer 记录导出环境的 ID 到导入环境的 ID 的映射
+type importer struct {
+	svc    *service
+	bizID  int64
+	dryRun bool
+	snap   snapshot
+
+	resourceIDs   map[int64]int64
+	permissionIDs map[int64]int64
+	roleIDs       map[int64]int64
+	attrDefIDs    map[int64]int64
+	// 导入环境里面的数据，写入关联关系的时候需要冗余资源和角色的信息
+	resources   map[int64]domain.Resource
+	permissions map[int64]domain.Permission
+	roles       map[int64]domain.Role
+
+	stats []domain.BizModelImportStat
+	// placeholder dry run 的时候分配给新数据的 ID，都是负数，不会和已有的数据重合
+	placeholder int64
+	// unpublished 草稿有变化或者还没有发布过，需要发布的策略
+	unpublished []domain.Policy
+}
+
+func (im *importer) result() domain.BizModelImportResult {
+	return domain.BizModelImportResult{DryRun: im.dryRun, Stats: im.stats}
+}
+
+// incomplete 写入中途失败的时候，说明哪些数据已经导入完成。已经写入的数据不会回滚，修正之后重新导入会跳过没有变化的数据
+func (im *importer) incomplete(err error) error {
+	if im.dryRun {
+		return err
+	}
+	done := slice.Map(im.stats, func(_ int, src domain.BizModelImportStat) string {
+		return src.Kind
+	})
+	return fmt.Errorf("%w: 已经导入完成的有 [%s]，其余数据可能只导入了一部分: %w",
+		errs.ErrBizModelImportIncomplete, strings.Join(done, ", "), err)
+}
+
+// publish 发布策略之前要先通过测试用例，没有发布的策略保留导入的草稿
+func (im *importer) publish(ctx context.Context) error {
+	var (
+		failed  []string
+		errList []error
+	)
+	for _, policy := range im.unpublished {
+		if _, err := im.svc.policySvc.Publish(ctx, im.bizID, policy.ID); err != nil {
+			failed = append(failed, policy.Name)
+			errList = append(errList, fmt.Errorf("发布策略 %s 失败: %w", policy.Name, err))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: 数据已经全部导入，策略 [%s] 没有发布: %w",
+		errs.ErrBizModelImportIncomplete, strings.Join(failed, ", "), errors.Join(errList...))
+}
+
+// save 按照数据是否已经存在以及有没有变化新增或者更新，返回数据的 ID。
+// id 是已有数据的 ID，为 0 表示不存在。dry run 的时候只统计
+func (im *importer) save(stat *domain.BizModelImportStat, id int64, equal bool, create func() (int64, error), update func() error) (int64, error) {
+	switch {
+	case id == 0:
+		stat.Created++
+		if im.dryRun {
+			im.placeholder--
+			return im.placeholder, nil
+		}
+		return create()
+	case equal:
+		stat.Unchanged++
+		return id, nil
+	default:
+		stat.Updated++
+		if im.dryRun {
+			return id, nil
+		}
+		return id, update()
+	}
+}
+
+func (im *importer) importResources(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "resources"}
+	existing := make(map[string]domain.Resource, len(im.snap.resources))
+	for _, res := range im.snap.resources {
+		existing[res.Type+"/"+res.Key] = res
+	}
+	for _, src := range model.Resources {
+		res := domain.Resource{
+			BizID:       im.bizID,
+			Type:        src.Type,
+			Key:         src.Key,
+			Name:        src.Name,
+			Description: src.Description,
+			Metadata:    src.Metadata,
+		}
+		old := existing[res.Type+"/"+res.Key]
+		res.ID = old.ID
+		id, err := im.save(&stat, old.ID,
+			old.Name == res.Name && old.Description == res.Description && old.Metadata == res.Metadata,
+			func() (int64, error) {
+				created, err := im.svc.rbacSvc.CreateResource(ctx, res)
+				return created.ID, err
+			},
+			func() error {
+				_, err := im.svc.rbacSvc.UpdateResource(ctx, res)
+				return err
+			})
+		if err != nil {
+			return fmt.Errorf("导入资源 %s/%s 失败: %w", res.Type, res.Key, err)
+		}
+		res.ID = id
+		im.resourceIDs[src.ID] = id
+		im.resources[id] = res
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+func (im *importer) importActionDefinitions(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "actionDefinitions"}
+	existing := make(map[string]domain.ActionDefinition, len(im.snap.actionDefs))
+	for _, def := range im.snap.actionDefs {
+		existing[def.Name] = def
+	}
+	for _, src := range model.ActionDefinitions {
+		def := domain.ActionDefinition{
+			BizID:       im.bizID,
+			Name:        src.Name,
+			Description: src.Description,
+			Implies:     src.Implies,
+		}
+		old := existing[def.Name]
+		def.ID = old.ID
+		_, err := im.save(&stat, old.ID,
+			old.Description == def.Description && slices.Equal(old.Implies, def.Implies),
+			func() (int64, error) {
+				created, err := im.svc.rbacSvc.CreateActionDefinition(ctx, def)
+				return created.ID, err
+			},
+			func() error {
+				_, err := im.svc.rbacSvc.UpdateActionDefinition(ctx, def)
+				return err
+			})
+		if err != nil {
+			return fmt.Errorf("导入操作 %s 失败: %w", def.Name, err)
+		}
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+func (im *importer) importPermissions(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "permissions"}
+	existing := make(map[string]domain.Permission, len(im.snap.permissions))
+	for _, perm := range im.snap.permissions {
+		existing[fmt.Sprintf("%d/%s", perm.Resource.ID, perm.Action)] = perm
+	}
+	for _, src := range model.Permissions {
+		perm := domain.Permission{
+			BizID:              im.bizID,
+			Name:               src.Name,
+			Description:        src.Description,
+			Resource:           im.resources[im.resourceIDs[src.ResourceID]],
+			Action:             src.Action,
+			Metadata:           src.Metadata,
+			CombiningAlgorithm: src.CombiningAlgorithm,
+		}
+		old := existing[fmt.Sprintf("%d/%s", perm.Resource.ID, perm.Action)]
+		perm.ID = old.ID
+		id, err := im.save(&stat, old.ID,
+			old.Name == perm.Name && old.Description == perm.Description &&
+				old.Metadata == perm.Metadata && old.CombiningAlgorithm == perm.CombiningAlgorithm,
+			func() (int64, error) {
+				created, err := im.svc.rbacSvc.CreatePermission(ctx, perm)
+				return created.ID, err
+			},
+			func() error {
+				_, err := im.svc.rbacSvc.UpdatePermission(ctx, perm)
+				return err
+			})
+		if err != nil {
+			return fmt.Errorf("导入权限 %s/%s:%s 失败: %w", perm.Resource.Type, perm.Resource.Key, perm.Action, err)
+		}
+		perm.ID = id
+		im.permissionIDs[src.ID] = id
+		im.permissions[id] = perm
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+func (im *importer) importRoles(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "roles"}
+	existing := make(map[string]domain.Role, len(im.snap.roles))
+	for _, role := range im.snap.roles {
+		existing[role.Type+"/"+role.Name] = role
+	}
+	for _, src := range model.Roles {
+		role := domain.Role{
+			BizID:           im.bizID,
+			Type:            src.Type,
+			Name:            src.Name,
+			Description:     src.Description,
+			Metadata:        src.Metadata,
+			MaxMembers:      src.MaxMembers,
+			MaxRolesPerUser: src.MaxRolesPerUser,
+		}
+		old := existing[role.Type+"/"+role.Name]
+		role.ID = old.ID
+		id, err := im.save(&stat, old.ID,
+			old.Description == role.Description && old.Metadata == role.Metadata &&
+				old.MaxMembers == role.MaxMembers && old.MaxRolesPerUser == role.MaxRolesPerUser,
+			func() (int64, error) {
+				created, err := im.svc.rbacSvc.CreateRole(ctx, role)
+				return created.ID, err
+			},
+			func() error {
+				_, err := im.svc.rbacSvc.UpdateRole(ctx, role)
+				return err
+			})
+		if err != nil {
+			return fmt.Errorf("导入角色 %s/%s 失败: %w", role.Type, role.Name, err)
+		}
+		role.ID = id
+		im.roleIDs[src.ID] = id
+		im.roles[id] = role
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+func (im *importer) importRoleInclusions(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "roleInclusions"}
+	existing := make(map[string]int64, len(im.snap.roleInclusions))
+	for _, inclusion := range im.snap.roleInclusions {
+		existing[fmt.Sprintf("%d/%d", inclusion.IncludingRole.ID, inclusion.IncludedRole.ID)] = inclusion.ID
+	}
+	for _, src := range model.RoleInclusions {
+		inclusion := domain.RoleInclusion{
+			BizID:         im.bizID,
+			IncludingRole: im.roles[im.roleIDs[src.IncludingRoleID]],
+			IncludedRole:  im.roles[im.roleIDs[src.IncludedRoleID]],
+		}
+		// 包含关系只有业务主键，存在就没有变化
+		_, err := im.save(&stat, existing[fmt.Sprintf("%d/%d", inclusion.IncludingRole.ID, inclusion.IncludedRole.ID)], true,
+			func() (int64, error) {
+				created, err := im.svc.rbacSvc.CreateRoleInclusion(ctx, inclusion)
+				return created.ID, err
+			}, nil)
+		if err != nil {
+			return fmt.Errorf("导入角色包含关系 %s -> %s 失败: %w", inclusion.IncludingRole.Name, inclusion.IncludedRole.Name, err)
+		}
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+func (im *importer) importRolePermissions(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "rolePermissions"}
+	existing := make(map[string]domain.RolePermission, len(im.snap.rolePermissions))
+	for _, rp := range im.snap.rolePermissions {
+		existing[fmt.Sprintf("%d/%d", rp.Role.ID, rp.Permission.ID)] = rp
+	}
+	for _, src := range model.RolePermissions {
+		rp := domain.RolePermission{
+			BizID:      im.bizID,
+			Role:       im.roles[im.roleIDs[src.RoleID]],
+			Permission: im.permissions[im.permissionIDs[src.PermissionID]],
+			StartTime:  src.StartTime,
+			EndTime:    src.EndTime,
+			Effect:     src.Effect,
+		}
+		old := existing[fmt.Sprintf("%d/%d", rp.Role.ID, rp.Permission.ID)]
+		grant := func() (int64, error) {
+			created, err := im.svc.rbacSvc.GrantRolePermission(ctx, rp)
+			return created.ID, err
+		}
+		// 没有更新的接口，先撤销再重新授予
+		_, err := im.save(&stat, old.ID,
+			old.StartTime == rp.StartTime && old.EndTime == rp.EndTime && old.Effect == rp.Effect,
+			grant,
+			func() error {
+				if err := im.svc.rbacSvc.RevokeRolePermission(ctx, im.bizID, old.ID); err != nil {
+					return err
+				}
+				_, err := grant()
+				return err
+			})
+		if err != nil {
+			return fmt.Errorf("导入角色 %s 的权限 %d 失败: %w", rp.Role.Name, rp.Permission.ID, err)
+		}
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+func (im *importer) importUserRoles(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "userRoles"}
+	existing := make(map[string]domain.UserRole, len(im.snap.userRoles))
+	for _, ur := range im.snap.userRoles {
+		existing[fmt.Sprintf("%d/%d", ur.UserID, ur.Role.ID)] = ur
+	}
+	for _, src := range model.UserRoles {
+		ur := domain.UserRole{
+			BizID:     im.bizID,
+			UserID:    src.UserID,
+			Role:      im.roles[im.roleIDs[src.RoleID]],
+			StartTime: src.StartTime,
+			EndTime:   toEndTime(src.EndTime),
+		}
+		old := existing[fmt.Sprintf("%d/%d", ur.UserID, ur.Role.ID)]
+		ur.ID = old.ID
+		_, err := im.save(&stat, old.ID,
+			old.StartTime == ur.StartTime && old.EndTime == ur.EndTime,
+			func() (int64, error) {
+				created, err := im.svc.rbacSvc.GrantUserRole(ctx, ur)
+				return created.ID, err
+			},
+			func() error {
+				_, err := im.svc.rbacSvc.UpdateUserRole(ctx, ur)
+				return err
+			})
+		if err != nil {
+			return fmt.Errorf("导入用户 %d 的角色 %s 失败: %w", ur.UserID, ur.Role.Name, err)
+		}
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+func (im *importer) importUserPermissions(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "userPermissions"}
+	existing := make(map[string]domain.UserPermission, len(im.snap.userPermissions))
+	for _, up := range im.snap.userPermissions {
+		existing[fmt.Sprintf("%d/%d", up.UserID, up.Permission.ID)] = up
+	}
+	for _, src := range model.UserPermissions {
+		up := domain.UserPermission{
+			BizID:      im.bizID,
+			UserID:     src.UserID,
+			Permission: im.permissions[im.permissionIDs[src.PermissionID]],
+			StartTime:  src.StartTime,
+			EndTime:    toEndTime(src.EndTime),
+			Effect:     src.Effect,
+		}
+		old := existing[fmt.Sprintf("%d/%d", up.UserID, up.Permission.ID)]
+		up.ID = old.ID
+		_, err := im.save(&stat, old.ID,
+			old.StartTime == up.StartTime && old.EndTime == up.EndTime && old.Effect == up.Effect,
+			func() (int64, error) {
+				created, err := im.svc.rbacSvc.GrantUserPermission(ctx, up)
+				return created.ID, err
+			},
+			func() error {
+				_, err := im.svc.rbacSvc.UpdateUserPermission(ctx, up)
+				return err
+			})
+		if err != nil {
+			return fmt.Errorf("导入用户 %d 的权限 %d 失败: %w", up.UserID, up.Permission.ID, err)
+		}
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+func (im *importer) importAttributeDefinitions(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "attributeDefinitions"}
+	existing := make(map[string]domain.AttributeDefinition, len(im.snap.attrDefs))
+	for _, def := range im.snap.attrDefs {
+		existing[def.Name] = def
+	}
+	for _, src := range model.AttributeDefinitions {
+		def := toAttributeDefinition(src)
+		old := existing[def.Name]
+		def.ID = old.ID
+		save := func() (int64, error) {
+			return im.svc.definitionRepo.Save(ctx, im.bizID, def)
+		}
+		id, err := im.save(&stat, old.ID,
+			old.Description == def.Description && old.DataType == def.DataType &&
+				old.EntityType == def.EntityType && old.ValidationRule == def.ValidationRule,
+			save,
+			func() error {
+				_, err := save()
+				return err
+			})
+		if err != nil {
+			return fmt.Errorf("导入属性定义 %s 失败: %w", def.Name, err)
+		}
+		im.attrDefIDs[src.ID] = id
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+func (im *importer) importAttributeValues(ctx context.Context, model domain.BizModel) error {
+	groups := []struct {
+		kind     string
+		existing []domain.ABACObject
+		values   []domain.BizModelAttributeValue
+		// objectID 导入环境里面的主体或者资源 ID
+		objectID func(src int64) int64
+		save     func(objectID int64, val domain.AttributeValue) error
+	}{
+		{
+			kind:     "subjectAttributeValues",
+			existing: im.snap.subjectValues,
+			values:   model.SubjectAttributeValues,
+			objectID: func(src int64) int64 { return src },
+			save: func(objectID int64, val domain.AttributeValue) error {
+				_, err := im.svc.valueRepo.SaveSubjectValue(ctx, im.bizID, objectID, val)
+				return err
+			},
+		},
+		{
+			kind:     "resourceAttributeValues",
+			existing: im.snap.resourceValues,
+			values:   model.ResourceAttributeValues,
+			objectID: func(src int64) int64 { return im.resourceIDs[src] },
+			save: func(objectID int64, val domain.AttributeValue) error {
+				_, err := im.svc.valueRepo.SaveResourceValue(ctx, im.bizID, objectID, val)
+				return err
+			},
+		},
+		{
+			kind:     "environmentAttributeValues",
+			existing: []domain.ABACObject{im.snap.envValues},
+			values:   model.EnvironmentAttributeValues,
+			objectID: func(int64) int64 { return 0 },
+			save: func(_ int64, val domain.AttributeValue) error {
+				_, err := im.svc.valueRepo.SaveEnvironmentValue(ctx, im.bizID, val)
+				return err
+			},
+		},
+	}
+	defs := make(map[int64]domain.BizModelAttributeDefinition, len(model.AttributeDefinitions))
+	for _, def := range model.AttributeDefinitions {
+		defs[def.ID] = def
+	}
+	for _, group := range groups {
+		stat := domain.BizModelImportStat{Kind: group.kind}
+		existing := make(map[string]domain.AttributeValue)
+		for _, obj := range group.existing {
+			for _, val := range obj.AttributeValues {
+				existing[fmt.Sprintf("%d/%d", obj.ID, val.Definition.ID)] = val
+			}
+		}
+		for _, src := range group.values {
+			objectID := group.objectID(src.ObjectID)
+			// 和单独保存属性值一样，保存规范化之后的值，这样重复导入的时候也能正确判断有没有变化
+			value, err := im.svc.validator.Validate(toAttributeDefinition(defs[src.AttrDefID]), src.Value)
+			if err != nil {
+				return err
+			}
+			val := domain.AttributeValue{
+				Definition: domain.AttributeDefinition{ID: im.attrDefIDs[src.AttrDefID]},
+				Value:      value,
+			}
+			old := existing[fmt.Sprintf("%d/%d", objectID, val.Definition.ID)]
+			val.ID = old.ID
+			_, err = im.save(&stat, old.ID, old.Value == val.Value,
+				func() (int64, error) {
+					return 0, group.save(objectID, val)
+				},
+				func() error {
+					return group.save(objectID, val)
+				})
+			if err != nil {
+				return fmt.Errorf("导入属性 %d 的值失败: %w", val.Definition.ID, err)
+			}
+		}
+		im.stats = append(im.stats, stat)
+	}
+	return nil
+}
+
+func (im *importer) importPolicies(ctx context.Context, model domain.BizModel) error {
+	stat := domain.BizModelImportStat{Kind: "policies"}
+	existing := make(map[string]domain.Policy, len(im.snap.policies))
+	for _, policy := range im.snap.policies {
+		existing[policy.Name] = policy
+	}
+	for _, src := range model.Policies {
+		policy := domain.Policy{
+			BizID:       im.bizID,
+			Name:        src.Name,
+			Description: src.Description,
+			ExecuteType: src.ExecuteType,
+			Expression:  src.Expression,
+			Status:      src.Status,
+		}
+		if policy.Status == "" {
+			policy.Status = domain.PolicyStatusActive
+		}
+		old := existing[policy.Name]
+		policy.ID = old.ID
+		rules := im.toRuleTrees(src.Rules)
+		rulesEqual := slices.Equal(ruleSignatures(old.Rules), ruleSignatures(rules))
+		effects := make(map[int64]domain.Effect, len(old.Permissions))
+		for _, perm := range old.Permissions {
+			effects[perm.Permission.ID] = perm.Effect
+		}
+		links := make(map[int64]domain.Effect, len(src.Permissions))
+		for _, link := range src.Permissions {
+			links[im.permissionIDs[link.PermissionID]] = link.Effect
+		}
+		linksEqual := maps.Equal(effects, links)
+		write := func() (int64, error) {
+			// 分析出来的问题不影响保存，导入的时候忽略
+			id, _, err := im.svc.policySvc.Save(ctx, policy)
+			if err != nil {
+				return 0, err
+			}
+			if !rulesEqual {
+				if err = im.replaceRules(ctx, id, old.Rules, src.Rules); err != nil {
+					return 0, err
+				}
+			}
+			return id, im.saveLinks(ctx, id, effects, links)
+		}
+		equal := old.Description == policy.Description && old.ExecuteType == policy.ExecuteType &&
+			old.Expression == policy.Expression && old.Status == policy.Status && rulesEqual && linksEqual
+		id, err := im.save(&stat, old.ID, equal,
+			write,
+			func() error {
+				_, err := write()
+				return err
+			})
+		if err != nil {
+			return fmt.Errorf("导入策略 %s 失败: %w", policy.Name, err)
+		}
+		// 草稿有变化或者还没有发布过的时候发布
+		if im.dryRun || (equal && old.PublishedVersion > 0) {
+			continue
+		}
+		policy.ID = id
+		im.unpublished = append(im.unpublished, policy)
+	}
+	im.stats = append(im.stats, stat)
+	return nil
+}
+
+// replaceRules 删除策略原有的规则，按照子规则在前的顺序保存新的规则，同时把子规则的 ID 替换成新的 ID
+func (im *importer) replaceRules(ctx context.Context, policyID int64, oldRules []domain.PolicyRule, rules []domain.BizModelPolicyRule) error {
+	for _, old := range flattenRules(oldRules) {
+		if err := im.svc.policySvc.DeleteRule(ctx, im.bizID, old.ID); err != nil {
+			return err
+		}
+	}
+	ordered, err := ruleOrder(rules)
+	if err != nil {
+		return err
+	}
+	ruleIDs := make(map[int64]int64, len(rules))
+	for _, src := range ordered {
+		rule := domain.PolicyRule{
+			AttrDef:  domain.AttributeDefinition{ID: im.attrDefIDs[src.AttrDefID]},
+			Value:    src.Value,
+			Operator: src.Operator,
+		}
+		if src.ValueAttrDefID != 0 {
+			rule.ValueAttrDef = &domain.AttributeDefinition{ID: im.attrDefIDs[src.ValueAttrDefID]}
+		}
+		if src.Left != 0 {
+			rule.LeftRule = &domain.PolicyRule{ID: ruleIDs[src.Left]}
+		}
+		if src.Right != 0 {
+			rule.RightRule = &domain.PolicyRule{ID: ruleIDs[src.Right]}
+		}
+		ruleIDs[src.ID], err = im.svc.policySvc.SaveRule(ctx, im.bizID, policyID, rule)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveLinks 把策略和权限的关联改成和文档一致：文档里面没有的删除，效果不一样的先删除再关联。
+// effects 和 links 都是权限 ID 到效果的映射，分别是已有的关联和文档里面的关联
+func (im *importer) saveLinks(ctx context.Context, policyID int64, effects, links map[int64]domain.Effect) error {
+	for permissionID, effect := range effects {
+		if linked, ok := links[permissionID]; ok && linked == effect {
+			continue
+		}
+		if err := im.svc.policySvc.DeletePermissionPolicy(ctx, im.bizID, policyID, permissionID); err != nil {
+			return err
+		}
+	}
+	for permissionID, effect := range links {
+		if old, ok := effects[permissionID]; ok && old == effect {
+			continue
+		}
+		if err := im.svc.policySvc.SavePermissionPolicy(ctx, im.bizID, policyID, permissionID, effect); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toRuleTrees 把文档里面的规则还原成规则树，属性定义替换成导入环境的 ID
+func (im *importer) toRuleTrees(rules []domain.BizModelPolicyRule) []domain.PolicyRule {
+	byID := make(map[int64]domain.BizModelPolicyRule, len(rules))
+	hasParent := make(map[int64]bool, len(rules))
+	for _, src := range rules {
+		byID[src.ID] = src
+		hasParent[src.Left] = true
+		hasParent[src.Right] = true
+	}
+	var build func(id int64) *domain.PolicyRule
+	build = func(id int64) *domain.PolicyRule {
+		src, ok := byID[id]
+		if !ok {
+			return nil
+		}
+		rule := &domain.PolicyRule{
+			ID:        src.ID,
+			AttrDef:   domain.AttributeDefinition{ID: im.attrDefIDs[src.AttrDefID]},
+			Value:     src.Value,
+			Operator:  src.Operator,
+			LeftRule:  build(src.Left),
+			RightRule: build(src.Right),
+		}
+		if src.ValueAttrDefID != 0 {
+			rule.ValueAttrDef = &domain.AttributeDefinition{ID: im.attrDefIDs[src.ValueAttrDefID]}
+		}
+		return rule
+	}
+	var res []domain.PolicyRule
+	for _, src := range rules {
+		if !hasParent[src.ID] {
+			res = append(res, *build(src.ID))
+		}
+	}
+	return res
+}
+
+// ruleSignatures 根规则的结构，和规则的 ID 无关，用来判断规则有没有变化
+func ruleSignatures(rules []domain.PolicyRule) []string {
+	var signature func(rule *domain.PolicyRule) string
+	signature = func(rule *domain.PolicyRule) string {
+		if rule == nil {
+			return ""
+		}
+		var valueDefID int64
+		if rule.ValueAttrDef != nil {
+			valueDefID = rule.ValueAttrDef.ID
+		}
+		return fmt.Sprintf("(%s %d %d %q %s %s)", rule.Operator, rule.AttrDef.ID, valueDefID, rule.Value,
+			signature(rule.LeftRule), signature(rule.RightRule))
+	}
+	res := make([]string, 0, len(rules))
+	for idx := range rules {
+		res = append(res, signature(&rules[idx]))
+	}
+	slices.Sort(res)
+	return res
+}
@@ -0,0 +1,184 @@
+//go:build unit
+
+package bizmodel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"gitee.com/flycash/permission-platform/internal/service/abac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubPolicySvc struct {
+	abac.PolicySvc
+	// failed 这些策略没有通过测试用例
+	failed    map[int64]bool
+	published []int64
+	// links 策略关联的权限，权限 ID 到效果
+	links map[int64]domain.Effect
+}
+
+func (s *stubPolicySvc) Save(_ context.Context, policy domain.Policy) (int64, []domain.PolicyIssue, error) {
+	return policy.ID, nil, nil
+}
+
+func (s *stubPolicySvc) SavePermissionPolicy(_ context.Context, _, _, permissionID int64, effect domain.Effect) error {
+	if _, ok := s.links[permissionID]; ok {
+		return errors.New("关联已经存在")
+	}
+	s.links[permissionID] = effect
+	return nil
+}
+
+func (s *stubPolicySvc) DeletePermissionPolicy(_ context.Context, _, _, permissionID int64) error {
+	delete(s.links, permissionID)
+	return nil
+}
+
+func (s *stubPolicySvc) Publish(_ context.Context, _, policyID int64) (int64, error) {
+	if s.failed[policyID] {
+		return 0, errs.ErrPolicyTestFailed
+	}
+	s.published = append(s.published, policyID)
+	return 1, nil
+}
+
+func TestToRuleTrees(t *testing.T) {
+	t.Parallel()
+
+	// 导出环境里面属性 1 和 2 在导入环境里面是 11 和 12
+	im := &importer{attrDefIDs: map[int64]int64{1: 11, 2: 12}}
+	exported := []domain.PolicyRule{
+		{
+			ID:       100,
+			Operator: domain.AND,
+			LeftRule: &domain.PolicyRule{ID: 101, AttrDef: domain.AttributeDefinition{ID: 1}, Operator: domain.Equals, Value: "dev"},
+			RightRule: &domain.PolicyRule{
+				ID: 102, Operator: domain.NOT,
+				RightRule: &domain.PolicyRule{
+					ID: 103, AttrDef: domain.AttributeDefinition{ID: 1}, Operator: domain.Equals,
+					ValueAttrDef: &domain.AttributeDefinition{ID: 2},
+				},
+			},
+		},
+		{ID: 104, AttrDef: domain.AttributeDefinition{ID: 2}, Operator: domain.NotEquals, Value: "qa"},
+	}
+	// 导入环境里面已有的规则，ID 不一样，结构一样
+	existing := []domain.PolicyRule{
+		{ID: 204, AttrDef: domain.AttributeDefinition{ID: 12}, Operator: domain.NotEquals, Value: "qa"},
+		{
+			ID:       200,
+			Operator: domain.AND,
+			LeftRule: &domain.PolicyRule{ID: 201, AttrDef: domain.AttributeDefinition{ID: 11}, Operator: domain.Equals, Value: "dev"},
+			RightRule: &domain.PolicyRule{
+				ID: 202, Operator: domain.NOT,
+				RightRule: &domain.PolicyRule{
+					ID: 203, AttrDef: domain.AttributeDefinition{ID: 11}, Operator: domain.Equals,
+					ValueAttrDef: &domain.AttributeDefinition{ID: 12},
+				},
+			},
+		},
+	}
+
+	trees := im.toRuleTrees(flattenRules(exported))
+	assert.Len(t, trees, 2)
+	assert.Equal(t, ruleSignatures(existing), ruleSignatures(trees))
+
+	// 改了比较值之后结构就不一样了
+	changed := flattenRules(exported)
+	changed[1].Value = "ops"
+	assert.NotEqual(t, ruleSignatures(existing), ruleSignatures(im.toRuleTrees(changed)))
+}
+
+func TestEndTime(t *testing.T) {
+	t.Parallel()
+
+	// 省略的失效时间导入之后永不过期，导出的时候再省略掉
+	assert.Equal(t, neverExpires, toEndTime(0))
+	assert.Equal(t, int64(0), toModelEndTime(toEndTime(0)))
+	assert.Equal(t, int64(1700000000000), toEndTime(1700000000000))
+	assert.Equal(t, int64(1700000000000), toModelEndTime(1700000000000))
+}
+
+func TestImporter_Publish(t *testing.T) {
+	t.Parallel()
+
+	policySvc := &stubPolicySvc{failed: map[int64]bool{2: true}}
+	im := &importer{
+		svc:   &service{policySvc: policySvc},
+		bizID: 1,
+		unpublished: []domain.Policy{
+			{ID: 1, Name: "只有程序员能读代码"},
+			{ID: 2, Name: "只有经理能审批"},
+			{ID: 3, Name: "只有运维能发布"},
+		},
+	}
+	err := im.publish(context.Background())
+	// 一个策略没有通过测试用例，不影响其它策略发布
+	assert.Equal(t, []int64{1, 3}, policySvc.published)
+	require.ErrorIs(t, err, errs.ErrBizModelImportIncomplete)
+	assert.ErrorIs(t, err, errs.ErrPolicyTestFailed)
+	assert.Contains(t, err.Error(), "只有经理能审批")
+	assert.NotContains(t, err.Error(), "只有运维能发布")
+}
+
+func TestImporter_Incomplete(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("mock db error")
+	im := &importer{stats: []domain.BizModelImportStat{{Kind: "resources"}, {Kind: "permissions"}}}
+	err := im.incomplete(cause)
+	assert.ErrorIs(t, err, errs.ErrBizModelImportIncomplete)
+	assert.ErrorIs(t, err, cause)
+	assert.Contains(t, err.Error(), "resources, permissions")
+
+	// dry run 不会写入任何数据
+	im.dryRun = true
+	assert.Equal(t, cause, im.incomplete(cause))
+}
+
+func TestImporter_ReimportPolicyLinks(t *testing.T) {
+	t.Parallel()
+
+	// 导入环境里面策略已经关联了权限 21 和 22，并且已经发布
+	policySvc := &stubPolicySvc{links: map[int64]domain.Effect{21: domain.EffectAllow, 22: domain.EffectAllow}}
+	old := domain.Policy{
+		ID: 10, BizID: 1, Name: "只有程序员能读代码", Status: domain.PolicyStatusActive, PublishedVersion: 1,
+		Permissions: []domain.UserPermission{
+			{Permission: domain.Permission{ID: 21}, Effect: domain.EffectAllow},
+			{Permission: domain.Permission{ID: 22}, Effect: domain.EffectAllow},
+		},
+	}
+	im := &importer{
+		svc:   &service{policySvc: policySvc},
+		bizID: 1,
+		snap:  snapshot{policies: []domain.Policy{old}},
+		// 导出环境里面的权限 1、2、3 在导入环境里面是 21、22、23
+		permissionIDs: map[int64]int64{1: 21, 2: 22, 3: 23},
+	}
+	// 重新导出的文档去掉了权限 2，权限 1 改成拒绝，新增了权限 3
+	model := domain.BizModel{Policies: []domain.BizModelPolicy{{
+		ID: 100, Name: old.Name, Status: domain.PolicyStatusActive,
+		Permissions: []domain.BizModelPolicyPermission{
+			{PermissionID: 1, Effect: domain.EffectDeny},
+			{PermissionID: 3, Effect: domain.EffectAllow},
+		},
+	}}}
+	require.NoError(t, im.importPolicies(context.Background(), model))
+	assert.Equal(t, map[int64]domain.Effect{21: domain.EffectDeny, 23: domain.EffectAllow}, policySvc.links)
+	assert.Equal(t, []domain.BizModelImportStat{{Kind: "policies", Updated: 1}}, im.stats)
+	assert.Len(t, im.unpublished, 1)
+
+	// 只去掉一个关联也算有变化
+	im.stats, im.unpublished = nil, nil
+	policySvc.links = map[int64]domain.Effect{21: domain.EffectAllow, 22: domain.EffectAllow}
+	model.Policies[0].Permissions = []domain.BizModelPolicyPermission{{PermissionID: 1, Effect: domain.EffectAllow}}
+	require.NoError(t, im.importPolicies(context.Background(), model))
+	assert.Equal(t, map[int64]domain.Effect{21: domain.EffectAllow}, policySvc.links)
+	assert.Equal(t, []domain.BizModelImportStat{{Kind: "policies", Updated: 1}}, im.stats)
+}
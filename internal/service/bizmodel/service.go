@@ -0,0 +1,360 @@
+// Package bizmodel 导出和导入一个业务完整的授权模型，用于在不同环境之间迁移配置
+package bizmodel
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/repository"
+	"gitee.com/flycash/permission-platform/internal/service/abac"
+	"gitee.com/flycash/permission-platform/internal/service/rbac"
+	"github.com/ecodeclub/ekit/slice"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// pageSize 分页读取业务数据的时候每一页的大小
+	pageSize = 500
+	// neverExpires 文档里面没有失效时间的用户角色和用户权限永不过期
+	neverExpires int64 = math.MaxInt64
+)
+
+type Service interface {
+	// Export 导出业务完整的授权模型，策略导出的是草稿
+	Export(ctx context.Context, bizID int64) (domain.BizModel, error)
+	// Import 把授权模型导入到业务 bizID，按照业务主键新增或者更新，文档里面没有的数据保持不变，
+	// 只有策略的规则和关联的权限会改成和文档一致。
+	// 有变化或者还没有发布过的策略在所有数据写入之后，通过测试用例再发布。
+	// 导入不是原子的，写入中途失败或者有策略没有发布的时候返回 errs.ErrBizModelImportIncomplete，
+	// 说明哪些数据已经导入，同时返回已经导入的统计。已经写入的数据不会回滚，修正之后重新导入即可。
+	// dryRun 为 true 的时候只校验和统计，不写入任何数据
+	Import(ctx context.Context, bizID int64, model domain.BizModel, dryRun bool) (domain.BizModelImportResult, error)
+}
+
+type service struct {
+	rbacSvc        rbac.Service
+	definitionRepo repository.AttributeDefinitionRepository
+	valueRepo      repository.AttributeValueRepository
+	policySvc      abac.PolicySvc
+	validator      *abac.AttributeValidator
+}
+
+func NewService(
+	rbacSvc rbac.Service,
+	definitionRepo repository.AttributeDefinitionRepository,
+	valueRepo repository.AttributeValueRepository,
+	policySvc abac.PolicySvc,
+) Service {
+	return &service{
+		rbacSvc:        rbacSvc,
+		definitionRepo: definitionRepo,
+		valueRepo:      valueRepo,
+		policySvc:      policySvc,
+		validator:      abac.NewAttributeValidator(),
+	}
+}
+
+// snapshot 业务当前的全部数据
+type snapshot struct {
+	resources       []domain.Resource
+	actionDefs      []domain.ActionDefinition
+	permissions     []domain.Permission
+	roles           []domain.Role
+	roleInclusions  []domain.RoleInclusion
+	rolePermissions []domain.RolePermission
+	userRoles       []domain.UserRole
+	userPermissions []domain.UserPermission
+	attrDefs        domain.AttrDefs
+	subjectValues   []domain.ABACObject
+	resourceValues  []domain.ABACObject
+	envValues       domain.ABACObject
+	policies        []domain.Policy
+}
+
+func (s *service) load(ctx context.Context, bizID int64) (snapshot, error) {
+	var (
+		snap snapshot
+		eg   errgroup.Group
+	)
+	eg.Go(func() error {
+		var err error
+		snap.resources, err = listAll(func(offset, limit int) ([]domain.Resource, error) {
+			return s.rbacSvc.ListResources(ctx, bizID, offset, limit)
+		})
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.actionDefs, err = listAll(func(offset, limit int) ([]domain.ActionDefinition, error) {
+			return s.rbacSvc.ListActionDefinitions(ctx, bizID, offset, limit)
+		})
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.permissions, err = listAll(func(offset, limit int) ([]domain.Permission, error) {
+			return s.rbacSvc.ListPermissions(ctx, bizID, offset, limit)
+		})
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.roles, err = listAll(func(offset, limit int) ([]domain.Role, error) {
+			return s.rbacSvc.ListRoles(ctx, bizID, offset, limit)
+		})
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.roleInclusions, err = listAll(func(offset, limit int) ([]domain.RoleInclusion, error) {
+			return s.rbacSvc.ListRoleInclusions(ctx, bizID, offset, limit)
+		})
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.rolePermissions, err = s.rbacSvc.ListRolePermissions(ctx, bizID)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.userRoles, err = s.rbacSvc.ListUserRoles(ctx, bizID)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.userPermissions, err = listAll(func(offset, limit int) ([]domain.UserPermission, error) {
+			return s.rbacSvc.ListUserPermissions(ctx, bizID, offset, limit)
+		})
+		return err
+	})
+	eg.Go(func() error {
+		defs, err := s.definitionRepo.Find(ctx, bizID)
+		if err != nil {
+			return err
+		}
+		snap.attrDefs = slices.Concat(defs.SubjectAttrDefs, defs.ResourceAttrDefs, defs.EnvironmentAttrDefs)
+		slices.SortFunc(snap.attrDefs, func(a, b domain.AttributeDefinition) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+		return nil
+	})
+	eg.Go(func() error {
+		var err error
+		snap.subjectValues, err = s.valueRepo.FindBizSubjectValues(ctx, bizID)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.resourceValues, err = s.valueRepo.FindBizResourceValues(ctx, bizID)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.envValues, err = s.valueRepo.FindEnvironmentValueWithDefinition(ctx, bizID)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		snap.policies, err = s.policySvc.FindDraftPolicies(ctx, bizID)
+		return err
+	})
+	return snap, eg.Wait()
+}
+
+// listAll 分页读取全部数据
+func listAll[T any](list func(offset, limit int) ([]T, error)) ([]T, error) {
+	var res []T
+	for offset := 0; ; offset += pageSize {
+		page, err := list(offset, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, page...)
+		if len(page) < pageSize {
+			return res, nil
+		}
+	}
+}
+
+func (s *service) Export(ctx context.Context, bizID int64) (domain.BizModel, error) {
+	snap, err := s.load(ctx, bizID)
+	if err != nil {
+		return domain.BizModel{}, err
+	}
+	return snap.toModel(bizID), nil
+}
+
+func (snap snapshot) toModel(bizID int64) domain.BizModel {
+	return domain.BizModel{
+		Version: domain.BizModelVersion,
+		BizID:   bizID,
+		Resources: slice.Map(snap.resources, func(_ int, src domain.Resource) domain.BizModelResource {
+			return domain.BizModelResource{
+				ID:          src.ID,
+				Type:        src.Type,
+				Key:         src.Key,
+				Name:        src.Name,
+				Description: src.Description,
+				Metadata:    src.Metadata,
+			}
+		}),
+		ActionDefinitions: slice.Map(snap.actionDefs, func(_ int, src domain.ActionDefinition) domain.BizModelActionDefinition {
+			return domain.BizModelActionDefinition{
+				Name:        src.Name,
+				Description: src.Description,
+				Implies:     src.Implies,
+			}
+		}),
+		Permissions: slice.Map(snap.permissions, func(_ int, src domain.Permission) domain.BizModelPermission {
+			return domain.BizModelPermission{
+				ID:                 src.ID,
+				ResourceID:         src.Resource.ID,
+				Action:             src.Action,
+				Name:               src.Name,
+				Description:        src.Description,
+				Metadata:           src.Metadata,
+				CombiningAlgorithm: src.CombiningAlgorithm,
+			}
+		}),
+		Roles: slice.Map(snap.roles, func(_ int, src domain.Role) domain.BizModelRole {
+			return domain.BizModelRole{
+				ID:              src.ID,
+				Type:            src.Type,
+				Name:            src.Name,
+				Description:     src.Description,
+				Metadata:        src.Metadata,
+				MaxMembers:      src.MaxMembers,
+				MaxRolesPerUser: src.MaxRolesPerUser,
+			}
+		}),
+		RoleInclusions: slice.Map(snap.roleInclusions, func(_ int, src domain.RoleInclusion) domain.BizModelRoleInclusion {
+			return domain.BizModelRoleInclusion{
+				IncludingRoleID: src.IncludingRole.ID,
+				IncludedRoleID:  src.IncludedRole.ID,
+			}
+		}),
+		RolePermissions: slice.Map(snap.rolePermissions, func(_ int, src domain.RolePermission) domain.BizModelRolePermission {
+			return domain.BizModelRolePermission{
+				RoleID:       src.Role.ID,
+				PermissionID: src.Permission.ID,
+				StartTime:    src.StartTime,
+				EndTime:      src.EndTime,
+				Effect:       src.Effect,
+			}
+		}),
+		UserRoles: slice.Map(snap.userRoles, func(_ int, src domain.UserRole) domain.BizModelUserRole {
+			return domain.BizModelUserRole{
+				UserID:    src.UserID,
+				RoleID:    src.Role.ID,
+				StartTime: src.StartTime,
+				EndTime:   toModelEndTime(src.EndTime),
+			}
+		}),
+		UserPermissions: slice.Map(snap.userPermissions, func(_ int, src domain.UserPermission) domain.BizModelUserPermission {
+			return domain.BizModelUserPermission{
+				UserID:       src.UserID,
+				PermissionID: src.Permission.ID,
+				StartTime:    src.StartTime,
+				EndTime:      toModelEndTime(src.EndTime),
+				Effect:       src.Effect,
+			}
+		}),
+		AttributeDefinitions: slice.Map(snap.attrDefs, func(_ int, src domain.AttributeDefinition) domain.BizModelAttributeDefinition {
+			return domain.BizModelAttributeDefinition{
+				ID:             src.ID,
+				Name:           src.Name,
+				Description:    src.Description,
+				DataType:       src.DataType,
+				EntityType:     src.EntityType,
+				ValidationRule: src.ValidationRule,
+			}
+		}),
+		SubjectAttributeValues:     toModelValues(snap.subjectValues),
+		ResourceAttributeValues:    toModelValues(snap.resourceValues),
+		EnvironmentAttributeValues: toModelValues([]domain.ABACObject{snap.envValues}),
+		Policies: slice.Map(snap.policies, func(_ int, src domain.Policy) domain.BizModelPolicy {
+			return domain.BizModelPolicy{
+				ID:          src.ID,
+				Name:        src.Name,
+				Description: src.Description,
+				ExecuteType: src.ExecuteType,
+				Expression:  src.Expression,
+				Status:      src.Status,
+				Rules:       flattenRules(src.Rules),
+				Permissions: slice.Map(src.Permissions, func(_ int, src domain.UserPermission) domain.BizModelPolicyPermission {
+					return domain.BizModelPolicyPermission{
+						PermissionID: src.Permission.ID,
+						Effect:       src.Effect,
+					}
+				}),
+			}
+		}),
+	}
+}
+
+// toModelEndTime 永不过期的时候省略失效时间
+func toModelEndTime(endTime int64) int64 {
+	if endTime == neverExpires {
+		return 0
+	}
+	return endTime
+}
+
+// toEndTime 文档里面省略的失效时间表示永不过期
+func toEndTime(endTime int64) int64 {
+	if endTime == 0 {
+		return neverExpires
+	}
+	return endTime
+}
+
+func toModelValues(objs []domain.ABACObject) []domain.BizModelAttributeValue {
+	var res []domain.BizModelAttributeValue
+	for _, obj := range objs {
+		for _, val := range obj.AttributeValues {
+			res = append(res, domain.BizModelAttributeValue{
+				ObjectID:  obj.ID,
+				AttrDefID: val.Definition.ID,
+				Value:     val.Value,
+			})
+		}
+	}
+	return res
+}
+
+// flattenRules 把规则树展开成列表，父规则在前
+func flattenRules(rules []domain.PolicyRule) []domain.BizModelPolicyRule {
+	var res []domain.BizModelPolicyRule
+	var visit func(rule *domain.PolicyRule)
+	visit = func(rule *domain.PolicyRule) {
+		if rule == nil {
+			return
+		}
+		item := domain.BizModelPolicyRule{
+			ID:        rule.ID,
+			AttrDefID: rule.AttrDef.ID,
+			Value:     rule.Value,
+			Operator:  rule.Operator,
+		}
+		if rule.ValueAttrDef != nil {
+			item.ValueAttrDefID = rule.ValueAttrDef.ID
+		}
+		if rule.LeftRule != nil {
+			item.Left = rule.LeftRule.ID
+		}
+		if rule.RightRule != nil {
+			item.Right = rule.RightRule.ID
+		}
+		res = append(res, item)
+		visit(rule.LeftRule)
+		visit(rule.RightRule)
+	}
+	for idx := range rules {
+		visit(&rules[idx])
+	}
+	return res
+}
@@ -0,0 +1,303 @@
+package bizmodel
+
+import (
+	"fmt"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"gitee.com/flycash/permission-platform/internal/service/abac"
+)
+
+func invalid(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", errs.ErrInvalidParameter, fmt.Sprintf(format, args...))
+}
+
+// validate 导入之前校验文档，保证引用的 ID 都能在文档里面找到，业务主键没有重复，
+// 这样导入的时候就不会因为文档本身的问题只写入一半
+func validate(model domain.BizModel, validator *abac.AttributeValidator) error {
+	if model.Version != domain.BizModelVersion {
+		return invalid("不支持的文档版本 %q，当前版本是 %s", model.Version, domain.BizModelVersion)
+	}
+	resources, err := indexByID(model.Resources, "资源", func(src domain.BizModelResource) (int64, string) {
+		return src.ID, src.Type + "/" + src.Key
+	})
+	if err != nil {
+		return err
+	}
+	if err = checkKeys(model.ActionDefinitions, "操作", func(src domain.BizModelActionDefinition) string {
+		return src.Name
+	}); err != nil {
+		return err
+	}
+	permissions, err := indexByID(model.Permissions, "权限", func(src domain.BizModelPermission) (int64, string) {
+		return src.ID, fmt.Sprintf("%d/%s", src.ResourceID, src.Action)
+	})
+	if err != nil {
+		return err
+	}
+	for _, src := range model.Permissions {
+		if _, ok := resources[src.ResourceID]; !ok {
+			return invalid("权限 %d 引用的资源 %d 不存在", src.ID, src.ResourceID)
+		}
+		if !src.CombiningAlgorithm.IsValid() {
+			return invalid("权限 %d 的策略合并算法 %s 不合法", src.ID, src.CombiningAlgorithm)
+		}
+	}
+	roles, err := indexByID(model.Roles, "角色", func(src domain.BizModelRole) (int64, string) {
+		return src.ID, src.Type + "/" + src.Name
+	})
+	if err != nil {
+		return err
+	}
+	if err = validateRelations(model, roles, permissions); err != nil {
+		return err
+	}
+	defs, err := indexByID(model.AttributeDefinitions, "属性定义", func(src domain.BizModelAttributeDefinition) (int64, string) {
+		return src.ID, src.Name
+	})
+	if err != nil {
+		return err
+	}
+	for _, src := range model.AttributeDefinitions {
+		switch src.EntityType {
+		case domain.EntityTypeSubject, domain.EntityTypeResource, domain.EntityTypeEnvironment:
+		default:
+			return invalid("属性 %s 的实体类型 %s 不合法", src.Name, src.EntityType)
+		}
+		if err = abac.CheckValidationRule(src.ValidationRule); err != nil {
+			return fmt.Errorf("属性 %s 的校验规则不合法: %w", src.Name, err)
+		}
+	}
+	if err = validateValues(model, defs, resources, validator); err != nil {
+		return err
+	}
+	if _, err = indexByID(model.Policies, "策略", func(src domain.BizModelPolicy) (int64, string) {
+		return src.ID, src.Name
+	}); err != nil {
+		return err
+	}
+	for _, src := range model.Policies {
+		if err = validatePolicy(src, defs, permissions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRelations(model domain.BizModel, roles map[int64]domain.BizModelRole, permissions map[int64]domain.BizModelPermission) error {
+	hasRole := func(id int64) bool {
+		_, ok := roles[id]
+		return ok
+	}
+	hasPermission := func(id int64) bool {
+		_, ok := permissions[id]
+		return ok
+	}
+	for _, src := range model.RoleInclusions {
+		if !hasRole(src.IncludingRoleID) || !hasRole(src.IncludedRoleID) {
+			return invalid("角色包含关系 %d -> %d 引用的角色不存在", src.IncludingRoleID, src.IncludedRoleID)
+		}
+	}
+	if err := checkKeys(model.RoleInclusions, "角色包含关系", func(src domain.BizModelRoleInclusion) string {
+		return fmt.Sprintf("%d -> %d", src.IncludingRoleID, src.IncludedRoleID)
+	}); err != nil {
+		return err
+	}
+	for _, src := range model.RolePermissions {
+		if !hasRole(src.RoleID) || !hasPermission(src.PermissionID) {
+			return invalid("角色 %d 的权限 %d 引用的角色或者权限不存在", src.RoleID, src.PermissionID)
+		}
+		if err := validateEffect(src.Effect); err != nil {
+			return err
+		}
+	}
+	if err := checkKeys(model.RolePermissions, "角色权限", func(src domain.BizModelRolePermission) string {
+		return fmt.Sprintf("%d/%d", src.RoleID, src.PermissionID)
+	}); err != nil {
+		return err
+	}
+	for _, src := range model.UserRoles {
+		if !hasRole(src.RoleID) {
+			return invalid("用户 %d 的角色 %d 不存在", src.UserID, src.RoleID)
+		}
+	}
+	if err := checkKeys(model.UserRoles, "用户角色", func(src domain.BizModelUserRole) string {
+		return fmt.Sprintf("%d/%d", src.UserID, src.RoleID)
+	}); err != nil {
+		return err
+	}
+	for _, src := range model.UserPermissions {
+		if !hasPermission(src.PermissionID) {
+			return invalid("用户 %d 的权限 %d 不存在", src.UserID, src.PermissionID)
+		}
+		if err := validateEffect(src.Effect); err != nil {
+			return err
+		}
+	}
+	return checkKeys(model.UserPermissions, "用户权限", func(src domain.BizModelUserPermission) string {
+		return fmt.Sprintf("%d/%d", src.UserID, src.PermissionID)
+	})
+}
+
+func validateValues(model domain.BizModel, defs map[int64]domain.BizModelAttributeDefinition,
+	resources map[int64]domain.BizModelResource, validator *abac.AttributeValidator,
+) error {
+	groups := []struct {
+		entityType domain.EntityType
+		values     []domain.BizModelAttributeValue
+	}{
+		{entityType: domain.EntityTypeSubject, values: model.SubjectAttributeValues},
+		{entityType: domain.EntityTypeResource, values: model.ResourceAttributeValues},
+		{entityType: domain.EntityTypeEnvironment, values: model.EnvironmentAttributeValues},
+	}
+	for _, group := range groups {
+		for _, src := range group.values {
+			def, ok := defs[src.AttrDefID]
+			if !ok || def.EntityType != group.entityType {
+				return invalid("%s属性值引用的属性定义 %d 不存在", group.entityType, src.AttrDefID)
+			}
+			if _, ok = resources[src.ObjectID]; group.entityType == domain.EntityTypeResource && !ok {
+				return invalid("属性 %s 的值引用的资源 %d 不存在", def.Name, src.ObjectID)
+			}
+			if _, err := validator.Validate(toAttributeDefinition(def), src.Value); err != nil {
+				return err
+			}
+		}
+		if err := checkKeys(group.values, string(group.entityType)+"属性值", func(src domain.BizModelAttributeValue) string {
+			return fmt.Sprintf("%d/%d", src.ObjectID, src.AttrDefID)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePolicy(policy domain.BizModelPolicy, defs map[int64]domain.BizModelAttributeDefinition, permissions map[int64]domain.BizModelPermission) error {
+	if !policy.ExecuteType.IsValid() {
+		return invalid("策略 %s 的执行方式 %s 不合法", policy.Name, policy.ExecuteType)
+	}
+	for _, src := range policy.Permissions {
+		if _, ok := permissions[src.PermissionID]; !ok {
+			return invalid("策略 %s 关联的权限 %d 不存在", policy.Name, src.PermissionID)
+		}
+		if err := validateEffect(src.Effect); err != nil {
+			return err
+		}
+	}
+	if err := checkKeys(policy.Permissions, "策略 "+policy.Name+" 关联的权限", func(src domain.BizModelPolicyPermission) string {
+		return fmt.Sprintf("%d", src.PermissionID)
+	}); err != nil {
+		return err
+	}
+	for _, src := range policy.Rules {
+		if !src.Operator.IsValid() {
+			return invalid("策略 %s 的规则 %d 的运算符 %s 不合法", policy.Name, src.ID, src.Operator)
+		}
+		for _, defID := range []int64{src.AttrDefID, src.ValueAttrDefID} {
+			if _, ok := defs[defID]; defID != 0 && !ok {
+				return invalid("策略 %s 的规则 %d 引用的属性定义 %d 不存在", policy.Name, src.ID, defID)
+			}
+		}
+	}
+	if _, err := ruleOrder(policy.Rules); err != nil {
+		return fmt.Errorf("策略 %s 的规则不合法: %w", policy.Name, err)
+	}
+	return nil
+}
+
+func validateEffect(effect domain.Effect) error {
+	if effect.IsAllow() || effect.IsDeny() {
+		return nil
+	}
+	return invalid("效果 %q 只能是 allow 或者 deny", effect)
+}
+
+// ruleOrder 检查规则组成的是若干棵树，返回子规则在前的顺序，保存父规则的时候子规则已经有了 ID
+func ruleOrder(rules []domain.BizModelPolicyRule) ([]domain.BizModelPolicyRule, error) {
+	byID, err := indexByID(rules, "规则", func(src domain.BizModelPolicyRule) (int64, string) {
+		return src.ID, fmt.Sprintf("%d", src.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	hasParent := make(map[int64]bool, len(rules))
+	for _, src := range rules {
+		for _, child := range []int64{src.Left, src.Right} {
+			if child == 0 {
+				continue
+			}
+			if _, ok := byID[child]; !ok {
+				return nil, invalid("规则 %d 引用的子规则 %d 不存在", src.ID, child)
+			}
+			if hasParent[child] {
+				return nil, invalid("规则 %d 被多个规则引用", child)
+			}
+			hasParent[child] = true
+		}
+	}
+	res := make([]domain.BizModelPolicyRule, 0, len(rules))
+	var visit func(id int64)
+	visit = func(id int64) {
+		if id == 0 {
+			return
+		}
+		rule := byID[id]
+		visit(rule.Left)
+		visit(rule.Right)
+		res = append(res, rule)
+	}
+	for _, src := range rules {
+		if !hasParent[src.ID] {
+			visit(src.ID)
+		}
+	}
+	// 每个规则最多只有一个父规则，从根规则出发到不了的规则一定在环上
+	if len(res) != len(rules) {
+		return nil, invalid("规则之间存在循环引用")
+	}
+	return res, nil
+}
+
+// indexByID 按照 ID 建立索引，同时检查 ID 和业务主键没有重复
+func indexByID[T any](items []T, kind string, keyOf func(src T) (int64, string)) (map[int64]T, error) {
+	res := make(map[int64]T, len(items))
+	keys := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		id, key := keyOf(item)
+		if id == 0 {
+			return nil, invalid("%s %s 缺少 ID", kind, key)
+		}
+		if _, ok := res[id]; ok {
+			return nil, invalid("%s的 ID %d 重复", kind, id)
+		}
+		if _, ok := keys[key]; ok {
+			return nil, invalid("%s %s 重复", kind, key)
+		}
+		res[id] = item
+		keys[key] = struct{}{}
+	}
+	return res, nil
+}
+
+// checkKeys 检查业务主键没有重复
+func checkKeys[T any](items []T, kind string, keyOf func(src T) string) error {
+	keys := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		key := keyOf(item)
+		if _, ok := keys[key]; ok {
+			return invalid("%s %s 重复", kind, key)
+		}
+		keys[key] = struct{}{}
+	}
+	return nil
+}
+
+func toAttributeDefinition(src domain.BizModelAttributeDefinition) domain.AttributeDefinition {
+	return domain.AttributeDefinition{
+		Name:           src.Name,
+		Description:    src.Description,
+		DataType:       src.DataType,
+		EntityType:     src.EntityType,
+		ValidationRule: src.ValidationRule,
+	}
+}
@@ -0,0 +1,230 @@
+//go:build unit
+
+package bizmodel
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"gitee.com/flycash/permission-platform/internal/service/abac"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validModel() domain.BizModel {
+	return domain.BizModel{
+		Version:   domain.BizModelVersion,
+		BizID:     1,
+		Resources: []domain.BizModelResource{{ID: 10, Type: "doc", Key: "doc1"}},
+		Permissions: []domain.BizModelPermission{
+			{ID: 20, ResourceID: 10, Action: "read"},
+		},
+		Roles: []domain.BizModelRole{
+			{ID: 30, Type: "system", Name: "admin"},
+			{ID: 31, Type: "system", Name: "viewer"},
+		},
+		RoleInclusions:  []domain.BizModelRoleInclusion{{IncludingRoleID: 30, IncludedRoleID: 31}},
+		RolePermissions: []domain.BizModelRolePermission{{RoleID: 31, PermissionID: 20, Effect: domain.EffectAllow}},
+		UserRoles:       []domain.BizModelUserRole{{UserID: 100, RoleID: 30}},
+		UserPermissions: []domain.BizModelUserPermission{{UserID: 100, PermissionID: 20, Effect: domain.EffectDeny}},
+		AttributeDefinitions: []domain.BizModelAttributeDefinition{
+			{ID: 40, Name: "age", DataType: domain.DataTypeNumber, EntityType: domain.EntityTypeSubject},
+			{ID: 41, Name: "level", DataType: domain.DataTypeNumber, EntityType: domain.EntityTypeResource},
+		},
+		SubjectAttributeValues:  []domain.BizModelAttributeValue{{ObjectID: 100, AttrDefID: 40, Value: "18"}},
+		ResourceAttributeValues: []domain.BizModelAttributeValue{{ObjectID: 10, AttrDefID: 41, Value: "3"}},
+		Policies: []domain.BizModelPolicy{
+			{
+				ID:          50,
+				Name:        "adult",
+				ExecuteType: domain.LogicType,
+				Rules: []domain.BizModelPolicyRule{
+					{ID: 60, Operator: domain.AND, Left: 61, Right: 62},
+					{ID: 61, AttrDefID: 40, Operator: domain.GreaterOrEqual, Value: "18"},
+					{ID: 62, AttrDefID: 40, Operator: domain.Greater, ValueAttrDefID: 41},
+				},
+				Permissions: []domain.BizModelPolicyPermission{{PermissionID: 20, Effect: domain.EffectAllow}},
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		modify  func(model *domain.BizModel)
+		wantErr error
+	}{
+		{
+			name:   "合法的文档",
+			modify: func(*domain.BizModel) {},
+		},
+		{
+			name: "版本不对",
+			modify: func(model *domain.BizModel) {
+				model.Version = "v0"
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "资源的业务主键重复",
+			modify: func(model *domain.BizModel) {
+				model.Resources = append(model.Resources, domain.BizModelResource{ID: 11, Type: "doc", Key: "doc1"})
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "角色的 ID 重复",
+			modify: func(model *domain.BizModel) {
+				model.Roles = append(model.Roles, domain.BizModelRole{ID: 30, Type: "system", Name: "guest"})
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "权限引用的资源不存在",
+			modify: func(model *domain.BizModel) {
+				model.Permissions[0].ResourceID = 99
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "角色包含关系引用的角色不存在",
+			modify: func(model *domain.BizModel) {
+				model.RoleInclusions[0].IncludedRoleID = 99
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "用户权限的效果不合法",
+			modify: func(model *domain.BizModel) {
+				model.UserPermissions[0].Effect = "maybe"
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "资源属性值引用了主体的属性定义",
+			modify: func(model *domain.BizModel) {
+				model.ResourceAttributeValues[0].AttrDefID = 40
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "属性值不合法",
+			modify: func(model *domain.BizModel) {
+				model.SubjectAttributeValues[0].Value = "abc"
+			},
+			wantErr: errs.ErrInvalidAttributeValue,
+		},
+		{
+			name: "规则引用的属性定义不存在",
+			modify: func(model *domain.BizModel) {
+				model.Policies[0].Rules[1].AttrDefID = 99
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "规则引用的子规则不存在",
+			modify: func(model *domain.BizModel) {
+				model.Policies[0].Rules[0].Right = 99
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "策略关联的权限不存在",
+			modify: func(model *domain.BizModel) {
+				model.Policies[0].Permissions[0].PermissionID = 99
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			model := validModel()
+			tc.modify(&model)
+			err := validate(model, abac.NewAttributeValidator())
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestRuleOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		rules   []domain.BizModelPolicyRule
+		wantIDs []int64
+		wantErr error
+	}{
+		{
+			name: "子规则在前",
+			rules: []domain.BizModelPolicyRule{
+				{ID: 1, Operator: domain.OR, Left: 2, Right: 3},
+				{ID: 2, Operator: domain.NOT, Right: 4},
+				{ID: 3, Operator: domain.Equals},
+				{ID: 4, Operator: domain.Equals},
+				{ID: 5, Operator: domain.Equals},
+			},
+			wantIDs: []int64{4, 2, 3, 1, 5},
+		},
+		{
+			name: "子规则被多个规则引用",
+			rules: []domain.BizModelPolicyRule{
+				{ID: 1, Operator: domain.AND, Left: 3, Right: 3},
+				{ID: 3, Operator: domain.Equals},
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "循环引用",
+			rules: []domain.BizModelPolicyRule{
+				{ID: 1, Operator: domain.NOT, Right: 2},
+				{ID: 2, Operator: domain.NOT, Right: 1},
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ordered, err := ruleOrder(tc.rules)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantIDs, slice.Map(ordered, func(_ int, src domain.BizModelPolicyRule) int64 {
+				return src.ID
+			}))
+		})
+	}
+}
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []Format{FormatJSON, FormatYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+			data, err := Encode(validModel(), format)
+			require.NoError(t, err)
+			model, err := Decode(data, format)
+			require.NoError(t, err)
+			assert.Equal(t, validModel(), model)
+		})
+	}
+
+	_, err := Decode([]byte("{"), FormatJSON)
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+	_, err = Encode(validModel(), "xml")
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+}
@@ -0,0 +1,132 @@
+package hybrid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/repository"
+	"gitee.com/flycash/permission-platform/internal/service/abac"
+	"gitee.com/flycash/permission-platform/internal/service/rbac"
+	"gorm.io/gorm"
+)
+
+// bizEnginePermissionService 按照业务配置中的判定引擎，把请求分发给对应的实现。
+// 业务配置由仓储缓存，修改之后删除缓存，所以判定引擎的变更立刻生效
+type bizEnginePermissionService struct {
+	bizConfigRepo repository.BusinessConfigRepository
+	engines       map[domain.Engine]PermissionService
+}
+
+func NewBizEnginePermissionService(
+	bizConfigRepo repository.BusinessConfigRepository,
+	rbacPermissionSvc rbac.PermissionService,
+	rbacSvc rbac.Service,
+	abacSvc abac.PermissionSvc,
+) PermissionService {
+	return &bizEnginePermissionService{
+		bizConfigRepo: bizConfigRepo,
+		engines: map[domain.Engine]PermissionService{
+			domain.EngineRBAC:            &rbacPermissionService{svc: rbacPermissionSvc},
+			domain.EngineABAC:            &abacPermissionService{svc: abacSvc},
+			domain.EngineRBACThenABAC:    NewPermissionService(rbacPermissionSvc, abacSvc),
+			domain.EngineRoleAsAttribute: NewRoleAsAttributePermissionService(rbacSvc, abacSvc),
+		},
+	}
+}
+
+func (s *bizEnginePermissionService) Check(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error) {
+	svc, err := s.engineOf(ctx, bizID)
+	if err != nil {
+		return false, err
+	}
+	return svc.Check(ctx, bizID, userID, resource, actions, attrs)
+}
+
+func (s *bizEnginePermissionService) Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (domain.PermissionTrace, error) {
+	svc, err := s.engineOf(ctx, bizID)
+	if err != nil {
+		return domain.PermissionTrace{}, err
+	}
+	return svc.Explain(ctx, bizID, userID, resource, actions, attrs)
+}
+
+func (s *bizEnginePermissionService) ListAccessibleResources(ctx context.Context, bizID, userID int64, resourceType, action string, attrs domain.Attributes) (domain.AccessibleResources, error) {
+	svc, err := s.engineOf(ctx, bizID)
+	if err != nil {
+		return domain.AccessibleResources{}, err
+	}
+	return svc.ListAccessibleResources(ctx, bizID, userID, resourceType, action, attrs)
+}
+
+func (s *bizEnginePermissionService) engineOf(ctx context.Context, bizID int64) (PermissionService, error) {
+	engine, err := s.getEngine(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	svc, ok := s.engines[engine]
+	if !ok {
+		return nil, fmt.Errorf("业务方 %d 配置了未知的权限判定引擎 %s", bizID, engine)
+	}
+	return svc, nil
+}
+
+func (s *bizEnginePermissionService) getEngine(ctx context.Context, bizID int64) (domain.Engine, error) {
+	cfg, err := s.bizConfigRepo.FindByID(ctx, bizID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", err
+	}
+	// 没有业务配置的使用默认的判定引擎
+	return cfg.Engine.OrDefault(), nil
+}
+
+// rbacPermissionService 纯 RBAC，忽略属性
+type rbacPermissionService struct {
+	svc rbac.PermissionService
+}
+
+func (p *rbacPermissionService) Check(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, _ domain.Attributes) (bool, error) {
+	return p.svc.Check(ctx, bizID, userID, resource, actions)
+}
+
+func (p *rbacPermissionService) Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, _ domain.Attributes) (domain.PermissionTrace, error) {
+	trace, err := p.svc.Explain(ctx, bizID, userID, resource, actions)
+	if err != nil {
+		return domain.PermissionTrace{}, err
+	}
+	return domain.PermissionTrace{Allowed: trace.Allowed, RBAC: &trace}, nil
+}
+
+func (p *rbacPermissionService) ListAccessibleResources(ctx context.Context, bizID, userID int64, resourceType, action string, _ domain.Attributes) (domain.AccessibleResources, error) {
+	access, err := p.svc.ListAccessible(ctx, bizID, userID, resourceType, action)
+	if err != nil {
+		return domain.AccessibleResources{}, err
+	}
+	return domain.AccessibleResources{RBAC: &access}, nil
+}
+
+// abacPermissionService 纯 ABAC
+type abacPermissionService struct {
+	svc abac.PermissionSvc
+}
+
+func (p *abacPermissionService) Check(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error) {
+	return p.svc.Check(ctx, bizID, userID, resource, actions, attrs)
+}
+
+func (p *abacPermissionService) Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (domain.PermissionTrace, error) {
+	trace, err := p.svc.Explain(ctx, bizID, userID, resource, actions, attrs)
+	if err != nil {
+		return domain.PermissionTrace{}, err
+	}
+	return domain.PermissionTrace{Allowed: trace.Allowed, ABAC: &trace}, nil
+}
+
+func (p *abacPermissionService) ListAccessibleResources(ctx context.Context, bizID, userID int64, resourceType, action string, attrs domain.Attributes) (domain.AccessibleResources, error) {
+	access, err := p.svc.ListAccessible(ctx, bizID, userID, resourceType, action, attrs)
+	if err != nil {
+		return domain.AccessibleResources{}, err
+	}
+	return domain.AccessibleResources{ABAC: &access}, nil
+}
@@ -0,0 +1,93 @@
+//go:build unit
+
+package hybrid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type stubBizConfigRepo struct {
+	repository.BusinessConfigRepository
+	cfg domain.BusinessConfig
+	err error
+}
+
+func (s *stubBizConfigRepo) FindByID(_ context.Context, _ int64) (domain.BusinessConfig, error) {
+	return s.cfg, s.err
+}
+
+type stubPermissionService struct {
+	allowed bool
+}
+
+func (s stubPermissionService) Check(_ context.Context, _, _ int64, _ domain.Resource, _ []string, _ domain.Attributes) (bool, error) {
+	return s.allowed, nil
+}
+
+func (s stubPermissionService) Explain(_ context.Context, _, _ int64, _ domain.Resource, _ []string, _ domain.Attributes) (domain.PermissionTrace, error) {
+	return domain.PermissionTrace{Allowed: s.allowed}, nil
+}
+
+func (s stubPermissionService) ListAccessibleResources(_ context.Context, _, _ int64, _, _ string, _ domain.Attributes) (domain.AccessibleResources, error) {
+	return domain.AccessibleResources{}, nil
+}
+
+func TestBizEnginePermissionService_Check(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		repo    *stubBizConfigRepo
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "使用业务方配置的判定引擎",
+			repo: &stubBizConfigRepo{cfg: domain.BusinessConfig{Engine: domain.EngineABAC}},
+			want: true,
+		},
+		{
+			name: "没有配置判定引擎的使用 RBAC",
+			repo: &stubBizConfigRepo{cfg: domain.BusinessConfig{}},
+			want: false,
+		},
+		{
+			name: "没有业务配置的使用 RBAC",
+			repo: &stubBizConfigRepo{err: gorm.ErrRecordNotFound},
+			want: false,
+		},
+		{
+			name:    "获取业务配置失败",
+			repo:    &stubBizConfigRepo{err: errors.New("mock db error")},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			svc := &bizEnginePermissionService{
+				bizConfigRepo: tc.repo,
+				engines: map[domain.Engine]PermissionService{
+					domain.EngineRBAC: stubPermissionService{allowed: false},
+					domain.EngineABAC: stubPermissionService{allowed: true},
+				},
+			}
+			ok, err := svc.Check(context.Background(), 1, 1, domain.Resource{}, nil, domain.Attributes{})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ok)
+		})
+	}
+}
@@ -16,6 +16,10 @@ const (
 
 type PermissionService interface {
 	Check(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error)
+	// Explain 和 Check 的判定逻辑一致，但是会返回判定过程
+	Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (domain.PermissionTrace, error)
+	// ListAccessibleResources 用户在某一类资源的某个操作上可以访问的资源，用于列表过滤，不需要逐个校验
+	ListAccessibleResources(ctx context.Context, bizID, userID int64, resourceType, action string, attrs domain.Attributes) (domain.AccessibleResources, error)
 }
 
 type permissionService struct {
@@ -41,6 +45,36 @@ func (p *permissionService) Check(ctx context.Context, bizID, userID int64, reso
 	return p.abacSvc.Check(ctx, bizID, userID, resource, actions, attrs)
 }
 
+func (p *permissionService) Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (domain.PermissionTrace, error) {
+	rbacTrace, err := p.rbacSvc.Explain(ctx, bizID, userID, resource, actions)
+	if err != nil {
+		return domain.PermissionTrace{}, err
+	}
+	if !rbacTrace.Allowed {
+		return domain.PermissionTrace{RBAC: &rbacTrace}, nil
+	}
+	abacTrace, err := p.abacSvc.Explain(ctx, bizID, userID, resource, actions, attrs)
+	if err != nil {
+		return domain.PermissionTrace{}, err
+	}
+	return domain.PermissionTrace{Allowed: abacTrace.Allowed, RBAC: &rbacTrace, ABAC: &abacTrace}, nil
+}
+
+func (p *permissionService) ListAccessibleResources(ctx context.Context, bizID, userID int64, resourceType, action string, attrs domain.Attributes) (domain.AccessibleResources, error) {
+	rbacAccess, err := p.rbacSvc.ListAccessible(ctx, bizID, userID, resourceType, action)
+	if err != nil {
+		return domain.AccessibleResources{}, err
+	}
+	if len(rbacAccess.Keys) == 0 {
+		return domain.AccessibleResources{RBAC: &rbacAccess}, nil
+	}
+	abacAccess, err := p.abacSvc.ListAccessible(ctx, bizID, userID, resourceType, action, attrs)
+	if err != nil {
+		return domain.AccessibleResources{}, err
+	}
+	return domain.AccessibleResources{RBAC: &rbacAccess, ABAC: &abacAccess}, nil
+}
+
 type roleAsAttributePermissionService struct {
 	rbacSvc           rbac.Service
 	converter         converter.Converter[[]string]
@@ -56,14 +90,47 @@ func NewRoleAsAttributePermissionService(rbacSvc rbac.Service, abacPermissionSvc
 }
 
 func (p *roleAsAttributePermissionService) Check(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error) {
-	userRoles, err := p.rbacSvc.ListUserRolesByUserID(ctx, bizID, userID)
+	attrs, err := p.withRoles(ctx, bizID, userID, attrs)
 	if err != nil {
 		return false, err
 	}
+	return p.abacPermissionSvc.Check(ctx, bizID, userID, resource, actions, attrs)
+}
+
+func (p *roleAsAttributePermissionService) Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (domain.PermissionTrace, error) {
+	attrs, err := p.withRoles(ctx, bizID, userID, attrs)
+	if err != nil {
+		return domain.PermissionTrace{}, err
+	}
+	abacTrace, err := p.abacPermissionSvc.Explain(ctx, bizID, userID, resource, actions, attrs)
+	if err != nil {
+		return domain.PermissionTrace{}, err
+	}
+	return domain.PermissionTrace{Allowed: abacTrace.Allowed, ABAC: &abacTrace}, nil
+}
+
+func (p *roleAsAttributePermissionService) ListAccessibleResources(ctx context.Context, bizID, userID int64, resourceType, action string, attrs domain.Attributes) (domain.AccessibleResources, error) {
+	attrs, err := p.withRoles(ctx, bizID, userID, attrs)
+	if err != nil {
+		return domain.AccessibleResources{}, err
+	}
+	abacAccess, err := p.abacPermissionSvc.ListAccessible(ctx, bizID, userID, resourceType, action, attrs)
+	if err != nil {
+		return domain.AccessibleResources{}, err
+	}
+	return domain.AccessibleResources{ABAC: &abacAccess}, nil
+}
+
+// withRoles 把用户的角色名作为主体属性
+func (p *roleAsAttributePermissionService) withRoles(ctx context.Context, bizID, userID int64, attrs domain.Attributes) (domain.Attributes, error) {
+	userRoles, err := p.rbacSvc.ListUserRolesByUserID(ctx, bizID, userID)
+	if err != nil {
+		return attrs, err
+	}
 	nameList := slice.Map(userRoles, func(_ int, src domain.UserRole) string {
 		return src.Role.Name
 	})
 	val, _ := p.converter.Encode(nameList)
 	attrs.Subject = attrs.Subject.SetKv(defaultRoleName, val)
-	return p.abacPermissionSvc.Check(ctx, bizID, userID, resource, actions, attrs)
+	return attrs, nil
 }
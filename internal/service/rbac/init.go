@@ -255,6 +255,6 @@ func (s *InitService) grantUserRole(ctx context.Context, role domain.Role) error
 		Role:      role,
 		StartTime: time.Now().UnixMilli(),
 		EndTime:   time.Now().AddDate(years, 0, 0).UnixMilli(),
-	})
+	}, nil)
 	return err
 }
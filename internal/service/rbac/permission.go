@@ -2,17 +2,24 @@ package rbac
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/ecodeclub/ekit/slice"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
 	"gitee.com/flycash/permission-platform/internal/repository"
+	"gitee.com/flycash/permission-platform/pkg/reskey"
 )
 
 // PermissionService RBAC模型下的权限服务接口
 type PermissionService interface {
 	// Check 检查用户是否有对特定权限
 	Check(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string) (bool, error)
+	// Explain 和 Check 的判定逻辑一致，但是会返回判定过程
+	Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string) (domain.RBACTrace, error)
+	// ListAccessible 用户在某一类资源的某个操作上允许访问的资源标识，已经扣除了拒绝
+	ListAccessible(ctx context.Context, bizID, userID int64, resourceType, action string) (domain.RBACAccess, error)
 }
 
 type permissionService struct {
@@ -27,26 +34,151 @@ func NewPermissionService(repo repository.UserPermissionRepository) PermissionSe
 }
 
 // Check 检查用户权限
+// 资源标识支持通配，每个操作上由最具体的授权决定结果，同样具体的时候拒绝优先，规则见 reskey 包。
+// 授权时的操作为 * 的权限作用在所有操作上，操作层级蕴含的操作已经由 repository 展开。
+// 任意一个操作被拒绝就返回 false，否则只要有一个操作被允许就返回 true
 func (s *permissionService) Check(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string) (bool, error) {
 	// 拿到用户的所有权限，看一下有没有我需要的权限
-	// allow or deny
 	permissions, err := s.repo.GetAll(ctx, bizID, userID)
 	if err != nil {
 		return false, err
 	}
-	var res bool
+	now := time.Now().UnixMilli()
+	resolvers := make(map[string]*reskey.Resolver, len(actions))
 	for i := range permissions {
 		p := permissions[i]
-		pr := p.Permission.Resource
-		if pr.Key == resource.Key && pr.Type == resource.Type &&
-			slice.Contains(actions, p.Permission.Action) {
-			// 找到了 resource，找到了 action
-			// 负权限
-			if p.Effect.IsDeny() {
-				return false, nil
-			}
-			res = true
+		// 不在生效期内的权限直接忽略
+		if !p.IsValidAt(now) || !s.matches(p, resource) {
+			continue
 		}
+		s.add(resolvers, p, actions, i)
+	}
+	_, decision := s.decide(actions, resolvers)
+	return decision == reskey.Allow, nil
+}
+
+// Explain 解释权限判定的过程，直接查询数据库，不走缓存
+func (s *permissionService) Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string) (domain.RBACTrace, error) {
+	sources, err := s.repo.GetAllWithSources(ctx, bizID, userID)
+	if err != nil {
+		return domain.RBACTrace{}, err
 	}
+	var res domain.RBACTrace
+	now := time.Now().UnixMilli()
+	resolvers := make(map[string]*reskey.Resolver, len(actions))
+	for i := range sources {
+		p := sources[i].UserPermission
+		if !s.matches(p, resource) || !s.matchesAny(p, actions) {
+			continue
+		}
+		match := domain.PermissionMatch{Source: sources[i], Valid: p.IsValidAt(now)}
+		res.Matched = append(res.Matched, match)
+		if match.Valid {
+			s.add(resolvers, p, actions, len(res.Matched)-1)
+		}
+	}
+	idx, decision := s.decide(actions, resolvers)
+	if decision != reskey.Undecided {
+		res.Decision = &res.Matched[idx]
+	}
+	res.Allowed = decision == reskey.Allow
 	return res, nil
 }
+
+// ListAccessible 和 Check 的判定逻辑一致：资源标识匹配某一条允许，并且没有匹配上和它一样具体或者更具体的拒绝
+func (s *permissionService) ListAccessible(ctx context.Context, bizID, userID int64, resourceType, action string) (domain.RBACAccess, error) {
+	permissions, err := s.repo.GetAll(ctx, bizID, userID)
+	if err != nil {
+		return domain.RBACAccess{}, err
+	}
+	now := time.Now().UnixMilli()
+	var allows, denies []string
+	for i := range permissions {
+		p := permissions[i]
+		if !p.IsValidAt(now) || p.Permission.Resource.Type != resourceType || !reskey.MatchAction(p.Permission.Action, action) {
+			continue
+		}
+		if p.Effect.IsDeny() {
+			denies = append(denies, p.Permission.Resource.Key)
+		} else {
+			allows = append(allows, p.Permission.Resource.Key)
+		}
+	}
+	return domain.RBACAccess{Keys: accessibleKeys(allows, denies)}, nil
+}
+
+// accessibleKeys 给每一条允许找出可能覆盖它的拒绝，也就是和它一样具体或者更具体、并且能匹配上同一个资源标识的拒绝。
+// 被完全等价的拒绝覆盖的允许直接去掉
+func accessibleKeys(allows, denies []string) []domain.AccessibleKey {
+	res := make([]domain.AccessibleKey, 0, len(allows))
+	seen := make(map[string]struct{}, len(allows))
+	for _, allow := range allows {
+		if _, ok := seen[allow]; ok {
+			continue
+		}
+		seen[allow] = struct{}{}
+		sp := reskey.SpecificityOf(allow)
+		var except []string
+		covered := false
+		for _, deny := range denies {
+			if reskey.SpecificityOf(deny).Compare(sp) < 0 || !reskey.Overlap(allow, deny) || slices.Contains(except, deny) {
+				continue
+			}
+			if reskey.Equivalent(allow, deny) {
+				covered = true
+				break
+			}
+			except = append(except, deny)
+		}
+		if !covered {
+			res = append(res, domain.AccessibleKey{Key: allow, Except: except})
+		}
+	}
+	return res
+}
+
+func (s *permissionService) matches(p domain.UserPermission, resource domain.Resource) bool {
+	pr := p.Permission.Resource
+	return pr.Type == resource.Type && reskey.Match(pr.Key, resource.Key)
+}
+
+func (s *permissionService) matchesAny(p domain.UserPermission, actions []string) bool {
+	return slice.ContainsFunc(actions, func(action string) bool {
+		return reskey.MatchAction(p.Permission.Action, action)
+	})
+}
+
+// add 把权限加入到它覆盖的每一个操作上
+func (s *permissionService) add(resolvers map[string]*reskey.Resolver, p domain.UserPermission, actions []string, index int) {
+	for _, action := range actions {
+		if !reskey.MatchAction(p.Permission.Action, action) {
+			continue
+		}
+		r, ok := resolvers[action]
+		if !ok {
+			r = &reskey.Resolver{}
+			resolvers[action] = r
+		}
+		r.Add(p.Permission.Resource.Key, p.Effect.IsDeny(), index)
+	}
+}
+
+// decide 汇总各个操作上的判定结果，任意一个操作被拒绝就是拒绝，返回决定结果的那条授权的下标
+func (s *permissionService) decide(actions []string, resolvers map[string]*reskey.Resolver) (int, reskey.Decision) {
+	idx, res := 0, reskey.Undecided
+	for _, action := range actions {
+		r, ok := resolvers[action]
+		if !ok {
+			continue
+		}
+		switch r.Decision() {
+		case reskey.Deny:
+			return r.Index(), reskey.Deny
+		case reskey.Allow:
+			if res == reskey.Undecided {
+				idx, res = r.Index(), reskey.Allow
+			}
+		}
+	}
+	return idx, res
+}
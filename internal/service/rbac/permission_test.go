@@ -0,0 +1,97 @@
+//go:build unit
+
+package rbac
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/pkg/reskey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessibleKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		allows []string
+		denies []string
+		want   []domain.AccessibleKey
+		// keys 逐个用 Check 的规则判定，结果要和 want 一致
+		keys []string
+	}{
+		{
+			name:   "没有拒绝",
+			allows: []string{"/docs/*", "/docs/*"},
+			want:   []domain.AccessibleKey{{Key: "/docs/*"}},
+			keys:   []string{"/docs/a", "/docs/a/b"},
+		},
+		{
+			name:   "更具体的拒绝扣除出来",
+			allows: []string{"/docs/**"},
+			denies: []string{"/docs/secret/*", "/orders/*"},
+			want:   []domain.AccessibleKey{{Key: "/docs/**", Except: []string{"/docs/secret/*"}}},
+			keys:   []string{"/docs/a", "/docs/secret/a", "/docs/secret", "/orders/1"},
+		},
+		{
+			name:   "更宽泛的拒绝不影响更具体的允许",
+			allows: []string{"/docs/public/*"},
+			denies: []string{"/docs/**"},
+			want:   []domain.AccessibleKey{{Key: "/docs/public/*"}},
+			keys:   []string{"/docs/public/a", "/docs/a"},
+		},
+		{
+			name:   "同样具体的拒绝",
+			allows: []string{"/docs/*/spec.md"},
+			denies: []string{"/docs/team-a/*"},
+			want:   []domain.AccessibleKey{{Key: "/docs/*/spec.md", Except: []string{"/docs/team-a/*"}}},
+			keys:   []string{"/docs/team-a/spec.md", "/docs/team-b/spec.md"},
+		},
+		{
+			name:   "被等价的拒绝完全覆盖",
+			allows: []string{"/orders/{id}", "/orders/1"},
+			denies: []string{"/orders/*"},
+			want:   []domain.AccessibleKey{{Key: "/orders/1"}},
+			keys:   []string{"/orders/1", "/orders/2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := accessibleKeys(tc.allows, tc.denies)
+			assert.Equal(t, tc.want, got)
+			for _, key := range tc.keys {
+				var r reskey.Resolver
+				for _, allow := range tc.allows {
+					if reskey.Match(allow, key) {
+						r.Add(allow, false, 0)
+					}
+				}
+				for _, deny := range tc.denies {
+					if reskey.Match(deny, key) {
+						r.Add(deny, true, 0)
+					}
+				}
+				assert.Equal(t, r.Decision() == reskey.Allow, accessible(got, key), key)
+			}
+		})
+	}
+}
+
+func accessible(keys []domain.AccessibleKey, key string) bool {
+	for _, k := range keys {
+		if !reskey.Match(k.Key, key) {
+			continue
+		}
+		excepted := false
+		for _, except := range k.Except {
+			excepted = excepted || reskey.Match(except, key)
+		}
+		if !excepted {
+			return true
+		}
+	}
+	return false
+}
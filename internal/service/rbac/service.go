@@ -2,12 +2,16 @@ package rbac
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"gitee.com/flycash/permission-platform/internal/api/grpc/interceptor/auth"
 	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
 	"gitee.com/flycash/permission-platform/internal/pkg/jwt"
 	"gitee.com/flycash/permission-platform/internal/repository"
+	"gitee.com/flycash/permission-platform/pkg/reskey"
+	"github.com/ecodeclub/ekit/slice"
 )
 
 // Service RBAC模型的管理接口
@@ -35,6 +39,14 @@ type Service interface {
 	DeletePermission(ctx context.Context, bizID, id int64) error
 	ListPermissions(ctx context.Context, bizID int64, offset, limit int) ([]domain.Permission, error)
 
+	// 操作定义相关方法
+
+	CreateActionDefinition(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error)
+	GetActionDefinition(ctx context.Context, bizID, id int64) (domain.ActionDefinition, error)
+	UpdateActionDefinition(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error)
+	DeleteActionDefinition(ctx context.Context, bizID, id int64) error
+	ListActionDefinitions(ctx context.Context, bizID int64, offset, limit int) ([]domain.ActionDefinition, error)
+
 	// 角色相关方法
 
 	CreateRole(ctx context.Context, role domain.Role) (domain.Role, error)
@@ -43,6 +55,8 @@ type Service interface {
 	DeleteRole(ctx context.Context, bizID, id int64) error
 	ListRolesByRoleType(ctx context.Context, bizID int64, roleType string, offset, limit int) ([]domain.Role, error)
 	ListRoles(ctx context.Context, bizID int64, offset, limit int) ([]domain.Role, error)
+	// ListRoleUtilizations 分页查看角色的授予人数以及上限
+	ListRoleUtilizations(ctx context.Context, bizID int64, offset, limit int) ([]domain.RoleUtilization, error)
 
 	// 角色包含关系相关方法
 
@@ -62,6 +76,8 @@ type Service interface {
 	// 用户角色相关方法
 
 	GrantUserRole(ctx context.Context, userRole domain.UserRole) (domain.UserRole, error)
+	// UpdateUserRole 修改授予的生效时间和失效时间，和授予一样校验上限和职责分离约束
+	UpdateUserRole(ctx context.Context, userRole domain.UserRole) (domain.UserRole, error)
 	RevokeUserRole(ctx context.Context, bizID, id int64) error
 	ListUserRolesByUserID(ctx context.Context, bizID, userID int64) ([]domain.UserRole, error)
 	ListUserRoles(ctx context.Context, bizID int64) ([]domain.UserRole, error)
@@ -69,20 +85,41 @@ type Service interface {
 	// 用户权限相关方法
 
 	GrantUserPermission(ctx context.Context, userPermission domain.UserPermission) (domain.UserPermission, error)
+	// UpdateUserPermission 修改授予的生效时间、失效时间以及效果
+	UpdateUserPermission(ctx context.Context, userPermission domain.UserPermission) (domain.UserPermission, error)
 	RevokeUserPermission(ctx context.Context, bizID, id int64) error
 	ListUserPermissionsByUserID(ctx context.Context, bizID, userID int64) ([]domain.UserPermission, error)
 	ListUserPermissions(ctx context.Context, bizID int64, offset, limit int) ([]domain.UserPermission, error)
+
+	// 职责分离约束相关方法，GrantUserRole 和 CreateRoleInclusion 违反静态约束的时候返回 *domain.SoDViolationError
+
+	CreateSoDConstraint(ctx context.Context, constraint domain.SoDConstraint) (domain.SoDConstraint, error)
+	GetSoDConstraint(ctx context.Context, bizID, id int64) (domain.SoDConstraint, error)
+	DeleteSoDConstraint(ctx context.Context, bizID, id int64) error
+	ListSoDConstraints(ctx context.Context, bizID int64) ([]domain.SoDConstraint, error)
+	// ActivateRoles 激活用户的这些角色，返回激活后直接或者间接生效的角色ID。每个用户只保留最近一次激活，
+	// 用户同时拥有动态约束里的多个角色的时候，权限判定只使用其中激活了的那一个。
+	// 用户当前必须拥有这些角色，同时激活违反动态约束的时候返回 *domain.SoDViolationError，之前的激活保持不变
+	ActivateRoles(ctx context.Context, bizID, userID int64, roleIDs []int64) ([]int64, error)
+
+	// 反向查找
+
+	// ListSubjectsWithPermission 按照用户ID升序分页查找可以在资源上执行操作的用户，判定规则和 Check 一致。
+	// 一页里面被拒绝的用户会被剔除，所以返回的用户可能少于 limit，下一页游标为 0 表示没有更多
+	ListSubjectsWithPermission(ctx context.Context, bizID int64, resource domain.Resource, action string, cursor int64, limit int) ([]domain.PermissionSubject, int64, error)
 }
 
 type rbacService struct {
 	businessConfigRepo repository.BusinessConfigRepository
 	resourceRepo       repository.ResourceRepository
 	permissionRepo     repository.PermissionRepository
+	actionDefRepo      repository.ActionDefinitionRepository
 	roleRepo           repository.RoleRepository
 	roleInclusionRepo  repository.RoleInclusionRepository
 	rolePermissionRepo repository.RolePermissionRepository
 	userRoleRepo       repository.UserRoleRepository
 	userPermissionRepo repository.UserPermissionRepository
+	sodConstraintRepo  repository.SoDConstraintRepository
 	jwtToken           *jwt.Token
 }
 
@@ -91,22 +128,26 @@ func NewService(
 	businessConfigRepo repository.BusinessConfigRepository,
 	resourceRepo repository.ResourceRepository,
 	permissionRepo repository.PermissionRepository,
+	actionDefRepo repository.ActionDefinitionRepository,
 	roleRepo repository.RoleRepository,
 	roleInclusionRepo repository.RoleInclusionRepository,
 	rolePermissionRepo repository.RolePermissionRepository,
 	userRoleRepo repository.UserRoleRepository,
 	userPermissionRepo repository.UserPermissionRepository,
+	sodConstraintRepo repository.SoDConstraintRepository,
 	jwtToken *jwt.Token,
 ) Service {
 	return &rbacService{
 		businessConfigRepo: businessConfigRepo,
 		resourceRepo:       resourceRepo,
 		permissionRepo:     permissionRepo,
+		actionDefRepo:      actionDefRepo,
 		roleRepo:           roleRepo,
 		roleInclusionRepo:  roleInclusionRepo,
 		rolePermissionRepo: rolePermissionRepo,
 		userRoleRepo:       userRoleRepo,
 		userPermissionRepo: userPermissionRepo,
+		sodConstraintRepo:  sodConstraintRepo,
 		jwtToken:           jwtToken,
 	}
 }
@@ -194,9 +235,34 @@ func (s *rbacService) ListPermissions(ctx context.Context, bizID int64, offset,
 	return s.permissionRepo.FindByBizID(ctx, bizID, offset, limit)
 }
 
+// 操作定义相关方法实现
+
+func (s *rbacService) CreateActionDefinition(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error) {
+	return s.actionDefRepo.Create(ctx, def)
+}
+
+func (s *rbacService) GetActionDefinition(ctx context.Context, bizID, id int64) (domain.ActionDefinition, error) {
+	return s.actionDefRepo.FindByBizIDAndID(ctx, bizID, id)
+}
+
+func (s *rbacService) UpdateActionDefinition(ctx context.Context, def domain.ActionDefinition) (domain.ActionDefinition, error) {
+	return s.actionDefRepo.UpdateByBizIDAndID(ctx, def)
+}
+
+func (s *rbacService) DeleteActionDefinition(ctx context.Context, bizID, id int64) error {
+	return s.actionDefRepo.DeleteByBizIDAndID(ctx, bizID, id)
+}
+
+func (s *rbacService) ListActionDefinitions(ctx context.Context, bizID int64, offset, limit int) ([]domain.ActionDefinition, error) {
+	return s.actionDefRepo.FindByBizID(ctx, bizID, offset, limit)
+}
+
 // 角色相关方法实现
 
 func (s *rbacService) CreateRole(ctx context.Context, role domain.Role) (domain.Role, error) {
+	if err := checkRoleLimits(role); err != nil {
+		return domain.Role{}, err
+	}
 	return s.roleRepo.Create(ctx, role)
 }
 
@@ -205,6 +271,9 @@ func (s *rbacService) GetRole(ctx context.Context, bizID, id int64) (domain.Role
 }
 
 func (s *rbacService) UpdateRole(ctx context.Context, role domain.Role) (domain.Role, error) {
+	if err := checkRoleLimits(role); err != nil {
+		return domain.Role{}, err
+	}
 	return s.roleRepo.UpdateByBizIDAndID(ctx, role)
 }
 
@@ -216,6 +285,30 @@ func (s *rbacService) ListRoles(ctx context.Context, bizID int64, offset, limit
 	return s.roleRepo.FindByBizID(ctx, bizID, offset, limit)
 }
 
+func (s *rbacService) ListRoleUtilizations(ctx context.Context, bizID int64, offset, limit int) ([]domain.RoleUtilization, error) {
+	roles, err := s.roleRepo.FindByBizID(ctx, bizID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.userRoleRepo.CountMembersByRoleIDs(ctx, bizID, slice.Map(roles, func(_ int, src domain.Role) int64 {
+		return src.ID
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(roles, func(_ int, src domain.Role) domain.RoleUtilization {
+		return domain.RoleUtilization{Role: src, Members: members[src.ID]}
+	}), nil
+}
+
+// checkRoleLimits 上限为 0 表示不限制，不能是负数
+func checkRoleLimits(role domain.Role) error {
+	if role.MaxMembers < 0 || role.MaxRolesPerUser < 0 {
+		return fmt.Errorf("%w: 角色的授予人数上限和用户角色数上限不能小于0", errs.ErrInvalidParameter)
+	}
+	return nil
+}
+
 func (s *rbacService) ListRolesByRoleType(ctx context.Context, bizID int64, roleType string, offset, limit int) ([]domain.Role, error) {
 	return s.roleRepo.FindByBizIDAndType(ctx, bizID, roleType, offset, limit)
 }
@@ -223,7 +316,11 @@ func (s *rbacService) ListRolesByRoleType(ctx context.Context, bizID int64, role
 // 角色包含关系相关方法实现
 
 func (s *rbacService) CreateRoleInclusion(ctx context.Context, roleInclusion domain.RoleInclusion) (domain.RoleInclusion, error) {
-	return s.roleInclusionRepo.Create(ctx, roleInclusion)
+	created, err := s.roleInclusionRepo.Create(ctx, roleInclusion, func(ctx context.Context, reader repository.SoDReader) error {
+		return checkCreateRoleInclusion(ctx, reader, roleInclusion)
+	})
+	s.recordSoDViolations(ctx, roleInclusion.BizID, err, roleInclusion)
+	return created, err
 }
 
 func (s *rbacService) GetRoleInclusion(ctx context.Context, bizID, id int64) (domain.RoleInclusion, error) {
@@ -266,7 +363,19 @@ func (s *rbacService) ListRolePermissionsByRoleID(ctx context.Context, bizID, ro
 // 用户角色相关方法实现
 
 func (s *rbacService) GrantUserRole(ctx context.Context, userRole domain.UserRole) (domain.UserRole, error) {
-	return s.userRoleRepo.Create(ctx, userRole)
+	created, err := s.userRoleRepo.Create(ctx, userRole, func(ctx context.Context, reader repository.SoDReader) error {
+		return checkGrantUserRole(ctx, reader, userRole)
+	})
+	s.recordSoDViolations(ctx, userRole.BizID, err, userRole)
+	return created, err
+}
+
+func (s *rbacService) UpdateUserRole(ctx context.Context, userRole domain.UserRole) (domain.UserRole, error) {
+	updated, err := s.userRoleRepo.UpdateByBizIDAndID(ctx, userRole, func(ctx context.Context, reader repository.SoDReader) error {
+		return checkGrantUserRole(ctx, reader, userRole)
+	})
+	s.recordSoDViolations(ctx, userRole.BizID, err, userRole)
+	return updated, err
 }
 
 func (s *rbacService) RevokeUserRole(ctx context.Context, bizID, id int64) error {
@@ -287,6 +396,10 @@ func (s *rbacService) GrantUserPermission(ctx context.Context, userPermission do
 	return s.userPermissionRepo.Create(ctx, userPermission)
 }
 
+func (s *rbacService) UpdateUserPermission(ctx context.Context, userPermission domain.UserPermission) (domain.UserPermission, error) {
+	return s.userPermissionRepo.UpdateByBizIDAndID(ctx, userPermission)
+}
+
 func (s *rbacService) RevokeUserPermission(ctx context.Context, bizID, id int64) error {
 	return s.userPermissionRepo.DeleteByBizIDAndID(ctx, bizID, id)
 }
@@ -298,3 +411,43 @@ func (s *rbacService) ListUserPermissions(ctx context.Context, bizID int64, offs
 func (s *rbacService) ListUserPermissionsByUserID(ctx context.Context, bizID, userID int64) ([]domain.UserPermission, error) {
 	return s.userPermissionRepo.FindByBizIDAndUserID(ctx, bizID, userID)
 }
+
+// 反向查找相关方法实现
+
+func (s *rbacService) ListSubjectsWithPermission(ctx context.Context, bizID int64, resource domain.Resource, action string, cursor int64, limit int) ([]domain.PermissionSubject, int64, error) {
+	candidates, next, err := s.userPermissionRepo.FindSubjectSources(ctx, bizID, resource, action, cursor, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	now := time.Now().UnixMilli()
+	res := make([]domain.PermissionSubject, 0, len(candidates))
+	for i := range candidates {
+		if subject, ok := allowedSubject(candidates[i], now); ok {
+			res = append(res, subject)
+		}
+	}
+	return res, next, nil
+}
+
+// allowedSubject 按照 Check 的规则判定，最具体的授权决定结果，同样具体的时候拒绝优先。
+// 被允许的时候只保留当前生效的允许授权，作为这个用户拥有权限的路径
+func allowedSubject(candidate domain.PermissionSubject, now int64) (domain.PermissionSubject, bool) {
+	var (
+		resolver reskey.Resolver
+		allows   []domain.UserPermissionSource
+	)
+	for _, src := range candidate.Sources {
+		p := src.UserPermission
+		if !p.IsValidAt(now) {
+			continue
+		}
+		resolver.Add(p.Permission.Resource.Key, p.Effect.IsDeny(), 0)
+		if !p.Effect.IsDeny() {
+			allows = append(allows, src)
+		}
+	}
+	if resolver.Decision() != reskey.Allow {
+		return domain.PermissionSubject{}, false
+	}
+	return domain.PermissionSubject{UserID: candidate.UserID, Sources: allows}, true
+}
@@ -0,0 +1,81 @@
+//go:build unit
+
+package rbac
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowedSubject(t *testing.T) {
+	t.Parallel()
+
+	const now int64 = 1000
+	source := func(key string, effect domain.Effect, startTime, endTime int64, rolePath ...int64) domain.UserPermissionSource {
+		return domain.UserPermissionSource{
+			UserPermission: domain.UserPermission{
+				Permission: domain.Permission{Resource: domain.Resource{Type: "api", Key: key}, Action: "delete"},
+				Effect:     effect,
+				StartTime:  startTime,
+				EndTime:    endTime,
+			},
+			RolePath: rolePath,
+		}
+	}
+	tests := []struct {
+		name        string
+		sources     []domain.UserPermissionSource
+		wantAllowed bool
+		wantPaths   [][]int64
+	}{
+		{
+			name: "直接授予和角色授予都保留",
+			sources: []domain.UserPermissionSource{
+				source("/payments/*", domain.EffectAllow, 0, 2000),
+				source("/payments/**", domain.EffectAllow, 0, 2000, 1, 2),
+			},
+			wantAllowed: true,
+			wantPaths:   [][]int64{nil, {1, 2}},
+		},
+		{
+			name: "同样具体的拒绝优先",
+			sources: []domain.UserPermissionSource{
+				source("/payments/*", domain.EffectAllow, 0, 2000, 1),
+				source("/payments/*", domain.EffectDeny, 0, 2000),
+			},
+		},
+		{
+			name: "更具体的允许覆盖宽泛的拒绝",
+			sources: []domain.UserPermissionSource{
+				source("/payments/**", domain.EffectDeny, 0, 2000),
+				source("/payments/*", domain.EffectAllow, 0, 2000, 3),
+			},
+			wantAllowed: true,
+			wantPaths:   [][]int64{{3}},
+		},
+		{
+			name: "不在生效期内的授权被忽略",
+			sources: []domain.UserPermissionSource{
+				source("/payments/*", domain.EffectAllow, 1500, 2000),
+				source("/payments/*", domain.EffectDeny, 0, 500),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := allowedSubject(domain.PermissionSubject{UserID: 7, Sources: tt.sources}, now)
+			assert.Equal(t, tt.wantAllowed, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, int64(7), got.UserID)
+			assert.Equal(t, tt.wantPaths, slice.Map(got.Sources, func(_ int, src domain.UserPermissionSource) []int64 {
+				return src.RolePath
+			}))
+		})
+	}
+}
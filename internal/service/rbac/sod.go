@@ -0,0 +1,268 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"gitee.com/flycash/permission-platform/internal/repository"
+	"github.com/ecodeclub/ekit/mapx"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// sodConflictUserLimit 创建角色包含关系被拒绝时，每个约束最多列出多少个会违反约束的用户
+const sodConflictUserLimit = 10
+
+// 职责分离约束相关方法实现
+
+func (s *rbacService) CreateSoDConstraint(ctx context.Context, constraint domain.SoDConstraint) (domain.SoDConstraint, error) {
+	if constraint.Name == "" {
+		return domain.SoDConstraint{}, fmt.Errorf("%w: 约束名称不能为空", errs.ErrInvalidParameter)
+	}
+	if !constraint.Type.IsValid() {
+		return domain.SoDConstraint{}, fmt.Errorf("%w: 约束类型 %q 非法", errs.ErrInvalidParameter, constraint.Type)
+	}
+	roleIDs := sortedRoleIDs(toRoleSet(constraint.RoleIDs))
+	const minRoles = 2
+	if len(roleIDs) < minRoles {
+		return domain.SoDConstraint{}, fmt.Errorf("%w: 约束至少需要两个不同的角色", errs.ErrInvalidParameter)
+	}
+	constraint.RoleIDs = roleIDs
+	return s.sodConstraintRepo.Create(ctx, constraint)
+}
+
+func (s *rbacService) GetSoDConstraint(ctx context.Context, bizID, id int64) (domain.SoDConstraint, error) {
+	return s.sodConstraintRepo.FindByBizIDAndID(ctx, bizID, id)
+}
+
+func (s *rbacService) DeleteSoDConstraint(ctx context.Context, bizID, id int64) error {
+	return s.sodConstraintRepo.DeleteByBizIDAndID(ctx, bizID, id)
+}
+
+func (s *rbacService) ListSoDConstraints(ctx context.Context, bizID int64) ([]domain.SoDConstraint, error) {
+	return s.sodConstraintRepo.FindByBizID(ctx, bizID)
+}
+
+func (s *rbacService) ActivateRoles(ctx context.Context, bizID, userID int64, roleIDs []int64) ([]int64, error) {
+	if len(roleIDs) == 0 {
+		return nil, fmt.Errorf("%w: 激活的角色不能为空", errs.ErrInvalidParameter)
+	}
+	held, err := s.sodConstraintRepo.FindEffectiveUserRoleIDs(ctx, bizID, userID)
+	if err != nil {
+		return nil, err
+	}
+	heldDesc, err := s.sodConstraintRepo.FindDescendantRoleIDs(ctx, bizID, held)
+	if err != nil {
+		return nil, err
+	}
+	heldSet := unionOf(heldDesc)
+	for _, roleID := range roleIDs {
+		if _, ok := heldSet[roleID]; !ok {
+			return nil, fmt.Errorf("%w: 用户 %d 当前没有角色 %d", errs.ErrInvalidParameter, userID, roleID)
+		}
+	}
+
+	desc, err := s.sodConstraintRepo.FindDescendantRoleIDs(ctx, bizID, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+	activated := sortedRoleIDs(unionOf(desc))
+	constraints, err := sodConstraints(ctx, s.sodConstraintRepo, bizID, domain.SoDDynamic)
+	if err != nil {
+		return nil, err
+	}
+	violations := sodViolations(constraints, domain.SoDOperationActivateRoles, nil, activated)
+	if len(violations) > 0 {
+		for i := range violations {
+			violations[i].UserIDs = []int64{userID}
+		}
+		err = &domain.SoDViolationError{Violations: violations}
+		s.recordSoDViolations(ctx, bizID, err, map[string]any{
+			"bizId": bizID, "userId": userID, "roleIds": roleIDs,
+		})
+		return nil, err
+	}
+	if err = s.sodConstraintRepo.SaveActivation(ctx, bizID, userID, activated); err != nil {
+		return nil, err
+	}
+	return activated, nil
+}
+
+// checkGrantUserRole 用户已经直接或者间接拥有的角色，加上新角色直接或者间接包含的角色，不能违反静态约束。
+// 通过 reader 在锁住用户的授予事务里读取，同一个用户的授予以及角色包含关系的创建都已经提交，读到的是最新的数据
+func checkGrantUserRole(ctx context.Context, reader repository.SoDReader, userRole domain.UserRole) error {
+	bizID := userRole.BizID
+	constraints, err := sodConstraints(ctx, reader, bizID, domain.SoDStatic)
+	if err != nil || len(constraints) == 0 {
+		return err
+	}
+	held, err := reader.FindUnexpiredUserRoleIDs(ctx, bizID, userRole.UserID)
+	if err != nil {
+		return err
+	}
+	desc, err := reader.FindDescendantRoleIDs(ctx, bizID, append(held, userRole.Role.ID))
+	if err != nil {
+		return err
+	}
+	heldSet := make(map[int64]struct{})
+	for _, roleID := range held {
+		for _, id := range desc[roleID] {
+			heldSet[id] = struct{}{}
+		}
+	}
+	violations := sodViolations(constraints, domain.SoDOperationGrantUserRole,
+		sortedRoleIDs(heldSet), desc[userRole.Role.ID])
+	if len(violations) == 0 {
+		return nil
+	}
+	for i := range violations {
+		violations[i].UserIDs = []int64{userRole.UserID}
+	}
+	return &domain.SoDViolationError{Violations: violations}
+}
+
+// checkCreateRoleInclusion 包含者及其祖先角色获得了被包含角色直接或者间接包含的角色，
+// 角色本身不能因此违反静态约束，已经拥有这些角色的用户也不能。通过 reader 在锁住业务授予的事务里读取
+func checkCreateRoleInclusion(ctx context.Context, reader repository.SoDReader, roleInclusion domain.RoleInclusion) error {
+	bizID := roleInclusion.BizID
+	constraints, err := sodConstraints(ctx, reader, bizID, domain.SoDStatic)
+	if err != nil || len(constraints) == 0 {
+		return err
+	}
+	including, included := roleInclusion.IncludingRole.ID, roleInclusion.IncludedRole.ID
+	ancestors, err := reader.FindAncestorRoleIDs(ctx, bizID, including)
+	if err != nil {
+		return err
+	}
+	gainers := append([]int64{including}, ancestors...)
+	desc, err := reader.FindDescendantRoleIDs(ctx, bizID, append(gainers, included))
+	if err != nil {
+		return err
+	}
+
+	var violations []domain.SoDViolation
+	violated := make(map[int64]struct{})
+	for _, roleID := range gainers {
+		for _, v := range sodViolations(constraints, domain.SoDOperationCreateRoleInclusion, desc[roleID], desc[included]) {
+			if _, ok := violated[v.Constraint.ID]; !ok {
+				violated[v.Constraint.ID] = struct{}{}
+				violations = append(violations, v)
+			}
+		}
+	}
+
+	gained := toRoleSet(desc[included])
+	for _, c := range constraints {
+		if _, ok := violated[c.ID]; ok {
+			continue
+		}
+		var hit, others []int64
+		for _, roleID := range c.RoleIDs {
+			if _, ok := gained[roleID]; ok {
+				hit = append(hit, roleID)
+			} else {
+				others = append(others, roleID)
+			}
+		}
+		if len(hit) == 0 || len(others) == 0 {
+			continue
+		}
+		userIDs, err := reader.FindConflictingUserIDs(ctx, bizID, []int64{including}, others, sodConflictUserLimit)
+		if err != nil {
+			return err
+		}
+		if len(userIDs) > 0 {
+			violations = append(violations, domain.SoDViolation{
+				Constraint: c,
+				Operation:  domain.SoDOperationCreateRoleInclusion,
+				UserIDs:    userIDs,
+				RoleIDs:    c.RoleIDs,
+			})
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return &domain.SoDViolationError{Violations: violations}
+}
+
+func sodConstraints(ctx context.Context, reader repository.SoDReader, bizID int64, typ domain.SoDType) ([]domain.SoDConstraint, error) {
+	constraints, err := reader.FindByBizID(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(constraints, func(c domain.SoDConstraint) bool {
+		return c.Type != typ
+	}), nil
+}
+
+// recordSoDViolations err 是 SoDViolationError 的时候记录被拒绝的操作，记录失败不影响拒绝。
+// 授予事务里的校验不记录，事务回滚、释放了锁之后再由这里记录
+func (s *rbacService) recordSoDViolations(ctx context.Context, bizID int64, err error, request any) {
+	var sodErr *domain.SoDViolationError
+	if !errors.As(err, &sodErr) {
+		return
+	}
+	if err1 := s.sodConstraintRepo.RecordViolations(ctx, bizID, sodErr.Violations, request); err1 != nil {
+		elog.DefaultLogger.Error("记录违反职责分离约束的操作失败",
+			elog.Int64("bizId", bizID),
+			elog.Any("violations", sodErr.Violations),
+			elog.FieldErr(err1),
+		)
+	}
+}
+
+// sodViolations 拥有 held 的主体再获得 gained 之后违反的约束。
+// 约束里至少有一个角色是新获得的、并且总共拥有其中两个及以上角色的时候才算违反，
+// 这样已经存在的违规不会影响无关的操作
+func sodViolations(constraints []domain.SoDConstraint, op domain.SoDOperation, held, gained []int64) []domain.SoDViolation {
+	heldSet, gainedSet := toRoleSet(held), toRoleSet(gained)
+	var violations []domain.SoDViolation
+	for _, c := range constraints {
+		var owned []int64
+		newly := false
+		for _, roleID := range c.RoleIDs {
+			_, inHeld := heldSet[roleID]
+			_, inGained := gainedSet[roleID]
+			if inHeld || inGained {
+				owned = append(owned, roleID)
+			}
+			newly = newly || (inGained && !inHeld)
+		}
+		if newly && len(owned) > 1 {
+			violations = append(violations, domain.SoDViolation{
+				Constraint: c,
+				Operation:  op,
+				RoleIDs:    owned,
+			})
+		}
+	}
+	return violations
+}
+
+func toRoleSet(roleIDs []int64) map[int64]struct{} {
+	set := make(map[int64]struct{}, len(roleIDs))
+	for _, roleID := range roleIDs {
+		set[roleID] = struct{}{}
+	}
+	return set
+}
+
+func unionOf(desc map[int64][]int64) map[int64]struct{} {
+	set := make(map[int64]struct{})
+	for _, roleIDs := range desc {
+		for _, roleID := range roleIDs {
+			set[roleID] = struct{}{}
+		}
+	}
+	return set
+}
+
+func sortedRoleIDs(set map[int64]struct{}) []int64 {
+	roleIDs := mapx.Keys(set)
+	slices.Sort(roleIDs)
+	return roleIDs
+}
@@ -0,0 +1,91 @@
+//go:build unit
+
+package rbac
+
+import (
+	"testing"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSoDViolations(t *testing.T) {
+	t.Parallel()
+
+	payment := domain.SoDConstraint{ID: 1, Name: "payment", Type: domain.SoDStatic, RoleIDs: []int64{1, 2}}
+	audit := domain.SoDConstraint{ID: 2, Name: "audit", Type: domain.SoDStatic, RoleIDs: []int64{3, 4, 5}}
+	constraints := []domain.SoDConstraint{payment, audit}
+
+	tests := []struct {
+		name   string
+		held   []int64
+		gained []int64
+		// wantRoleIDs 约束ID -> 冲突的角色
+		wantRoleIDs map[int64][]int64
+	}{
+		{
+			name:        "没有拥有约束里的角色",
+			held:        []int64{10},
+			gained:      []int64{1, 11},
+			wantRoleIDs: map[int64][]int64{},
+		},
+		{
+			name:        "获得互斥的角色",
+			held:        []int64{1},
+			gained:      []int64{2},
+			wantRoleIDs: map[int64][]int64{1: {1, 2}},
+		},
+		{
+			name:        "获得的角色本身就包含互斥的角色",
+			gained:      []int64{3, 5, 10},
+			wantRoleIDs: map[int64][]int64{2: {3, 5}},
+		},
+		{
+			name:        "同时违反多个约束",
+			held:        []int64{1, 4},
+			gained:      []int64{2, 5},
+			wantRoleIDs: map[int64][]int64{1: {1, 2}, 2: {4, 5}},
+		},
+		{
+			name:        "重复获得已经拥有的角色",
+			held:        []int64{1, 2},
+			gained:      []int64{2},
+			wantRoleIDs: map[int64][]int64{},
+		},
+		{
+			name:        "已经存在的违规不影响无关的角色",
+			held:        []int64{1, 2},
+			gained:      []int64{3},
+			wantRoleIDs: map[int64][]int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			violations := sodViolations(constraints, domain.SoDOperationGrantUserRole, tt.held, tt.gained)
+			got := make(map[int64][]int64, len(violations))
+			for _, v := range violations {
+				assert.Equal(t, domain.SoDOperationGrantUserRole, v.Operation)
+				got[v.Constraint.ID] = v.RoleIDs
+			}
+			assert.Equal(t, tt.wantRoleIDs, got)
+		})
+	}
+}
+
+func TestSoDViolationError(t *testing.T) {
+	t.Parallel()
+
+	err := error(&domain.SoDViolationError{Violations: []domain.SoDViolation{
+		{Constraint: domain.SoDConstraint{ID: 1, Name: "payment"}, UserIDs: []int64{7}, RoleIDs: []int64{1, 2}},
+	}})
+	assert.ErrorIs(t, err, errs.ErrSoDViolation)
+	var violationErr *domain.SoDViolationError
+	assert.ErrorAs(t, err, &violationErr)
+	assert.Equal(t, []int64{1}, slice.Map(violationErr.Violations, func(_ int, src domain.SoDViolation) int64 {
+		return src.Constraint.ID
+	}))
+}
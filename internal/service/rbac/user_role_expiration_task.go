@@ -0,0 +1,104 @@
+package rbac
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/repository"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+const (
+	defaultExpirationCheckInterval = time.Minute
+	defaultExpirationBatchSize     = 100
+)
+
+// UserRoleExpirationTask 定时撤销已经失效的用户角色，并重新加载受影响用户的权限缓存
+type UserRoleExpirationTask struct {
+	repo          *repository.UserRoleDefaultRepository
+	cacheReloader repository.UserPermissionCacheReloader
+	interval      time.Duration
+	batchSize     int
+	logger        *elog.Component
+}
+
+func NewUserRoleExpirationTask(
+	repo *repository.UserRoleDefaultRepository,
+	cacheReloader repository.UserPermissionCacheReloader,
+	interval time.Duration,
+	batchSize int,
+) *UserRoleExpirationTask {
+	if interval <= 0 {
+		interval = defaultExpirationCheckInterval
+	}
+	if batchSize <= 0 {
+		batchSize = defaultExpirationBatchSize
+	}
+	return &UserRoleExpirationTask{
+		repo:          repo,
+		cacheReloader: cacheReloader,
+		interval:      interval,
+		batchSize:     batchSize,
+		logger:        elog.DefaultLogger.With(elog.FieldName("UserRoleExpirationTask")),
+	}
+}
+
+func (t *UserRoleExpirationTask) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := t.Run(ctx); err != nil {
+					t.logger.Error("撤销已失效的用户角色失败", elog.FieldErr(err))
+				}
+			}
+		}
+	}()
+}
+
+// Run 分批撤销所有已经失效的用户角色
+func (t *UserRoleExpirationTask) Run(ctx context.Context) error {
+	now := time.Now().UnixMilli()
+	for {
+		expired, err := t.repo.FindExpired(ctx, now, t.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(expired) == 0 {
+			return nil
+		}
+		err = t.repo.DeleteExpired(ctx, expired, now)
+		if err != nil {
+			return err
+		}
+		users := t.affectedUsers(expired)
+		if err1 := t.cacheReloader.Reload(ctx, users); err1 != nil {
+			t.logger.Warn("撤销已失效的用户角色后，重新加载受影响用户的缓存失败",
+				elog.FieldErr(err1),
+				elog.Any("users", users),
+			)
+		}
+		if len(expired) < t.batchSize {
+			return nil
+		}
+	}
+}
+
+func (t *UserRoleExpirationTask) affectedUsers(userRoles []domain.UserRole) []domain.User {
+	seen := make(map[domain.User]struct{}, len(userRoles))
+	users := make([]domain.User, 0, len(userRoles))
+	for i := range userRoles {
+		u := domain.User{ID: userRoles[i].UserID, BizID: userRoles[i].BizID}
+		if _, ok := seen[u]; ok {
+			continue
+		}
+		seen[u] = struct{}{}
+		users = append(users, u)
+	}
+	return users
+}
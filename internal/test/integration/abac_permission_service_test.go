@@ -8,12 +8,14 @@ import (
 	"time"
 
 	"github.com/ecodeclub/ecache/memory/lru"
+	"github.com/ecodeclub/ekit/slice"
 
 	"gitee.com/flycash/permission-platform/internal/repository/dao"
 	"github.com/ego-component/egorm"
 	"github.com/stretchr/testify/assert"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
 	"gitee.com/flycash/permission-platform/internal/repository"
 	abacsvc "gitee.com/flycash/permission-platform/internal/service/abac"
 	"gitee.com/flycash/permission-platform/internal/test/integration/ioc/abac"
@@ -25,6 +27,7 @@ import (
 type ABACPermissionSuite struct {
 	suite.Suite
 	permissionSvc  abacsvc.PermissionSvc
+	policySvc      abacsvc.PolicySvc
 	valRepo        repository.AttributeValueRepository
 	definitionRepo repository.AttributeDefinitionRepository
 	permissionRepo repository.PermissionRepository
@@ -39,6 +42,7 @@ func (s *ABACPermissionSuite) SetupSuite() {
 	svc := abac.Init(db, redisClient, lru.NewCache(10000))
 	s.definitionRepo = svc.DefinitionRepo
 	s.permissionSvc = svc.PermissionSvc
+	s.policySvc = svc.PolicySvc
 	s.valRepo = svc.ValRepo
 	s.policyRepo = svc.PolicyRepo
 	s.permissionRepo = svc.PermissionRepo
@@ -224,6 +228,9 @@ func (s *ABACPermissionSuite) TestPermission() {
 				// 关联权限和策略
 				err = s.policyRepo.SavePermissionPolicy(t.Context(), bizId, id, per.ID, domain.EffectAllow)
 				require.NoError(t, err)
+				// 发布之后才会生效
+				_, err = s.policyRepo.Publish(t.Context(), bizId, id)
+				require.NoError(t, err)
 			},
 			wantVal: true,
 		},
@@ -240,6 +247,156 @@ func (s *ABACPermissionSuite) TestPermission() {
 	}
 }
 
+func (s *ABACPermissionSuite) TestPolicyTestCases() {
+	t := s.T()
+	bizId := int64(10000)
+	defer s.clearBizVal(bizId)
+	s.setupDefinitionV1()
+
+	per, err := s.permissionRepo.Create(t.Context(), domain.Permission{
+		BizID:    bizId,
+		Name:     "代码仓库权限",
+		Resource: domain.Resource{Type: "code", Key: "order.com"},
+		Action:   "read",
+		Metadata: "[1]",
+	})
+	require.NoError(t, err)
+	policyID, err := s.policyRepo.Save(t.Context(), domain.Policy{
+		BizID:  bizId,
+		Name:   "只有程序员能读代码",
+		Status: domain.PolicyStatusActive,
+	})
+	require.NoError(t, err)
+	_, err = s.policyRepo.SaveRule(t.Context(), bizId, policyID, domain.PolicyRule{
+		AttrDef:  domain.AttributeDefinition{ID: 10006},
+		Operator: domain.Equals,
+		Value:    "程序员",
+	})
+	require.NoError(t, err)
+	err = s.policyRepo.SavePermissionPolicy(t.Context(), bizId, policyID, per.ID, domain.EffectAllow)
+	require.NoError(t, err)
+
+	// 用例只使用给定的属性，不需要预存属性值，资源也不需要存在
+	saveCase := func(name, role string, expected domain.Effect) int64 {
+		id, err := s.policySvc.SaveTestCase(t.Context(), domain.PolicyTestCase{
+			BizID:    bizId,
+			PolicyID: policyID,
+			Name:     name,
+			Resource: domain.Resource{BizID: bizId, Type: "code", Key: "order.com"},
+			Action:   "read",
+			Attrs:    domain.Attributes{Subject: domain.SubAttrs{"role": role}},
+			Expected: expected,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	saveCase("程序员可以读", "程序员", domain.EffectAllow)
+	saveCase("产品不能读", "产品", domain.EffectDeny)
+	wrongID := saveCase("经理可以读", "经理", domain.EffectAllow)
+
+	_, err = s.policySvc.SaveTestCase(t.Context(), domain.PolicyTestCase{
+		BizID: bizId, PolicyID: policyID, Name: "没有预期结果",
+		Resource: domain.Resource{Type: "code", Key: "order.com"}, Action: "read",
+	})
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+
+	// 不能关联或者修改其它业务的策略和测试用例
+	const otherBizID = 99999
+	_, err = s.policySvc.SaveTestCase(t.Context(), domain.PolicyTestCase{
+		BizID: otherBizID, PolicyID: policyID, Name: "其它业务的策略",
+		Resource: domain.Resource{Type: "code", Key: "order.com"}, Action: "read", Expected: domain.EffectAllow,
+	})
+	assert.ErrorIs(t, err, errs.ErrPolicyNotFound)
+	otherPolicyID, err := s.policyRepo.Save(t.Context(), domain.Policy{
+		BizID:  otherBizID,
+		Name:   fmt.Sprintf("其它业务的策略-%d", time.Now().UnixNano()),
+		Status: domain.PolicyStatusActive,
+	})
+	require.NoError(t, err)
+	_, err = s.policySvc.SaveTestCase(t.Context(), domain.PolicyTestCase{
+		ID: wrongID, BizID: otherBizID, PolicyID: otherPolicyID, Name: "修改其它业务的测试用例",
+		Resource: domain.Resource{Type: "code", Key: "order.com"}, Action: "read", Expected: domain.EffectDeny,
+	})
+	assert.ErrorIs(t, err, errs.ErrPolicyTestCaseNotFound)
+
+	// 有用例没有通过，不允许发布
+	_, err = s.policySvc.Publish(t.Context(), bizId, policyID)
+	assert.ErrorIs(t, err, errs.ErrPolicyTestFailed)
+	versions, err := s.policySvc.FindVersions(t.Context(), bizId, policyID)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+
+	require.NoError(t, s.policySvc.DeleteTestCase(t.Context(), bizId, wrongID))
+	version, err := s.policySvc.Publish(t.Context(), bizId, policyID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), version)
+
+	results, err := s.policySvc.RunTests(t.Context(), bizId, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.True(t, result.Passed, result.Case.Name)
+		assert.Equal(t, result.Case.Expected, result.Actual)
+	}
+
+	// 测试之后草稿又被修改过，不能发布测试过的旧快照
+	draft, err := s.policyRepo.FindDraft(t.Context(), bizId, policyID)
+	require.NoError(t, err)
+	_, err = s.policyRepo.SaveRule(t.Context(), bizId, policyID, domain.PolicyRule{
+		AttrDef:  domain.AttributeDefinition{ID: 10006},
+		Operator: domain.NotEquals,
+		Value:    "产品",
+	})
+	require.NoError(t, err)
+	_, err = s.policyRepo.PublishDraft(t.Context(), draft)
+	assert.ErrorIs(t, err, errs.ErrPolicyDraftChanged)
+	versions, err = s.policySvc.FindVersions(t.Context(), bizId, policyID)
+	require.NoError(t, err)
+	assert.Len(t, versions, 1)
+
+	// 回滚也要通过测试用例
+	saveCase("经理可以读", "经理", domain.EffectAllow)
+	_, err = s.policySvc.Rollback(t.Context(), bizId, policyID, 1)
+	assert.ErrorIs(t, err, errs.ErrPolicyTestFailed)
+	_, err = s.policySvc.Rollback(t.Context(), bizId, policyID, 99)
+	assert.ErrorIs(t, err, errs.ErrPolicyVersionNotFound)
+}
+
+func (s *ABACPermissionSuite) TestAnalyze() {
+	t := s.T()
+	bizId := int64(10000)
+	defer s.clearBizVal(bizId)
+	s.setupDefinitionV1()
+
+	policyID, issues, err := s.policySvc.Save(t.Context(), domain.Policy{
+		BizID:  bizId,
+		Name:   "矛盾的策略",
+		Status: domain.PolicyStatusActive,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+	rules := []domain.PolicyRule{
+		{AttrDef: domain.AttributeDefinition{ID: 10006}, Operator: domain.Equals, Value: "程序员"},
+		{AttrDef: domain.AttributeDefinition{ID: 10006}, Operator: domain.Equals, Value: "经理"},
+		// 属性已经被删除了
+		{AttrDef: domain.AttributeDefinition{ID: 99999}, Operator: domain.Equals, Value: "user.com"},
+	}
+	for idx := range rules {
+		_, err = s.policyRepo.SaveRule(t.Context(), bizId, policyID, rules[idx])
+		require.NoError(t, err)
+	}
+
+	issues, err = s.policySvc.Analyze(t.Context(), bizId, policyID)
+	require.NoError(t, err)
+	kinds := slice.Map(issues, func(_ int, src domain.PolicyIssue) domain.PolicyIssueKind {
+		return src.Kind
+	})
+	assert.ElementsMatch(t, []domain.PolicyIssueKind{
+		domain.PolicyIssueUndefinedAttribute,
+		domain.PolicyIssueUnsatisfiable,
+	}, kinds)
+}
+
 func (s *ABACPermissionSuite) setupDefinitionV1() {
 	// 初始化属性定义
 	subjectAttrDef1 := domain.AttributeDefinition{
@@ -287,6 +444,8 @@ func (s *ABACPermissionSuite) clearBizVal(bizId int64) {
 	t.Helper()
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.Policy{})
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.PolicyRule{})
+	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.PolicyVersion{})
+	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.PolicyTestCase{})
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.SubjectAttributeValue{})
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.ResourceAttributeValue{})
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.EnvironmentAttributeValue{})
@@ -0,0 +1,94 @@
+//go:build e2e
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/repository/dao"
+	testioc "gitee.com/flycash/permission-platform/internal/test/ioc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInitTables_BackfillPolicyVersions 从策略版本上线前的表结构和数据升级，已有的策略要继续生效
+func TestInitTables_BackfillPolicyVersions(t *testing.T) {
+	db := testioc.InitDBAndTables()
+	ctx := context.Background()
+	const bizID int64 = 16001
+
+	// 回到策略版本上线之前：策略表没有 published_version，也没有执行过迁移。
+	// 版本表已经由 SQL 脚本建好，迁移不能依赖版本表是否存在
+	require.NoError(t, db.Migrator().DropTable(&dao.PolicyVersion{}, &dao.DataMigration{}))
+	require.NoError(t, db.Migrator().DropColumn(&dao.Policy{}, "published_version"))
+	require.NoError(t, db.AutoMigrate(&dao.PolicyVersion{}))
+	now := time.Now().UnixMilli()
+	require.NoError(t, db.Table("policies").Create(map[string]any{
+		"biz_id": bizID, "name": "上线前的拒绝策略", "status": "active", "execute_type": "logic",
+		"ctime": now, "utime": now,
+	}).Error)
+	var policyID int64
+	require.NoError(t, db.Table("policies").Select("id").
+		Where("biz_id = ? AND name = ?", bizID, "上线前的拒绝策略").Scan(&policyID).Error)
+	require.NoError(t, db.Create(&dao.PolicyRule{
+		BizID: bizID, PolicyID: policyID, AttrDefID: 1, Value: "1", Operator: "=", Ctime: now, Utime: now,
+	}).Error)
+	require.NoError(t, db.Create(&dao.PermissionPolicy{
+		BizID: bizID, PermissionID: 1, PolicyID: policyID, Effect: "deny", Ctime: now, Utime: now,
+	}).Error)
+
+	time.Sleep(time.Millisecond)
+	require.NoError(t, dao.InitTables(db))
+
+	// 升级之后新建的草稿，再次启动也不会被发布
+	policyDAO := dao.NewPolicyDAO(db)
+	_, err := policyDAO.SavePolicy(ctx, dao.Policy{BizID: bizID, Name: "上线后的草稿", Status: "active", ExecuteType: "logic"})
+	require.NoError(t, err)
+	require.NoError(t, dao.InitTables(db))
+
+	versions, err := policyDAO.FindPublishedPolicyVersions(ctx, bizID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, policyID, versions[0].PolicyID)
+	assert.Equal(t, int64(1), versions[0].Version)
+	var snapshot dao.PolicySnapshot
+	require.NoError(t, json.Unmarshal([]byte(versions[0].Snapshot), &snapshot))
+	assert.Len(t, snapshot.Rules, 1)
+	require.Len(t, snapshot.PermissionPolicies, 1)
+	assert.Equal(t, "deny", snapshot.PermissionPolicies[0].Effect)
+}
+
+// TestInitTables_ResumePolicyVersionBackfill 上一次启动迁移到一半失败，重启之后继续补发布剩下的策略
+func TestInitTables_ResumePolicyVersionBackfill(t *testing.T) {
+	db := testioc.InitDBAndTables()
+	ctx := context.Background()
+	const bizID int64 = 16002
+
+	now := time.Now().UnixMilli()
+	legacy := []string{"已经补发布的策略", "还没有补发布的策略"}
+	ids := make([]int64, 0, len(legacy))
+	for _, name := range legacy {
+		policy := dao.Policy{BizID: bizID, Name: name, Status: "active", ExecuteType: "logic", Ctime: now, Utime: now}
+		require.NoError(t, db.Create(&policy).Error)
+		ids = append(ids, policy.ID)
+	}
+	// 第一个策略已经补发布，迁移还没有完成
+	require.NoError(t, db.Create(&dao.PolicyVersion{BizID: bizID, PolicyID: ids[0], Version: 1, Snapshot: "{}", Ctime: now}).Error)
+	require.NoError(t, db.Model(&dao.Policy{}).Where("id = ?", ids[0]).Update("published_version", 1).Error)
+	require.NoError(t, db.Where("name = ?", "backfill_policy_versions").Delete(&dao.DataMigration{}).Error)
+	time.Sleep(time.Millisecond)
+
+	require.NoError(t, dao.InitTables(db))
+
+	for _, id := range ids {
+		versions, err := dao.NewPolicyDAO(db).FindPolicyVersions(ctx, bizID, id)
+		require.NoError(t, err)
+		assert.Len(t, versions, 1)
+	}
+	var migration dao.DataMigration
+	require.NoError(t, db.Where("name = ?", "backfill_policy_versions").First(&migration).Error)
+	assert.True(t, migration.Done)
+}
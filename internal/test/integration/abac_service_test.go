@@ -18,10 +18,12 @@ import (
 	"gorm.io/gorm"
 
 	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
 	"gitee.com/flycash/permission-platform/internal/repository"
 	"gitee.com/flycash/permission-platform/internal/repository/dao"
 	"gitee.com/flycash/permission-platform/internal/test/integration/ioc/abac"
 	testioc "gitee.com/flycash/permission-platform/internal/test/ioc"
+	"github.com/ecodeclub/ekit/slice"
 	"github.com/ego-component/egorm"
 	"github.com/stretchr/testify/suite"
 )
@@ -64,6 +66,7 @@ func (s *AbacServiceSuite) clearBizVal(bizId int64) {
 	t.Helper()
 	s.db.WithContext(t.Context()).Where("biz_id = ?", bizId).Delete(&dao.Policy{})
 	s.db.WithContext(t.Context()).Where("biz_id = ?", bizId).Delete(&dao.PolicyRule{})
+	s.db.WithContext(t.Context()).Where("biz_id = ?", bizId).Delete(&dao.PolicyVersion{})
 	s.db.WithContext(t.Context()).Where("biz_id = ?", bizId).Delete(&dao.SubjectAttributeValue{})
 	s.db.WithContext(t.Context()).Where("biz_id = ?", bizId).Delete(&dao.ResourceAttributeValue{})
 	s.db.WithContext(t.Context()).Where("biz_id = ?", bizId).Delete(&dao.EnvironmentAttributeValue{})
@@ -1226,6 +1229,10 @@ func (s *AbacServiceSuite) TestPolicy_Save() {
 				s.Equal("这是一个测试策略", savedPolicy.Description)
 				s.Equal("priority", string(savedPolicy.ExecuteType))
 
+				// 草稿发布之后才会更新缓存
+				_, err = s.policyRepo.Publish(ctx, bizID, id)
+				s.NoError(err)
+
 				// 验证本地缓存
 				localCacheKey := fmt.Sprintf("abac:policy:%d", bizID)
 				localVal := s.lruCache.Get(ctx, localCacheKey)
@@ -1264,6 +1271,10 @@ func (s *AbacServiceSuite) TestPolicy_Save() {
 				s.Equal("测试策略1", savedPolicy.Name)             // 名称保持不变
 				s.Equal("这是更新后的策略描述", savedPolicy.Description) // 描述已更新
 
+				// 草稿发布之后才会更新缓存
+				_, err = s.policyRepo.Publish(ctx, bizID, id)
+				s.NoError(err)
+
 				// 验证本地缓存已更新
 				localCacheKey := fmt.Sprintf("abac:policy:%d", bizID)
 				localVal := s.lruCache.Get(ctx, localCacheKey)
@@ -1409,6 +1420,10 @@ func (s *AbacServiceSuite) TestPolicy_SavePermissionPolicy() {
 	require.NoError(s.T(), err)
 	s.Equal(string(domain.EffectAllow), res.Effect)
 
+	// 草稿发布之后才会更新缓存
+	_, err = s.policyRepo.Publish(ctx, bizID, id)
+	s.NoError(err)
+
 	// 验证本地缓存已更新
 	localCacheKey := fmt.Sprintf("abac:policy:%d", bizID)
 	localVal := s.lruCache.Get(ctx, localCacheKey)
@@ -1569,6 +1584,10 @@ func (s *AbacServiceSuite) TestPolicy_SaveRule() {
 	s.Equal(domain.RuleOperator(">"), foundPolicy.Rules[0].Operator)
 	s.Equal("18", foundPolicy.Rules[0].Value)
 
+	// 草稿发布之后才会更新缓存
+	_, err = s.policyRepo.Publish(ctx, bizID, policyID)
+	s.NoError(err)
+
 	// 验证本地缓存已更新
 	localCacheKey := fmt.Sprintf("abac:policy:%d", bizID)
 	localVal := s.lruCache.Get(ctx, localCacheKey)
@@ -1630,6 +1649,10 @@ func (s *AbacServiceSuite) TestPolicy_DeleteRule() {
 	s.NoError(err)
 	s.Empty(foundPolicy.Rules)
 
+	// 草稿发布之后才会更新缓存
+	_, err = s.policyRepo.Publish(ctx, bizID, policyID)
+	s.NoError(err)
+
 	// 验证本地缓存已更新
 	localCacheKey := fmt.Sprintf("abac:policy:%d", bizID)
 	localVal := s.lruCache.Get(ctx, localCacheKey)
@@ -1647,6 +1670,80 @@ func (s *AbacServiceSuite) TestPolicy_DeleteRule() {
 	s.NoError(err) // 删除不存在的记录应该不返回错误
 }
 
+func (s *AbacServiceSuite) TestPolicy_PublishAndRollback() {
+	ctx := s.T().Context()
+	bizID := int64(28)
+	defer s.clearBizVal(bizID)
+
+	policyID, err := s.policyRepo.Save(ctx, domain.Policy{
+		BizID:       bizID,
+		Name:        "版本测试策略",
+		Description: "这是一个测试策略",
+	})
+	s.NoError(err)
+	rule := domain.PolicyRule{
+		AttrDef:  domain.AttributeDefinition{ID: 1},
+		Operator: ">",
+		Value:    "18",
+	}
+	rule.ID, err = s.policyRepo.SaveRule(ctx, bizID, policyID, rule)
+	s.NoError(err)
+	permissionID := int64(1004)
+	err = s.policyRepo.SavePermissionPolicy(ctx, bizID, policyID, permissionID, domain.EffectAllow)
+	s.NoError(err)
+
+	// 草稿没有发布之前不会生效
+	policies, err := s.policyRepo.FindBizPolicies(ctx, bizID)
+	s.NoError(err)
+	s.Empty(policies)
+
+	publishedValue := func() string {
+		policies, err := s.policyRepo.FindPoliciesByPermissionIDs(ctx, bizID, []int64{permissionID})
+		s.NoError(err)
+		s.Len(policies, 1)
+		s.Len(policies[0].Rules, 1)
+		return policies[0].Rules[0].Value
+	}
+
+	version, err := s.policyRepo.Publish(ctx, bizID, policyID)
+	s.NoError(err)
+	s.Equal(int64(1), version)
+	s.Equal("18", publishedValue())
+
+	// 修改草稿，生效的还是已经发布的版本
+	rule.Value = "20"
+	_, err = s.policyRepo.SaveRule(ctx, bizID, policyID, rule)
+	s.NoError(err)
+	s.Equal("18", publishedValue())
+
+	version, err = s.policyRepo.Publish(ctx, bizID, policyID)
+	s.NoError(err)
+	s.Equal(int64(2), version)
+	s.Equal("20", publishedValue())
+
+	// 回滚会用版本 1 的内容发布版本 3，草稿不受影响
+	version, err = s.policyRepo.Rollback(ctx, bizID, policyID, 1)
+	s.NoError(err)
+	s.Equal(int64(3), version)
+	s.Equal("18", publishedValue())
+
+	draft, err := s.policyRepo.First(ctx, bizID, policyID)
+	s.NoError(err)
+	s.Equal(int64(3), draft.PublishedVersion)
+	s.Equal("20", draft.Rules[0].Value)
+
+	versions, err := s.policyRepo.FindVersions(ctx, bizID, policyID)
+	s.NoError(err)
+	s.Equal([]int64{3, 2, 1}, slice.Map(versions, func(_ int, src domain.PolicyVersion) int64 {
+		return src.Version
+	}))
+	s.Equal("18", versions[0].Policy.Rules[0].Value)
+
+	// 回滚到不存在的版本
+	_, err = s.policyRepo.Rollback(ctx, bizID, policyID, 99)
+	s.ErrorIs(err, errs.ErrPolicyVersionNotFound)
+}
+
 func (s *AbacServiceSuite) TestAttributeSubjectValue_Cache() {
 	ctx := context.Background()
 	bizID := int64(25)
@@ -299,6 +299,9 @@ func (s *HybridPermissionSuite) TestCheck() {
 				// 关联权限和策略
 				err = s.policyRepo.SavePermissionPolicy(t.Context(), bizId, id, per.ID, domain.EffectAllow)
 				require.NoError(t, err)
+				// 发布之后才会生效
+				_, err = s.policyRepo.Publish(t.Context(), bizId, id)
+				require.NoError(t, err)
 			},
 			permission: domain.Permission{
 				Resource: domain.Resource{
@@ -333,6 +336,7 @@ func (s *HybridPermissionSuite) clearBizVal(bizId int64) {
 	t.Helper()
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.Policy{})
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.PolicyRule{})
+	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.PolicyVersion{})
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.SubjectAttributeValue{})
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.ResourceAttributeValue{})
 	s.db.WithContext(s.T().Context()).Where("biz_id = ?", bizId).Delete(&dao.EnvironmentAttributeValue{})
@@ -17,6 +17,7 @@ import (
 
 type Service struct {
 	PermissionSvc  abacsvc.PermissionSvc
+	PolicySvc      abacsvc.PolicySvc
 	ValRepo        repository.AttributeValueRepository
 	DefinitionRepo repository.AttributeDefinitionRepository
 	PermissionRepo repository.PermissionRepository
@@ -33,14 +34,19 @@ func Init(db *egorm.Component, redisClient *redis.Client, lruCache *lru.Cache) *
 		dao.NewAttributeDefinitionDAO,
 		dao.NewResourceDAO,
 		dao.NewPermissionDAO,
+		dao.NewBusinessConfigDAO,
 		repository.NewPermissionRepository,
+		repository.NewBusinessConfigDefaultRepository,
+		wire.Bind(new(repository.BusinessConfigRepository), new(*repository.BusinessConfigDefaultRepository)),
 		repository.NewResourceRepository,
 		initAbacDefinitionLocalCache,
 		initAbacPolicyRepo,
 		initAbacAttribueValRepo,
 		evaluator.NewSelector,
 		abacsvc.NewPolicyExecutor,
+		initRecentRequests,
 		abacsvc.NewPermissionSvc,
+		abacsvc.NewPolicySvc,
 		wire.Struct(new(Service), "*"),
 	)
 	return nil
@@ -68,3 +74,8 @@ func initAbacAttribueValRepo(envDao dao.EnvironmentAttributeDAO,
 	redisCache := redisx.NewAbacAttributeValCache(client)
 	return repository.NewAttributeValueRepository(envDao, resourceDao, subjectDao, definitionDao, redisCache, localCache)
 }
+
+// initRecentRequests 测试不记录最近的校验请求
+func initRecentRequests() *abacsvc.RecentRequests {
+	return abacsvc.NewRecentRequests(abacsvc.RecentRequestsConfig{})
+}
@@ -21,6 +21,8 @@ import (
 // Injectors from wire.go:
 
 func Init(db *egorm.Component, redisClient *redis.Client, lruCache *lru.Cache) *Service {
+	businessConfigDAO := dao.NewBusinessConfigDAO(db)
+	businessConfigDefaultRepository := repository.NewBusinessConfigDefaultRepository(businessConfigDAO)
 	permissionDAO := dao.NewPermissionDAO(db)
 	permissionRepository := repository.NewPermissionRepository(permissionDAO)
 	resourceDAO := dao.NewResourceDAO(db)
@@ -35,9 +37,12 @@ func Init(db *egorm.Component, redisClient *redis.Client, lruCache *lru.Cache) *
 	attributeDefinitionRepository := initAbacDefinitionLocalCache(attributeDefinitionDAO, redisClient, lruCache)
 	selector := evaluator.NewSelector()
 	policyExecutor := abac.NewPolicyExecutor(selector)
-	permissionSvc := abac.NewPermissionSvc(permissionRepository, resourceRepository, policyRepo, attributeValueRepository, attributeDefinitionRepository, policyExecutor)
+	recentRequests := initRecentRequests()
+	permissionSvc := abac.NewPermissionSvc(businessConfigDefaultRepository, permissionRepository, resourceRepository, policyRepo, attributeValueRepository, attributeDefinitionRepository, policyExecutor, recentRequests)
+	policySvc := abac.NewPolicySvc(policyRepo, attributeDefinitionRepository, permissionSvc)
 	service := &Service{
 		PermissionSvc:  permissionSvc,
+		PolicySvc:      policySvc,
 		ValRepo:        attributeValueRepository,
 		DefinitionRepo: attributeDefinitionRepository,
 		PermissionRepo: permissionRepository,
@@ -51,6 +56,7 @@ func Init(db *egorm.Component, redisClient *redis.Client, lruCache *lru.Cache) *
 
 type Service struct {
 	PermissionSvc  abac.PermissionSvc
+	PolicySvc      abac.PolicySvc
 	ValRepo        repository.AttributeValueRepository
 	DefinitionRepo repository.AttributeDefinitionRepository
 	PermissionRepo repository.PermissionRepository
@@ -80,3 +86,8 @@ func initAbacAttribueValRepo(envDao dao.EnvironmentAttributeDAO,
 	redisCache := redisx.NewAbacAttributeValCache(client)
 	return repository.NewAttributeValueRepository(envDao, resourceDao, subjectDao, definitionDao, redisCache, localCache)
 }
+
+// initRecentRequests 测试不记录最近的校验请求
+func initRecentRequests() *abac.RecentRequests {
+	return abac.NewRecentRequests(abac.RecentRequestsConfig{})
+}
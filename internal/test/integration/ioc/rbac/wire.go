@@ -8,6 +8,7 @@ import (
 
 	"gitee.com/flycash/permission-platform/internal/repository"
 	"gitee.com/flycash/permission-platform/internal/repository/dao"
+	auditdao "gitee.com/flycash/permission-platform/internal/repository/dao/audit"
 	rbacsvc "gitee.com/flycash/permission-platform/internal/service/rbac"
 )
 
@@ -31,12 +32,16 @@ func Init() *Service {
 		rbacsvc.NewService,
 
 		dao.NewBusinessConfigDAO,
-		repository.NewBusinessConfigRepository,
+		repository.NewBusinessConfigDefaultRepository,
+		wire.Bind(new(repository.BusinessConfigRepository), new(*repository.BusinessConfigDefaultRepository)),
 
 		dao.NewResourceDAO,
 		repository.NewResourceRepository,
 		dao.NewPermissionDAO,
 		repository.NewPermissionRepository,
+		dao.NewActionDefinitionDAO,
+		repository.NewActionDefinitionDefaultRepository,
+		wire.Bind(new(repository.ActionDefinitionRepository), new(*repository.ActionDefinitionDefaultRepository)),
 		dao.NewRoleDAO,
 		repository.NewRoleRepository,
 		dao.NewRoleInclusionDAO,
@@ -51,6 +56,11 @@ func Init() *Service {
 		dao.NewUserPermissionDAO,
 		repository.NewUserPermissionDefaultRepository,
 		wire.Bind(new(repository.UserPermissionRepository), new(*repository.UserPermissionDefaultRepository)),
+		dao.NewSoDConstraintDAO,
+		dao.NewRoleActivationDAO,
+		auditdao.NewSoDViolationLogDAO,
+		repository.NewSoDConstraintDefaultRepository,
+		wire.Bind(new(repository.SoDConstraintRepository), new(*repository.SoDConstraintDefaultRepository)),
 
 		wire.Struct(new(Service), "*"),
 	)
@@ -9,6 +9,7 @@ package rbac
 import (
 	"gitee.com/flycash/permission-platform/internal/repository"
 	"gitee.com/flycash/permission-platform/internal/repository/dao"
+	"gitee.com/flycash/permission-platform/internal/repository/dao/audit"
 	"gitee.com/flycash/permission-platform/internal/service/rbac"
 	"gitee.com/flycash/permission-platform/internal/test/ioc"
 )
@@ -18,11 +19,13 @@ import (
 func Init() *Service {
 	v := ioc.InitDBAndTables()
 	businessConfigDAO := dao.NewBusinessConfigDAO(v)
-	businessConfigRepository := repository.NewBusinessConfigRepository(businessConfigDAO)
+	businessConfigDefaultRepository := repository.NewBusinessConfigDefaultRepository(businessConfigDAO)
 	resourceDAO := dao.NewResourceDAO(v)
 	resourceRepository := repository.NewResourceRepository(resourceDAO)
 	permissionDAO := dao.NewPermissionDAO(v)
 	permissionRepository := repository.NewPermissionRepository(permissionDAO)
+	actionDefinitionDAO := dao.NewActionDefinitionDAO(v)
+	actionDefinitionDefaultRepository := repository.NewActionDefinitionDefaultRepository(actionDefinitionDAO)
 	roleDAO := dao.NewRoleDAO(v)
 	roleRepository := repository.NewRoleRepository(roleDAO)
 	roleInclusionDAO := dao.NewRoleInclusionDAO(v)
@@ -32,14 +35,18 @@ func Init() *Service {
 	userRoleDAO := dao.NewUserRoleDAO(v)
 	userRoleDefaultRepository := repository.NewUserRoleDefaultRepository(userRoleDAO)
 	userPermissionDAO := dao.NewUserPermissionDAO(v)
-	userPermissionDefaultRepository := repository.NewUserPermissionDefaultRepository(roleInclusionDAO, rolePermissionDAO, userRoleDAO, userPermissionDAO)
+	soDConstraintDAO := dao.NewSoDConstraintDAO(v)
+	roleActivationDAO := dao.NewRoleActivationDAO(v)
+	userPermissionDefaultRepository := repository.NewUserPermissionDefaultRepository(actionDefinitionDAO, permissionDAO, roleInclusionDAO, rolePermissionDAO, userRoleDAO, userPermissionDAO, soDConstraintDAO, roleActivationDAO)
+	soDViolationLogDAO := audit.NewSoDViolationLogDAO(v)
+	soDConstraintDefaultRepository := repository.NewSoDConstraintDefaultRepository(soDConstraintDAO, roleInclusionDAO, userRoleDAO, roleActivationDAO, soDViolationLogDAO)
 	token := ioc.InitJWTToken()
-	service := rbac.NewService(businessConfigRepository, resourceRepository, permissionRepository, roleRepository, roleInclusionDefaultRepository, rolePermissionDefaultRepository, userRoleDefaultRepository, userPermissionDefaultRepository, token)
+	service := rbac.NewService(businessConfigDefaultRepository, resourceRepository, permissionRepository, actionDefinitionDefaultRepository, roleRepository, roleInclusionDefaultRepository, rolePermissionDefaultRepository, userRoleDefaultRepository, userPermissionDefaultRepository, soDConstraintDefaultRepository, token)
 	permissionService := rbac.NewPermissionService(userPermissionDefaultRepository)
 	rbacService := &Service{
 		Svc:                service,
 		PermissionSvc:      permissionService,
-		BusinessConfigRepo: businessConfigRepository,
+		BusinessConfigRepo: businessConfigDefaultRepository,
 		ResourceRepo:       resourceRepository,
 		PermissionRepo:     permissionRepository,
 		RoleRepo:           roleRepository,
@@ -60,6 +60,7 @@ func (s *PolicyCronSuite) clearBizVal(bizId int64) {
 	t.Helper()
 	s.db.WithContext(t.Context()).Where("biz_id = ?", bizId).Delete(&dao.Policy{})
 	s.db.WithContext(t.Context()).Where("biz_id = ?", bizId).Delete(&dao.PolicyRule{})
+	s.db.WithContext(t.Context()).Where("biz_id = ?", bizId).Delete(&dao.PolicyVersion{})
 	s.redisClient.Del(s.T().Context(), fmt.Sprintf("abac:policy:%d", bizId))
 	s.lruCache.Delete(s.T().Context(), fmt.Sprintf("abac:policy:%d", bizId))
 }
@@ -0,0 +1,158 @@
+//go:build e2e
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	rbacioc "gitee.com/flycash/permission-platform/internal/test/integration/ioc/rbac"
+	testioc "gitee.com/flycash/permission-platform/internal/test/ioc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// RoleLimitTestSuite 角色授予上限测试套件
+type RoleLimitTestSuite struct {
+	suite.Suite
+	svc   *rbacioc.Service
+	bizID int64
+}
+
+func (s *RoleLimitTestSuite) SetupSuite() {
+	testioc.InitDBAndTables()
+	s.svc = rbacioc.Init()
+
+	created, err := s.svc.Svc.CreateBusinessConfig(context.Background(), createTestBusinessConfig("角色授予上限测试"))
+	s.Require().NoError(err)
+	s.bizID = created.ID
+}
+
+func TestRoleLimitSuite(t *testing.T) {
+	suite.Run(t, new(RoleLimitTestSuite))
+}
+
+func (s *RoleLimitTestSuite) createRole(name string, maxMembers, maxRolesPerUser int) domain.Role {
+	role := createTestRole(s.bizID, RoleTypeCustom)
+	role.Name = fmt.Sprintf("%s-%d", name, time.Now().UnixNano())
+	role.MaxMembers = maxMembers
+	role.MaxRolesPerUser = maxRolesPerUser
+	created, err := s.svc.Svc.CreateRole(context.Background(), role)
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *RoleLimitTestSuite) TestCreateRole_InvalidLimits() {
+	role := createTestRole(s.bizID, RoleTypeCustom)
+	role.MaxMembers = -1
+	_, err := s.svc.Svc.CreateRole(context.Background(), role)
+	s.ErrorIs(err, errs.ErrInvalidParameter)
+}
+
+func (s *RoleLimitTestSuite) TestMaxMembers() {
+	t := s.T()
+	ctx := context.Background()
+	const maxMembers, users = 3, 10
+	owner := s.createRole("biz_owner", maxMembers, 0)
+
+	// 并发授予，最多只有 maxMembers 个成功
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		granted int
+	)
+	for i := range users {
+		wg.Add(1)
+		go func(userID int64) {
+			defer wg.Done()
+			_, err := s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, owner))
+			if err != nil {
+				assert.ErrorIs(t, err, errs.ErrRoleMemberLimitExceeded)
+				return
+			}
+			mu.Lock()
+			granted++
+			mu.Unlock()
+		}(int64(20001 + i))
+	}
+	wg.Wait()
+	assert.Equal(t, maxMembers, granted)
+
+	utilizations, err := s.svc.Svc.ListRoleUtilizations(ctx, s.bizID, 0, 100)
+	require.NoError(t, err)
+	var found bool
+	for _, u := range utilizations {
+		if u.Role.ID == owner.ID {
+			found = true
+			assert.Equal(t, int64(maxMembers), u.Members)
+			assert.Equal(t, maxMembers, u.Role.MaxMembers)
+		}
+	}
+	assert.True(t, found)
+}
+
+func (s *RoleLimitTestSuite) TestMaxRolesPerUser() {
+	t := s.T()
+	ctx := context.Background()
+	const userID int64 = 20101
+	limited := s.createRole("limited", 0, 2)
+	first, second := s.createRole("first", 0, 0), s.createRole("second", 0, 0)
+
+	_, err := s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, first))
+	require.NoError(t, err)
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, limited))
+	require.NoError(t, err)
+
+	// 已经拥有的角色上的上限同样生效
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, second))
+	assert.ErrorIs(t, err, errs.ErrUserRoleLimitExceeded)
+
+	// 新角色上的上限
+	const otherUserID int64 = 20102
+	for _, role := range []domain.Role{first, second} {
+		_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, otherUserID, role))
+		require.NoError(t, err)
+	}
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, otherUserID, limited))
+	assert.ErrorIs(t, err, errs.ErrUserRoleLimitExceeded)
+}
+
+func (s *RoleLimitTestSuite) TestMaxRolesPerUser_Concurrent() {
+	t := s.T()
+	ctx := context.Background()
+	// 用户还没有任何角色，并发授予的时候也只能有 maxRoles 个成功
+	const userID int64 = 20201
+	const maxRoles, roles = 2, 6
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		granted int
+	)
+	for i := range roles {
+		role := s.createRole(fmt.Sprintf("concurrent_%d", i), 0, maxRoles)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, role))
+			if err != nil {
+				assert.ErrorIs(t, err, errs.ErrUserRoleLimitExceeded)
+				return
+			}
+			mu.Lock()
+			granted++
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, maxRoles, granted)
+
+	userRoles, err := s.svc.Svc.ListUserRolesByUserID(ctx, s.bizID, userID)
+	require.NoError(t, err)
+	assert.Len(t, userRoles, maxRoles)
+}
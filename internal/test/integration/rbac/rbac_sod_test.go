@@ -0,0 +1,296 @@
+//go:build e2e
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	"gitee.com/flycash/permission-platform/internal/errs"
+	auditdao "gitee.com/flycash/permission-platform/internal/repository/dao/audit"
+	rbacioc "gitee.com/flycash/permission-platform/internal/test/integration/ioc/rbac"
+	testioc "gitee.com/flycash/permission-platform/internal/test/ioc"
+	"github.com/ego-component/egorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// SoDTestSuite 职责分离约束测试套件
+type SoDTestSuite struct {
+	suite.Suite
+	db    *egorm.Component
+	svc   *rbacioc.Service
+	bizID int64
+}
+
+func (s *SoDTestSuite) SetupSuite() {
+	s.db = testioc.InitDBAndTables()
+	s.svc = rbacioc.Init()
+
+	created, err := s.svc.Svc.CreateBusinessConfig(context.Background(), createTestBusinessConfig("职责分离约束测试"))
+	s.Require().NoError(err)
+	s.bizID = created.ID
+}
+
+func TestSoDSuite(t *testing.T) {
+	suite.Run(t, new(SoDTestSuite))
+}
+
+func (s *SoDTestSuite) createRole(name string) domain.Role {
+	role := createTestRole(s.bizID, RoleTypeCustom)
+	role.Name = fmt.Sprintf("%s-%d", name, time.Now().UnixNano())
+	created, err := s.svc.Svc.CreateRole(context.Background(), role)
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *SoDTestSuite) createConstraint(typ domain.SoDType, roles ...domain.Role) domain.SoDConstraint {
+	roleIDs := make([]int64, 0, len(roles))
+	for _, role := range roles {
+		roleIDs = append(roleIDs, role.ID)
+	}
+	created, err := s.svc.Svc.CreateSoDConstraint(context.Background(), domain.SoDConstraint{
+		BizID:   s.bizID,
+		Name:    fmt.Sprintf("约束-%d", time.Now().UnixNano()),
+		Type:    typ,
+		RoleIDs: roleIDs,
+	})
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *SoDTestSuite) assertViolation(t *testing.T, err error, constraintID int64, userIDs []int64) {
+	require.ErrorIs(t, err, errs.ErrSoDViolation)
+	var violationErr *domain.SoDViolationError
+	require.ErrorAs(t, err, &violationErr)
+	require.Len(t, violationErr.Violations, 1)
+	assert.Equal(t, constraintID, violationErr.Violations[0].Constraint.ID)
+	assert.Equal(t, userIDs, violationErr.Violations[0].UserIDs)
+
+	var count int64
+	require.NoError(t, s.db.WithContext(context.Background()).Model(&auditdao.SoDViolationLog{}).
+		Where("biz_id = ? AND constraint_id = ?", s.bizID, constraintID).Count(&count).Error)
+	assert.Positive(t, count)
+}
+
+func (s *SoDTestSuite) TestCreateSoDConstraint() {
+	t := s.T()
+	ctx := context.Background()
+	role := s.createRole("唯一角色")
+
+	_, err := s.svc.Svc.CreateSoDConstraint(ctx, domain.SoDConstraint{
+		BizID: s.bizID, Name: "重复角色", Type: domain.SoDStatic, RoleIDs: []int64{role.ID, role.ID},
+	})
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+
+	_, err = s.svc.Svc.CreateSoDConstraint(ctx, domain.SoDConstraint{
+		BizID: s.bizID, Name: "非法类型", Type: "unknown", RoleIDs: []int64{role.ID, role.ID + 1},
+	})
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+
+	created := s.createConstraint(domain.SoDStatic, role, s.createRole("另一个角色"))
+	found, err := s.svc.Svc.GetSoDConstraint(ctx, s.bizID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.RoleIDs, found.RoleIDs)
+
+	duplicate := created
+	duplicate.ID = 0
+	_, err = s.svc.Svc.CreateSoDConstraint(ctx, duplicate)
+	assert.ErrorIs(t, err, errs.ErrSoDConstraintDuplicate)
+
+	require.NoError(t, s.svc.Svc.DeleteSoDConstraint(ctx, s.bizID, created.ID))
+	constraints, err := s.svc.Svc.ListSoDConstraints(ctx, s.bizID)
+	require.NoError(t, err)
+	for _, c := range constraints {
+		assert.NotEqual(t, created.ID, c.ID)
+	}
+}
+
+func (s *SoDTestSuite) TestGrantUserRole() {
+	t := s.T()
+	ctx := context.Background()
+	creator, approver := s.createRole("payment_creator"), s.createRole("payment_approver")
+	manager := s.createRole("payment_manager")
+	constraint := s.createConstraint(domain.SoDStatic, creator, approver)
+	_, err := s.svc.Svc.CreateRoleInclusion(ctx, createTestRoleInclusion(s.bizID, manager, approver))
+	require.NoError(t, err)
+
+	const userID int64 = 10001
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, creator))
+	require.NoError(t, err)
+
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, approver))
+	s.assertViolation(t, err, constraint.ID, []int64{userID})
+
+	// 通过角色包含关系间接拥有
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, manager))
+	s.assertViolation(t, err, constraint.ID, []int64{userID})
+
+	userRoles, err := s.svc.Svc.ListUserRolesByUserID(ctx, s.bizID, userID)
+	require.NoError(t, err)
+	assert.Len(t, userRoles, 1)
+}
+
+func (s *SoDTestSuite) TestCreateRoleInclusion() {
+	t := s.T()
+	ctx := context.Background()
+	creator, approver := s.createRole("payment_creator"), s.createRole("payment_approver")
+	manager, auditor := s.createRole("payment_manager"), s.createRole("payment_auditor")
+	constraint := s.createConstraint(domain.SoDStatic, creator, approver)
+
+	t.Run("角色本身违反约束", func(t *testing.T) {
+		_, err := s.svc.Svc.CreateRoleInclusion(ctx, createTestRoleInclusion(s.bizID, manager, creator))
+		require.NoError(t, err)
+		_, err = s.svc.Svc.CreateRoleInclusion(ctx, createTestRoleInclusion(s.bizID, manager, approver))
+		s.assertViolation(t, err, constraint.ID, nil)
+	})
+
+	t.Run("拥有角色的用户间接违反约束", func(t *testing.T) {
+		const userID int64 = 10002
+		_, err := s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, creator))
+		require.NoError(t, err)
+		_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, auditor))
+		require.NoError(t, err)
+		_, err = s.svc.Svc.CreateRoleInclusion(ctx, createTestRoleInclusion(s.bizID, auditor, approver))
+		s.assertViolation(t, err, constraint.ID, []int64{userID})
+	})
+}
+
+func (s *SoDTestSuite) TestConcurrentGrants() {
+	t := s.T()
+	ctx := context.Background()
+
+	t.Run("并发授予互斥的角色", func(t *testing.T) {
+		const userID int64 = 10003
+		roles := []domain.Role{s.createRole("cashier"), s.createRole("accountant"), s.createRole("auditor")}
+		constraint := s.createConstraint(domain.SoDStatic, roles...)
+		// 只能有一个授予成功
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			granted int
+		)
+		for _, role := range roles {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, role))
+				if err != nil {
+					s.assertViolation(t, err, constraint.ID, []int64{userID})
+					return
+				}
+				mu.Lock()
+				granted++
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, granted)
+	})
+
+	t.Run("并发授予角色和创建角色包含关系", func(t *testing.T) {
+		const userID int64 = 10004
+		creator, approver := s.createRole("payment_creator"), s.createRole("payment_approver")
+		auditor := s.createRole("payment_auditor")
+		s.createConstraint(domain.SoDStatic, creator, approver)
+		_, err := s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, creator))
+		require.NoError(t, err)
+		// 两个操作单独执行都可以，同时成功的话用户就间接拥有了互斥的角色
+		var (
+			wg       sync.WaitGroup
+			grantErr error
+			inclErr  error
+		)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, grantErr = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, auditor))
+		}()
+		go func() {
+			defer wg.Done()
+			_, inclErr = s.svc.Svc.CreateRoleInclusion(ctx, createTestRoleInclusion(s.bizID, auditor, approver))
+		}()
+		wg.Wait()
+		assert.True(t, (grantErr == nil) != (inclErr == nil), "grant: %v, inclusion: %v", grantErr, inclErr)
+		for _, err := range []error{grantErr, inclErr} {
+			if err != nil {
+				assert.ErrorIs(t, err, errs.ErrSoDViolation)
+			}
+		}
+	})
+}
+
+func (s *SoDTestSuite) TestActivateRoles() {
+	t := s.T()
+	ctx := context.Background()
+	initiator, reviewer := s.createRole("contract_initiator"), s.createRole("contract_reviewer")
+	other := s.createRole("other")
+	constraint := s.createConstraint(domain.SoDDynamic, initiator, reviewer)
+
+	// 动态约束允许同时拥有
+	const userID int64 = 10003
+	_, err := s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, initiator))
+	require.NoError(t, err)
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, reviewer))
+	require.NoError(t, err)
+
+	effective, err := s.svc.Svc.ActivateRoles(ctx, s.bizID, userID, []int64{reviewer.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{reviewer.ID}, effective)
+
+	_, err = s.svc.Svc.ActivateRoles(ctx, s.bizID, userID, []int64{initiator.ID, reviewer.ID})
+	s.assertViolation(t, err, constraint.ID, []int64{userID})
+
+	_, err = s.svc.Svc.ActivateRoles(ctx, s.bizID, userID, []int64{other.ID})
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+}
+
+func (s *SoDTestSuite) TestCheckWithDynamicConstraint() {
+	t := s.T()
+	ctx := context.Background()
+	initiator, reviewer := s.createRole("payment_initiator"), s.createRole("payment_reviewer")
+	s.createConstraint(domain.SoDDynamic, initiator, reviewer)
+
+	resource, err := s.svc.Svc.CreateResource(ctx, createTestResource(s.bizID, "payment", fmt.Sprintf("/payment/%d", time.Now().UnixNano())))
+	require.NoError(t, err)
+	initiate, err := s.svc.Svc.CreatePermission(ctx, createTestPermission(s.bizID, resource, ActionTypeCreate))
+	require.NoError(t, err)
+	review, err := s.svc.Svc.CreatePermission(ctx, createTestPermission(s.bizID, resource, ActionTypeExecute))
+	require.NoError(t, err)
+	_, err = s.svc.Svc.GrantRolePermission(ctx, createTestRolePermission(s.bizID, initiator, initiate))
+	require.NoError(t, err)
+	_, err = s.svc.Svc.GrantRolePermission(ctx, createTestRolePermission(s.bizID, reviewer, review))
+	require.NoError(t, err)
+
+	const userID int64 = 10004
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, initiator))
+	require.NoError(t, err)
+	check := func(action ActionType) bool {
+		ok, err := s.svc.PermissionSvc.Check(ctx, s.bizID, userID, resource, []string{string(action)})
+		require.NoError(t, err)
+		return ok
+	}
+	// 只拥有约束里的一个角色的时候不需要激活
+	assert.True(t, check(ActionTypeCreate))
+
+	// 拥有了第二个角色之后，没有激活的时候两个角色都不生效
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, reviewer))
+	require.NoError(t, err)
+	assert.False(t, check(ActionTypeCreate))
+	assert.False(t, check(ActionTypeExecute))
+
+	_, err = s.svc.Svc.ActivateRoles(ctx, s.bizID, userID, []int64{initiator.ID})
+	require.NoError(t, err)
+	assert.True(t, check(ActionTypeCreate))
+	assert.False(t, check(ActionTypeExecute))
+
+	_, err = s.svc.Svc.ActivateRoles(ctx, s.bizID, userID, []int64{reviewer.ID})
+	require.NoError(t, err)
+	assert.False(t, check(ActionTypeCreate))
+	assert.True(t, check(ActionTypeExecute))
+}
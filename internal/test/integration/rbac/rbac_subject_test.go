@@ -0,0 +1,170 @@
+//go:build e2e
+
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/permission-platform/internal/domain"
+	rbacioc "gitee.com/flycash/permission-platform/internal/test/integration/ioc/rbac"
+	testioc "gitee.com/flycash/permission-platform/internal/test/ioc"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSubjectSuite(t *testing.T) {
+	suite.Run(t, new(SubjectTestSuite))
+}
+
+// SubjectTestSuite 反向查找拥有权限的用户
+type SubjectTestSuite struct {
+	suite.Suite
+	svc   *rbacioc.Service
+	bizID int64
+}
+
+func (s *SubjectTestSuite) SetupSuite() {
+	testioc.InitDBAndTables()
+	s.svc = rbacioc.Init()
+
+	created, err := s.svc.Svc.CreateBusinessConfig(context.Background(), createTestBusinessConfig("反向查找测试"))
+	s.Require().NoError(err)
+	s.bizID = created.ID
+}
+
+func (s *SubjectTestSuite) TestListSubjectsWithPermission() {
+	t := s.T()
+	ctx := context.Background()
+
+	createPermission := func(key string) domain.Permission {
+		res, err := s.svc.Svc.CreateResource(ctx, createTestResource(s.bizID, "payment", key))
+		require.NoError(t, err)
+		perm, err := s.svc.Svc.CreatePermission(ctx, createTestPermission(s.bizID, res, ActionTypeDelete))
+		require.NoError(t, err)
+		return perm
+	}
+	createRole := func(name string) domain.Role {
+		role := createTestRole(s.bizID, RoleTypeSystem)
+		role.Name = name + "-" + time.Now().String()
+		created, err := s.svc.Svc.CreateRole(ctx, role)
+		require.NoError(t, err)
+		return created
+	}
+	anyPayment := createPermission("/payments/*")
+	allPayments := createPermission("/payments/**")
+
+	// admin 包含 ops，ops 可以删除任意一个支付
+	admin, ops := createRole("admin"), createRole("ops")
+	_, err := s.svc.Svc.CreateRoleInclusion(ctx, createTestRoleInclusion(s.bizID, admin, ops))
+	require.NoError(t, err)
+	_, err = s.svc.Svc.GrantRolePermission(ctx, createTestRolePermission(s.bizID, ops, anyPayment))
+	require.NoError(t, err)
+
+	// 1 通过 admin 继承，2 直接授予，3 有 ops 但是被同样具体的拒绝覆盖，4 只有拒绝，5 直接拥有 ops
+	grantRole := func(userID int64, role domain.Role) {
+		_, err := s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, role))
+		require.NoError(t, err)
+	}
+	grantPermission := func(userID int64, perm domain.Permission, effect domain.Effect) {
+		_, err := s.svc.Svc.GrantUserPermission(ctx, createTestUserPermission(s.bizID, userID, perm, effect))
+		require.NoError(t, err)
+	}
+	grantRole(1, admin)
+	grantPermission(2, anyPayment, domain.EffectAllow)
+	grantRole(3, ops)
+	grantPermission(3, anyPayment, domain.EffectDeny)
+	grantPermission(4, allPayments, domain.EffectDeny)
+	grantRole(5, ops)
+
+	list := func(cursor int64) ([]domain.PermissionSubject, int64) {
+		subjects, next, err := s.svc.Svc.ListSubjectsWithPermission(ctx, s.bizID, domain.Resource{
+			Type: "payment",
+			Key:  "/payments/*",
+		}, string(ActionTypeDelete), cursor, 2)
+		require.NoError(t, err)
+		return subjects, next
+	}
+	userIDs := func(subjects []domain.PermissionSubject) []int64 {
+		return slice.Map(subjects, func(_ int, src domain.PermissionSubject) int64 {
+			return src.UserID
+		})
+	}
+
+	page, next := list(0)
+	assert.Equal(t, []int64{1, 2}, userIDs(page))
+	assert.Equal(t, int64(2), next)
+	require.Len(t, page[0].Sources, 1)
+	assert.Equal(t, []int64{admin.ID, ops.ID}, page[0].Sources[0].RolePath)
+	require.Len(t, page[1].Sources, 1)
+	assert.True(t, page[1].Sources[0].IsDirect())
+
+	// 3 被拒绝所以被剔除了，这一页只有 5
+	page, next = list(next)
+	assert.Equal(t, []int64{5}, userIDs(page))
+	assert.Equal(t, []int64{ops.ID}, page[0].Sources[0].RolePath)
+	assert.Equal(t, int64(5), next)
+
+	page, next = list(next)
+	assert.Empty(t, page)
+	assert.Zero(t, next)
+
+	// 具体到某一个支付的时候，/payments/** 上的拒绝也会生效，不过它没有 /payments/* 上的允许具体
+	subjects, _, err := s.svc.Svc.ListSubjectsWithPermission(ctx, s.bizID, domain.Resource{
+		Type: "payment",
+		Key:  "/payments/1",
+	}, string(ActionTypeDelete), 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 5}, userIDs(subjects))
+}
+
+func (s *SubjectTestSuite) TestListAccessible() {
+	t := s.T()
+	ctx := context.Background()
+
+	const userID int64 = 11
+	createPermission := func(key string) domain.Permission {
+		res, err := s.svc.Svc.CreateResource(ctx, createTestResource(s.bizID, "report", key))
+		require.NoError(t, err)
+		perm, err := s.svc.Svc.CreatePermission(ctx, createTestPermission(s.bizID, res, ActionTypeRead))
+		require.NoError(t, err)
+		return perm
+	}
+	grant := func(perm domain.Permission, effect domain.Effect) {
+		_, err := s.svc.Svc.GrantUserPermission(ctx, createTestUserPermission(s.bizID, userID, perm, effect))
+		require.NoError(t, err)
+	}
+	// 可以读所有报表，除了财务报表；财务报表里的公开报表又可以读；/reports/draft 被角色上同样具体的拒绝覆盖
+	grant(createPermission("/reports/**"), domain.EffectAllow)
+	grant(createPermission("/reports/finance/*"), domain.EffectDeny)
+	grant(createPermission("/reports/finance/public"), domain.EffectAllow)
+	draft := createPermission("/reports/draft")
+	grant(draft, domain.EffectAllow)
+	role, err := s.svc.Svc.CreateRole(ctx, createTestRole(s.bizID, RoleTypeSystem))
+	require.NoError(t, err)
+	rolePerm := createTestRolePermission(s.bizID, role, draft)
+	rolePerm.Effect = domain.EffectDeny
+	_, err = s.svc.Svc.GrantRolePermission(ctx, rolePerm)
+	require.NoError(t, err)
+	_, err = s.svc.Svc.GrantUserRole(ctx, createTestUserRole(s.bizID, userID, role))
+	require.NoError(t, err)
+
+	access, err := s.svc.PermissionSvc.ListAccessible(ctx, s.bizID, userID, "report", string(ActionTypeRead))
+	require.NoError(t, err)
+	require.Len(t, access.Keys, 2)
+	keys := make(map[string][]string, len(access.Keys))
+	for _, k := range access.Keys {
+		keys[k.Key] = k.Except
+	}
+	assert.ElementsMatch(t, []string{"/reports/finance/*", "/reports/draft"}, keys["/reports/**"])
+	assert.Contains(t, keys, "/reports/finance/public")
+	assert.Empty(t, keys["/reports/finance/public"])
+
+	// 其他操作上没有授权
+	access, err = s.svc.PermissionSvc.ListAccessible(ctx, s.bizID, userID, "report", string(ActionTypeDelete))
+	require.NoError(t, err)
+	assert.Empty(t, access.Keys)
+}
@@ -29,6 +29,22 @@ func (m *MockPermissionServiceClient) CheckPermission(ctx context.Context, req *
 	return args.Get(0).(*permissionv1.CheckPermissionResponse), args.Error(1)
 }
 
+func (m *MockPermissionServiceClient) ExplainPermission(ctx context.Context, req *permissionv1.ExplainPermissionRequest, _ ...grpc.CallOption) (*permissionv1.ExplainPermissionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*permissionv1.ExplainPermissionResponse), args.Error(1)
+}
+
+func (m *MockPermissionServiceClient) ListAccessibleResources(ctx context.Context, req *permissionv1.ListAccessibleResourcesRequest, _ ...grpc.CallOption) (*permissionv1.ListAccessibleResourcesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*permissionv1.ListAccessibleResourcesResponse), args.Error(1)
+}
+
 func TestAccessPlugin(t *testing.T) {
 	// 创建模拟的权限服务客户端
 	mockClient := new(MockPermissionServiceClient)
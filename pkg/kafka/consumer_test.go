@@ -45,6 +45,14 @@ func (m *MockPermissionServiceClient) CheckPermission(ctx context.Context, req *
 	}, nil
 }
 
+func (m *MockPermissionServiceClient) ExplainPermission(_ context.Context, _ *permissionv1.ExplainPermissionRequest, _ ...grpc.CallOption) (*permissionv1.ExplainPermissionResponse, error) {
+	return &permissionv1.ExplainPermissionResponse{}, nil
+}
+
+func (m *MockPermissionServiceClient) ListAccessibleResources(_ context.Context, _ *permissionv1.ListAccessibleResourcesRequest, _ ...grpc.CallOption) (*permissionv1.ListAccessibleResourcesResponse, error) {
+	return &permissionv1.ListAccessibleResourcesResponse{}, nil
+}
+
 func TestAccessConsumer_Subscribe(t *testing.T) {
 	t.Skip("Skipping integration test")
 
@@ -33,6 +33,22 @@ func (m *TestPermissionServiceClient) CheckPermission(ctx context.Context, req *
 	return val, args.Error(1)
 }
 
+func (m *TestPermissionServiceClient) ExplainPermission(ctx context.Context, req *permissionv1.ExplainPermissionRequest, _ ...grpc.CallOption) (*permissionv1.ExplainPermissionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*permissionv1.ExplainPermissionResponse), args.Error(1)
+}
+
+func (m *TestPermissionServiceClient) ListAccessibleResources(ctx context.Context, req *permissionv1.ListAccessibleResourcesRequest, _ ...grpc.CallOption) (*permissionv1.ListAccessibleResourcesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*permissionv1.ListAccessibleResourcesResponse), args.Error(1)
+}
+
 func TestAccessProducer_Produce(t *testing.T) {
 	// 创建 Kafka 生产者配置
 	config := &kafka.ConfigMap{
@@ -65,6 +65,6 @@ func NewLocalCachedClient(
 }
 
 // NewRedisCachedClient 创建Redis缓存客户端
-func NewRedisCachedClient(client permissionv1.PermissionServiceClient, rd redis.Cmdable, expiration time.Duration) *RedisCachedClient {
-	return internal.NewRedisCachedClient(client, rd, expiration)
+func NewRedisCachedClient(client permissionv1.PermissionServiceClient, rd redis.Cmdable) *RedisCachedClient {
+	return internal.NewRedisCachedClient(client, rd)
 }
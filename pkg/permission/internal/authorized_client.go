@@ -46,3 +46,13 @@ func (c *AuthorizedClient) CheckPermission(ctx context.Context, in *permissionv1
 	ctx = metadata.AppendToOutgoingContext(ctx, "Authorization", c.token)
 	return c.client.CheckPermission(ctx, in, opts...)
 }
+
+func (c *AuthorizedClient) ExplainPermission(ctx context.Context, in *permissionv1.ExplainPermissionRequest, opts ...grpc.CallOption) (*permissionv1.ExplainPermissionResponse, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "Authorization", c.token)
+	return c.client.ExplainPermission(ctx, in, opts...)
+}
+
+func (c *AuthorizedClient) ListAccessibleResources(ctx context.Context, in *permissionv1.ListAccessibleResourcesRequest, opts ...grpc.CallOption) (*permissionv1.ListAccessibleResourcesResponse, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "Authorization", c.token)
+	return c.client.ListAccessibleResources(ctx, in, opts...)
+}
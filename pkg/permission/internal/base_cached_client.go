@@ -2,11 +2,12 @@ package internal
 
 import (
 	"fmt"
-	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	permissionv1 "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
+	"gitee.com/flycash/permission-platform/pkg/reskey"
 )
 
 const (
@@ -59,31 +60,50 @@ func (c *baseCachedClient) parseKey(key string) (bizID, userID int64, err error)
 	return bizID, userID, nil
 }
 
+// checkPermission 和服务端的判定规则一致：每个操作上由最具体的授权决定结果，同样具体的时候拒绝优先，规则见 reskey 包。
+// 任意一个操作被拒绝就返回 false，所有操作都被允许才返回 true，其余情况缓存里的数据不足以判定，返回错误交给服务端判定
 func (c *baseCachedClient) checkPermission(userPermission UserPermission, in *permissionv1.CheckPermissionRequest) (*permissionv1.CheckPermissionResponse, error) {
 	bizID := in.GetPermission().GetBizId()
 	resourceType := in.GetPermission().GetResourceType()
 	resourceKey := in.GetPermission().GetResourceKey()
 	actions := in.GetPermission().GetActions()
-	allowedCounter := 0
+	if userPermission.BizID != bizID {
+		return nil, fmt.Errorf("%w, actions: %v", ErrUnknownPermissionAction, actions)
+	}
+	resolvers := make(map[string]*reskey.Resolver, len(actions))
+	for _, action := range actions {
+		resolvers[action] = &reskey.Resolver{}
+	}
+	now := time.Now().UnixMilli()
 	for i := range userPermission.Permissions {
-
 		permission := userPermission.Permissions[i]
-
-		if userPermission.BizID == bizID &&
-			permission.Resource.Type == resourceType &&
-			permission.Resource.Key == resourceKey &&
-			slices.Contains(actions, permission.Action) {
-
-			if permission.Effect == "deny" {
-				return &permissionv1.CheckPermissionResponse{Allowed: false}, nil
+		// 缓存可能是在权限失效前写入的，不在生效期内的权限需要忽略
+		if !permission.IsValidAt(now) {
+			continue
+		}
+		if permission.Resource.Type != resourceType ||
+			!reskey.Match(permission.Resource.Key, resourceKey) {
+			continue
+		}
+		// 授权时的操作为 * 的权限作用在所有操作上
+		for action, r := range resolvers {
+			if reskey.MatchAction(permission.Action, action) {
+				r.Add(permission.Resource.Key, permission.Effect == "deny", i)
 			}
+		}
+	}
 
-			allowedCounter++
+	allowed := len(actions) > 0
+	for _, action := range actions {
+		switch resolvers[action].Decision() {
+		case reskey.Deny:
+			return &permissionv1.CheckPermissionResponse{Allowed: false}, nil
+		case reskey.Undecided:
+			allowed = false
 		}
 	}
-	if len(actions) != allowedCounter {
+	if !allowed {
 		return nil, fmt.Errorf("%w, actions: %v", ErrUnknownPermissionAction, actions)
 	}
 	return &permissionv1.CheckPermissionResponse{Allowed: true}, nil
-	// return &permissionv1.CheckPermissionResponse{Allowed: len(actions) == allowedCounter}, nil
 }
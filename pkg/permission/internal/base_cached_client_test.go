@@ -0,0 +1,179 @@
+//go:build unit
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	permissionv1 "gitee.com/flycash/permission-platform/api/proto/gen/permission/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseCachedClient_checkPermission_Validity(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UnixMilli()
+	hour := time.Hour.Milliseconds()
+	in := &permissionv1.CheckPermissionRequest{
+		Uid: 1,
+		Permission: &permissionv1.Permission{
+			BizId:        1,
+			ResourceType: "api",
+			ResourceKey:  "/orders",
+			Actions:      []string{"read"},
+		},
+	}
+	newPermission := func(effect string, start, end int64) PermissionV1 {
+		return PermissionV1{
+			Resource:  Resource{Type: "api", Key: "/orders"},
+			Action:    "read",
+			Effect:    effect,
+			StartTime: start,
+			EndTime:   end,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		permissions []PermissionV1
+		wantAllowed bool
+		wantErr     error
+	}{
+		{
+			name:        "生效期内",
+			permissions: []PermissionV1{newPermission("allow", now-hour, now+hour)},
+			wantAllowed: true,
+		},
+		{
+			name:        "没有设置生效期",
+			permissions: []PermissionV1{newPermission("allow", 0, 0)},
+			wantAllowed: true,
+		},
+		{
+			name:        "缓存写入之后已经过期",
+			permissions: []PermissionV1{newPermission("allow", now-2*hour, now-hour)},
+			wantErr:     ErrUnknownPermissionAction,
+		},
+		{
+			name:        "还没有到生效时间",
+			permissions: []PermissionV1{newPermission("allow", now+hour, now+2*hour)},
+			wantErr:     ErrUnknownPermissionAction,
+		},
+		{
+			name: "过期的负权限不再生效",
+			permissions: []PermissionV1{
+				newPermission("deny", now-2*hour, now-hour),
+				newPermission("allow", now-hour, now+hour),
+			},
+			wantAllowed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := &baseCachedClient{}
+			resp, err := c.checkPermission(UserPermission{UserID: 1, BizID: 1, Permissions: tc.permissions}, in)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAllowed, resp.GetAllowed())
+		})
+	}
+}
+
+func TestBaseCachedClient_checkPermission_Wildcard(t *testing.T) {
+	t.Parallel()
+
+	newPermission := func(key, action, effect string) PermissionV1 {
+		return PermissionV1{
+			Resource: Resource{Type: "doc", Key: key},
+			Action:   action,
+			Effect:   effect,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		actions     []string
+		permissions []PermissionV1
+		wantAllowed bool
+		wantErr     error
+	}{
+		{
+			name:        "匹配多段通配",
+			actions:     []string{"read"},
+			permissions: []PermissionV1{newPermission("/docs/**", "read", "allow")},
+			wantAllowed: true,
+		},
+		{
+			name:    "更具体的允许覆盖宽泛的拒绝",
+			actions: []string{"read"},
+			permissions: []PermissionV1{
+				newPermission("/docs/**", "read", "deny"),
+				newPermission("/docs/team-a/*", "read", "allow"),
+			},
+			wantAllowed: true,
+		},
+		{
+			name:    "更具体的拒绝",
+			actions: []string{"read"},
+			permissions: []PermissionV1{
+				newPermission("/docs/**", "read", "allow"),
+				newPermission("/docs/team-a/spec.md", "read", "deny"),
+			},
+			wantAllowed: false,
+		},
+		{
+			name:    "部分操作没有授权交给服务端判定",
+			actions: []string{"read", "write"},
+			permissions: []PermissionV1{
+				newPermission("/docs/**", "read", "allow"),
+				newPermission("/docs/*/spec.md", "read", "allow"),
+			},
+			wantErr: ErrUnknownPermissionAction,
+		},
+		{
+			name:        "通配操作覆盖所有操作",
+			actions:     []string{"read", "write"},
+			permissions: []PermissionV1{newPermission("/docs/**", "*", "allow")},
+			wantAllowed: true,
+		},
+		{
+			name:    "通配操作上的拒绝",
+			actions: []string{"write"},
+			permissions: []PermissionV1{
+				newPermission("/docs/team-a/spec.md", "write", "allow"),
+				newPermission("/docs/team-a/spec.md", "*", "deny"),
+			},
+			wantAllowed: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := &baseCachedClient{}
+			in := &permissionv1.CheckPermissionRequest{
+				Uid: 1,
+				Permission: &permissionv1.Permission{
+					BizId:        1,
+					ResourceType: "doc",
+					ResourceKey:  "/docs/team-a/spec.md",
+					Actions:      tc.actions,
+				},
+			}
+			resp, err := c.checkPermission(UserPermission{UserID: 1, BizID: 1, Permissions: tc.permissions}, in)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAllowed, resp.GetAllowed())
+		})
+	}
+}
@@ -15,9 +15,23 @@ type PermissionV1 struct {
 	Resource Resource `json:"resource"`
 	Action   string   `json:"action"`
 	Effect   string   `json:"effect"`
+	// 生效期，毫秒时间戳，0 表示不设限
+	StartTime int64 `json:"startTime,omitempty"`
+	EndTime   int64 `json:"endTime,omitempty"`
 }
 
 type Resource struct {
 	Key  string `json:"key"`
 	Type string `json:"type"`
 }
+
+// IsValidAt 判断权限在 t（毫秒时间戳）时刻是否处于生效期内
+func (p PermissionV1) IsValidAt(t int64) bool {
+	if p.StartTime > 0 && t < p.StartTime {
+		return false
+	}
+	if p.EndTime > 0 && t > p.EndTime {
+		return false
+	}
+	return true
+}
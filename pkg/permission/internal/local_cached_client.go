@@ -113,3 +113,13 @@ func (c *LocalCachedClient) CheckPermission(ctx context.Context, in *permissionv
 	// 2. 从 client 取
 	return c.client.CheckPermission(ctx, in, opts...)
 }
+
+// ExplainPermission 用于排查问题，不走缓存
+func (c *LocalCachedClient) ExplainPermission(ctx context.Context, in *permissionv1.ExplainPermissionRequest, opts ...grpc.CallOption) (*permissionv1.ExplainPermissionResponse, error) {
+	return c.client.ExplainPermission(ctx, in, opts...)
+}
+
+// ListAccessibleResources 结果和用户的全部授权有关，不走缓存
+func (c *LocalCachedClient) ListAccessibleResources(ctx context.Context, in *permissionv1.ListAccessibleResourcesRequest, opts ...grpc.CallOption) (*permissionv1.ListAccessibleResourcesResponse, error) {
+	return c.client.ListAccessibleResources(ctx, in, opts...)
+}
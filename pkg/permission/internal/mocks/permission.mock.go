@@ -62,6 +62,46 @@ func (mr *MockPermissionServiceClientMockRecorder) CheckPermission(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermission", reflect.TypeOf((*MockPermissionServiceClient)(nil).CheckPermission), varargs...)
 }
 
+// ExplainPermission mocks base method.
+func (m *MockPermissionServiceClient) ExplainPermission(ctx context.Context, in *permissionv1.ExplainPermissionRequest, opts ...grpc.CallOption) (*permissionv1.ExplainPermissionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExplainPermission", varargs...)
+	ret0, _ := ret[0].(*permissionv1.ExplainPermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainPermission indicates an expected call of ExplainPermission.
+func (mr *MockPermissionServiceClientMockRecorder) ExplainPermission(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainPermission", reflect.TypeOf((*MockPermissionServiceClient)(nil).ExplainPermission), varargs...)
+}
+
+// ListAccessibleResources mocks base method.
+func (m *MockPermissionServiceClient) ListAccessibleResources(ctx context.Context, in *permissionv1.ListAccessibleResourcesRequest, opts ...grpc.CallOption) (*permissionv1.ListAccessibleResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccessibleResources", varargs...)
+	ret0, _ := ret[0].(*permissionv1.ListAccessibleResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccessibleResources indicates an expected call of ListAccessibleResources.
+func (mr *MockPermissionServiceClientMockRecorder) ListAccessibleResources(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessibleResources", reflect.TypeOf((*MockPermissionServiceClient)(nil).ListAccessibleResources), varargs...)
+}
+
 // MockPermissionServiceServer is a mock of PermissionServiceServer interface.
 type MockPermissionServiceServer struct {
 	ctrl     *gomock.Controller
@@ -101,6 +141,36 @@ func (mr *MockPermissionServiceServerMockRecorder) CheckPermission(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermission", reflect.TypeOf((*MockPermissionServiceServer)(nil).CheckPermission), arg0, arg1)
 }
 
+// ExplainPermission mocks base method.
+func (m *MockPermissionServiceServer) ExplainPermission(arg0 context.Context, arg1 *permissionv1.ExplainPermissionRequest) (*permissionv1.ExplainPermissionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainPermission", arg0, arg1)
+	ret0, _ := ret[0].(*permissionv1.ExplainPermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainPermission indicates an expected call of ExplainPermission.
+func (mr *MockPermissionServiceServerMockRecorder) ExplainPermission(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainPermission", reflect.TypeOf((*MockPermissionServiceServer)(nil).ExplainPermission), arg0, arg1)
+}
+
+// ListAccessibleResources mocks base method.
+func (m *MockPermissionServiceServer) ListAccessibleResources(arg0 context.Context, arg1 *permissionv1.ListAccessibleResourcesRequest) (*permissionv1.ListAccessibleResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccessibleResources", arg0, arg1)
+	ret0, _ := ret[0].(*permissionv1.ListAccessibleResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccessibleResources indicates an expected call of ListAccessibleResources.
+func (mr *MockPermissionServiceServerMockRecorder) ListAccessibleResources(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessibleResources", reflect.TypeOf((*MockPermissionServiceServer)(nil).ListAccessibleResources), arg0, arg1)
+}
+
 // MockUnsafePermissionServiceServer is a mock of UnsafePermissionServiceServer interface.
 type MockUnsafePermissionServiceServer struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,201 @@
+// Package reskey 定义了资源标识的匹配规则，服务端和 SDK 共用，保证两边的判定结果一致。
+//
+// 资源标识形如 /xxx/xxx/xxx，授权的时候资源标识里可以使用以下通配段：
+//   - *      匹配任意一段
+//   - {name} 匹配任意一段，和 * 等价，只是可读性更好，例如 /orders/{id}/items
+//   - **     匹配零段或者多段，例如 /docs/** 匹配 /docs 以及它下面的所有资源
+//
+// 同一个操作匹配上多条授权的时候，最具体的授权决定结果；同样具体的授权里，拒绝优先。
+// 具体程度依次比较：普通段越多越具体，其次单段通配越多越具体，最后 ** 越少越具体。
+// 因此 /docs/** 上的拒绝可以被 /docs/public/* 上的允许覆盖，而完全相同的资源标识上，拒绝永远优先。
+//
+// 授权时的操作为 * 表示所有操作，见 MatchAction。
+package reskey
+
+import "strings"
+
+const (
+	separator   = "/"
+	anySegment  = "*"
+	anySegments = "**"
+
+	// AnyAction 授权时使用，表示所有操作
+	AnyAction = "*"
+)
+
+// MatchAction 判断授权时使用的操作 pattern 是否覆盖了要校验的操作 action
+func MatchAction(pattern, action string) bool {
+	return pattern == action || pattern == AnyAction
+}
+
+// Match 判断资源标识 key 是否匹配授权时使用的 pattern
+func Match(pattern, key string) bool {
+	if pattern == key {
+		return true
+	}
+	if !strings.Contains(pattern, anySegment) && !strings.Contains(pattern, "{") {
+		return false
+	}
+	return matchSegments(split(pattern), split(key))
+}
+
+func matchSegments(pattern, key []string) bool {
+	for len(pattern) > 0 {
+		seg := pattern[0]
+		if seg == anySegments {
+			// 连续的 ** 和单个 ** 等价
+			for len(pattern) > 0 && pattern[0] == anySegments {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(key); i++ {
+				if matchSegments(pattern, key[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(key) == 0 {
+			return false
+		}
+		if !isSingleWildcard(seg) && seg != key[0] {
+			return false
+		}
+		pattern, key = pattern[1:], key[1:]
+	}
+	return len(key) == 0
+}
+
+func split(s string) []string {
+	s = strings.Trim(s, separator)
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, separator)
+}
+
+func isSingleWildcard(seg string) bool {
+	return seg == anySegment ||
+		(len(seg) > 2 && strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}"))
+}
+
+// Specificity 授权时使用的资源标识的具体程度
+type Specificity struct {
+	literals int // 普通段的数量
+	singles  int // 单段通配的数量
+	multis   int // ** 的数量
+}
+
+// SpecificityOf 计算 pattern 的具体程度
+func SpecificityOf(pattern string) Specificity {
+	var res Specificity
+	for _, seg := range split(pattern) {
+		switch {
+		case seg == anySegments:
+			res.multis++
+		case isSingleWildcard(seg):
+			res.singles++
+		default:
+			res.literals++
+		}
+	}
+	return res
+}
+
+// Compare 比 other 更具体返回正数，更宽泛返回负数，一样具体返回 0
+func (s Specificity) Compare(other Specificity) int {
+	if s.literals != other.literals {
+		return s.literals - other.literals
+	}
+	if s.singles != other.singles {
+		return s.singles - other.singles
+	}
+	return other.multis - s.multis
+}
+
+// Decision 单个操作上的判定结果
+type Decision int8
+
+const (
+	Undecided Decision = iota // 没有匹配上任何授权
+	Allow
+	Deny
+)
+
+// Resolver 按照最具体优先、同样具体时拒绝优先的规则，逐条累积同一个操作上匹配上的授权。
+// 零值可以直接使用。
+type Resolver struct {
+	decision    Decision
+	specificity Specificity
+	index       int
+}
+
+// Add 加入一条已经匹配上的授权，index 是调用方自己的下标，用于找回决定结果的那条授权
+func (r *Resolver) Add(pattern string, deny bool, index int) {
+	sp := SpecificityOf(pattern)
+	decision := Allow
+	if deny {
+		decision = Deny
+	}
+	if r.decision != Undecided {
+		cmp := sp.Compare(r.specificity)
+		if cmp < 0 || (cmp == 0 && (r.decision == Deny || decision == Allow)) {
+			return
+		}
+	}
+	r.decision = decision
+	r.specificity = sp
+	r.index = index
+}
+
+// Decision 当前的判定结果
+func (r *Resolver) Decision() Decision {
+	return r.decision
+}
+
+// Index 决定了判定结果的那条授权的下标，Decision 为 Undecided 的时候没有意义
+func (r *Resolver) Index() int {
+	return r.index
+}
+
+// Overlap 判断两个 pattern 是否能匹配上同一个资源标识
+func Overlap(a, b string) bool {
+	return overlapSegments(split(a), split(b))
+}
+
+func overlapSegments(a, b []string) bool {
+	switch {
+	case len(a) > 0 && a[0] == anySegments:
+		return overlapSegments(a[1:], b) || (len(b) > 0 && overlapSegments(a, b[1:]))
+	case len(b) > 0 && b[0] == anySegments:
+		return overlapSegments(a, b[1:]) || (len(a) > 0 && overlapSegments(a[1:], b))
+	case len(a) == 0 || len(b) == 0:
+		return len(a) == len(b)
+	case isSingleWildcard(a[0]) || isSingleWildcard(b[0]) || a[0] == b[0]:
+		return overlapSegments(a[1:], b[1:])
+	default:
+		return false
+	}
+}
+
+// Equivalent 判断两个 pattern 是否匹配完全相同的资源标识，{name} 和 * 等价，连续的 ** 和单个 ** 等价
+func Equivalent(a, b string) bool {
+	return normalize(a) == normalize(b)
+}
+
+func normalize(pattern string) string {
+	segs := split(pattern)
+	res := make([]string, 0, len(segs))
+	for _, seg := range segs {
+		switch {
+		case seg == anySegments && len(res) > 0 && res[len(res)-1] == anySegments:
+		case isSingleWildcard(seg):
+			res = append(res, anySegment)
+		default:
+			res = append(res, seg)
+		}
+	}
+	return strings.Join(res, separator)
+}
@@ -0,0 +1,157 @@
+//go:build unit
+
+package reskey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		pattern string
+		key     string
+		want    bool
+	}{
+		{name: "完全相同", pattern: "/docs/spec.md", key: "/docs/spec.md", want: true},
+		{name: "普通段不同", pattern: "/docs/spec.md", key: "/docs/readme.md", want: false},
+		{name: "单段通配", pattern: "/docs/*", key: "/docs/spec.md", want: true},
+		{name: "单段通配不跨段", pattern: "/docs/*", key: "/docs/team-a/spec.md", want: false},
+		{name: "单段通配不匹配空段", pattern: "/docs/*", key: "/docs", want: false},
+		{name: "命名参数", pattern: "/orders/{id}/items", key: "/orders/123/items", want: true},
+		{name: "命名参数不跨段", pattern: "/orders/{id}/items", key: "/orders/1/2/items", want: false},
+		{name: "多段通配", pattern: "/docs/**", key: "/docs/team-a/spec.md", want: true},
+		{name: "多段通配匹配零段", pattern: "/docs/**", key: "/docs", want: true},
+		{name: "多段通配在中间", pattern: "/docs/**/spec.md", key: "/docs/a/b/spec.md", want: true},
+		{name: "多段通配在中间匹配零段", pattern: "/docs/**/spec.md", key: "/docs/spec.md", want: true},
+		{name: "多段通配之后不匹配", pattern: "/docs/**/spec.md", key: "/docs/a/readme.md", want: false},
+		{name: "前缀不同", pattern: "/docs/**", key: "/orders/1", want: false},
+		{name: "根上的多段通配", pattern: "/**", key: "/orders/1", want: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, Match(tc.pattern, tc.key))
+		})
+	}
+}
+
+func TestOverlap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "完全相同", a: "/docs/spec.md", b: "/docs/spec.md", want: true},
+		{name: "普通段不同", a: "/docs/spec.md", b: "/docs/readme.md", want: false},
+		{name: "单段通配和普通段", a: "/docs/*", b: "/docs/spec.md", want: true},
+		{name: "段数不同", a: "/docs/*", b: "/docs/a/spec.md", want: false},
+		{name: "多段通配和普通段", a: "/docs/**", b: "/docs/a/spec.md", want: true},
+		{name: "多段通配匹配零段", a: "/docs/**", b: "/docs", want: true},
+		{name: "两边都有多段通配", a: "/docs/**/spec.md", b: "/docs/a/**", want: true},
+		{name: "多段通配之后的普通段不同", a: "/docs/**/spec.md", b: "/docs/*/readme.md", want: false},
+		{name: "前缀不同", a: "/docs/**", b: "/orders/**", want: false},
+		{name: "命名参数", a: "/orders/{id}/items", b: "/orders/1/*", want: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, Overlap(tc.a, tc.b))
+			assert.Equal(t, tc.want, Overlap(tc.b, tc.a))
+		})
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "完全相同", a: "/docs/spec.md", b: "/docs/spec.md", want: true},
+		{name: "命名参数和单段通配", a: "/orders/{id}", b: "/orders/*", want: true},
+		{name: "连续的多段通配", a: "/docs/**/**", b: "/docs/**", want: true},
+		{name: "单段通配和多段通配", a: "/docs/*", b: "/docs/**", want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, Equivalent(tc.a, tc.b))
+		})
+	}
+}
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+
+	type grant struct {
+		pattern string
+		deny    bool
+	}
+	testCases := []struct {
+		name      string
+		grants    []grant
+		want      Decision
+		wantIndex int
+	}{
+		{
+			name: "没有授权",
+			want: Undecided,
+		},
+		{
+			name:      "更具体的允许覆盖宽泛的拒绝",
+			grants:    []grant{{pattern: "/docs/**", deny: true}, {pattern: "/docs/public/*"}},
+			want:      Allow,
+			wantIndex: 1,
+		},
+		{
+			name:      "更具体的拒绝覆盖宽泛的允许",
+			grants:    []grant{{pattern: "/docs/public/spec.md", deny: true}, {pattern: "/docs/**"}},
+			want:      Deny,
+			wantIndex: 0,
+		},
+		{
+			name:      "单段通配比多段通配更具体",
+			grants:    []grant{{pattern: "/docs/**"}, {pattern: "/docs/*", deny: true}},
+			want:      Deny,
+			wantIndex: 1,
+		},
+		{
+			name:      "同样具体的时候拒绝优先",
+			grants:    []grant{{pattern: "/docs/spec.md"}, {pattern: "/docs/spec.md", deny: true}, {pattern: "/docs/spec.md"}},
+			want:      Deny,
+			wantIndex: 1,
+		},
+		{
+			name:      "完全相同的资源标识比中间带多段通配的更具体",
+			grants:    []grant{{pattern: "/docs/**/spec.md", deny: true}, {pattern: "/docs/spec.md"}},
+			want:      Allow,
+			wantIndex: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var r Resolver
+			for i, g := range tc.grants {
+				r.Add(g.pattern, g.deny, i)
+			}
+			assert.Equal(t, tc.want, r.Decision())
+			if tc.want != Undecided {
+				assert.Equal(t, tc.wantIndex, r.Index())
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ INSERT INTO `permission`.`permissions` (`id`, `biz_id`, `name`, `description`, `
 -- 权限策略关联
 INSERT INTO `permission`.`permission_policies` (`id`, `biz_id`, `effect`, `permission_id`, `policy_id`, `ctime`, `utime`) VALUES (44, 10000, 'allow', 63, 44, 1747834083712, 1747834083712);
 -- 策略
-INSERT INTO `permission`.`policies` (`id`, `biz_id`, `name`, `description`, `status`, `ctime`, `utime`, `execute_type`) VALUES (44, 10000, '代码仓库读取策略', '允许用户读取代码仓库', 'active', 1747834083697, 1747834083697, 'logic');
+INSERT INTO `permission`.`policies` (`id`, `biz_id`, `name`, `description`, `status`, `ctime`, `utime`, `execute_type`, `published_version`) VALUES (44, 10000, '代码仓库读取策略', '允许用户读取代码仓库', 'active', 1747834083697, 1747834083697, 'logic', 1);
+-- 策略版本，只有发布之后的版本才会生效
+INSERT INTO `permission`.`policy_versions` (`id`, `biz_id`, `policy_id`, `version`, `snapshot`, `ctime`) VALUES (44, 10000, 44, 1, '{"policy":{"ID":44,"BizID":10000,"name":"代码仓库读取策略","description":"允许用户读取代码仓库","status":"active","ExecuteType":"logic","Expression":"","PublishedVersion":0,"Ctime":1747834083697,"Utime":1747834083697},"rules":[{"ID":10010,"BizID":10000,"PolicyID":44,"AttrDefID":10006,"Value":"[\\"程序员\\",\\"经理\\"]","ValueAttrDefID":0,"Left":0,"Right":0,"Operator":"IN","Ctime":0,"Utime":1747834083698},{"ID":10011,"BizID":10000,"PolicyID":44,"AttrDefID":10007,"Value":"@day(20:28)","ValueAttrDefID":0,"Left":0,"Right":0,"Operator":"\\u003e=","Ctime":0,"Utime":1747834083700},{"ID":10012,"BizID":10000,"PolicyID":44,"AttrDefID":10007,"Value":"@day(22:28)","ValueAttrDefID":0,"Left":0,"Right":0,"Operator":"\\u003c=","Ctime":0,"Utime":1747834083702},{"ID":10013,"BizID":10000,"PolicyID":44,"AttrDefID":10008,"Value":"办公室","ValueAttrDefID":0,"Left":0,"Right":0,"Operator":"=","Ctime":0,"Utime":1747834083704},{"ID":10014,"BizID":10000,"PolicyID":44,"AttrDefID":10009,"Value":"user.com","ValueAttrDefID":0,"Left":0,"Right":0,"Operator":"=","Ctime":0,"Utime":1747834083705},{"ID":10015,"BizID":10000,"PolicyID":44,"AttrDefID":0,"Value":"","ValueAttrDefID":0,"Left":10010,"Right":10011,"Operator":"AND","Ctime":0,"Utime":1747834083707},{"ID":10016,"BizID":10000,"PolicyID":44,"AttrDefID":0,"Value":"","ValueAttrDefID":0,"Left":10015,"Right":10012,"Operator":"AND","Ctime":0,"Utime":1747834083708},{"ID":10017,"BizID":10000,"PolicyID":44,"AttrDefID":0,"Value":"","ValueAttrDefID":0,"Left":10016,"Right":10013,"Operator":"AND","Ctime":0,"Utime":1747834083710},{"ID":10018,"BizID":10000,"PolicyID":44,"AttrDefID":0,"Value":"","ValueAttrDefID":0,"Left":10017,"Right":10014,"Operator":"AND","Ctime":0,"Utime":1747834083711}],"permissionPolicies":[{"ID":44,"BizID":10000,"Effect":"allow","PermissionID":63,"PolicyID":44,"Ctime":1747834083712,"Utime":1747834083712}]}', 1747834083712);
 
 -- 策略规则
 INSERT INTO `permission`.`policy_rules` (`id`, `biz_id`, `policy_id`, `attr_def_id`, `value`, `left`, `right`, `operator`, `ctime`, `utime`) VALUES (10010, 10000, 44, 10006, '[\"程序员\",\"经理\"]', 0, 0, 'IN', 0, 1747834083698);
@@ -197,6 +197,7 @@ CREATE TABLE `policies` (
   `description` text COMMENT '策略描述',
   `execute_type` varchar(255) NOT NULL DEFAULT 'logic' COMMENT '策略执行类型',
   `status` enum('active','inactive') NOT NULL DEFAULT 'active' COMMENT '策略状态',
+  `published_version` bigint NOT NULL DEFAULT '0' COMMENT '当前生效的版本',
   `ctime` bigint DEFAULT NULL COMMENT '创建时间',
   `utime` bigint DEFAULT NULL COMMENT '更新时间',
   PRIMARY KEY (`id`),
@@ -249,6 +250,35 @@ LOCK TABLES `policy_rules` WRITE;
 /*!40000 ALTER TABLE `policy_rules` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `policy_versions`
+--
+
+DROP TABLE IF EXISTS `policy_versions`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `policy_versions` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `biz_id` bigint DEFAULT NULL COMMENT '业务ID',
+  `policy_id` bigint NOT NULL COMMENT '策略ID',
+  `version` bigint NOT NULL COMMENT '版本号，同一个策略内递增',
+  `snapshot` longtext COMMENT '策略快照',
+  `ctime` bigint DEFAULT NULL COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_policy_version` (`policy_id`,`version`),
+  KEY `idx_biz_id` (`biz_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `policy_versions`
+--
+
+LOCK TABLES `policy_versions` WRITE;
+/*!40000 ALTER TABLE `policy_versions` DISABLE KEYS */;
+/*!40000 ALTER TABLE `policy_versions` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `resource_attribute_values`
 --
@@ -402,6 +432,8 @@ CREATE TABLE `roles` (
   `name` varchar(255) NOT NULL COMMENT '''角色名称（被冗余，创建后不可修改）''',
   `description` text COMMENT '''角色描述''',
   `metadata` text COMMENT '''角色元数据，可扩展字段''',
+  `max_members` bigint NOT NULL DEFAULT '0' COMMENT '''最多授予多少个用户，0 表示不限制''',
+  `max_roles_per_user` bigint NOT NULL DEFAULT '0' COMMENT '''拥有该角色的用户最多拥有多少个角色，0 表示不限制''',
   `ctime` bigint DEFAULT NULL,
   `utime` bigint DEFAULT NULL,
   PRIMARY KEY (`id`),
@@ -417,7 +449,7 @@ CREATE TABLE `roles` (
 
 LOCK TABLES `roles` WRITE;
 /*!40000 ALTER TABLE `roles` DISABLE KEYS */;
-INSERT INTO `roles` VALUES (1,1,'admin_account','权限平台管理后台系统管理员','具有权限平台管理后台内最高管理权限','',1747542224070,1747542224070);
+INSERT INTO `roles` VALUES (1,1,'admin_account','权限平台管理后台系统管理员','具有权限平台管理后台内最高管理权限','',0,0,1747542224070,1747542224070);
 /*!40000 ALTER TABLE `roles` ENABLE KEYS */;
 UNLOCK TABLES;
 
@@ -495,6 +527,32 @@ LOCK TABLES `user_permissions` WRITE;
 /*!40000 ALTER TABLE `user_permissions` ENABLE KEYS */;
 UNLOCK TABLES;
 
+--
+-- Table structure for table `user_role_locks`
+--
+
+DROP TABLE IF EXISTS `user_role_locks`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `user_role_locks` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT '''加锁记录主键''',
+  `biz_id` bigint NOT NULL COMMENT '''业务ID''',
+  `user_id` bigint NOT NULL COMMENT '''用户ID''',
+  `ctime` bigint DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_biz_user` (`biz_id`,`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `user_role_locks`
+--
+
+LOCK TABLES `user_role_locks` WRITE;
+/*!40000 ALTER TABLE `user_role_locks` DISABLE KEYS */;
+/*!40000 ALTER TABLE `user_role_locks` ENABLE KEYS */;
+UNLOCK TABLES;
+
 --
 -- Table structure for table `user_roles`
 --